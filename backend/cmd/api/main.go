@@ -1,14 +1,21 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"strconv"
+	"time"
+	// Users' timezones must load even where the system has no zoneinfo
+	_ "time/tzdata"
 
 	"good-todo-go/internal/domain/repository"
 	"good-todo-go/internal/ent/generated"
+	"good-todo-go/internal/infrastructure/database"
 	"good-todo-go/internal/infrastructure/environment"
 	infrarepo "good-todo-go/internal/infrastructure/repository"
 	"good-todo-go/internal/pkg"
+	"good-todo-go/internal/presentation/job"
 	"good-todo-go/internal/presentation/public/api"
 	"good-todo-go/internal/presentation/public/controller"
 	"good-todo-go/internal/presentation/public/presenter"
@@ -19,12 +26,6 @@ import (
 	"github.com/labstack/echo/v4"
 	echomiddleware "github.com/labstack/echo/v4/middleware"
 	"go.uber.org/dig"
-
-	"database/sql"
-
-	"entgo.io/ent/dialect"
-	entsql "entgo.io/ent/dialect/sql"
-	_ "github.com/lib/pq"
 )
 
 func main() {
@@ -36,13 +37,11 @@ func main() {
 	}
 
 	// Database
-	if err := container.Provide(func(env *environment.Environment) (*generated.Client, error) {
-		db, err := sql.Open("postgres", env.GetAppDSN())
-		if err != nil {
-			return nil, fmt.Errorf("failed to open database: %w", err)
-		}
-		drv := entsql.OpenDB(dialect.Postgres, db)
-		return generated.NewClient(generated.Driver(drv)), nil
+	if err := container.Provide(database.NewDatabase); err != nil {
+		log.Fatal(err)
+	}
+	if err := container.Provide(func(db *database.Database) *generated.Client {
+		return db.Client
 	}); err != nil {
 		log.Fatal(err)
 	}
@@ -61,8 +60,16 @@ func main() {
 	}); err != nil {
 		log.Fatal(err)
 	}
+	if err := container.Provide(pkg.NewClock); err != nil {
+		log.Fatal(err)
+	}
 
 	// Repositories
+	if err := container.Provide(func(db *database.Database) repository.IUnitOfWork {
+		return infrarepo.NewUnitOfWork(db.DB)
+	}); err != nil {
+		log.Fatal(err)
+	}
 	if err := container.Provide(func(client *generated.Client) repository.ITenantRepository {
 		return infrarepo.NewTenantRepository(client)
 	}); err != nil {
@@ -73,37 +80,207 @@ func main() {
 	}); err != nil {
 		log.Fatal(err)
 	}
+	if err := container.Provide(func(client *generated.Client) repository.IMembershipRepository {
+		return infrarepo.NewMembershipRepository(client)
+	}); err != nil {
+		log.Fatal(err)
+	}
 	if err := container.Provide(func(client *generated.Client) repository.ITodoRepository {
 		return infrarepo.NewTodoRepository(client)
 	}); err != nil {
 		log.Fatal(err)
 	}
+	if err := container.Provide(func(client *generated.Client) repository.ITagRepository {
+		return infrarepo.NewTagRepository(client)
+	}); err != nil {
+		log.Fatal(err)
+	}
+	if err := container.Provide(func(client *generated.Client) repository.IProjectRepository {
+		return infrarepo.NewProjectRepository(client)
+	}); err != nil {
+		log.Fatal(err)
+	}
+	if err := container.Provide(func(client *generated.Client) repository.IReminderRepository {
+		return infrarepo.NewReminderRepository(client)
+	}); err != nil {
+		log.Fatal(err)
+	}
+	if err := container.Provide(func(client *generated.Client) repository.ICommentRepository {
+		return infrarepo.NewCommentRepository(client)
+	}); err != nil {
+		log.Fatal(err)
+	}
+	if err := container.Provide(func(client *generated.Client) repository.ITodoActivityRepository {
+		return infrarepo.NewTodoActivityRepository(client)
+	}); err != nil {
+		log.Fatal(err)
+	}
+	if err := container.Provide(func(client *generated.Client) repository.ITenantDomainRepository {
+		return infrarepo.NewTenantDomainRepository(client)
+	}); err != nil {
+		log.Fatal(err)
+	}
+	if err := container.Provide(func() repository.IDNSRepository {
+		return infrarepo.NewDNSRepository()
+	}); err != nil {
+		log.Fatal(err)
+	}
 	if err := container.Provide(func(env *environment.Environment) repository.IAuthRepository {
 		return infrarepo.NewAuthRepository(env)
 	}); err != nil {
 		log.Fatal(err)
 	}
+	if err := container.Provide(func(env *environment.Environment) repository.IMailRepository {
+		return infrarepo.NewMailRepository(env)
+	}); err != nil {
+		log.Fatal(err)
+	}
 
 	// Usecases
+	if err := container.Provide(func() usecase.IPermissionEvaluator {
+		return usecase.NewPermissionEvaluator(usecase.DefaultPermissionRules())
+	}); err != nil {
+		log.Fatal(err)
+	}
 	if err := container.Provide(func(
+		unitOfWork repository.IUnitOfWork,
 		tenantRepo repository.ITenantRepository,
 		userRepo repository.IUserRepository,
+		membershipRepo repository.IMembershipRepository,
 		authRepo repository.IAuthRepository,
 		jwtService *pkg.JWTService,
 		passwordService *pkg.PasswordService,
 		uuidGenerator pkg.IUUIDGenerator,
 	) usecase.IAuthInteractor {
-		return usecase.NewAuthInteractor(tenantRepo, userRepo, authRepo, jwtService, passwordService, uuidGenerator)
+		return usecase.NewAuthInteractor(unitOfWork, tenantRepo, userRepo, membershipRepo, authRepo, jwtService, passwordService, uuidGenerator)
 	}); err != nil {
 		log.Fatal(err)
 	}
-	if err := container.Provide(func(userRepo repository.IUserRepository) usecase.IUserInteractor {
-		return usecase.NewUserInteractor(userRepo)
+	if err := container.Provide(func(
+		userRepo repository.IUserRepository,
+		membershipRepo repository.IMembershipRepository,
+		permission usecase.IPermissionEvaluator,
+	) usecase.IUserInteractor {
+		return usecase.NewUserInteractor(userRepo, membershipRepo, permission)
 	}); err != nil {
 		log.Fatal(err)
 	}
-	if err := container.Provide(func(todoRepo repository.ITodoRepository, uuidGenerator pkg.IUUIDGenerator) usecase.ITodoInteractor {
-		return usecase.NewTodoInteractor(todoRepo, uuidGenerator)
+	if err := container.Provide(func(
+		unitOfWork repository.IUnitOfWork,
+		tenantRepo repository.ITenantRepository,
+		tenantDomainRepo repository.ITenantDomainRepository,
+		dnsRepo repository.IDNSRepository,
+		permission usecase.IPermissionEvaluator,
+		uuidGenerator pkg.IUUIDGenerator,
+	) usecase.ITenantInteractor {
+		return usecase.NewTenantInteractor(unitOfWork, tenantRepo, tenantDomainRepo, dnsRepo, permission, uuidGenerator)
+	}); err != nil {
+		log.Fatal(err)
+	}
+	if err := container.Provide(func(
+		env *environment.Environment,
+		unitOfWork repository.IUnitOfWork,
+		todoRepo repository.ITodoRepository,
+		activityRepo repository.ITodoActivityRepository,
+		reminderRepo repository.IReminderRepository,
+		tagRepo repository.ITagRepository,
+		projectRepo repository.IProjectRepository,
+		userRepo repository.IUserRepository,
+		membershipRepo repository.IMembershipRepository,
+		mailRepo repository.IMailRepository,
+		permission usecase.IPermissionEvaluator,
+		uuidGenerator pkg.IUUIDGenerator,
+		clock pkg.IClock,
+	) (usecase.ITodoInteractor, error) {
+		maxSubtaskDepth, err := strconv.Atoi(env.MaxSubtaskDepth)
+		if err != nil || maxSubtaskDepth < 1 {
+			return nil, fmt.Errorf("invalid MAX_SUBTASK_DEPTH %q", env.MaxSubtaskDepth)
+		}
+		return usecase.NewTodoInteractor(unitOfWork, todoRepo, activityRepo, reminderRepo, tagRepo, projectRepo, userRepo, membershipRepo, mailRepo, permission, uuidGenerator, clock, maxSubtaskDepth), nil
+	}); err != nil {
+		log.Fatal(err)
+	}
+	if err := container.Provide(func(
+		unitOfWork repository.IUnitOfWork,
+		projectRepo repository.IProjectRepository,
+		todoRepo repository.ITodoRepository,
+		permission usecase.IPermissionEvaluator,
+		uuidGenerator pkg.IUUIDGenerator,
+	) usecase.IProjectInteractor {
+		return usecase.NewProjectInteractor(unitOfWork, projectRepo, todoRepo, permission, uuidGenerator)
+	}); err != nil {
+		log.Fatal(err)
+	}
+	if err := container.Provide(func(
+		tagRepo repository.ITagRepository,
+		permission usecase.IPermissionEvaluator,
+		uuidGenerator pkg.IUUIDGenerator,
+	) usecase.ITagInteractor {
+		return usecase.NewTagInteractor(tagRepo, permission, uuidGenerator)
+	}); err != nil {
+		log.Fatal(err)
+	}
+	if err := container.Provide(func(
+		reminderRepo repository.IReminderRepository,
+		todoRepo repository.ITodoRepository,
+		permission usecase.IPermissionEvaluator,
+		uuidGenerator pkg.IUUIDGenerator,
+		clock pkg.IClock,
+	) usecase.IReminderInteractor {
+		return usecase.NewReminderInteractor(reminderRepo, todoRepo, permission, uuidGenerator, clock)
+	}); err != nil {
+		log.Fatal(err)
+	}
+	if err := container.Provide(func(
+		commentRepo repository.ICommentRepository,
+		todoRepo repository.ITodoRepository,
+		userRepo repository.IUserRepository,
+		membershipRepo repository.IMembershipRepository,
+		mailRepo repository.IMailRepository,
+		permission usecase.IPermissionEvaluator,
+		uuidGenerator pkg.IUUIDGenerator,
+		clock pkg.IClock,
+	) usecase.ICommentInteractor {
+		return usecase.NewCommentInteractor(commentRepo, todoRepo, userRepo, membershipRepo, mailRepo, permission, uuidGenerator, clock)
+	}); err != nil {
+		log.Fatal(err)
+	}
+	if err := container.Provide(func(
+		unitOfWork repository.IUnitOfWork,
+		tenantRepo repository.ITenantRepository,
+		todoRepo repository.ITodoRepository,
+	) usecase.ITodoPurgeInteractor {
+		return usecase.NewTodoPurgeInteractor(unitOfWork, tenantRepo, todoRepo)
+	}); err != nil {
+		log.Fatal(err)
+	}
+	if err := container.Provide(func(
+		unitOfWork repository.IUnitOfWork,
+		tenantRepo repository.ITenantRepository,
+		todoRepo repository.ITodoRepository,
+	) usecase.ITodoRebalanceInteractor {
+		return usecase.NewTodoRebalanceInteractor(unitOfWork, tenantRepo, todoRepo, usecase.DefaultMaxTodoPositionLength)
+	}); err != nil {
+		log.Fatal(err)
+	}
+	if err := container.Provide(func(
+		unitOfWork repository.IUnitOfWork,
+		tenantRepo repository.ITenantRepository,
+		reminderRepo repository.IReminderRepository,
+		mailRepo repository.IMailRepository,
+	) usecase.IReminderDeliveryInteractor {
+		return usecase.NewReminderDeliveryInteractor(unitOfWork, tenantRepo, reminderRepo, mailRepo)
+	}); err != nil {
+		log.Fatal(err)
+	}
+	if err := container.Provide(func(
+		unitOfWork repository.IUnitOfWork,
+		tenantRepo repository.ITenantRepository,
+		membershipRepo repository.IMembershipRepository,
+		todoRepo repository.ITodoRepository,
+		mailRepo repository.IMailRepository,
+	) usecase.IDigestInteractor {
+		return usecase.NewDigestInteractor(unitOfWork, tenantRepo, membershipRepo, todoRepo, mailRepo)
 	}); err != nil {
 		log.Fatal(err)
 	}
@@ -119,11 +296,36 @@ func main() {
 	}); err != nil {
 		log.Fatal(err)
 	}
+	if err := container.Provide(func() presenter.ITenantPresenter {
+		return presenter.NewTenantPresenter()
+	}); err != nil {
+		log.Fatal(err)
+	}
 	if err := container.Provide(func() presenter.ITodoPresenter {
 		return presenter.NewTodoPresenter()
 	}); err != nil {
 		log.Fatal(err)
 	}
+	if err := container.Provide(func() presenter.ITagPresenter {
+		return presenter.NewTagPresenter()
+	}); err != nil {
+		log.Fatal(err)
+	}
+	if err := container.Provide(func() presenter.IProjectPresenter {
+		return presenter.NewProjectPresenter()
+	}); err != nil {
+		log.Fatal(err)
+	}
+	if err := container.Provide(func() presenter.IReminderPresenter {
+		return presenter.NewReminderPresenter()
+	}); err != nil {
+		log.Fatal(err)
+	}
+	if err := container.Provide(func() presenter.ICommentPresenter {
+		return presenter.NewCommentPresenter()
+	}); err != nil {
+		log.Fatal(err)
+	}
 
 	// Controllers
 	if err := container.Provide(func(
@@ -143,6 +345,14 @@ func main() {
 	}); err != nil {
 		log.Fatal(err)
 	}
+	if err := container.Provide(func(
+		tenantUsecase usecase.ITenantInteractor,
+		tenantPresenter presenter.ITenantPresenter,
+	) *controller.TenantController {
+		return controller.NewTenantController(tenantUsecase, tenantPresenter)
+	}); err != nil {
+		log.Fatal(err)
+	}
 	if err := container.Provide(func(
 		todoUsecase usecase.ITodoInteractor,
 		todoPresenter presenter.ITodoPresenter,
@@ -151,6 +361,38 @@ func main() {
 	}); err != nil {
 		log.Fatal(err)
 	}
+	if err := container.Provide(func(
+		tagUsecase usecase.ITagInteractor,
+		tagPresenter presenter.ITagPresenter,
+	) *controller.TagController {
+		return controller.NewTagController(tagUsecase, tagPresenter)
+	}); err != nil {
+		log.Fatal(err)
+	}
+	if err := container.Provide(func(
+		projectUsecase usecase.IProjectInteractor,
+		projectPresenter presenter.IProjectPresenter,
+	) *controller.ProjectController {
+		return controller.NewProjectController(projectUsecase, projectPresenter)
+	}); err != nil {
+		log.Fatal(err)
+	}
+	if err := container.Provide(func(
+		reminderUsecase usecase.IReminderInteractor,
+		reminderPresenter presenter.IReminderPresenter,
+	) *controller.ReminderController {
+		return controller.NewReminderController(reminderUsecase, reminderPresenter)
+	}); err != nil {
+		log.Fatal(err)
+	}
+	if err := container.Provide(func(
+		commentUsecase usecase.ICommentInteractor,
+		commentPresenter presenter.ICommentPresenter,
+	) *controller.CommentController {
+		return controller.NewCommentController(commentUsecase, commentPresenter)
+	}); err != nil {
+		log.Fatal(err)
+	}
 
 	// Middleware
 	if err := container.Provide(func(jwtService *pkg.JWTService) *middleware.JWTAuthMiddleware {
@@ -158,6 +400,65 @@ func main() {
 	}); err != nil {
 		log.Fatal(err)
 	}
+	if err := container.Provide(func(
+		env *environment.Environment,
+		tenantRepo repository.ITenantRepository,
+		clock pkg.IClock,
+	) *middleware.TenantResolverMiddleware {
+		return middleware.NewTenantResolverMiddleware(tenantRepo, env.BaseDomain, clock)
+	}); err != nil {
+		log.Fatal(err)
+	}
+
+	// Jobs
+	if err := container.Provide(func(
+		env *environment.Environment,
+		purgeUsecase usecase.ITodoPurgeInteractor,
+	) (*job.TrashPurger, error) {
+		interval, err := time.ParseDuration(env.TrashPurgeInterval)
+		if err != nil || interval <= 0 {
+			return nil, fmt.Errorf("invalid TRASH_PURGE_INTERVAL %q", env.TrashPurgeInterval)
+		}
+		return job.NewTrashPurger(purgeUsecase, interval), nil
+	}); err != nil {
+		log.Fatal(err)
+	}
+	if err := container.Provide(func(
+		env *environment.Environment,
+		rebalanceUsecase usecase.ITodoRebalanceInteractor,
+	) (*job.PositionRebalancer, error) {
+		interval, err := time.ParseDuration(env.PositionRebalanceInterval)
+		if err != nil || interval <= 0 {
+			return nil, fmt.Errorf("invalid POSITION_REBALANCE_INTERVAL %q", env.PositionRebalanceInterval)
+		}
+		return job.NewPositionRebalancer(rebalanceUsecase, interval), nil
+	}); err != nil {
+		log.Fatal(err)
+	}
+	if err := container.Provide(func(
+		env *environment.Environment,
+		deliveryUsecase usecase.IReminderDeliveryInteractor,
+	) (*job.ReminderScheduler, error) {
+		interval, err := time.ParseDuration(env.ReminderInterval)
+		if err != nil || interval <= 0 {
+			return nil, fmt.Errorf("invalid REMINDER_INTERVAL %q", env.ReminderInterval)
+		}
+		return job.NewReminderScheduler(deliveryUsecase, interval), nil
+	}); err != nil {
+		log.Fatal(err)
+	}
+	if err := container.Provide(func(
+		env *environment.Environment,
+		digestUsecase usecase.IDigestInteractor,
+	) (*job.DigestSender, error) {
+		interval, err := time.ParseDuration(env.DigestInterval)
+		if err != nil || interval <= 0 {
+			return nil, fmt.Errorf("invalid DIGEST_INTERVAL %q", env.DigestInterval)
+		}
+		return job.NewDigestSender(digestUsecase, interval), nil
+	}); err != nil {
+		log.Fatal(err)
+	}
 
 	// Server
 	if err := container.Provide(router.NewServer); err != nil {
@@ -169,14 +470,26 @@ func main() {
 		env *environment.Environment,
 		server *router.Server,
 		jwtAuth *middleware.JWTAuthMiddleware,
+		tenantResolver *middleware.TenantResolverMiddleware,
+		trashPurger *job.TrashPurger,
+		positionRebalancer *job.PositionRebalancer,
+		reminderScheduler *job.ReminderScheduler,
+		digestSender *job.DigestSender,
 	) error {
+		go trashPurger.Run(context.Background())
+		go positionRebalancer.Run(context.Background())
+		go reminderScheduler.Run(context.Background())
+		go digestSender.Run(context.Background())
+
 		e := echo.New()
 		e.Use(echomiddleware.Logger())
 		e.Use(echomiddleware.Recover())
 		e.Use(echomiddleware.CORSWithConfig(echomiddleware.CORSConfig{
 			AllowOrigins: []string{"http://localhost:3000"},
-			AllowMethods: []string{echo.GET, echo.POST, echo.PUT, echo.DELETE, echo.OPTIONS},
-			AllowHeaders: []string{echo.HeaderOrigin, echo.HeaderContentType, echo.HeaderAccept, echo.HeaderAuthorization},
+			AllowMethods: []string{echo.GET, echo.POST, echo.PUT, echo.PATCH, echo.DELETE, echo.OPTIONS},
+			AllowHeaders: []string{echo.HeaderOrigin, echo.HeaderContentType, echo.HeaderAccept, echo.HeaderAuthorization, "If-Match"},
+			// Clients read the ETag to send it back as If-Match
+			ExposeHeaders: []string{"ETag"},
 		}))
 
 		// Register routes with authentication middleware for protected endpoints
@@ -191,9 +504,11 @@ func main() {
 		apiGroup.POST("/auth/register", func(c echo.Context) error {
 			return server.Register(c)
 		})
+		// Login and the protected routes resolve the tenant from the Host header, before
+		// authentication checks the token against it
 		apiGroup.POST("/auth/login", func(c echo.Context) error {
 			return server.Login(c)
-		})
+		}, tenantResolver.Resolve)
 		apiGroup.POST("/auth/verify-email", func(c echo.Context) error {
 			return server.VerifyEmail(c)
 		})
@@ -202,27 +517,139 @@ func main() {
 		})
 
 		// Protected routes
-		protected := apiGroup.Group("", jwtAuth.Authenticate)
+		protected := apiGroup.Group("", tenantResolver.Resolve, jwtAuth.Authenticate)
 		protected.GET("/me", func(c echo.Context) error {
 			return server.GetMe(c)
 		})
 		protected.PUT("/me", func(c echo.Context) error {
 			return server.UpdateMe(c)
 		})
-		protected.GET("/todos", func(c echo.Context) error {
-			return server.ListTodos(c)
+		protected.GET("/me/digest", func(c echo.Context) error {
+			return server.GetMyDigest(c)
+		})
+		protected.PUT("/me/digest", func(c echo.Context) error {
+			return server.UpdateMyDigest(c)
+		})
+		protected.GET("/me/tenants", func(c echo.Context) error {
+			return server.ListMyTenants(c)
+		})
+		protected.POST("/me/tenants", func(c echo.Context) error {
+			return server.CreateMyTenant(c)
+		})
+		protected.POST("/auth/switch-tenant", func(c echo.Context) error {
+			return server.SwitchTenant(c)
+		})
+		protected.GET("/tenant", func(c echo.Context) error {
+			return server.GetTenant(c)
+		})
+		protected.PUT("/tenant", func(c echo.Context) error {
+			return server.UpdateTenant(c)
+		})
+		protected.GET("/tenant/domains", func(c echo.Context) error {
+			return server.ListTenantDomains(c)
+		})
+		protected.POST("/tenant/domains", func(c echo.Context) error {
+			return server.AddTenantDomain(c)
 		})
-		protected.GET("/todos-public", func(c echo.Context) error {
-			return server.ListPublicTodos(c)
+		protected.DELETE("/tenant/domains/:id", func(c echo.Context) error {
+			return server.RemoveTenantDomain(c, c.Param("id"))
+		})
+		protected.POST("/tenant/domains/:id/verify", func(c echo.Context) error {
+			return server.VerifyTenantDomain(c, c.Param("id"))
+		})
+		protected.GET("/tags", func(c echo.Context) error {
+			return server.ListTags(c)
+		})
+		protected.POST("/tags", func(c echo.Context) error {
+			return server.CreateTag(c)
+		})
+		protected.PATCH("/tags/:id", func(c echo.Context) error {
+			return server.UpdateTag(c, c.Param("id"))
+		})
+		protected.DELETE("/tags/:id", func(c echo.Context) error {
+			return server.DeleteTag(c, c.Param("id"))
+		})
+		// Operations with query or header parameters go through the generated wrapper for binding
+		wrapper := api.ServerInterfaceWrapper{Handler: server}
+		protected.GET("/todos", wrapper.ListTodos)
+		protected.GET("/todos-public", wrapper.ListPublicTodos)
+		protected.GET("/todos/assigned-to-me", wrapper.ListAssignedTodos)
+		protected.GET("/todos/search", wrapper.SearchTodos)
+		protected.GET("/todos/trash", wrapper.ListTrashedTodos)
+		protected.GET("/todos/views/:view", wrapper.ListTodoView)
+		protected.GET("/projects", wrapper.ListProjects)
+		protected.POST("/projects", func(c echo.Context) error {
+			return server.CreateProject(c)
+		})
+		protected.GET("/projects/:id", func(c echo.Context) error {
+			return server.GetProject(c, c.Param("id"))
+		})
+		protected.PATCH("/projects/:id", func(c echo.Context) error {
+			return server.UpdateProject(c, c.Param("id"))
+		})
+		protected.DELETE("/projects/:id", wrapper.DeleteProject)
+		protected.GET("/projects/:id/todos", wrapper.ListProjectTodos)
+		protected.POST("/todos/batch", func(c echo.Context) error {
+			return server.BatchTodos(c)
 		})
 		protected.POST("/todos", func(c echo.Context) error {
 			return server.CreateTodo(c)
 		})
-		protected.PUT("/todos/:id", func(c echo.Context) error {
-			return server.UpdateTodo(c, c.Param("id"))
+		protected.PUT("/todos/:id", wrapper.UpdateTodo)
+		protected.PATCH("/todos/:id", wrapper.PatchTodo)
+		protected.DELETE("/todos/:id", wrapper.DeleteTodo)
+		protected.POST("/todos/:id/restore", func(c echo.Context) error {
+			return server.RestoreTodo(c, c.Param("id"))
+		})
+		protected.GET("/todos/:id/occurrences", wrapper.ListTodoOccurrences)
+		protected.GET("/todos/:id/comments", wrapper.ListComments)
+		protected.POST("/todos/:id/comments", func(c echo.Context) error {
+			return server.CreateComment(c, c.Param("id"))
+		})
+		protected.PATCH("/todos/:id/comments/:comment_id", func(c echo.Context) error {
+			return server.UpdateComment(c, c.Param("id"), c.Param("comment_id"))
+		})
+		protected.DELETE("/todos/:id/comments/:comment_id", func(c echo.Context) error {
+			return server.DeleteComment(c, c.Param("id"), c.Param("comment_id"))
+		})
+		protected.GET("/todos/:id/reminders", func(c echo.Context) error {
+			return server.ListReminders(c, c.Param("id"))
+		})
+		protected.POST("/todos/:id/reminders", func(c echo.Context) error {
+			return server.CreateReminder(c, c.Param("id"))
+		})
+		protected.DELETE("/todos/:id/reminders/:reminder_id", func(c echo.Context) error {
+			return server.DeleteReminder(c, c.Param("id"), c.Param("reminder_id"))
+		})
+		protected.GET("/todos/:id/subtasks", func(c echo.Context) error {
+			return server.ListSubtasks(c, c.Param("id"))
+		})
+		protected.GET("/todos/:id/blockers", func(c echo.Context) error {
+			return server.ListBlockers(c, c.Param("id"))
+		})
+		protected.POST("/todos/:id/blockers", func(c echo.Context) error {
+			return server.AddBlocker(c, c.Param("id"))
+		})
+		protected.DELETE("/todos/:id/blockers/:blocker_id", func(c echo.Context) error {
+			return server.RemoveBlocker(c, c.Param("id"), c.Param("blocker_id"))
+		})
+		protected.POST("/todos/:id/move", func(c echo.Context) error {
+			return server.MoveTodo(c, c.Param("id"))
+		})
+		protected.PUT("/todos/:id/assignee", func(c echo.Context) error {
+			return server.AssignTodo(c, c.Param("id"))
+		})
+		protected.DELETE("/todos/:id/assignee", func(c echo.Context) error {
+			return server.UnassignTodo(c, c.Param("id"))
+		})
+		protected.POST("/todos/:id/snooze", func(c echo.Context) error {
+			return server.SnoozeTodo(c, c.Param("id"))
+		})
+		protected.DELETE("/todos/:id/snooze", func(c echo.Context) error {
+			return server.UnsnoozeTodo(c, c.Param("id"))
 		})
-		protected.DELETE("/todos/:id", func(c echo.Context) error {
-			return server.DeleteTodo(c, c.Param("id"))
+		protected.GET("/todos/:id/activity", func(c echo.Context) error {
+			return server.ListTodoActivity(c, c.Param("id"))
 		})
 
 		// Verify ServerInterface implementation
@@ -0,0 +1,26 @@
+package model
+
+import "time"
+
+// Comment is a member's markdown note on a todo. EditedAt is nil until the body is
+// first changed.
+type Comment struct {
+	ID        string
+	TenantID  string
+	TodoID    string
+	AuthorID  string
+	Body      string
+	EditedAt  *time.Time
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// CommentMention tells a member that a comment mentioned them, with what its email
+// needs. MentionedBy names the comment's author.
+type CommentMention struct {
+	TodoTitle   string
+	CommentBody string
+	MentionedBy string
+	Email       string
+	Name        string
+}
@@ -0,0 +1,27 @@
+package model
+
+import "time"
+
+// Digest is a member's daily summary of their own open todos in one tenant. Date is the
+// member's local day at midnight, in the location due dates are shown in.
+type Digest struct {
+	Email       string
+	Name        string
+	TenantName  string
+	Date        time.Time
+	Overdue     DigestSection
+	DueToday    DigestSection
+	DueThisWeek DigestSection
+}
+
+// DigestSection lists the todos of one part of a digest, soonest due first. More is set
+// when there were more todos than the digest lists.
+type DigestSection struct {
+	Todos []*Todo
+	More  bool
+}
+
+// IsEmpty reports whether the digest has nothing to tell.
+func (d *Digest) IsEmpty() bool {
+	return len(d.Overdue.Todos) == 0 && len(d.DueToday.Todos) == 0 && len(d.DueThisWeek.Todos) == 0
+}
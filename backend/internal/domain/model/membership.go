@@ -0,0 +1,24 @@
+package model
+
+import "time"
+
+// Membership grants a user a role in a tenant. It also holds the member's daily digest
+// preference: once DigestTime ("15:04") has passed in DigestTimezone, or the user's
+// timezone when nil, the member is emailed a digest unless DigestLastSentOn is that day.
+type Membership struct {
+	ID               string
+	TenantID         string
+	UserID           string
+	Role             UserRole
+	DigestEnabled    bool
+	DigestTime       string
+	DigestTimezone   *string
+	DigestLastSentOn *time.Time
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+
+	// Tenant is only loaded by queries that list memberships across tenants
+	Tenant *Tenant
+	// User is only loaded by queries that list the members to email
+	User *User
+}
@@ -0,0 +1,18 @@
+package model
+
+import "time"
+
+// Project groups todos. It is private to its owner unless IsPublic shares it with the
+// tenant. Archived projects are hidden from listings and take no new todos.
+type Project struct {
+	ID          string
+	TenantID    string
+	UserID      string
+	Name        string
+	Description string
+	Color       string
+	Archived    bool
+	IsPublic    bool
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
@@ -0,0 +1,40 @@
+package model
+
+import "time"
+
+type ReminderStatus string
+
+const (
+	ReminderStatusPending   ReminderStatus = "pending"
+	ReminderStatusDelivered ReminderStatus = "delivered"
+	ReminderStatusFailed    ReminderStatus = "failed"
+)
+
+// Reminder emails a todo's owner at RemindAt, or OffsetMinutes before the todo is due;
+// exactly one of the two is set. An offset follows the due date when it moves. A
+// reminder stays pending until it is delivered or runs out of attempts and fails.
+type Reminder struct {
+	ID            string
+	TenantID      string
+	TodoID        string
+	RemindAt      *time.Time
+	OffsetMinutes *int
+	Status        ReminderStatus
+	Attempts      int
+	NextAttemptAt *time.Time
+	LastError     *string
+	DeliveredAt   *time.Time
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// DueReminder is a reminder claimed for delivery, with what its email needs: the todo
+// and the owner it goes to. Timezone is the owner's, for showing the due date.
+type DueReminder struct {
+	Reminder  *Reminder
+	TodoTitle string
+	DueDate   *time.Time
+	Email     string
+	Name      string
+	Timezone  string
+}
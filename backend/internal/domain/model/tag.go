@@ -0,0 +1,13 @@
+package model
+
+import "time"
+
+// Tag labels todos across a tenant. Color is a #rrggbb hex color.
+type Tag struct {
+	ID        string
+	TenantID  string
+	Name      string
+	Color     string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
@@ -3,9 +3,21 @@ package model
 import "time"
 
 type Tenant struct {
-	ID        string
-	Name      string
-	Slug      string
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	ID   string
+	Name string
+	Slug string
+	// TrashRetentionDays is how long deleted todos can be restored before they are purged
+	TrashRetentionDays int
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+}
+
+// TenantDomain is a custom domain that resolves to a tenant once verified.
+type TenantDomain struct {
+	ID                string
+	TenantID          string
+	Domain            string
+	VerificationToken string
+	VerifiedAt        *time.Time
+	CreatedAt         time.Time
 }
@@ -2,16 +2,90 @@ package model
 
 import "time"
 
+// TodoPriority ranks todos from none up to urgent. Priorities compare in that order,
+// not by name.
+type TodoPriority string
+
+const (
+	TodoPriorityNone   TodoPriority = "none"
+	TodoPriorityLow    TodoPriority = "low"
+	TodoPriorityMedium TodoPriority = "medium"
+	TodoPriorityHigh   TodoPriority = "high"
+	TodoPriorityUrgent TodoPriority = "urgent"
+)
+
+// Valid reports whether p is one of the known priorities.
+func (p TodoPriority) Valid() bool {
+	switch p {
+	case TodoPriorityNone, TodoPriorityLow, TodoPriorityMedium, TodoPriorityHigh, TodoPriorityUrgent:
+		return true
+	}
+	return false
+}
+
+// Todo is a user's task. A nil ProjectID keeps it in the inbox and a nil ParentID makes
+// it a top-level todo rather than a subtask. Tags are sorted by name, and saving a todo
+// replaces its tags with exactly these. SubtaskCount and CompletedSubtaskCount count the
+// live subtasks, BlockedCount the open todos blocking this one and BlockingCount the open
+// todos it blocks; they are read-only. Position is the todo's key in its tenant's manual
+// order, which saving a todo leaves alone; only moving it changes the key. A todo with a
+// RecurrenceRule repeats from its DueDate in the wall clock of RecurrenceTimezone.
+// Listings leave a todo out while its SnoozedUntil is in the future. AssigneeID is the
+// member of the tenant the todo is delegated to, who may work on it next to its owner.
 type Todo struct {
-	ID          string
-	TenantID    string
-	UserID      string
-	Title       string
-	Description string
-	Completed   bool
-	IsPublic    bool
-	DueDate     *time.Time
-	CompletedAt *time.Time
-	CreatedAt   time.Time
-	UpdatedAt   time.Time
+	ID                    string
+	TenantID              string
+	UserID                string
+	AssigneeID            *string
+	ProjectID             *string
+	ParentID              *string
+	Title                 string
+	Description           string
+	Completed             bool
+	IsPublic              bool
+	AutoComplete          bool
+	Priority              TodoPriority
+	DueDate               *time.Time
+	RecurrenceRule        *string
+	RecurrenceTimezone    *string
+	CompletedAt           *time.Time
+	SnoozedUntil          *time.Time
+	Version               int
+	DeletedAt             *time.Time
+	Position              string
+	Tags                  []*Tag
+	SubtaskCount          int
+	CompletedSubtaskCount int
+	BlockedCount          int
+	BlockingCount         int
+	CreatedAt             time.Time
+	UpdatedAt             time.Time
+}
+
+// Highlight markers wrap matched terms in TodoSearchHit snippets. They are control
+// characters so they can never collide with user text.
+const (
+	HighlightStart = "\x02"
+	HighlightEnd   = "\x03"
+)
+
+// TodoSearchHit is a todo matched by a full-text search with its relevance and
+// highlighted title and description snippets.
+type TodoSearchHit struct {
+	Todo                 *Todo
+	Rank                 float64
+	TitleHighlight       string
+	DescriptionHighlight string
+}
+
+// TodoAssignment tells a member that a todo was assigned to them, with what its email
+// needs. AssignedBy names who assigned it and Timezone is the assignee's, for showing
+// the due date.
+type TodoAssignment struct {
+	TodoTitle  string
+	DueDate    *time.Time
+	AssignedBy string
+	Email      string
+	Name       string
+	Timezone   string
 }
@@ -0,0 +1,24 @@
+package model
+
+import "time"
+
+// TodoActivityAction is a request someone made on a todo. A snooze running out is not
+// one, so waking up is never recorded; the snooze's SnoozedUntil says when it happened.
+type TodoActivityAction string
+
+const (
+	TodoActivitySnoozed   TodoActivityAction = "snoozed"
+	TodoActivityUnsnoozed TodoActivityAction = "unsnoozed"
+)
+
+// TodoActivity records a change ActorID made to a todo. SnoozedUntil is set for
+// snoozes only.
+type TodoActivity struct {
+	ID           string
+	TenantID     string
+	TodoID       string
+	ActorID      string
+	Action       TodoActivityAction
+	SnoozedUntil *time.Time
+	CreatedAt    time.Time
+}
@@ -9,13 +9,14 @@ const (
 	UserRoleMember UserRole = "member"
 )
 
+// User is a global identity. Access to a tenant is granted by a Membership. Timezone is
+// an IANA name that decides where the user's days start.
 type User struct {
 	ID                         string
-	TenantID                   string
 	Email                      string
 	PasswordHash               string
 	Name                       string
-	Role                       UserRole
+	Timezone                   string
 	EmailVerified              bool
 	VerificationToken          *string
 	VerificationTokenExpiresAt *time.Time
@@ -0,0 +1,27 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"good-todo-go/internal/domain/model"
+)
+
+//go:generate go run go.uber.org/mock/mockgen -source=comment.go -destination=mock/comment.go -package=mock
+
+// CommentCursor is the keyset position of the last comment on a page.
+type CommentCursor struct {
+	CreatedAt time.Time
+	ID        string
+}
+
+type ICommentRepository interface {
+	Create(ctx context.Context, comment *model.Comment) (*model.Comment, error)
+	FindByID(ctx context.Context, tenantID, id string) (*model.Comment, error)
+	// FindByTodoID lists up to limit of the todo's comments, oldest first, starting after
+	// after when it is set.
+	FindByTodoID(ctx context.Context, tenantID, todoID string, after *CommentCursor, limit int) ([]*model.Comment, error)
+	// Update saves the comment's body and edited time.
+	Update(ctx context.Context, comment *model.Comment) (*model.Comment, error)
+	Delete(ctx context.Context, id string) error
+}
@@ -0,0 +1,12 @@
+package repository
+
+import (
+	"context"
+)
+
+//go:generate go run go.uber.org/mock/mockgen -source=dns.go -destination=mock/dns.go -package=mock
+
+type IDNSRepository interface {
+	// LookupTXT returns the TXT records of name, or nil if the name does not exist.
+	LookupTXT(ctx context.Context, name string) ([]string, error)
+}
@@ -0,0 +1,21 @@
+package repository
+
+import "errors"
+
+// Errors returned by repositories when a write violates a uniqueness constraint.
+var (
+	ErrUserAlreadyExists    = errors.New("user already exists")
+	ErrTenantSlugConflict   = errors.New("tenant slug already taken")
+	ErrTenantDomainConflict = errors.New("tenant domain already registered")
+	ErrTagNameConflict      = errors.New("tag name already taken")
+)
+
+// ErrVersionMismatch is returned by a conditional write when the row no longer has
+// the expected version, or no longer exists.
+var ErrVersionMismatch = errors.New("version mismatch")
+
+// ErrTodoNotFound is returned by an unconditional write to a todo that no longer exists.
+var ErrTodoNotFound = errors.New("todo not found")
+
+// ErrDependencyCycle is returned when a new dependency would make a todo wait on itself.
+var ErrDependencyCycle = errors.New("dependency cycle")
@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+
+	"good-todo-go/internal/domain/model"
+)
+
+//go:generate go run go.uber.org/mock/mockgen -source=mail.go -destination=mock/mail.go -package=mock
+
+type IMailRepository interface {
+	// SendReminder emails a due reminder to the owner of its todo.
+	SendReminder(ctx context.Context, reminder *model.DueReminder) error
+	// SendAssignment tells the assignee of a todo that it was assigned to them.
+	SendAssignment(ctx context.Context, assignment *model.TodoAssignment) error
+	// SendMention tells a member that a comment on a todo mentioned them.
+	SendMention(ctx context.Context, mention *model.CommentMention) error
+	// SendDigest emails a member their daily digest as HTML with a plain text alternative.
+	SendDigest(ctx context.Context, digest *model.Digest) error
+}
@@ -0,0 +1,27 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"good-todo-go/internal/domain/model"
+)
+
+//go:generate go run go.uber.org/mock/mockgen -source=membership.go -destination=mock/membership.go -package=mock
+
+type IMembershipRepository interface {
+	Create(ctx context.Context, membership *model.Membership) (*model.Membership, error)
+	FindByUserAndTenant(ctx context.Context, userID, tenantID string) (*model.Membership, error)
+	// ListByUser returns every membership of the user with its tenant loaded.
+	ListByUser(ctx context.Context, userID string) ([]*model.Membership, error)
+	// UpdateDigest saves the membership's digest preference: whether it is enabled, its
+	// time and its timezone.
+	UpdateDigest(ctx context.Context, membership *model.Membership) (*model.Membership, error)
+	// ListDigestSubscribers returns the tenant's memberships with the digest enabled, with
+	// their users loaded.
+	ListDigestSubscribers(ctx context.Context, tenantID string) ([]*model.Membership, error)
+	// MarkDigestSent records day as the date of the membership's last digest and reports
+	// whether it was not already recorded for day or later. The row stays locked until the
+	// surrounding unit of work ends, so concurrent senders see the recorded date.
+	MarkDigestSent(ctx context.Context, id string, day time.Time) (bool, error)
+}
@@ -0,0 +1,117 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: comment.go
+//
+// Generated by this command:
+//
+//	mockgen -source=comment.go -destination=mock/comment.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	model "good-todo-go/internal/domain/model"
+	repository "good-todo-go/internal/domain/repository"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockICommentRepository is a mock of ICommentRepository interface.
+type MockICommentRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockICommentRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockICommentRepositoryMockRecorder is the mock recorder for MockICommentRepository.
+type MockICommentRepositoryMockRecorder struct {
+	mock *MockICommentRepository
+}
+
+// NewMockICommentRepository creates a new mock instance.
+func NewMockICommentRepository(ctrl *gomock.Controller) *MockICommentRepository {
+	mock := &MockICommentRepository{ctrl: ctrl}
+	mock.recorder = &MockICommentRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockICommentRepository) EXPECT() *MockICommentRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockICommentRepository) Create(ctx context.Context, comment *model.Comment) (*model.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, comment)
+	ret0, _ := ret[0].(*model.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockICommentRepositoryMockRecorder) Create(ctx, comment any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockICommentRepository)(nil).Create), ctx, comment)
+}
+
+// Delete mocks base method.
+func (m *MockICommentRepository) Delete(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockICommentRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockICommentRepository)(nil).Delete), ctx, id)
+}
+
+// FindByID mocks base method.
+func (m *MockICommentRepository) FindByID(ctx context.Context, tenantID, id string) (*model.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, tenantID, id)
+	ret0, _ := ret[0].(*model.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockICommentRepositoryMockRecorder) FindByID(ctx, tenantID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockICommentRepository)(nil).FindByID), ctx, tenantID, id)
+}
+
+// FindByTodoID mocks base method.
+func (m *MockICommentRepository) FindByTodoID(ctx context.Context, tenantID, todoID string, after *repository.CommentCursor, limit int) ([]*model.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByTodoID", ctx, tenantID, todoID, after, limit)
+	ret0, _ := ret[0].([]*model.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByTodoID indicates an expected call of FindByTodoID.
+func (mr *MockICommentRepositoryMockRecorder) FindByTodoID(ctx, tenantID, todoID, after, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByTodoID", reflect.TypeOf((*MockICommentRepository)(nil).FindByTodoID), ctx, tenantID, todoID, after, limit)
+}
+
+// Update mocks base method.
+func (m *MockICommentRepository) Update(ctx context.Context, comment *model.Comment) (*model.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, comment)
+	ret0, _ := ret[0].(*model.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockICommentRepositoryMockRecorder) Update(ctx, comment any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockICommentRepository)(nil).Update), ctx, comment)
+}
@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: dns.go
+//
+// Generated by this command:
+//
+//	mockgen -source=dns.go -destination=mock/dns.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIDNSRepository is a mock of IDNSRepository interface.
+type MockIDNSRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIDNSRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockIDNSRepositoryMockRecorder is the mock recorder for MockIDNSRepository.
+type MockIDNSRepositoryMockRecorder struct {
+	mock *MockIDNSRepository
+}
+
+// NewMockIDNSRepository creates a new mock instance.
+func NewMockIDNSRepository(ctrl *gomock.Controller) *MockIDNSRepository {
+	mock := &MockIDNSRepository{ctrl: ctrl}
+	mock.recorder = &MockIDNSRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIDNSRepository) EXPECT() *MockIDNSRepositoryMockRecorder {
+	return m.recorder
+}
+
+// LookupTXT mocks base method.
+func (m *MockIDNSRepository) LookupTXT(ctx context.Context, name string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LookupTXT", ctx, name)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LookupTXT indicates an expected call of LookupTXT.
+func (mr *MockIDNSRepositoryMockRecorder) LookupTXT(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LookupTXT", reflect.TypeOf((*MockIDNSRepository)(nil).LookupTXT), ctx, name)
+}
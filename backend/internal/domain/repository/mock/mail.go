@@ -0,0 +1,98 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: mail.go
+//
+// Generated by this command:
+//
+//	mockgen -source=mail.go -destination=mock/mail.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	model "good-todo-go/internal/domain/model"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIMailRepository is a mock of IMailRepository interface.
+type MockIMailRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIMailRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockIMailRepositoryMockRecorder is the mock recorder for MockIMailRepository.
+type MockIMailRepositoryMockRecorder struct {
+	mock *MockIMailRepository
+}
+
+// NewMockIMailRepository creates a new mock instance.
+func NewMockIMailRepository(ctrl *gomock.Controller) *MockIMailRepository {
+	mock := &MockIMailRepository{ctrl: ctrl}
+	mock.recorder = &MockIMailRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIMailRepository) EXPECT() *MockIMailRepositoryMockRecorder {
+	return m.recorder
+}
+
+// SendAssignment mocks base method.
+func (m *MockIMailRepository) SendAssignment(ctx context.Context, assignment *model.TodoAssignment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendAssignment", ctx, assignment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendAssignment indicates an expected call of SendAssignment.
+func (mr *MockIMailRepositoryMockRecorder) SendAssignment(ctx, assignment any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendAssignment", reflect.TypeOf((*MockIMailRepository)(nil).SendAssignment), ctx, assignment)
+}
+
+// SendDigest mocks base method.
+func (m *MockIMailRepository) SendDigest(ctx context.Context, digest *model.Digest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendDigest", ctx, digest)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendDigest indicates an expected call of SendDigest.
+func (mr *MockIMailRepositoryMockRecorder) SendDigest(ctx, digest any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendDigest", reflect.TypeOf((*MockIMailRepository)(nil).SendDigest), ctx, digest)
+}
+
+// SendMention mocks base method.
+func (m *MockIMailRepository) SendMention(ctx context.Context, mention *model.CommentMention) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendMention", ctx, mention)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMention indicates an expected call of SendMention.
+func (mr *MockIMailRepositoryMockRecorder) SendMention(ctx, mention any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMention", reflect.TypeOf((*MockIMailRepository)(nil).SendMention), ctx, mention)
+}
+
+// SendReminder mocks base method.
+func (m *MockIMailRepository) SendReminder(ctx context.Context, reminder *model.DueReminder) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendReminder", ctx, reminder)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendReminder indicates an expected call of SendReminder.
+func (mr *MockIMailRepositoryMockRecorder) SendReminder(ctx, reminder any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendReminder", reflect.TypeOf((*MockIMailRepository)(nil).SendReminder), ctx, reminder)
+}
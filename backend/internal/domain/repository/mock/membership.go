@@ -0,0 +1,133 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: membership.go
+//
+// Generated by this command:
+//
+//	mockgen -source=membership.go -destination=mock/membership.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	model "good-todo-go/internal/domain/model"
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIMembershipRepository is a mock of IMembershipRepository interface.
+type MockIMembershipRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIMembershipRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockIMembershipRepositoryMockRecorder is the mock recorder for MockIMembershipRepository.
+type MockIMembershipRepositoryMockRecorder struct {
+	mock *MockIMembershipRepository
+}
+
+// NewMockIMembershipRepository creates a new mock instance.
+func NewMockIMembershipRepository(ctrl *gomock.Controller) *MockIMembershipRepository {
+	mock := &MockIMembershipRepository{ctrl: ctrl}
+	mock.recorder = &MockIMembershipRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIMembershipRepository) EXPECT() *MockIMembershipRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockIMembershipRepository) Create(ctx context.Context, membership *model.Membership) (*model.Membership, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, membership)
+	ret0, _ := ret[0].(*model.Membership)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockIMembershipRepositoryMockRecorder) Create(ctx, membership any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockIMembershipRepository)(nil).Create), ctx, membership)
+}
+
+// FindByUserAndTenant mocks base method.
+func (m *MockIMembershipRepository) FindByUserAndTenant(ctx context.Context, userID, tenantID string) (*model.Membership, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByUserAndTenant", ctx, userID, tenantID)
+	ret0, _ := ret[0].(*model.Membership)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByUserAndTenant indicates an expected call of FindByUserAndTenant.
+func (mr *MockIMembershipRepositoryMockRecorder) FindByUserAndTenant(ctx, userID, tenantID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUserAndTenant", reflect.TypeOf((*MockIMembershipRepository)(nil).FindByUserAndTenant), ctx, userID, tenantID)
+}
+
+// ListByUser mocks base method.
+func (m *MockIMembershipRepository) ListByUser(ctx context.Context, userID string) ([]*model.Membership, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByUser", ctx, userID)
+	ret0, _ := ret[0].([]*model.Membership)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByUser indicates an expected call of ListByUser.
+func (mr *MockIMembershipRepositoryMockRecorder) ListByUser(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByUser", reflect.TypeOf((*MockIMembershipRepository)(nil).ListByUser), ctx, userID)
+}
+
+// ListDigestSubscribers mocks base method.
+func (m *MockIMembershipRepository) ListDigestSubscribers(ctx context.Context, tenantID string) ([]*model.Membership, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDigestSubscribers", ctx, tenantID)
+	ret0, _ := ret[0].([]*model.Membership)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDigestSubscribers indicates an expected call of ListDigestSubscribers.
+func (mr *MockIMembershipRepositoryMockRecorder) ListDigestSubscribers(ctx, tenantID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDigestSubscribers", reflect.TypeOf((*MockIMembershipRepository)(nil).ListDigestSubscribers), ctx, tenantID)
+}
+
+// MarkDigestSent mocks base method.
+func (m *MockIMembershipRepository) MarkDigestSent(ctx context.Context, id string, day time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkDigestSent", ctx, id, day)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkDigestSent indicates an expected call of MarkDigestSent.
+func (mr *MockIMembershipRepositoryMockRecorder) MarkDigestSent(ctx, id, day any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkDigestSent", reflect.TypeOf((*MockIMembershipRepository)(nil).MarkDigestSent), ctx, id, day)
+}
+
+// UpdateDigest mocks base method.
+func (m *MockIMembershipRepository) UpdateDigest(ctx context.Context, membership *model.Membership) (*model.Membership, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDigest", ctx, membership)
+	ret0, _ := ret[0].(*model.Membership)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateDigest indicates an expected call of UpdateDigest.
+func (mr *MockIMembershipRepositoryMockRecorder) UpdateDigest(ctx, membership any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDigest", reflect.TypeOf((*MockIMembershipRepository)(nil).UpdateDigest), ctx, membership)
+}
@@ -0,0 +1,116 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: project.go
+//
+// Generated by this command:
+//
+//	mockgen -source=project.go -destination=mock/project.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	model "good-todo-go/internal/domain/model"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIProjectRepository is a mock of IProjectRepository interface.
+type MockIProjectRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIProjectRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockIProjectRepositoryMockRecorder is the mock recorder for MockIProjectRepository.
+type MockIProjectRepositoryMockRecorder struct {
+	mock *MockIProjectRepository
+}
+
+// NewMockIProjectRepository creates a new mock instance.
+func NewMockIProjectRepository(ctrl *gomock.Controller) *MockIProjectRepository {
+	mock := &MockIProjectRepository{ctrl: ctrl}
+	mock.recorder = &MockIProjectRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIProjectRepository) EXPECT() *MockIProjectRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockIProjectRepository) Create(ctx context.Context, project *model.Project) (*model.Project, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, project)
+	ret0, _ := ret[0].(*model.Project)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockIProjectRepositoryMockRecorder) Create(ctx, project any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockIProjectRepository)(nil).Create), ctx, project)
+}
+
+// Delete mocks base method.
+func (m *MockIProjectRepository) Delete(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockIProjectRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockIProjectRepository)(nil).Delete), ctx, id)
+}
+
+// FindByID mocks base method.
+func (m *MockIProjectRepository) FindByID(ctx context.Context, tenantID, id string) (*model.Project, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, tenantID, id)
+	ret0, _ := ret[0].(*model.Project)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockIProjectRepositoryMockRecorder) FindByID(ctx, tenantID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockIProjectRepository)(nil).FindByID), ctx, tenantID, id)
+}
+
+// FindVisible mocks base method.
+func (m *MockIProjectRepository) FindVisible(ctx context.Context, tenantID, userID string, includeArchived bool) ([]*model.Project, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindVisible", ctx, tenantID, userID, includeArchived)
+	ret0, _ := ret[0].([]*model.Project)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindVisible indicates an expected call of FindVisible.
+func (mr *MockIProjectRepositoryMockRecorder) FindVisible(ctx, tenantID, userID, includeArchived any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindVisible", reflect.TypeOf((*MockIProjectRepository)(nil).FindVisible), ctx, tenantID, userID, includeArchived)
+}
+
+// Update mocks base method.
+func (m *MockIProjectRepository) Update(ctx context.Context, project *model.Project) (*model.Project, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, project)
+	ret0, _ := ret[0].(*model.Project)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockIProjectRepositoryMockRecorder) Update(ctx, project any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockIProjectRepository)(nil).Update), ctx, project)
+}
@@ -0,0 +1,131 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: reminder.go
+//
+// Generated by this command:
+//
+//	mockgen -source=reminder.go -destination=mock/reminder.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	model "good-todo-go/internal/domain/model"
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIReminderRepository is a mock of IReminderRepository interface.
+type MockIReminderRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIReminderRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockIReminderRepositoryMockRecorder is the mock recorder for MockIReminderRepository.
+type MockIReminderRepositoryMockRecorder struct {
+	mock *MockIReminderRepository
+}
+
+// NewMockIReminderRepository creates a new mock instance.
+func NewMockIReminderRepository(ctrl *gomock.Controller) *MockIReminderRepository {
+	mock := &MockIReminderRepository{ctrl: ctrl}
+	mock.recorder = &MockIReminderRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIReminderRepository) EXPECT() *MockIReminderRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ClaimDue mocks base method.
+func (m *MockIReminderRepository) ClaimDue(ctx context.Context, tenantID string, now time.Time, limit int) ([]*model.DueReminder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimDue", ctx, tenantID, now, limit)
+	ret0, _ := ret[0].([]*model.DueReminder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimDue indicates an expected call of ClaimDue.
+func (mr *MockIReminderRepositoryMockRecorder) ClaimDue(ctx, tenantID, now, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimDue", reflect.TypeOf((*MockIReminderRepository)(nil).ClaimDue), ctx, tenantID, now, limit)
+}
+
+// Create mocks base method.
+func (m *MockIReminderRepository) Create(ctx context.Context, reminder *model.Reminder) (*model.Reminder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, reminder)
+	ret0, _ := ret[0].(*model.Reminder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockIReminderRepositoryMockRecorder) Create(ctx, reminder any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockIReminderRepository)(nil).Create), ctx, reminder)
+}
+
+// Delete mocks base method.
+func (m *MockIReminderRepository) Delete(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockIReminderRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockIReminderRepository)(nil).Delete), ctx, id)
+}
+
+// FindByID mocks base method.
+func (m *MockIReminderRepository) FindByID(ctx context.Context, tenantID, id string) (*model.Reminder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, tenantID, id)
+	ret0, _ := ret[0].(*model.Reminder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockIReminderRepositoryMockRecorder) FindByID(ctx, tenantID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockIReminderRepository)(nil).FindByID), ctx, tenantID, id)
+}
+
+// FindByTodoID mocks base method.
+func (m *MockIReminderRepository) FindByTodoID(ctx context.Context, tenantID, todoID string) ([]*model.Reminder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByTodoID", ctx, tenantID, todoID)
+	ret0, _ := ret[0].([]*model.Reminder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByTodoID indicates an expected call of FindByTodoID.
+func (mr *MockIReminderRepositoryMockRecorder) FindByTodoID(ctx, tenantID, todoID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByTodoID", reflect.TypeOf((*MockIReminderRepository)(nil).FindByTodoID), ctx, tenantID, todoID)
+}
+
+// UpdateDelivery mocks base method.
+func (m *MockIReminderRepository) UpdateDelivery(ctx context.Context, reminder *model.Reminder) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDelivery", ctx, reminder)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateDelivery indicates an expected call of UpdateDelivery.
+func (mr *MockIReminderRepositoryMockRecorder) UpdateDelivery(ctx, reminder any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDelivery", reflect.TypeOf((*MockIReminderRepository)(nil).UpdateDelivery), ctx, reminder)
+}
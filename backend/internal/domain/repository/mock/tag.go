@@ -0,0 +1,131 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: tag.go
+//
+// Generated by this command:
+//
+//	mockgen -source=tag.go -destination=mock/tag.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	model "good-todo-go/internal/domain/model"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockITagRepository is a mock of ITagRepository interface.
+type MockITagRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockITagRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockITagRepositoryMockRecorder is the mock recorder for MockITagRepository.
+type MockITagRepositoryMockRecorder struct {
+	mock *MockITagRepository
+}
+
+// NewMockITagRepository creates a new mock instance.
+func NewMockITagRepository(ctrl *gomock.Controller) *MockITagRepository {
+	mock := &MockITagRepository{ctrl: ctrl}
+	mock.recorder = &MockITagRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockITagRepository) EXPECT() *MockITagRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockITagRepository) Create(ctx context.Context, tag *model.Tag) (*model.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, tag)
+	ret0, _ := ret[0].(*model.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockITagRepositoryMockRecorder) Create(ctx, tag any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockITagRepository)(nil).Create), ctx, tag)
+}
+
+// Delete mocks base method.
+func (m *MockITagRepository) Delete(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockITagRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockITagRepository)(nil).Delete), ctx, id)
+}
+
+// FindByID mocks base method.
+func (m *MockITagRepository) FindByID(ctx context.Context, tenantID, id string) (*model.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, tenantID, id)
+	ret0, _ := ret[0].(*model.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockITagRepositoryMockRecorder) FindByID(ctx, tenantID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockITagRepository)(nil).FindByID), ctx, tenantID, id)
+}
+
+// FindByIDs mocks base method.
+func (m *MockITagRepository) FindByIDs(ctx context.Context, tenantID string, ids []string) ([]*model.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByIDs", ctx, tenantID, ids)
+	ret0, _ := ret[0].([]*model.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByIDs indicates an expected call of FindByIDs.
+func (mr *MockITagRepositoryMockRecorder) FindByIDs(ctx, tenantID, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByIDs", reflect.TypeOf((*MockITagRepository)(nil).FindByIDs), ctx, tenantID, ids)
+}
+
+// ListByTenant mocks base method.
+func (m *MockITagRepository) ListByTenant(ctx context.Context, tenantID string) ([]*model.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByTenant", ctx, tenantID)
+	ret0, _ := ret[0].([]*model.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByTenant indicates an expected call of ListByTenant.
+func (mr *MockITagRepositoryMockRecorder) ListByTenant(ctx, tenantID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByTenant", reflect.TypeOf((*MockITagRepository)(nil).ListByTenant), ctx, tenantID)
+}
+
+// Update mocks base method.
+func (m *MockITagRepository) Update(ctx context.Context, tag *model.Tag) (*model.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, tag)
+	ret0, _ := ret[0].(*model.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockITagRepositoryMockRecorder) Update(ctx, tag any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockITagRepository)(nil).Update), ctx, tag)
+}
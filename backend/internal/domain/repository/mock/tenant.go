@@ -13,6 +13,7 @@ import (
 	context "context"
 	model "good-todo-go/internal/domain/model"
 	reflect "reflect"
+	time "time"
 
 	gomock "go.uber.org/mock/gomock"
 )
@@ -41,6 +42,21 @@ func (m *MockITenantRepository) EXPECT() *MockITenantRepositoryMockRecorder {
 	return m.recorder
 }
 
+// ChangeSlug mocks base method.
+func (m *MockITenantRepository) ChangeSlug(ctx context.Context, tenantID, slug string, keepPreviousUntil time.Time) (*model.Tenant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeSlug", ctx, tenantID, slug, keepPreviousUntil)
+	ret0, _ := ret[0].(*model.Tenant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangeSlug indicates an expected call of ChangeSlug.
+func (mr *MockITenantRepositoryMockRecorder) ChangeSlug(ctx, tenantID, slug, keepPreviousUntil any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeSlug", reflect.TypeOf((*MockITenantRepository)(nil).ChangeSlug), ctx, tenantID, slug, keepPreviousUntil)
+}
+
 // Create mocks base method.
 func (m *MockITenantRepository) Create(ctx context.Context, tenant *model.Tenant) (*model.Tenant, error) {
 	m.ctrl.T.Helper()
@@ -56,6 +72,36 @@ func (mr *MockITenantRepositoryMockRecorder) Create(ctx, tenant any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockITenantRepository)(nil).Create), ctx, tenant)
 }
 
+// FindAll mocks base method.
+func (m *MockITenantRepository) FindAll(ctx context.Context) ([]*model.Tenant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx)
+	ret0, _ := ret[0].([]*model.Tenant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockITenantRepositoryMockRecorder) FindAll(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockITenantRepository)(nil).FindAll), ctx)
+}
+
+// FindByDomain mocks base method.
+func (m *MockITenantRepository) FindByDomain(ctx context.Context, domain string) (*model.Tenant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByDomain", ctx, domain)
+	ret0, _ := ret[0].(*model.Tenant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByDomain indicates an expected call of FindByDomain.
+func (mr *MockITenantRepositoryMockRecorder) FindByDomain(ctx, domain any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByDomain", reflect.TypeOf((*MockITenantRepository)(nil).FindByDomain), ctx, domain)
+}
+
 // FindByID mocks base method.
 func (m *MockITenantRepository) FindByID(ctx context.Context, id string) (*model.Tenant, error) {
 	m.ctrl.T.Helper()
@@ -85,3 +131,18 @@ func (mr *MockITenantRepositoryMockRecorder) FindBySlug(ctx, slug any) *gomock.C
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBySlug", reflect.TypeOf((*MockITenantRepository)(nil).FindBySlug), ctx, slug)
 }
+
+// Update mocks base method.
+func (m *MockITenantRepository) Update(ctx context.Context, tenant *model.Tenant) (*model.Tenant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, tenant)
+	ret0, _ := ret[0].(*model.Tenant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockITenantRepositoryMockRecorder) Update(ctx, tenant any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockITenantRepository)(nil).Update), ctx, tenant)
+}
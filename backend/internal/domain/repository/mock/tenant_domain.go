@@ -0,0 +1,117 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: tenant_domain.go
+//
+// Generated by this command:
+//
+//	mockgen -source=tenant_domain.go -destination=mock/tenant_domain.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	model "good-todo-go/internal/domain/model"
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockITenantDomainRepository is a mock of ITenantDomainRepository interface.
+type MockITenantDomainRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockITenantDomainRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockITenantDomainRepositoryMockRecorder is the mock recorder for MockITenantDomainRepository.
+type MockITenantDomainRepositoryMockRecorder struct {
+	mock *MockITenantDomainRepository
+}
+
+// NewMockITenantDomainRepository creates a new mock instance.
+func NewMockITenantDomainRepository(ctrl *gomock.Controller) *MockITenantDomainRepository {
+	mock := &MockITenantDomainRepository{ctrl: ctrl}
+	mock.recorder = &MockITenantDomainRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockITenantDomainRepository) EXPECT() *MockITenantDomainRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockITenantDomainRepository) Create(ctx context.Context, domain *model.TenantDomain) (*model.TenantDomain, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, domain)
+	ret0, _ := ret[0].(*model.TenantDomain)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockITenantDomainRepositoryMockRecorder) Create(ctx, domain any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockITenantDomainRepository)(nil).Create), ctx, domain)
+}
+
+// Delete mocks base method.
+func (m *MockITenantDomainRepository) Delete(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockITenantDomainRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockITenantDomainRepository)(nil).Delete), ctx, id)
+}
+
+// FindByID mocks base method.
+func (m *MockITenantDomainRepository) FindByID(ctx context.Context, tenantID, id string) (*model.TenantDomain, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, tenantID, id)
+	ret0, _ := ret[0].(*model.TenantDomain)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockITenantDomainRepositoryMockRecorder) FindByID(ctx, tenantID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockITenantDomainRepository)(nil).FindByID), ctx, tenantID, id)
+}
+
+// ListByTenant mocks base method.
+func (m *MockITenantDomainRepository) ListByTenant(ctx context.Context, tenantID string) ([]*model.TenantDomain, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByTenant", ctx, tenantID)
+	ret0, _ := ret[0].([]*model.TenantDomain)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByTenant indicates an expected call of ListByTenant.
+func (mr *MockITenantDomainRepositoryMockRecorder) ListByTenant(ctx, tenantID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByTenant", reflect.TypeOf((*MockITenantDomainRepository)(nil).ListByTenant), ctx, tenantID)
+}
+
+// MarkVerified mocks base method.
+func (m *MockITenantDomainRepository) MarkVerified(ctx context.Context, id string, verifiedAt time.Time) (*model.TenantDomain, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkVerified", ctx, id, verifiedAt)
+	ret0, _ := ret[0].(*model.TenantDomain)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkVerified indicates an expected call of MarkVerified.
+func (mr *MockITenantDomainRepositoryMockRecorder) MarkVerified(ctx, id, verifiedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkVerified", reflect.TypeOf((*MockITenantDomainRepository)(nil).MarkVerified), ctx, id, verifiedAt)
+}
@@ -12,7 +12,9 @@ package mock
 import (
 	context "context"
 	model "good-todo-go/internal/domain/model"
+	repository "good-todo-go/internal/domain/repository"
 	reflect "reflect"
+	time "time"
 
 	gomock "go.uber.org/mock/gomock"
 )
@@ -41,6 +43,35 @@ func (m *MockITodoRepository) EXPECT() *MockITodoRepositoryMockRecorder {
 	return m.recorder
 }
 
+// AddBlocker mocks base method.
+func (m *MockITodoRepository) AddBlocker(ctx context.Context, tenantID, todoID, blockerID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddBlocker", ctx, tenantID, todoID, blockerID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddBlocker indicates an expected call of AddBlocker.
+func (mr *MockITodoRepositoryMockRecorder) AddBlocker(ctx, tenantID, todoID, blockerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddBlocker", reflect.TypeOf((*MockITodoRepository)(nil).AddBlocker), ctx, tenantID, todoID, blockerID)
+}
+
+// AdjacentPosition mocks base method.
+func (m *MockITodoRepository) AdjacentPosition(ctx context.Context, tenantID, position string, after bool, excludeID string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AdjacentPosition", ctx, tenantID, position, after, excludeID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AdjacentPosition indicates an expected call of AdjacentPosition.
+func (mr *MockITodoRepositoryMockRecorder) AdjacentPosition(ctx, tenantID, position, after, excludeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AdjacentPosition", reflect.TypeOf((*MockITodoRepository)(nil).AdjacentPosition), ctx, tenantID, position, after, excludeID)
+}
+
 // Create mocks base method.
 func (m *MockITodoRepository) Create(ctx context.Context, todo *model.Todo) (*model.Todo, error) {
 	m.ctrl.T.Helper()
@@ -57,17 +88,47 @@ func (mr *MockITodoRepositoryMockRecorder) Create(ctx, todo any) *gomock.Call {
 }
 
 // Delete mocks base method.
-func (m *MockITodoRepository) Delete(ctx context.Context, id string) error {
+func (m *MockITodoRepository) Delete(ctx context.Context, id string, expectedVersion *int) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret := m.ctrl.Call(m, "Delete", ctx, id, expectedVersion)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Delete indicates an expected call of Delete.
-func (mr *MockITodoRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
+func (mr *MockITodoRepositoryMockRecorder) Delete(ctx, id, expectedVersion any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockITodoRepository)(nil).Delete), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockITodoRepository)(nil).Delete), ctx, id, expectedVersion)
+}
+
+// FindBlockers mocks base method.
+func (m *MockITodoRepository) FindBlockers(ctx context.Context, todoID string) ([]*model.Todo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindBlockers", ctx, todoID)
+	ret0, _ := ret[0].([]*model.Todo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindBlockers indicates an expected call of FindBlockers.
+func (mr *MockITodoRepositoryMockRecorder) FindBlockers(ctx, todoID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBlockers", reflect.TypeOf((*MockITodoRepository)(nil).FindBlockers), ctx, todoID)
+}
+
+// FindByAssigneeID mocks base method.
+func (m *MockITodoRepository) FindByAssigneeID(ctx context.Context, userID string, query repository.TodoQuery) ([]*model.Todo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByAssigneeID", ctx, userID, query)
+	ret0, _ := ret[0].([]*model.Todo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByAssigneeID indicates an expected call of FindByAssigneeID.
+func (mr *MockITodoRepositoryMockRecorder) FindByAssigneeID(ctx, userID, query any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByAssigneeID", reflect.TypeOf((*MockITodoRepository)(nil).FindByAssigneeID), ctx, userID, query)
 }
 
 // FindByID mocks base method.
@@ -85,47 +146,299 @@ func (mr *MockITodoRepositoryMockRecorder) FindByID(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockITodoRepository)(nil).FindByID), ctx, id)
 }
 
+// FindByIDWithTrashed mocks base method.
+func (m *MockITodoRepository) FindByIDWithTrashed(ctx context.Context, id string) (*model.Todo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByIDWithTrashed", ctx, id)
+	ret0, _ := ret[0].(*model.Todo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByIDWithTrashed indicates an expected call of FindByIDWithTrashed.
+func (mr *MockITodoRepositoryMockRecorder) FindByIDWithTrashed(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByIDWithTrashed", reflect.TypeOf((*MockITodoRepository)(nil).FindByIDWithTrashed), ctx, id)
+}
+
+// FindByIDs mocks base method.
+func (m *MockITodoRepository) FindByIDs(ctx context.Context, ids []string) ([]*model.Todo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByIDs", ctx, ids)
+	ret0, _ := ret[0].([]*model.Todo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByIDs indicates an expected call of FindByIDs.
+func (mr *MockITodoRepositoryMockRecorder) FindByIDs(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByIDs", reflect.TypeOf((*MockITodoRepository)(nil).FindByIDs), ctx, ids)
+}
+
+// FindByProjectID mocks base method.
+func (m *MockITodoRepository) FindByProjectID(ctx context.Context, projectID string, query repository.TodoQuery) ([]*model.Todo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByProjectID", ctx, projectID, query)
+	ret0, _ := ret[0].([]*model.Todo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByProjectID indicates an expected call of FindByProjectID.
+func (mr *MockITodoRepositoryMockRecorder) FindByProjectID(ctx, projectID, query any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByProjectID", reflect.TypeOf((*MockITodoRepository)(nil).FindByProjectID), ctx, projectID, query)
+}
+
 // FindByUserID mocks base method.
-func (m *MockITodoRepository) FindByUserID(ctx context.Context, userID string) ([]*model.Todo, error) {
+func (m *MockITodoRepository) FindByUserID(ctx context.Context, userID string, query repository.TodoQuery) ([]*model.Todo, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindByUserID", ctx, userID)
+	ret := m.ctrl.Call(m, "FindByUserID", ctx, userID, query)
 	ret0, _ := ret[0].([]*model.Todo)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FindByUserID indicates an expected call of FindByUserID.
-func (mr *MockITodoRepositoryMockRecorder) FindByUserID(ctx, userID any) *gomock.Call {
+func (mr *MockITodoRepositoryMockRecorder) FindByUserID(ctx, userID, query any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUserID", reflect.TypeOf((*MockITodoRepository)(nil).FindByUserID), ctx, userID, query)
+}
+
+// FindIDsByPosition mocks base method.
+func (m *MockITodoRepository) FindIDsByPosition(ctx context.Context, tenantID string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindIDsByPosition", ctx, tenantID)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindIDsByPosition indicates an expected call of FindIDsByPosition.
+func (mr *MockITodoRepositoryMockRecorder) FindIDsByPosition(ctx, tenantID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUserID", reflect.TypeOf((*MockITodoRepository)(nil).FindByUserID), ctx, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindIDsByPosition", reflect.TypeOf((*MockITodoRepository)(nil).FindIDsByPosition), ctx, tenantID)
 }
 
 // FindPublicByTenantID mocks base method.
-func (m *MockITodoRepository) FindPublicByTenantID(ctx context.Context, tenantID string) ([]*model.Todo, error) {
+func (m *MockITodoRepository) FindPublicByTenantID(ctx context.Context, tenantID string, query repository.TodoQuery) ([]*model.Todo, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindPublicByTenantID", ctx, tenantID)
+	ret := m.ctrl.Call(m, "FindPublicByTenantID", ctx, tenantID, query)
 	ret0, _ := ret[0].([]*model.Todo)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FindPublicByTenantID indicates an expected call of FindPublicByTenantID.
-func (mr *MockITodoRepositoryMockRecorder) FindPublicByTenantID(ctx, tenantID any) *gomock.Call {
+func (mr *MockITodoRepositoryMockRecorder) FindPublicByTenantID(ctx, tenantID, query any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPublicByTenantID", reflect.TypeOf((*MockITodoRepository)(nil).FindPublicByTenantID), ctx, tenantID, query)
+}
+
+// FindSubtasks mocks base method.
+func (m *MockITodoRepository) FindSubtasks(ctx context.Context, parentIDs []string) ([]*model.Todo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindSubtasks", ctx, parentIDs)
+	ret0, _ := ret[0].([]*model.Todo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindSubtasks indicates an expected call of FindSubtasks.
+func (mr *MockITodoRepositoryMockRecorder) FindSubtasks(ctx, parentIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindSubtasks", reflect.TypeOf((*MockITodoRepository)(nil).FindSubtasks), ctx, parentIDs)
+}
+
+// LockPositions mocks base method.
+func (m *MockITodoRepository) LockPositions(ctx context.Context, tenantID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockPositions", ctx, tenantID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LockPositions indicates an expected call of LockPositions.
+func (mr *MockITodoRepositoryMockRecorder) LockPositions(ctx, tenantID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockPositions", reflect.TypeOf((*MockITodoRepository)(nil).LockPositions), ctx, tenantID)
+}
+
+// LongestPosition mocks base method.
+func (m *MockITodoRepository) LongestPosition(ctx context.Context, tenantID string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LongestPosition", ctx, tenantID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LongestPosition indicates an expected call of LongestPosition.
+func (mr *MockITodoRepositoryMockRecorder) LongestPosition(ctx, tenantID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LongestPosition", reflect.TypeOf((*MockITodoRepository)(nil).LongestPosition), ctx, tenantID)
+}
+
+// Move mocks base method.
+func (m *MockITodoRepository) Move(ctx context.Context, todo *model.Todo) (*model.Todo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Move", ctx, todo)
+	ret0, _ := ret[0].(*model.Todo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Move indicates an expected call of Move.
+func (mr *MockITodoRepositoryMockRecorder) Move(ctx, todo any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Move", reflect.TypeOf((*MockITodoRepository)(nil).Move), ctx, todo)
+}
+
+// PurgeTrash mocks base method.
+func (m *MockITodoRepository) PurgeTrash(ctx context.Context, tenantID string, cutoff time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeTrash", ctx, tenantID, cutoff)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeTrash indicates an expected call of PurgeTrash.
+func (mr *MockITodoRepositoryMockRecorder) PurgeTrash(ctx, tenantID, cutoff any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeTrash", reflect.TypeOf((*MockITodoRepository)(nil).PurgeTrash), ctx, tenantID, cutoff)
+}
+
+// RemoveBlocker mocks base method.
+func (m *MockITodoRepository) RemoveBlocker(ctx context.Context, todoID, blockerID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveBlocker", ctx, todoID, blockerID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveBlocker indicates an expected call of RemoveBlocker.
+func (mr *MockITodoRepositoryMockRecorder) RemoveBlocker(ctx, todoID, blockerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveBlocker", reflect.TypeOf((*MockITodoRepository)(nil).RemoveBlocker), ctx, todoID, blockerID)
+}
+
+// Restore mocks base method.
+func (m *MockITodoRepository) Restore(ctx context.Context, id string) (*model.Todo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, id)
+	ret0, _ := ret[0].(*model.Todo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockITodoRepositoryMockRecorder) Restore(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockITodoRepository)(nil).Restore), ctx, id)
+}
+
+// Search mocks base method.
+func (m *MockITodoRepository) Search(ctx context.Context, tenantID, userID, text string, limit int) ([]*model.TodoSearchHit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", ctx, tenantID, userID, text, limit)
+	ret0, _ := ret[0].([]*model.TodoSearchHit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search.
+func (mr *MockITodoRepositoryMockRecorder) Search(ctx, tenantID, userID, text, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockITodoRepository)(nil).Search), ctx, tenantID, userID, text, limit)
+}
+
+// SetAssignee mocks base method.
+func (m *MockITodoRepository) SetAssignee(ctx context.Context, id string, assigneeID *string) (*model.Todo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetAssignee", ctx, id, assigneeID)
+	ret0, _ := ret[0].(*model.Todo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetAssignee indicates an expected call of SetAssignee.
+func (mr *MockITodoRepositoryMockRecorder) SetAssignee(ctx, id, assigneeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAssignee", reflect.TypeOf((*MockITodoRepository)(nil).SetAssignee), ctx, id, assigneeID)
+}
+
+// SetPositions mocks base method.
+func (m *MockITodoRepository) SetPositions(ctx context.Context, ids, positions []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPositions", ctx, ids, positions)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetPositions indicates an expected call of SetPositions.
+func (mr *MockITodoRepositoryMockRecorder) SetPositions(ctx, ids, positions any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPositions", reflect.TypeOf((*MockITodoRepository)(nil).SetPositions), ctx, ids, positions)
+}
+
+// Snooze mocks base method.
+func (m *MockITodoRepository) Snooze(ctx context.Context, id string, until *time.Time) (*model.Todo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Snooze", ctx, id, until)
+	ret0, _ := ret[0].(*model.Todo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Snooze indicates an expected call of Snooze.
+func (mr *MockITodoRepositoryMockRecorder) Snooze(ctx, id, until any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Snooze", reflect.TypeOf((*MockITodoRepository)(nil).Snooze), ctx, id, until)
+}
+
+// Trash mocks base method.
+func (m *MockITodoRepository) Trash(ctx context.Context, id string, deletedAt time.Time, expectedVersion *int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trash", ctx, id, deletedAt, expectedVersion)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Trash indicates an expected call of Trash.
+func (mr *MockITodoRepositoryMockRecorder) Trash(ctx, id, deletedAt, expectedVersion any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trash", reflect.TypeOf((*MockITodoRepository)(nil).Trash), ctx, id, deletedAt, expectedVersion)
+}
+
+// TrashProjectTodos mocks base method.
+func (m *MockITodoRepository) TrashProjectTodos(ctx context.Context, projectID, userID string, deletedAt time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TrashProjectTodos", ctx, projectID, userID, deletedAt)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TrashProjectTodos indicates an expected call of TrashProjectTodos.
+func (mr *MockITodoRepositoryMockRecorder) TrashProjectTodos(ctx, projectID, userID, deletedAt any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPublicByTenantID", reflect.TypeOf((*MockITodoRepository)(nil).FindPublicByTenantID), ctx, tenantID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TrashProjectTodos", reflect.TypeOf((*MockITodoRepository)(nil).TrashProjectTodos), ctx, projectID, userID, deletedAt)
 }
 
 // Update mocks base method.
-func (m *MockITodoRepository) Update(ctx context.Context, todo *model.Todo) (*model.Todo, error) {
+func (m *MockITodoRepository) Update(ctx context.Context, todo *model.Todo, expectedVersion *int) (*model.Todo, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Update", ctx, todo)
+	ret := m.ctrl.Call(m, "Update", ctx, todo, expectedVersion)
 	ret0, _ := ret[0].(*model.Todo)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Update indicates an expected call of Update.
-func (mr *MockITodoRepositoryMockRecorder) Update(ctx, todo any) *gomock.Call {
+func (mr *MockITodoRepositoryMockRecorder) Update(ctx, todo, expectedVersion any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockITodoRepository)(nil).Update), ctx, todo)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockITodoRepository)(nil).Update), ctx, todo, expectedVersion)
 }
@@ -0,0 +1,72 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: todo_activity.go
+//
+// Generated by this command:
+//
+//	mockgen -source=todo_activity.go -destination=mock/todo_activity.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	model "good-todo-go/internal/domain/model"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockITodoActivityRepository is a mock of ITodoActivityRepository interface.
+type MockITodoActivityRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockITodoActivityRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockITodoActivityRepositoryMockRecorder is the mock recorder for MockITodoActivityRepository.
+type MockITodoActivityRepositoryMockRecorder struct {
+	mock *MockITodoActivityRepository
+}
+
+// NewMockITodoActivityRepository creates a new mock instance.
+func NewMockITodoActivityRepository(ctrl *gomock.Controller) *MockITodoActivityRepository {
+	mock := &MockITodoActivityRepository{ctrl: ctrl}
+	mock.recorder = &MockITodoActivityRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockITodoActivityRepository) EXPECT() *MockITodoActivityRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockITodoActivityRepository) Create(ctx context.Context, activity *model.TodoActivity) (*model.TodoActivity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, activity)
+	ret0, _ := ret[0].(*model.TodoActivity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockITodoActivityRepositoryMockRecorder) Create(ctx, activity any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockITodoActivityRepository)(nil).Create), ctx, activity)
+}
+
+// FindByTodoID mocks base method.
+func (m *MockITodoActivityRepository) FindByTodoID(ctx context.Context, tenantID, todoID string) ([]*model.TodoActivity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByTodoID", ctx, tenantID, todoID)
+	ret0, _ := ret[0].([]*model.TodoActivity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByTodoID indicates an expected call of FindByTodoID.
+func (mr *MockITodoActivityRepositoryMockRecorder) FindByTodoID(ctx, tenantID, todoID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByTodoID", reflect.TypeOf((*MockITodoActivityRepository)(nil).FindByTodoID), ctx, tenantID, todoID)
+}
@@ -0,0 +1,55 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: unit_of_work.go
+//
+// Generated by this command:
+//
+//	mockgen -source=unit_of_work.go -destination=mock/unit_of_work.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIUnitOfWork is a mock of IUnitOfWork interface.
+type MockIUnitOfWork struct {
+	ctrl     *gomock.Controller
+	recorder *MockIUnitOfWorkMockRecorder
+	isgomock struct{}
+}
+
+// MockIUnitOfWorkMockRecorder is the mock recorder for MockIUnitOfWork.
+type MockIUnitOfWorkMockRecorder struct {
+	mock *MockIUnitOfWork
+}
+
+// NewMockIUnitOfWork creates a new mock instance.
+func NewMockIUnitOfWork(ctrl *gomock.Controller) *MockIUnitOfWork {
+	mock := &MockIUnitOfWork{ctrl: ctrl}
+	mock.recorder = &MockIUnitOfWorkMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIUnitOfWork) EXPECT() *MockIUnitOfWorkMockRecorder {
+	return m.recorder
+}
+
+// RunInTenantTx mocks base method.
+func (m *MockIUnitOfWork) RunInTenantTx(ctx context.Context, tenantID string, fn func(context.Context) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunInTenantTx", ctx, tenantID, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RunInTenantTx indicates an expected call of RunInTenantTx.
+func (mr *MockIUnitOfWorkMockRecorder) RunInTenantTx(ctx, tenantID, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunInTenantTx", reflect.TypeOf((*MockIUnitOfWork)(nil).RunInTenantTx), ctx, tenantID, fn)
+}
@@ -57,18 +57,18 @@ func (mr *MockIUserRepositoryMockRecorder) Create(ctx, user any) *gomock.Call {
 }
 
 // FindByEmail mocks base method.
-func (m *MockIUserRepository) FindByEmail(ctx context.Context, tenantID, email string) (*model.User, error) {
+func (m *MockIUserRepository) FindByEmail(ctx context.Context, email string) (*model.User, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindByEmail", ctx, tenantID, email)
+	ret := m.ctrl.Call(m, "FindByEmail", ctx, email)
 	ret0, _ := ret[0].(*model.User)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FindByEmail indicates an expected call of FindByEmail.
-func (mr *MockIUserRepositoryMockRecorder) FindByEmail(ctx, tenantID, email any) *gomock.Call {
+func (mr *MockIUserRepositoryMockRecorder) FindByEmail(ctx, email any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByEmail", reflect.TypeOf((*MockIUserRepository)(nil).FindByEmail), ctx, tenantID, email)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByEmail", reflect.TypeOf((*MockIUserRepository)(nil).FindByEmail), ctx, email)
 }
 
 // FindByID mocks base method.
@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+
+	"good-todo-go/internal/domain/model"
+)
+
+//go:generate go run go.uber.org/mock/mockgen -source=project.go -destination=mock/project.go -package=mock
+
+type IProjectRepository interface {
+	Create(ctx context.Context, project *model.Project) (*model.Project, error)
+	FindByID(ctx context.Context, tenantID, id string) (*model.Project, error)
+	// FindVisible lists the user's own projects and the tenant's public ones, sorted by
+	// name. Archived projects are only included when includeArchived is set.
+	FindVisible(ctx context.Context, tenantID, userID string, includeArchived bool) ([]*model.Project, error)
+	Update(ctx context.Context, project *model.Project) (*model.Project, error)
+	// Delete removes the project. Its todos, trashed ones included, move to the inbox.
+	Delete(ctx context.Context, id string) error
+}
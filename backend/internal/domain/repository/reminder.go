@@ -0,0 +1,27 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"good-todo-go/internal/domain/model"
+)
+
+//go:generate go run go.uber.org/mock/mockgen -source=reminder.go -destination=mock/reminder.go -package=mock
+
+type IReminderRepository interface {
+	Create(ctx context.Context, reminder *model.Reminder) (*model.Reminder, error)
+	FindByID(ctx context.Context, tenantID, id string) (*model.Reminder, error)
+	// FindByTodoID lists the todo's reminders, oldest first.
+	FindByTodoID(ctx context.Context, tenantID, todoID string) ([]*model.Reminder, error)
+	Delete(ctx context.Context, id string) error
+	// ClaimDue locks up to limit of the tenant's pending reminders that are due at now,
+	// earliest first. Reminders of completed or trashed todos are not due, nor are those
+	// of todos snoozed past now or waiting to retry. Rows locked by another transaction are skipped, so concurrent
+	// schedulers never claim the same reminder. It must run in a unit of work, which
+	// holds the locks until it ends.
+	ClaimDue(ctx context.Context, tenantID string, now time.Time, limit int) ([]*model.DueReminder, error)
+	// UpdateDelivery saves how delivering the reminder went: its status, attempts, next
+	// attempt, last error and delivery time.
+	UpdateDelivery(ctx context.Context, reminder *model.Reminder) error
+}
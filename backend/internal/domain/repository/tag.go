@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"context"
+
+	"good-todo-go/internal/domain/model"
+)
+
+//go:generate go run go.uber.org/mock/mockgen -source=tag.go -destination=mock/tag.go -package=mock
+
+type ITagRepository interface {
+	// Create returns ErrTagNameConflict if the tenant already has a tag of that name, ignoring case.
+	Create(ctx context.Context, tag *model.Tag) (*model.Tag, error)
+	FindByID(ctx context.Context, tenantID, id string) (*model.Tag, error)
+	// FindByIDs finds the tenant's tags among ids, sorted by name. Unknown ids are left out.
+	FindByIDs(ctx context.Context, tenantID string, ids []string) ([]*model.Tag, error)
+	// ListByTenant returns all of the tenant's tags sorted by name.
+	ListByTenant(ctx context.Context, tenantID string) ([]*model.Tag, error)
+	// Update saves the name and color, with the same name check as Create.
+	Update(ctx context.Context, tag *model.Tag) (*model.Tag, error)
+	// Delete removes the tag and untags every todo that carried it.
+	Delete(ctx context.Context, id string) error
+}
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"good-todo-go/internal/domain/model"
 )
@@ -11,5 +12,14 @@ import (
 type ITenantRepository interface {
 	Create(ctx context.Context, tenant *model.Tenant) (*model.Tenant, error)
 	FindByID(ctx context.Context, id string) (*model.Tenant, error)
+	// FindBySlug resolves the current slug or a previous slug that is still within its
+	// grace period. The returned tenant always carries the canonical (current) slug.
 	FindBySlug(ctx context.Context, slug string) (*model.Tenant, error)
+	// FindByDomain resolves a verified custom domain.
+	FindByDomain(ctx context.Context, domain string) (*model.Tenant, error)
+	// FindAll lists every tenant, for background jobs that work tenant by tenant.
+	FindAll(ctx context.Context) ([]*model.Tenant, error)
+	Update(ctx context.Context, tenant *model.Tenant) (*model.Tenant, error)
+	// ChangeSlug renames the tenant and keeps the previous slug resolvable until keepPreviousUntil.
+	ChangeSlug(ctx context.Context, tenantID, slug string, keepPreviousUntil time.Time) (*model.Tenant, error)
 }
@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"good-todo-go/internal/domain/model"
+)
+
+//go:generate go run go.uber.org/mock/mockgen -source=tenant_domain.go -destination=mock/tenant_domain.go -package=mock
+
+type ITenantDomainRepository interface {
+	Create(ctx context.Context, domain *model.TenantDomain) (*model.TenantDomain, error)
+	FindByID(ctx context.Context, tenantID, id string) (*model.TenantDomain, error)
+	ListByTenant(ctx context.Context, tenantID string) ([]*model.TenantDomain, error)
+	MarkVerified(ctx context.Context, id string, verifiedAt time.Time) (*model.TenantDomain, error)
+	Delete(ctx context.Context, id string) error
+}
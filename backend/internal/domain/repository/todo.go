@@ -2,17 +2,153 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"good-todo-go/internal/domain/model"
 )
 
 //go:generate go run go.uber.org/mock/mockgen -source=todo.go -destination=mock/todo.go -package=mock
 
+type TodoSortField string
+
+const (
+	TodoSortCreatedAt TodoSortField = "created_at"
+	TodoSortDueDate   TodoSortField = "due_date"
+	TodoSortUpdatedAt TodoSortField = "updated_at"
+	TodoSortTitle     TodoSortField = "title"
+	TodoSortPosition  TodoSortField = "position"
+	TodoSortPriority  TodoSortField = "priority"
+)
+
+// TodoSort orders todos by Field, breaking ties by id in the same direction.
+// Todos without a due date come last when sorting by due date in either direction.
+// Sorting by priority breaks ties by earliest due date instead, then by ascending id.
+type TodoSort struct {
+	Field TodoSortField
+	Desc  bool
+}
+
+// TodoFilter narrows a todo listing. Nil and empty fields are not applied.
+// Listings only hold live todos unless Trashed asks for the trash instead.
+// DueFrom is inclusive while DueBefore and DueAfter are exclusive.
+// Overdue compares due dates against Now and only matches incomplete todos.
+// Every Text entry must appear in the title or description, case-insensitively,
+// and no ExcludeText entry may. Likewise the todo must carry every tag in TagIDs and
+// every tag named in Tags, and none named in ExcludeTags; names ignore case.
+// ProjectID keeps the todos of that project, or those in the inbox when it is empty.
+// Live todos snoozed until after Now are left out unless IncludeSnoozed is set; the
+// trash holds snoozed todos either way.
+// VisibleTo keeps the todos that user can see: their own, those assigned to them and
+// public ones. Pages count only those, so a limit is never spent on hidden todos.
+type TodoFilter struct {
+	Completed      *bool
+	IsPublic       *bool
+	DueBefore      *time.Time
+	DueAfter       *time.Time
+	DueFrom        *time.Time
+	Overdue        *bool
+	HasDueDate     *bool
+	CreatedBefore  *time.Time
+	CreatedAfter   *time.Time
+	Text           []string
+	ExcludeText    []string
+	TagIDs         []string
+	Tags           []string
+	ExcludeTags    []string
+	ProjectID      *string
+	Trashed        bool
+	IncludeSnoozed bool
+	VisibleTo      string
+	Now            time.Time
+}
+
+// TodoCursor is the keyset position of a todo: the value of every sortable field and
+// the id breaking ties. Only the field of the active sort is compared.
+type TodoCursor struct {
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	DueDate   *time.Time
+	Title     string
+	Position  string
+	Priority  model.TodoPriority
+	ID        string
+}
+
+// TodoQuery requests at most Limit todos matching Filter in Sort order, starting after After when set.
+type TodoQuery struct {
+	Filter TodoFilter
+	Sort   TodoSort
+	Limit  int
+	After  *TodoCursor
+}
+
 type ITodoRepository interface {
 	Create(ctx context.Context, todo *model.Todo) (*model.Todo, error)
+	// FindByID finds a live todo; todos in the trash are not returned.
 	FindByID(ctx context.Context, id string) (*model.Todo, error)
-	FindByUserID(ctx context.Context, userID string) ([]*model.Todo, error)
-	FindPublicByTenantID(ctx context.Context, tenantID string) ([]*model.Todo, error)
-	Update(ctx context.Context, todo *model.Todo) (*model.Todo, error)
-	Delete(ctx context.Context, id string) error
+	// FindByIDWithTrashed finds a todo whether or not it is in the trash.
+	FindByIDWithTrashed(ctx context.Context, id string) (*model.Todo, error)
+	// FindByIDs finds the live todos among ids in one query, in no particular order.
+	// Unknown and trashed ids are left out.
+	FindByIDs(ctx context.Context, ids []string) ([]*model.Todo, error)
+	FindByUserID(ctx context.Context, userID string, query TodoQuery) ([]*model.Todo, error)
+	FindPublicByTenantID(ctx context.Context, tenantID string, query TodoQuery) ([]*model.Todo, error)
+	// FindByAssigneeID lists the todos assigned to the user, whoever owns them.
+	FindByAssigneeID(ctx context.Context, userID string, query TodoQuery) ([]*model.Todo, error)
+	FindByProjectID(ctx context.Context, projectID string, query TodoQuery) ([]*model.Todo, error)
+	// FindSubtasks finds the live direct subtasks of any of parentIDs, oldest first.
+	FindSubtasks(ctx context.Context, parentIDs []string) ([]*model.Todo, error)
+	// FindBlockers finds the live todos that todoID is blocked by, oldest first.
+	FindBlockers(ctx context.Context, todoID string) ([]*model.Todo, error)
+	// AddBlocker records that todoID is blocked by blockerID; recording it again is a no-op.
+	// It returns ErrDependencyCycle if blockerID already waits on todoID, directly or
+	// through other todos. Run it in a transaction: it serializes the tenant's dependency
+	// changes until commit so concurrent additions cannot close a cycle together.
+	AddBlocker(ctx context.Context, tenantID, todoID, blockerID string) error
+	// RemoveBlocker deletes the dependency and reports whether there was one.
+	RemoveBlocker(ctx context.Context, todoID, blockerID string) (bool, error)
+	// LockPositions serializes changes to the tenant's manual order until the surrounding
+	// transaction ends, so a move cannot compute its key from keys being rewritten.
+	LockPositions(ctx context.Context, tenantID string) error
+	// AdjacentPosition returns the tenant's closest position after position when after is set
+	// and before it otherwise, ignoring excludeID, or "" if there is none. Trashed todos
+	// count, so they keep their place when restored. The empty position comes before all
+	// others, so AdjacentPosition(ctx, tenantID, "", true, "") is the first one.
+	AdjacentPosition(ctx context.Context, tenantID, position string, after bool, excludeID string) (string, error)
+	// Move saves the position and project of a live todo, and nothing else, and bumps its
+	// version. It returns nil if the todo is not live.
+	Move(ctx context.Context, todo *model.Todo) (*model.Todo, error)
+	// LongestPosition returns the length of the tenant's longest position.
+	LongestPosition(ctx context.Context, tenantID string) (int, error)
+	// FindIDsByPosition lists the ids of all of the tenant's todos, trashed ones included,
+	// in manual order.
+	FindIDsByPosition(ctx context.Context, tenantID string) ([]string, error)
+	// SetPositions gives each of ids the position at the same index, leaving versions alone.
+	SetPositions(ctx context.Context, ids, positions []string) error
+	// Search ranks the user's own, assigned and the tenant's public todos matching text, best match first.
+	Search(ctx context.Context, tenantID, userID, text string, limit int) ([]*model.TodoSearchHit, error)
+	// Update saves todo and bumps its version. With a non-nil expectedVersion the write only
+	// applies while the stored version still matches, otherwise it returns ErrVersionMismatch.
+	Update(ctx context.Context, todo *model.Todo, expectedVersion *int) (*model.Todo, error)
+	// Trash moves a live todo to the trash and bumps its version, under the same expectedVersion
+	// condition as Update. It returns ErrVersionMismatch if the todo changed or is already trashed,
+	// or ErrTodoNotFound if no expectedVersion was given and there is no live todo to trash.
+	Trash(ctx context.Context, id string, deletedAt time.Time, expectedVersion *int) error
+	// Restore takes a todo out of the trash and bumps its version. It returns nil if the
+	// todo is not in the trash.
+	Restore(ctx context.Context, id string) (*model.Todo, error)
+	// Snooze saves when a live todo wakes, clearing it when until is nil, and nothing else,
+	// and bumps its version. It returns nil if the todo is not live.
+	Snooze(ctx context.Context, id string, until *time.Time) (*model.Todo, error)
+	// SetAssignee saves the assignee of a live todo, clearing it when assigneeID is nil, and
+	// nothing else, and bumps its version. It returns nil if the todo is not live.
+	SetAssignee(ctx context.Context, id string, assigneeID *string) (*model.Todo, error)
+	// Delete removes the todo permanently, under the same expectedVersion condition as Update.
+	// Without an expectedVersion it returns ErrTodoNotFound if the todo does not exist.
+	Delete(ctx context.Context, id string, expectedVersion *int) error
+	// TrashProjectTodos moves the user's live todos in the project to the trash, bumping
+	// their versions, and returns how many.
+	TrashProjectTodos(ctx context.Context, projectID, userID string, deletedAt time.Time) (int, error)
+	// PurgeTrash permanently removes the tenant's todos trashed before cutoff and returns how many.
+	PurgeTrash(ctx context.Context, tenantID string, cutoff time.Time) (int, error)
 }
@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+
+	"good-todo-go/internal/domain/model"
+)
+
+//go:generate go run go.uber.org/mock/mockgen -source=todo_activity.go -destination=mock/todo_activity.go -package=mock
+
+type ITodoActivityRepository interface {
+	Create(ctx context.Context, activity *model.TodoActivity) (*model.TodoActivity, error)
+	// FindByTodoID lists the todo's activity, oldest first.
+	FindByTodoID(ctx context.Context, tenantID, todoID string) ([]*model.TodoActivity, error)
+}
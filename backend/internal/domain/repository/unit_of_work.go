@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"context"
+)
+
+//go:generate go run go.uber.org/mock/mockgen -source=unit_of_work.go -destination=mock/unit_of_work.go -package=mock
+
+type IUnitOfWork interface {
+	// RunInTenantTx runs fn in a single transaction with RLS scoped to tenantID.
+	// Repository calls made with the context passed to fn take part in the transaction,
+	// which is committed if fn returns nil and rolled back otherwise.
+	RunInTenantTx(ctx context.Context, tenantID string, fn func(ctx context.Context) error) error
+}
@@ -11,7 +11,7 @@ import (
 type IUserRepository interface {
 	Create(ctx context.Context, user *model.User) (*model.User, error)
 	FindByID(ctx context.Context, id string) (*model.User, error)
-	FindByEmail(ctx context.Context, tenantID, email string) (*model.User, error)
+	FindByEmail(ctx context.Context, email string) (*model.User, error)
 	FindByVerificationToken(ctx context.Context, token string) (*model.User, error)
 	Update(ctx context.Context, user *model.User) (*model.User, error)
 }
@@ -6,8 +6,16 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"good-todo-go/internal/ent/generated/comment"
+	"good-todo-go/internal/ent/generated/membership"
+	"good-todo-go/internal/ent/generated/project"
+	"good-todo-go/internal/ent/generated/reminder"
+	"good-todo-go/internal/ent/generated/tag"
 	"good-todo-go/internal/ent/generated/tenant"
+	"good-todo-go/internal/ent/generated/tenantdomain"
+	"good-todo-go/internal/ent/generated/tenantslughistory"
 	"good-todo-go/internal/ent/generated/todo"
+	"good-todo-go/internal/ent/generated/todoactivity"
 	"good-todo-go/internal/ent/generated/user"
 	"reflect"
 	"sync"
@@ -75,9 +83,17 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			tenant.Table: tenant.ValidColumn,
-			todo.Table:   todo.ValidColumn,
-			user.Table:   user.ValidColumn,
+			comment.Table:           comment.ValidColumn,
+			membership.Table:        membership.ValidColumn,
+			project.Table:           project.ValidColumn,
+			reminder.Table:          reminder.ValidColumn,
+			tag.Table:               tag.ValidColumn,
+			tenant.Table:            tenant.ValidColumn,
+			tenantdomain.Table:      tenantdomain.ValidColumn,
+			tenantslughistory.Table: tenantslughistory.ValidColumn,
+			todo.Table:              todo.ValidColumn,
+			todoactivity.Table:      todoactivity.ValidColumn,
+			user.Table:              user.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
@@ -0,0 +1,597 @@
+// Code generated by ent, DO NOT EDIT.
+
+package membership
+
+import (
+	"good-todo-go/internal/ent/generated/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.Membership {
+	return predicate.Membership(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.Membership {
+	return predicate.Membership(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.Membership {
+	return predicate.Membership(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.Membership {
+	return predicate.Membership(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.Membership {
+	return predicate.Membership(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.Membership {
+	return predicate.Membership(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.Membership {
+	return predicate.Membership(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.Membership {
+	return predicate.Membership(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.Membership {
+	return predicate.Membership(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.Membership {
+	return predicate.Membership(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.Membership {
+	return predicate.Membership(sql.FieldContainsFold(FieldID, id))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v string) predicate.Membership {
+	return predicate.Membership(sql.FieldEQ(FieldTenantID, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v string) predicate.Membership {
+	return predicate.Membership(sql.FieldEQ(FieldUserID, v))
+}
+
+// DigestEnabled applies equality check predicate on the "digest_enabled" field. It's identical to DigestEnabledEQ.
+func DigestEnabled(v bool) predicate.Membership {
+	return predicate.Membership(sql.FieldEQ(FieldDigestEnabled, v))
+}
+
+// DigestTime applies equality check predicate on the "digest_time" field. It's identical to DigestTimeEQ.
+func DigestTime(v string) predicate.Membership {
+	return predicate.Membership(sql.FieldEQ(FieldDigestTime, v))
+}
+
+// DigestTimezone applies equality check predicate on the "digest_timezone" field. It's identical to DigestTimezoneEQ.
+func DigestTimezone(v string) predicate.Membership {
+	return predicate.Membership(sql.FieldEQ(FieldDigestTimezone, v))
+}
+
+// DigestLastSentOn applies equality check predicate on the "digest_last_sent_on" field. It's identical to DigestLastSentOnEQ.
+func DigestLastSentOn(v time.Time) predicate.Membership {
+	return predicate.Membership(sql.FieldEQ(FieldDigestLastSentOn, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Membership {
+	return predicate.Membership(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Membership {
+	return predicate.Membership(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v string) predicate.Membership {
+	return predicate.Membership(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v string) predicate.Membership {
+	return predicate.Membership(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...string) predicate.Membership {
+	return predicate.Membership(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...string) predicate.Membership {
+	return predicate.Membership(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v string) predicate.Membership {
+	return predicate.Membership(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v string) predicate.Membership {
+	return predicate.Membership(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v string) predicate.Membership {
+	return predicate.Membership(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v string) predicate.Membership {
+	return predicate.Membership(sql.FieldLTE(FieldTenantID, v))
+}
+
+// TenantIDContains applies the Contains predicate on the "tenant_id" field.
+func TenantIDContains(v string) predicate.Membership {
+	return predicate.Membership(sql.FieldContains(FieldTenantID, v))
+}
+
+// TenantIDHasPrefix applies the HasPrefix predicate on the "tenant_id" field.
+func TenantIDHasPrefix(v string) predicate.Membership {
+	return predicate.Membership(sql.FieldHasPrefix(FieldTenantID, v))
+}
+
+// TenantIDHasSuffix applies the HasSuffix predicate on the "tenant_id" field.
+func TenantIDHasSuffix(v string) predicate.Membership {
+	return predicate.Membership(sql.FieldHasSuffix(FieldTenantID, v))
+}
+
+// TenantIDEqualFold applies the EqualFold predicate on the "tenant_id" field.
+func TenantIDEqualFold(v string) predicate.Membership {
+	return predicate.Membership(sql.FieldEqualFold(FieldTenantID, v))
+}
+
+// TenantIDContainsFold applies the ContainsFold predicate on the "tenant_id" field.
+func TenantIDContainsFold(v string) predicate.Membership {
+	return predicate.Membership(sql.FieldContainsFold(FieldTenantID, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v string) predicate.Membership {
+	return predicate.Membership(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v string) predicate.Membership {
+	return predicate.Membership(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...string) predicate.Membership {
+	return predicate.Membership(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...string) predicate.Membership {
+	return predicate.Membership(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v string) predicate.Membership {
+	return predicate.Membership(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v string) predicate.Membership {
+	return predicate.Membership(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v string) predicate.Membership {
+	return predicate.Membership(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v string) predicate.Membership {
+	return predicate.Membership(sql.FieldLTE(FieldUserID, v))
+}
+
+// UserIDContains applies the Contains predicate on the "user_id" field.
+func UserIDContains(v string) predicate.Membership {
+	return predicate.Membership(sql.FieldContains(FieldUserID, v))
+}
+
+// UserIDHasPrefix applies the HasPrefix predicate on the "user_id" field.
+func UserIDHasPrefix(v string) predicate.Membership {
+	return predicate.Membership(sql.FieldHasPrefix(FieldUserID, v))
+}
+
+// UserIDHasSuffix applies the HasSuffix predicate on the "user_id" field.
+func UserIDHasSuffix(v string) predicate.Membership {
+	return predicate.Membership(sql.FieldHasSuffix(FieldUserID, v))
+}
+
+// UserIDEqualFold applies the EqualFold predicate on the "user_id" field.
+func UserIDEqualFold(v string) predicate.Membership {
+	return predicate.Membership(sql.FieldEqualFold(FieldUserID, v))
+}
+
+// UserIDContainsFold applies the ContainsFold predicate on the "user_id" field.
+func UserIDContainsFold(v string) predicate.Membership {
+	return predicate.Membership(sql.FieldContainsFold(FieldUserID, v))
+}
+
+// RoleEQ applies the EQ predicate on the "role" field.
+func RoleEQ(v Role) predicate.Membership {
+	return predicate.Membership(sql.FieldEQ(FieldRole, v))
+}
+
+// RoleNEQ applies the NEQ predicate on the "role" field.
+func RoleNEQ(v Role) predicate.Membership {
+	return predicate.Membership(sql.FieldNEQ(FieldRole, v))
+}
+
+// RoleIn applies the In predicate on the "role" field.
+func RoleIn(vs ...Role) predicate.Membership {
+	return predicate.Membership(sql.FieldIn(FieldRole, vs...))
+}
+
+// RoleNotIn applies the NotIn predicate on the "role" field.
+func RoleNotIn(vs ...Role) predicate.Membership {
+	return predicate.Membership(sql.FieldNotIn(FieldRole, vs...))
+}
+
+// DigestEnabledEQ applies the EQ predicate on the "digest_enabled" field.
+func DigestEnabledEQ(v bool) predicate.Membership {
+	return predicate.Membership(sql.FieldEQ(FieldDigestEnabled, v))
+}
+
+// DigestEnabledNEQ applies the NEQ predicate on the "digest_enabled" field.
+func DigestEnabledNEQ(v bool) predicate.Membership {
+	return predicate.Membership(sql.FieldNEQ(FieldDigestEnabled, v))
+}
+
+// DigestTimeEQ applies the EQ predicate on the "digest_time" field.
+func DigestTimeEQ(v string) predicate.Membership {
+	return predicate.Membership(sql.FieldEQ(FieldDigestTime, v))
+}
+
+// DigestTimeNEQ applies the NEQ predicate on the "digest_time" field.
+func DigestTimeNEQ(v string) predicate.Membership {
+	return predicate.Membership(sql.FieldNEQ(FieldDigestTime, v))
+}
+
+// DigestTimeIn applies the In predicate on the "digest_time" field.
+func DigestTimeIn(vs ...string) predicate.Membership {
+	return predicate.Membership(sql.FieldIn(FieldDigestTime, vs...))
+}
+
+// DigestTimeNotIn applies the NotIn predicate on the "digest_time" field.
+func DigestTimeNotIn(vs ...string) predicate.Membership {
+	return predicate.Membership(sql.FieldNotIn(FieldDigestTime, vs...))
+}
+
+// DigestTimeGT applies the GT predicate on the "digest_time" field.
+func DigestTimeGT(v string) predicate.Membership {
+	return predicate.Membership(sql.FieldGT(FieldDigestTime, v))
+}
+
+// DigestTimeGTE applies the GTE predicate on the "digest_time" field.
+func DigestTimeGTE(v string) predicate.Membership {
+	return predicate.Membership(sql.FieldGTE(FieldDigestTime, v))
+}
+
+// DigestTimeLT applies the LT predicate on the "digest_time" field.
+func DigestTimeLT(v string) predicate.Membership {
+	return predicate.Membership(sql.FieldLT(FieldDigestTime, v))
+}
+
+// DigestTimeLTE applies the LTE predicate on the "digest_time" field.
+func DigestTimeLTE(v string) predicate.Membership {
+	return predicate.Membership(sql.FieldLTE(FieldDigestTime, v))
+}
+
+// DigestTimeContains applies the Contains predicate on the "digest_time" field.
+func DigestTimeContains(v string) predicate.Membership {
+	return predicate.Membership(sql.FieldContains(FieldDigestTime, v))
+}
+
+// DigestTimeHasPrefix applies the HasPrefix predicate on the "digest_time" field.
+func DigestTimeHasPrefix(v string) predicate.Membership {
+	return predicate.Membership(sql.FieldHasPrefix(FieldDigestTime, v))
+}
+
+// DigestTimeHasSuffix applies the HasSuffix predicate on the "digest_time" field.
+func DigestTimeHasSuffix(v string) predicate.Membership {
+	return predicate.Membership(sql.FieldHasSuffix(FieldDigestTime, v))
+}
+
+// DigestTimeEqualFold applies the EqualFold predicate on the "digest_time" field.
+func DigestTimeEqualFold(v string) predicate.Membership {
+	return predicate.Membership(sql.FieldEqualFold(FieldDigestTime, v))
+}
+
+// DigestTimeContainsFold applies the ContainsFold predicate on the "digest_time" field.
+func DigestTimeContainsFold(v string) predicate.Membership {
+	return predicate.Membership(sql.FieldContainsFold(FieldDigestTime, v))
+}
+
+// DigestTimezoneEQ applies the EQ predicate on the "digest_timezone" field.
+func DigestTimezoneEQ(v string) predicate.Membership {
+	return predicate.Membership(sql.FieldEQ(FieldDigestTimezone, v))
+}
+
+// DigestTimezoneNEQ applies the NEQ predicate on the "digest_timezone" field.
+func DigestTimezoneNEQ(v string) predicate.Membership {
+	return predicate.Membership(sql.FieldNEQ(FieldDigestTimezone, v))
+}
+
+// DigestTimezoneIn applies the In predicate on the "digest_timezone" field.
+func DigestTimezoneIn(vs ...string) predicate.Membership {
+	return predicate.Membership(sql.FieldIn(FieldDigestTimezone, vs...))
+}
+
+// DigestTimezoneNotIn applies the NotIn predicate on the "digest_timezone" field.
+func DigestTimezoneNotIn(vs ...string) predicate.Membership {
+	return predicate.Membership(sql.FieldNotIn(FieldDigestTimezone, vs...))
+}
+
+// DigestTimezoneGT applies the GT predicate on the "digest_timezone" field.
+func DigestTimezoneGT(v string) predicate.Membership {
+	return predicate.Membership(sql.FieldGT(FieldDigestTimezone, v))
+}
+
+// DigestTimezoneGTE applies the GTE predicate on the "digest_timezone" field.
+func DigestTimezoneGTE(v string) predicate.Membership {
+	return predicate.Membership(sql.FieldGTE(FieldDigestTimezone, v))
+}
+
+// DigestTimezoneLT applies the LT predicate on the "digest_timezone" field.
+func DigestTimezoneLT(v string) predicate.Membership {
+	return predicate.Membership(sql.FieldLT(FieldDigestTimezone, v))
+}
+
+// DigestTimezoneLTE applies the LTE predicate on the "digest_timezone" field.
+func DigestTimezoneLTE(v string) predicate.Membership {
+	return predicate.Membership(sql.FieldLTE(FieldDigestTimezone, v))
+}
+
+// DigestTimezoneContains applies the Contains predicate on the "digest_timezone" field.
+func DigestTimezoneContains(v string) predicate.Membership {
+	return predicate.Membership(sql.FieldContains(FieldDigestTimezone, v))
+}
+
+// DigestTimezoneHasPrefix applies the HasPrefix predicate on the "digest_timezone" field.
+func DigestTimezoneHasPrefix(v string) predicate.Membership {
+	return predicate.Membership(sql.FieldHasPrefix(FieldDigestTimezone, v))
+}
+
+// DigestTimezoneHasSuffix applies the HasSuffix predicate on the "digest_timezone" field.
+func DigestTimezoneHasSuffix(v string) predicate.Membership {
+	return predicate.Membership(sql.FieldHasSuffix(FieldDigestTimezone, v))
+}
+
+// DigestTimezoneIsNil applies the IsNil predicate on the "digest_timezone" field.
+func DigestTimezoneIsNil() predicate.Membership {
+	return predicate.Membership(sql.FieldIsNull(FieldDigestTimezone))
+}
+
+// DigestTimezoneNotNil applies the NotNil predicate on the "digest_timezone" field.
+func DigestTimezoneNotNil() predicate.Membership {
+	return predicate.Membership(sql.FieldNotNull(FieldDigestTimezone))
+}
+
+// DigestTimezoneEqualFold applies the EqualFold predicate on the "digest_timezone" field.
+func DigestTimezoneEqualFold(v string) predicate.Membership {
+	return predicate.Membership(sql.FieldEqualFold(FieldDigestTimezone, v))
+}
+
+// DigestTimezoneContainsFold applies the ContainsFold predicate on the "digest_timezone" field.
+func DigestTimezoneContainsFold(v string) predicate.Membership {
+	return predicate.Membership(sql.FieldContainsFold(FieldDigestTimezone, v))
+}
+
+// DigestLastSentOnEQ applies the EQ predicate on the "digest_last_sent_on" field.
+func DigestLastSentOnEQ(v time.Time) predicate.Membership {
+	return predicate.Membership(sql.FieldEQ(FieldDigestLastSentOn, v))
+}
+
+// DigestLastSentOnNEQ applies the NEQ predicate on the "digest_last_sent_on" field.
+func DigestLastSentOnNEQ(v time.Time) predicate.Membership {
+	return predicate.Membership(sql.FieldNEQ(FieldDigestLastSentOn, v))
+}
+
+// DigestLastSentOnIn applies the In predicate on the "digest_last_sent_on" field.
+func DigestLastSentOnIn(vs ...time.Time) predicate.Membership {
+	return predicate.Membership(sql.FieldIn(FieldDigestLastSentOn, vs...))
+}
+
+// DigestLastSentOnNotIn applies the NotIn predicate on the "digest_last_sent_on" field.
+func DigestLastSentOnNotIn(vs ...time.Time) predicate.Membership {
+	return predicate.Membership(sql.FieldNotIn(FieldDigestLastSentOn, vs...))
+}
+
+// DigestLastSentOnGT applies the GT predicate on the "digest_last_sent_on" field.
+func DigestLastSentOnGT(v time.Time) predicate.Membership {
+	return predicate.Membership(sql.FieldGT(FieldDigestLastSentOn, v))
+}
+
+// DigestLastSentOnGTE applies the GTE predicate on the "digest_last_sent_on" field.
+func DigestLastSentOnGTE(v time.Time) predicate.Membership {
+	return predicate.Membership(sql.FieldGTE(FieldDigestLastSentOn, v))
+}
+
+// DigestLastSentOnLT applies the LT predicate on the "digest_last_sent_on" field.
+func DigestLastSentOnLT(v time.Time) predicate.Membership {
+	return predicate.Membership(sql.FieldLT(FieldDigestLastSentOn, v))
+}
+
+// DigestLastSentOnLTE applies the LTE predicate on the "digest_last_sent_on" field.
+func DigestLastSentOnLTE(v time.Time) predicate.Membership {
+	return predicate.Membership(sql.FieldLTE(FieldDigestLastSentOn, v))
+}
+
+// DigestLastSentOnIsNil applies the IsNil predicate on the "digest_last_sent_on" field.
+func DigestLastSentOnIsNil() predicate.Membership {
+	return predicate.Membership(sql.FieldIsNull(FieldDigestLastSentOn))
+}
+
+// DigestLastSentOnNotNil applies the NotNil predicate on the "digest_last_sent_on" field.
+func DigestLastSentOnNotNil() predicate.Membership {
+	return predicate.Membership(sql.FieldNotNull(FieldDigestLastSentOn))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Membership {
+	return predicate.Membership(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Membership {
+	return predicate.Membership(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Membership {
+	return predicate.Membership(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Membership {
+	return predicate.Membership(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Membership {
+	return predicate.Membership(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Membership {
+	return predicate.Membership(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Membership {
+	return predicate.Membership(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Membership {
+	return predicate.Membership(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Membership {
+	return predicate.Membership(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Membership {
+	return predicate.Membership(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Membership {
+	return predicate.Membership(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Membership {
+	return predicate.Membership(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Membership {
+	return predicate.Membership(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Membership {
+	return predicate.Membership(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Membership {
+	return predicate.Membership(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Membership {
+	return predicate.Membership(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasTenant applies the HasEdge predicate on the "tenant" edge.
+func HasTenant() predicate.Membership {
+	return predicate.Membership(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, TenantTable, TenantColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTenantWith applies the HasEdge predicate on the "tenant" edge with a given conditions (other predicates).
+func HasTenantWith(preds ...predicate.Tenant) predicate.Membership {
+	return predicate.Membership(func(s *sql.Selector) {
+		step := newTenantStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.Membership {
+	return predicate.Membership(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.Membership {
+	return predicate.Membership(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Membership) predicate.Membership {
+	return predicate.Membership(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Membership) predicate.Membership {
+	return predicate.Membership(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Membership) predicate.Membership {
+	return predicate.Membership(sql.NotPredicates(p))
+}
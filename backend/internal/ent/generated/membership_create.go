@@ -0,0 +1,992 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"good-todo-go/internal/ent/generated/membership"
+	"good-todo-go/internal/ent/generated/tenant"
+	"good-todo-go/internal/ent/generated/user"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MembershipCreate is the builder for creating a Membership entity.
+type MembershipCreate struct {
+	config
+	mutation *MembershipMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *MembershipCreate) SetTenantID(v string) *MembershipCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *MembershipCreate) SetUserID(v string) *MembershipCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetRole sets the "role" field.
+func (_c *MembershipCreate) SetRole(v membership.Role) *MembershipCreate {
+	_c.mutation.SetRole(v)
+	return _c
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (_c *MembershipCreate) SetNillableRole(v *membership.Role) *MembershipCreate {
+	if v != nil {
+		_c.SetRole(*v)
+	}
+	return _c
+}
+
+// SetDigestEnabled sets the "digest_enabled" field.
+func (_c *MembershipCreate) SetDigestEnabled(v bool) *MembershipCreate {
+	_c.mutation.SetDigestEnabled(v)
+	return _c
+}
+
+// SetNillableDigestEnabled sets the "digest_enabled" field if the given value is not nil.
+func (_c *MembershipCreate) SetNillableDigestEnabled(v *bool) *MembershipCreate {
+	if v != nil {
+		_c.SetDigestEnabled(*v)
+	}
+	return _c
+}
+
+// SetDigestTime sets the "digest_time" field.
+func (_c *MembershipCreate) SetDigestTime(v string) *MembershipCreate {
+	_c.mutation.SetDigestTime(v)
+	return _c
+}
+
+// SetNillableDigestTime sets the "digest_time" field if the given value is not nil.
+func (_c *MembershipCreate) SetNillableDigestTime(v *string) *MembershipCreate {
+	if v != nil {
+		_c.SetDigestTime(*v)
+	}
+	return _c
+}
+
+// SetDigestTimezone sets the "digest_timezone" field.
+func (_c *MembershipCreate) SetDigestTimezone(v string) *MembershipCreate {
+	_c.mutation.SetDigestTimezone(v)
+	return _c
+}
+
+// SetNillableDigestTimezone sets the "digest_timezone" field if the given value is not nil.
+func (_c *MembershipCreate) SetNillableDigestTimezone(v *string) *MembershipCreate {
+	if v != nil {
+		_c.SetDigestTimezone(*v)
+	}
+	return _c
+}
+
+// SetDigestLastSentOn sets the "digest_last_sent_on" field.
+func (_c *MembershipCreate) SetDigestLastSentOn(v time.Time) *MembershipCreate {
+	_c.mutation.SetDigestLastSentOn(v)
+	return _c
+}
+
+// SetNillableDigestLastSentOn sets the "digest_last_sent_on" field if the given value is not nil.
+func (_c *MembershipCreate) SetNillableDigestLastSentOn(v *time.Time) *MembershipCreate {
+	if v != nil {
+		_c.SetDigestLastSentOn(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *MembershipCreate) SetCreatedAt(v time.Time) *MembershipCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *MembershipCreate) SetNillableCreatedAt(v *time.Time) *MembershipCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *MembershipCreate) SetUpdatedAt(v time.Time) *MembershipCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *MembershipCreate) SetNillableUpdatedAt(v *time.Time) *MembershipCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *MembershipCreate) SetID(v string) *MembershipCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (_c *MembershipCreate) SetTenant(v *Tenant) *MembershipCreate {
+	return _c.SetTenantID(v.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *MembershipCreate) SetUser(v *User) *MembershipCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// Mutation returns the MembershipMutation object of the builder.
+func (_c *MembershipCreate) Mutation() *MembershipMutation {
+	return _c.mutation
+}
+
+// Save creates the Membership in the database.
+func (_c *MembershipCreate) Save(ctx context.Context) (*Membership, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *MembershipCreate) SaveX(ctx context.Context) *Membership {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *MembershipCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *MembershipCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *MembershipCreate) defaults() {
+	if _, ok := _c.mutation.Role(); !ok {
+		v := membership.DefaultRole
+		_c.mutation.SetRole(v)
+	}
+	if _, ok := _c.mutation.DigestEnabled(); !ok {
+		v := membership.DefaultDigestEnabled
+		_c.mutation.SetDigestEnabled(v)
+	}
+	if _, ok := _c.mutation.DigestTime(); !ok {
+		v := membership.DefaultDigestTime
+		_c.mutation.SetDigestTime(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := membership.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := membership.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *MembershipCreate) check() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`generated: missing required field "Membership.tenant_id"`)}
+	}
+	if v, ok := _c.mutation.TenantID(); ok {
+		if err := membership.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`generated: validator failed for field "Membership.tenant_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`generated: missing required field "Membership.user_id"`)}
+	}
+	if v, ok := _c.mutation.UserID(); ok {
+		if err := membership.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`generated: validator failed for field "Membership.user_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Role(); !ok {
+		return &ValidationError{Name: "role", err: errors.New(`generated: missing required field "Membership.role"`)}
+	}
+	if v, ok := _c.mutation.Role(); ok {
+		if err := membership.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`generated: validator failed for field "Membership.role": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.DigestEnabled(); !ok {
+		return &ValidationError{Name: "digest_enabled", err: errors.New(`generated: missing required field "Membership.digest_enabled"`)}
+	}
+	if _, ok := _c.mutation.DigestTime(); !ok {
+		return &ValidationError{Name: "digest_time", err: errors.New(`generated: missing required field "Membership.digest_time"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`generated: missing required field "Membership.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`generated: missing required field "Membership.updated_at"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := membership.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`generated: validator failed for field "Membership.id": %w`, err)}
+		}
+	}
+	if len(_c.mutation.TenantIDs()) == 0 {
+		return &ValidationError{Name: "tenant", err: errors.New(`generated: missing required edge "Membership.tenant"`)}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`generated: missing required edge "Membership.user"`)}
+	}
+	return nil
+}
+
+func (_c *MembershipCreate) sqlSave(ctx context.Context) (*Membership, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected Membership.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *MembershipCreate) createSpec() (*Membership, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Membership{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(membership.Table, sqlgraph.NewFieldSpec(membership.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.Role(); ok {
+		_spec.SetField(membership.FieldRole, field.TypeEnum, value)
+		_node.Role = value
+	}
+	if value, ok := _c.mutation.DigestEnabled(); ok {
+		_spec.SetField(membership.FieldDigestEnabled, field.TypeBool, value)
+		_node.DigestEnabled = value
+	}
+	if value, ok := _c.mutation.DigestTime(); ok {
+		_spec.SetField(membership.FieldDigestTime, field.TypeString, value)
+		_node.DigestTime = value
+	}
+	if value, ok := _c.mutation.DigestTimezone(); ok {
+		_spec.SetField(membership.FieldDigestTimezone, field.TypeString, value)
+		_node.DigestTimezone = &value
+	}
+	if value, ok := _c.mutation.DigestLastSentOn(); ok {
+		_spec.SetField(membership.FieldDigestLastSentOn, field.TypeTime, value)
+		_node.DigestLastSentOn = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(membership.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(membership.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   membership.TenantTable,
+			Columns: []string{membership.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TenantID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   membership.UserTable,
+			Columns: []string{membership.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Membership.Create().
+//		SetTenantID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.MembershipUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *MembershipCreate) OnConflict(opts ...sql.ConflictOption) *MembershipUpsertOne {
+	_c.conflict = opts
+	return &MembershipUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Membership.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *MembershipCreate) OnConflictColumns(columns ...string) *MembershipUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &MembershipUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// MembershipUpsertOne is the builder for "upsert"-ing
+	//  one Membership node.
+	MembershipUpsertOne struct {
+		create *MembershipCreate
+	}
+
+	// MembershipUpsert is the "OnConflict" setter.
+	MembershipUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetRole sets the "role" field.
+func (u *MembershipUpsert) SetRole(v membership.Role) *MembershipUpsert {
+	u.Set(membership.FieldRole, v)
+	return u
+}
+
+// UpdateRole sets the "role" field to the value that was provided on create.
+func (u *MembershipUpsert) UpdateRole() *MembershipUpsert {
+	u.SetExcluded(membership.FieldRole)
+	return u
+}
+
+// SetDigestEnabled sets the "digest_enabled" field.
+func (u *MembershipUpsert) SetDigestEnabled(v bool) *MembershipUpsert {
+	u.Set(membership.FieldDigestEnabled, v)
+	return u
+}
+
+// UpdateDigestEnabled sets the "digest_enabled" field to the value that was provided on create.
+func (u *MembershipUpsert) UpdateDigestEnabled() *MembershipUpsert {
+	u.SetExcluded(membership.FieldDigestEnabled)
+	return u
+}
+
+// SetDigestTime sets the "digest_time" field.
+func (u *MembershipUpsert) SetDigestTime(v string) *MembershipUpsert {
+	u.Set(membership.FieldDigestTime, v)
+	return u
+}
+
+// UpdateDigestTime sets the "digest_time" field to the value that was provided on create.
+func (u *MembershipUpsert) UpdateDigestTime() *MembershipUpsert {
+	u.SetExcluded(membership.FieldDigestTime)
+	return u
+}
+
+// SetDigestTimezone sets the "digest_timezone" field.
+func (u *MembershipUpsert) SetDigestTimezone(v string) *MembershipUpsert {
+	u.Set(membership.FieldDigestTimezone, v)
+	return u
+}
+
+// UpdateDigestTimezone sets the "digest_timezone" field to the value that was provided on create.
+func (u *MembershipUpsert) UpdateDigestTimezone() *MembershipUpsert {
+	u.SetExcluded(membership.FieldDigestTimezone)
+	return u
+}
+
+// ClearDigestTimezone clears the value of the "digest_timezone" field.
+func (u *MembershipUpsert) ClearDigestTimezone() *MembershipUpsert {
+	u.SetNull(membership.FieldDigestTimezone)
+	return u
+}
+
+// SetDigestLastSentOn sets the "digest_last_sent_on" field.
+func (u *MembershipUpsert) SetDigestLastSentOn(v time.Time) *MembershipUpsert {
+	u.Set(membership.FieldDigestLastSentOn, v)
+	return u
+}
+
+// UpdateDigestLastSentOn sets the "digest_last_sent_on" field to the value that was provided on create.
+func (u *MembershipUpsert) UpdateDigestLastSentOn() *MembershipUpsert {
+	u.SetExcluded(membership.FieldDigestLastSentOn)
+	return u
+}
+
+// ClearDigestLastSentOn clears the value of the "digest_last_sent_on" field.
+func (u *MembershipUpsert) ClearDigestLastSentOn() *MembershipUpsert {
+	u.SetNull(membership.FieldDigestLastSentOn)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *MembershipUpsert) SetUpdatedAt(v time.Time) *MembershipUpsert {
+	u.Set(membership.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *MembershipUpsert) UpdateUpdatedAt() *MembershipUpsert {
+	u.SetExcluded(membership.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Membership.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(membership.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *MembershipUpsertOne) UpdateNewValues() *MembershipUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(membership.FieldID)
+		}
+		if _, exists := u.create.mutation.TenantID(); exists {
+			s.SetIgnore(membership.FieldTenantID)
+		}
+		if _, exists := u.create.mutation.UserID(); exists {
+			s.SetIgnore(membership.FieldUserID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(membership.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Membership.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *MembershipUpsertOne) Ignore() *MembershipUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *MembershipUpsertOne) DoNothing() *MembershipUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the MembershipCreate.OnConflict
+// documentation for more info.
+func (u *MembershipUpsertOne) Update(set func(*MembershipUpsert)) *MembershipUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&MembershipUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetRole sets the "role" field.
+func (u *MembershipUpsertOne) SetRole(v membership.Role) *MembershipUpsertOne {
+	return u.Update(func(s *MembershipUpsert) {
+		s.SetRole(v)
+	})
+}
+
+// UpdateRole sets the "role" field to the value that was provided on create.
+func (u *MembershipUpsertOne) UpdateRole() *MembershipUpsertOne {
+	return u.Update(func(s *MembershipUpsert) {
+		s.UpdateRole()
+	})
+}
+
+// SetDigestEnabled sets the "digest_enabled" field.
+func (u *MembershipUpsertOne) SetDigestEnabled(v bool) *MembershipUpsertOne {
+	return u.Update(func(s *MembershipUpsert) {
+		s.SetDigestEnabled(v)
+	})
+}
+
+// UpdateDigestEnabled sets the "digest_enabled" field to the value that was provided on create.
+func (u *MembershipUpsertOne) UpdateDigestEnabled() *MembershipUpsertOne {
+	return u.Update(func(s *MembershipUpsert) {
+		s.UpdateDigestEnabled()
+	})
+}
+
+// SetDigestTime sets the "digest_time" field.
+func (u *MembershipUpsertOne) SetDigestTime(v string) *MembershipUpsertOne {
+	return u.Update(func(s *MembershipUpsert) {
+		s.SetDigestTime(v)
+	})
+}
+
+// UpdateDigestTime sets the "digest_time" field to the value that was provided on create.
+func (u *MembershipUpsertOne) UpdateDigestTime() *MembershipUpsertOne {
+	return u.Update(func(s *MembershipUpsert) {
+		s.UpdateDigestTime()
+	})
+}
+
+// SetDigestTimezone sets the "digest_timezone" field.
+func (u *MembershipUpsertOne) SetDigestTimezone(v string) *MembershipUpsertOne {
+	return u.Update(func(s *MembershipUpsert) {
+		s.SetDigestTimezone(v)
+	})
+}
+
+// UpdateDigestTimezone sets the "digest_timezone" field to the value that was provided on create.
+func (u *MembershipUpsertOne) UpdateDigestTimezone() *MembershipUpsertOne {
+	return u.Update(func(s *MembershipUpsert) {
+		s.UpdateDigestTimezone()
+	})
+}
+
+// ClearDigestTimezone clears the value of the "digest_timezone" field.
+func (u *MembershipUpsertOne) ClearDigestTimezone() *MembershipUpsertOne {
+	return u.Update(func(s *MembershipUpsert) {
+		s.ClearDigestTimezone()
+	})
+}
+
+// SetDigestLastSentOn sets the "digest_last_sent_on" field.
+func (u *MembershipUpsertOne) SetDigestLastSentOn(v time.Time) *MembershipUpsertOne {
+	return u.Update(func(s *MembershipUpsert) {
+		s.SetDigestLastSentOn(v)
+	})
+}
+
+// UpdateDigestLastSentOn sets the "digest_last_sent_on" field to the value that was provided on create.
+func (u *MembershipUpsertOne) UpdateDigestLastSentOn() *MembershipUpsertOne {
+	return u.Update(func(s *MembershipUpsert) {
+		s.UpdateDigestLastSentOn()
+	})
+}
+
+// ClearDigestLastSentOn clears the value of the "digest_last_sent_on" field.
+func (u *MembershipUpsertOne) ClearDigestLastSentOn() *MembershipUpsertOne {
+	return u.Update(func(s *MembershipUpsert) {
+		s.ClearDigestLastSentOn()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *MembershipUpsertOne) SetUpdatedAt(v time.Time) *MembershipUpsertOne {
+	return u.Update(func(s *MembershipUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *MembershipUpsertOne) UpdateUpdatedAt() *MembershipUpsertOne {
+	return u.Update(func(s *MembershipUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *MembershipUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("generated: missing options for MembershipCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *MembershipUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *MembershipUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("generated: MembershipUpsertOne.ID is not supported by MySQL driver. Use MembershipUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *MembershipUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// MembershipCreateBulk is the builder for creating many Membership entities in bulk.
+type MembershipCreateBulk struct {
+	config
+	err      error
+	builders []*MembershipCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Membership entities in the database.
+func (_c *MembershipCreateBulk) Save(ctx context.Context) ([]*Membership, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Membership, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MembershipMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *MembershipCreateBulk) SaveX(ctx context.Context) []*Membership {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *MembershipCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *MembershipCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Membership.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.MembershipUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *MembershipCreateBulk) OnConflict(opts ...sql.ConflictOption) *MembershipUpsertBulk {
+	_c.conflict = opts
+	return &MembershipUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Membership.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *MembershipCreateBulk) OnConflictColumns(columns ...string) *MembershipUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &MembershipUpsertBulk{
+		create: _c,
+	}
+}
+
+// MembershipUpsertBulk is the builder for "upsert"-ing
+// a bulk of Membership nodes.
+type MembershipUpsertBulk struct {
+	create *MembershipCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Membership.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(membership.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *MembershipUpsertBulk) UpdateNewValues() *MembershipUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(membership.FieldID)
+			}
+			if _, exists := b.mutation.TenantID(); exists {
+				s.SetIgnore(membership.FieldTenantID)
+			}
+			if _, exists := b.mutation.UserID(); exists {
+				s.SetIgnore(membership.FieldUserID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(membership.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Membership.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *MembershipUpsertBulk) Ignore() *MembershipUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *MembershipUpsertBulk) DoNothing() *MembershipUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the MembershipCreateBulk.OnConflict
+// documentation for more info.
+func (u *MembershipUpsertBulk) Update(set func(*MembershipUpsert)) *MembershipUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&MembershipUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetRole sets the "role" field.
+func (u *MembershipUpsertBulk) SetRole(v membership.Role) *MembershipUpsertBulk {
+	return u.Update(func(s *MembershipUpsert) {
+		s.SetRole(v)
+	})
+}
+
+// UpdateRole sets the "role" field to the value that was provided on create.
+func (u *MembershipUpsertBulk) UpdateRole() *MembershipUpsertBulk {
+	return u.Update(func(s *MembershipUpsert) {
+		s.UpdateRole()
+	})
+}
+
+// SetDigestEnabled sets the "digest_enabled" field.
+func (u *MembershipUpsertBulk) SetDigestEnabled(v bool) *MembershipUpsertBulk {
+	return u.Update(func(s *MembershipUpsert) {
+		s.SetDigestEnabled(v)
+	})
+}
+
+// UpdateDigestEnabled sets the "digest_enabled" field to the value that was provided on create.
+func (u *MembershipUpsertBulk) UpdateDigestEnabled() *MembershipUpsertBulk {
+	return u.Update(func(s *MembershipUpsert) {
+		s.UpdateDigestEnabled()
+	})
+}
+
+// SetDigestTime sets the "digest_time" field.
+func (u *MembershipUpsertBulk) SetDigestTime(v string) *MembershipUpsertBulk {
+	return u.Update(func(s *MembershipUpsert) {
+		s.SetDigestTime(v)
+	})
+}
+
+// UpdateDigestTime sets the "digest_time" field to the value that was provided on create.
+func (u *MembershipUpsertBulk) UpdateDigestTime() *MembershipUpsertBulk {
+	return u.Update(func(s *MembershipUpsert) {
+		s.UpdateDigestTime()
+	})
+}
+
+// SetDigestTimezone sets the "digest_timezone" field.
+func (u *MembershipUpsertBulk) SetDigestTimezone(v string) *MembershipUpsertBulk {
+	return u.Update(func(s *MembershipUpsert) {
+		s.SetDigestTimezone(v)
+	})
+}
+
+// UpdateDigestTimezone sets the "digest_timezone" field to the value that was provided on create.
+func (u *MembershipUpsertBulk) UpdateDigestTimezone() *MembershipUpsertBulk {
+	return u.Update(func(s *MembershipUpsert) {
+		s.UpdateDigestTimezone()
+	})
+}
+
+// ClearDigestTimezone clears the value of the "digest_timezone" field.
+func (u *MembershipUpsertBulk) ClearDigestTimezone() *MembershipUpsertBulk {
+	return u.Update(func(s *MembershipUpsert) {
+		s.ClearDigestTimezone()
+	})
+}
+
+// SetDigestLastSentOn sets the "digest_last_sent_on" field.
+func (u *MembershipUpsertBulk) SetDigestLastSentOn(v time.Time) *MembershipUpsertBulk {
+	return u.Update(func(s *MembershipUpsert) {
+		s.SetDigestLastSentOn(v)
+	})
+}
+
+// UpdateDigestLastSentOn sets the "digest_last_sent_on" field to the value that was provided on create.
+func (u *MembershipUpsertBulk) UpdateDigestLastSentOn() *MembershipUpsertBulk {
+	return u.Update(func(s *MembershipUpsert) {
+		s.UpdateDigestLastSentOn()
+	})
+}
+
+// ClearDigestLastSentOn clears the value of the "digest_last_sent_on" field.
+func (u *MembershipUpsertBulk) ClearDigestLastSentOn() *MembershipUpsertBulk {
+	return u.Update(func(s *MembershipUpsert) {
+		s.ClearDigestLastSentOn()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *MembershipUpsertBulk) SetUpdatedAt(v time.Time) *MembershipUpsertBulk {
+	return u.Update(func(s *MembershipUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *MembershipUpsertBulk) UpdateUpdatedAt() *MembershipUpsertBulk {
+	return u.Update(func(s *MembershipUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *MembershipUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("generated: OnConflict was set for builder %d. Set it on the MembershipCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("generated: missing options for MembershipCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *MembershipUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
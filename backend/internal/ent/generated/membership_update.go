@@ -0,0 +1,456 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"good-todo-go/internal/ent/generated/membership"
+	"good-todo-go/internal/ent/generated/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MembershipUpdate is the builder for updating Membership entities.
+type MembershipUpdate struct {
+	config
+	hooks    []Hook
+	mutation *MembershipMutation
+}
+
+// Where appends a list predicates to the MembershipUpdate builder.
+func (_u *MembershipUpdate) Where(ps ...predicate.Membership) *MembershipUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetRole sets the "role" field.
+func (_u *MembershipUpdate) SetRole(v membership.Role) *MembershipUpdate {
+	_u.mutation.SetRole(v)
+	return _u
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (_u *MembershipUpdate) SetNillableRole(v *membership.Role) *MembershipUpdate {
+	if v != nil {
+		_u.SetRole(*v)
+	}
+	return _u
+}
+
+// SetDigestEnabled sets the "digest_enabled" field.
+func (_u *MembershipUpdate) SetDigestEnabled(v bool) *MembershipUpdate {
+	_u.mutation.SetDigestEnabled(v)
+	return _u
+}
+
+// SetNillableDigestEnabled sets the "digest_enabled" field if the given value is not nil.
+func (_u *MembershipUpdate) SetNillableDigestEnabled(v *bool) *MembershipUpdate {
+	if v != nil {
+		_u.SetDigestEnabled(*v)
+	}
+	return _u
+}
+
+// SetDigestTime sets the "digest_time" field.
+func (_u *MembershipUpdate) SetDigestTime(v string) *MembershipUpdate {
+	_u.mutation.SetDigestTime(v)
+	return _u
+}
+
+// SetNillableDigestTime sets the "digest_time" field if the given value is not nil.
+func (_u *MembershipUpdate) SetNillableDigestTime(v *string) *MembershipUpdate {
+	if v != nil {
+		_u.SetDigestTime(*v)
+	}
+	return _u
+}
+
+// SetDigestTimezone sets the "digest_timezone" field.
+func (_u *MembershipUpdate) SetDigestTimezone(v string) *MembershipUpdate {
+	_u.mutation.SetDigestTimezone(v)
+	return _u
+}
+
+// SetNillableDigestTimezone sets the "digest_timezone" field if the given value is not nil.
+func (_u *MembershipUpdate) SetNillableDigestTimezone(v *string) *MembershipUpdate {
+	if v != nil {
+		_u.SetDigestTimezone(*v)
+	}
+	return _u
+}
+
+// ClearDigestTimezone clears the value of the "digest_timezone" field.
+func (_u *MembershipUpdate) ClearDigestTimezone() *MembershipUpdate {
+	_u.mutation.ClearDigestTimezone()
+	return _u
+}
+
+// SetDigestLastSentOn sets the "digest_last_sent_on" field.
+func (_u *MembershipUpdate) SetDigestLastSentOn(v time.Time) *MembershipUpdate {
+	_u.mutation.SetDigestLastSentOn(v)
+	return _u
+}
+
+// SetNillableDigestLastSentOn sets the "digest_last_sent_on" field if the given value is not nil.
+func (_u *MembershipUpdate) SetNillableDigestLastSentOn(v *time.Time) *MembershipUpdate {
+	if v != nil {
+		_u.SetDigestLastSentOn(*v)
+	}
+	return _u
+}
+
+// ClearDigestLastSentOn clears the value of the "digest_last_sent_on" field.
+func (_u *MembershipUpdate) ClearDigestLastSentOn() *MembershipUpdate {
+	_u.mutation.ClearDigestLastSentOn()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *MembershipUpdate) SetUpdatedAt(v time.Time) *MembershipUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the MembershipMutation object of the builder.
+func (_u *MembershipUpdate) Mutation() *MembershipMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *MembershipUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *MembershipUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *MembershipUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *MembershipUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *MembershipUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := membership.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *MembershipUpdate) check() error {
+	if v, ok := _u.mutation.Role(); ok {
+		if err := membership.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`generated: validator failed for field "Membership.role": %w`, err)}
+		}
+	}
+	if _u.mutation.TenantCleared() && len(_u.mutation.TenantIDs()) > 0 {
+		return errors.New(`generated: clearing a required unique edge "Membership.tenant"`)
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`generated: clearing a required unique edge "Membership.user"`)
+	}
+	return nil
+}
+
+func (_u *MembershipUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(membership.Table, membership.Columns, sqlgraph.NewFieldSpec(membership.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Role(); ok {
+		_spec.SetField(membership.FieldRole, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.DigestEnabled(); ok {
+		_spec.SetField(membership.FieldDigestEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.DigestTime(); ok {
+		_spec.SetField(membership.FieldDigestTime, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.DigestTimezone(); ok {
+		_spec.SetField(membership.FieldDigestTimezone, field.TypeString, value)
+	}
+	if _u.mutation.DigestTimezoneCleared() {
+		_spec.ClearField(membership.FieldDigestTimezone, field.TypeString)
+	}
+	if value, ok := _u.mutation.DigestLastSentOn(); ok {
+		_spec.SetField(membership.FieldDigestLastSentOn, field.TypeTime, value)
+	}
+	if _u.mutation.DigestLastSentOnCleared() {
+		_spec.ClearField(membership.FieldDigestLastSentOn, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(membership.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{membership.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// MembershipUpdateOne is the builder for updating a single Membership entity.
+type MembershipUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *MembershipMutation
+}
+
+// SetRole sets the "role" field.
+func (_u *MembershipUpdateOne) SetRole(v membership.Role) *MembershipUpdateOne {
+	_u.mutation.SetRole(v)
+	return _u
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (_u *MembershipUpdateOne) SetNillableRole(v *membership.Role) *MembershipUpdateOne {
+	if v != nil {
+		_u.SetRole(*v)
+	}
+	return _u
+}
+
+// SetDigestEnabled sets the "digest_enabled" field.
+func (_u *MembershipUpdateOne) SetDigestEnabled(v bool) *MembershipUpdateOne {
+	_u.mutation.SetDigestEnabled(v)
+	return _u
+}
+
+// SetNillableDigestEnabled sets the "digest_enabled" field if the given value is not nil.
+func (_u *MembershipUpdateOne) SetNillableDigestEnabled(v *bool) *MembershipUpdateOne {
+	if v != nil {
+		_u.SetDigestEnabled(*v)
+	}
+	return _u
+}
+
+// SetDigestTime sets the "digest_time" field.
+func (_u *MembershipUpdateOne) SetDigestTime(v string) *MembershipUpdateOne {
+	_u.mutation.SetDigestTime(v)
+	return _u
+}
+
+// SetNillableDigestTime sets the "digest_time" field if the given value is not nil.
+func (_u *MembershipUpdateOne) SetNillableDigestTime(v *string) *MembershipUpdateOne {
+	if v != nil {
+		_u.SetDigestTime(*v)
+	}
+	return _u
+}
+
+// SetDigestTimezone sets the "digest_timezone" field.
+func (_u *MembershipUpdateOne) SetDigestTimezone(v string) *MembershipUpdateOne {
+	_u.mutation.SetDigestTimezone(v)
+	return _u
+}
+
+// SetNillableDigestTimezone sets the "digest_timezone" field if the given value is not nil.
+func (_u *MembershipUpdateOne) SetNillableDigestTimezone(v *string) *MembershipUpdateOne {
+	if v != nil {
+		_u.SetDigestTimezone(*v)
+	}
+	return _u
+}
+
+// ClearDigestTimezone clears the value of the "digest_timezone" field.
+func (_u *MembershipUpdateOne) ClearDigestTimezone() *MembershipUpdateOne {
+	_u.mutation.ClearDigestTimezone()
+	return _u
+}
+
+// SetDigestLastSentOn sets the "digest_last_sent_on" field.
+func (_u *MembershipUpdateOne) SetDigestLastSentOn(v time.Time) *MembershipUpdateOne {
+	_u.mutation.SetDigestLastSentOn(v)
+	return _u
+}
+
+// SetNillableDigestLastSentOn sets the "digest_last_sent_on" field if the given value is not nil.
+func (_u *MembershipUpdateOne) SetNillableDigestLastSentOn(v *time.Time) *MembershipUpdateOne {
+	if v != nil {
+		_u.SetDigestLastSentOn(*v)
+	}
+	return _u
+}
+
+// ClearDigestLastSentOn clears the value of the "digest_last_sent_on" field.
+func (_u *MembershipUpdateOne) ClearDigestLastSentOn() *MembershipUpdateOne {
+	_u.mutation.ClearDigestLastSentOn()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *MembershipUpdateOne) SetUpdatedAt(v time.Time) *MembershipUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the MembershipMutation object of the builder.
+func (_u *MembershipUpdateOne) Mutation() *MembershipMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the MembershipUpdate builder.
+func (_u *MembershipUpdateOne) Where(ps ...predicate.Membership) *MembershipUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *MembershipUpdateOne) Select(field string, fields ...string) *MembershipUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Membership entity.
+func (_u *MembershipUpdateOne) Save(ctx context.Context) (*Membership, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *MembershipUpdateOne) SaveX(ctx context.Context) *Membership {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *MembershipUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *MembershipUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *MembershipUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := membership.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *MembershipUpdateOne) check() error {
+	if v, ok := _u.mutation.Role(); ok {
+		if err := membership.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`generated: validator failed for field "Membership.role": %w`, err)}
+		}
+	}
+	if _u.mutation.TenantCleared() && len(_u.mutation.TenantIDs()) > 0 {
+		return errors.New(`generated: clearing a required unique edge "Membership.tenant"`)
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`generated: clearing a required unique edge "Membership.user"`)
+	}
+	return nil
+}
+
+func (_u *MembershipUpdateOne) sqlSave(ctx context.Context) (_node *Membership, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(membership.Table, membership.Columns, sqlgraph.NewFieldSpec(membership.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`generated: missing "Membership.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, membership.FieldID)
+		for _, f := range fields {
+			if !membership.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("generated: invalid field %q for query", f)}
+			}
+			if f != membership.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Role(); ok {
+		_spec.SetField(membership.FieldRole, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.DigestEnabled(); ok {
+		_spec.SetField(membership.FieldDigestEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.DigestTime(); ok {
+		_spec.SetField(membership.FieldDigestTime, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.DigestTimezone(); ok {
+		_spec.SetField(membership.FieldDigestTimezone, field.TypeString, value)
+	}
+	if _u.mutation.DigestTimezoneCleared() {
+		_spec.ClearField(membership.FieldDigestTimezone, field.TypeString)
+	}
+	if value, ok := _u.mutation.DigestLastSentOn(); ok {
+		_spec.SetField(membership.FieldDigestLastSentOn, field.TypeTime, value)
+	}
+	if _u.mutation.DigestLastSentOnCleared() {
+		_spec.ClearField(membership.FieldDigestLastSentOn, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(membership.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &Membership{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{membership.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -8,11 +8,211 @@ import (
 )
 
 var (
+	// CommentsColumns holds the columns for the "comments" table.
+	CommentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "body", Type: field.TypeString, Size: 2147483647},
+		{Name: "edited_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "tenant_id", Type: field.TypeString},
+		{Name: "todo_id", Type: field.TypeString},
+		{Name: "author_id", Type: field.TypeString},
+	}
+	// CommentsTable holds the schema information for the "comments" table.
+	CommentsTable = &schema.Table{
+		Name:       "comments",
+		Columns:    CommentsColumns,
+		PrimaryKey: []*schema.Column{CommentsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "comments_tenants_comments",
+				Columns:    []*schema.Column{CommentsColumns[5]},
+				RefColumns: []*schema.Column{TenantsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "comments_todos_comments",
+				Columns:    []*schema.Column{CommentsColumns[6]},
+				RefColumns: []*schema.Column{TodosColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "comments_users_comments",
+				Columns:    []*schema.Column{CommentsColumns[7]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "comment_todo_id_created_at_id",
+				Unique:  false,
+				Columns: []*schema.Column{CommentsColumns[6], CommentsColumns[3], CommentsColumns[0]},
+			},
+		},
+	}
+	// MembershipsColumns holds the columns for the "memberships" table.
+	MembershipsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "role", Type: field.TypeEnum, Enums: []string{"admin", "member"}, Default: "member"},
+		{Name: "digest_enabled", Type: field.TypeBool, Default: false},
+		{Name: "digest_time", Type: field.TypeString, Default: "08:00"},
+		{Name: "digest_timezone", Type: field.TypeString, Nullable: true},
+		{Name: "digest_last_sent_on", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"postgres": "date"}},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "tenant_id", Type: field.TypeString},
+		{Name: "user_id", Type: field.TypeString},
+	}
+	// MembershipsTable holds the schema information for the "memberships" table.
+	MembershipsTable = &schema.Table{
+		Name:       "memberships",
+		Columns:    MembershipsColumns,
+		PrimaryKey: []*schema.Column{MembershipsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "memberships_tenants_memberships",
+				Columns:    []*schema.Column{MembershipsColumns[8]},
+				RefColumns: []*schema.Column{TenantsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "memberships_users_memberships",
+				Columns:    []*schema.Column{MembershipsColumns[9]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "membership_tenant_id_user_id",
+				Unique:  true,
+				Columns: []*schema.Column{MembershipsColumns[8], MembershipsColumns[9]},
+			},
+		},
+	}
+	// ProjectsColumns holds the columns for the "projects" table.
+	ProjectsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "name", Type: field.TypeString},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647, Default: ""},
+		{Name: "color", Type: field.TypeString},
+		{Name: "archived", Type: field.TypeBool, Default: false},
+		{Name: "is_public", Type: field.TypeBool, Default: false},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "tenant_id", Type: field.TypeString},
+		{Name: "user_id", Type: field.TypeString},
+	}
+	// ProjectsTable holds the schema information for the "projects" table.
+	ProjectsTable = &schema.Table{
+		Name:       "projects",
+		Columns:    ProjectsColumns,
+		PrimaryKey: []*schema.Column{ProjectsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "projects_tenants_projects",
+				Columns:    []*schema.Column{ProjectsColumns[8]},
+				RefColumns: []*schema.Column{TenantsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "projects_users_projects",
+				Columns:    []*schema.Column{ProjectsColumns[9]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "project_tenant_id_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{ProjectsColumns[8], ProjectsColumns[9]},
+			},
+			{
+				Name:    "project_tenant_id_is_public",
+				Unique:  false,
+				Columns: []*schema.Column{ProjectsColumns[8], ProjectsColumns[5]},
+			},
+		},
+	}
+	// RemindersColumns holds the columns for the "reminders" table.
+	RemindersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "remind_at", Type: field.TypeTime, Nullable: true},
+		{Name: "offset_minutes", Type: field.TypeInt, Nullable: true},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "delivered", "failed"}, Default: "pending"},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "next_attempt_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_error", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "delivered_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "tenant_id", Type: field.TypeString},
+		{Name: "todo_id", Type: field.TypeString},
+	}
+	// RemindersTable holds the schema information for the "reminders" table.
+	RemindersTable = &schema.Table{
+		Name:       "reminders",
+		Columns:    RemindersColumns,
+		PrimaryKey: []*schema.Column{RemindersColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "reminders_tenants_reminders",
+				Columns:    []*schema.Column{RemindersColumns[10]},
+				RefColumns: []*schema.Column{TenantsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "reminders_todos_reminders",
+				Columns:    []*schema.Column{RemindersColumns[11]},
+				RefColumns: []*schema.Column{TodosColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "reminder_tenant_id_status",
+				Unique:  false,
+				Columns: []*schema.Column{RemindersColumns[10], RemindersColumns[3]},
+			},
+			{
+				Name:    "reminder_todo_id",
+				Unique:  false,
+				Columns: []*schema.Column{RemindersColumns[11]},
+			},
+		},
+	}
+	// TagsColumns holds the columns for the "tags" table.
+	TagsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "name", Type: field.TypeString},
+		{Name: "color", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "tenant_id", Type: field.TypeString},
+	}
+	// TagsTable holds the schema information for the "tags" table.
+	TagsTable = &schema.Table{
+		Name:       "tags",
+		Columns:    TagsColumns,
+		PrimaryKey: []*schema.Column{TagsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "tags_tenants_tags",
+				Columns:    []*schema.Column{TagsColumns[5]},
+				RefColumns: []*schema.Column{TenantsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
 	// TenantsColumns holds the columns for the "tenants" table.
 	TenantsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString},
 		{Name: "name", Type: field.TypeString},
 		{Name: "slug", Type: field.TypeString, Unique: true},
+		{Name: "trash_retention_days", Type: field.TypeInt, Default: 30},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 	}
@@ -22,18 +222,74 @@ var (
 		Columns:    TenantsColumns,
 		PrimaryKey: []*schema.Column{TenantsColumns[0]},
 	}
+	// TenantDomainsColumns holds the columns for the "tenant_domains" table.
+	TenantDomainsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "domain", Type: field.TypeString, Unique: true},
+		{Name: "verification_token", Type: field.TypeString},
+		{Name: "verified_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "tenant_id", Type: field.TypeString},
+	}
+	// TenantDomainsTable holds the schema information for the "tenant_domains" table.
+	TenantDomainsTable = &schema.Table{
+		Name:       "tenant_domains",
+		Columns:    TenantDomainsColumns,
+		PrimaryKey: []*schema.Column{TenantDomainsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "tenant_domains_tenants_domains",
+				Columns:    []*schema.Column{TenantDomainsColumns[5]},
+				RefColumns: []*schema.Column{TenantsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// TenantSlugHistoriesColumns holds the columns for the "tenant_slug_histories" table.
+	TenantSlugHistoriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "slug", Type: field.TypeString, Unique: true},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "tenant_id", Type: field.TypeString},
+	}
+	// TenantSlugHistoriesTable holds the schema information for the "tenant_slug_histories" table.
+	TenantSlugHistoriesTable = &schema.Table{
+		Name:       "tenant_slug_histories",
+		Columns:    TenantSlugHistoriesColumns,
+		PrimaryKey: []*schema.Column{TenantSlugHistoriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "tenant_slug_histories_tenants_slug_histories",
+				Columns:    []*schema.Column{TenantSlugHistoriesColumns[4]},
+				RefColumns: []*schema.Column{TenantsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
 	// TodosColumns holds the columns for the "todos" table.
 	TodosColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString},
+		{Name: "assignee_id", Type: field.TypeString, Nullable: true},
 		{Name: "title", Type: field.TypeString},
 		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647, Default: ""},
 		{Name: "completed", Type: field.TypeBool, Default: false},
 		{Name: "is_public", Type: field.TypeBool, Default: false},
+		{Name: "auto_complete", Type: field.TypeBool, Default: false},
+		{Name: "priority", Type: field.TypeEnum, Enums: []string{"none", "low", "medium", "high", "urgent"}, Default: "none", SchemaType: map[string]string{"postgres": "todo_priority"}},
 		{Name: "due_date", Type: field.TypeTime, Nullable: true},
+		{Name: "recurrence_rule", Type: field.TypeString, Nullable: true},
+		{Name: "recurrence_timezone", Type: field.TypeString, Nullable: true},
 		{Name: "completed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "snoozed_until", Type: field.TypeTime, Nullable: true},
+		{Name: "position", Type: field.TypeString},
+		{Name: "version", Type: field.TypeInt, Default: 1},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "project_id", Type: field.TypeString, Nullable: true},
 		{Name: "tenant_id", Type: field.TypeString},
+		{Name: "parent_id", Type: field.TypeString, Nullable: true},
 		{Name: "user_id", Type: field.TypeString},
 	}
 	// TodosTable holds the schema information for the "todos" table.
@@ -42,65 +298,231 @@ var (
 		Columns:    TodosColumns,
 		PrimaryKey: []*schema.Column{TodosColumns[0]},
 		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "todos_projects_todos",
+				Columns:    []*schema.Column{TodosColumns[18]},
+				RefColumns: []*schema.Column{ProjectsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 			{
 				Symbol:     "todos_tenants_todos",
-				Columns:    []*schema.Column{TodosColumns[9]},
+				Columns:    []*schema.Column{TodosColumns[19]},
 				RefColumns: []*schema.Column{TenantsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
+			{
+				Symbol:     "todos_todos_subtasks",
+				Columns:    []*schema.Column{TodosColumns[20]},
+				RefColumns: []*schema.Column{TodosColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 			{
 				Symbol:     "todos_users_todos",
-				Columns:    []*schema.Column{TodosColumns[10]},
+				Columns:    []*schema.Column{TodosColumns[21]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "todo_tenant_id_user_id_created_at_id",
+				Unique:  false,
+				Columns: []*schema.Column{TodosColumns[19], TodosColumns[21], TodosColumns[16], TodosColumns[0]},
+			},
+			{
+				Name:    "todo_tenant_id_is_public_created_at_id",
+				Unique:  false,
+				Columns: []*schema.Column{TodosColumns[19], TodosColumns[5], TodosColumns[16], TodosColumns[0]},
+			},
+			{
+				Name:    "todo_tenant_id_assignee_id_created_at_id",
+				Unique:  false,
+				Columns: []*schema.Column{TodosColumns[19], TodosColumns[1], TodosColumns[16], TodosColumns[0]},
+			},
+			{
+				Name:    "todo_project_id",
+				Unique:  false,
+				Columns: []*schema.Column{TodosColumns[18]},
+			},
+			{
+				Name:    "todo_parent_id",
+				Unique:  false,
+				Columns: []*schema.Column{TodosColumns[20]},
+			},
+			{
+				Name:    "todo_tenant_id_user_id_priority",
+				Unique:  false,
+				Columns: []*schema.Column{TodosColumns[19], TodosColumns[21], TodosColumns[7]},
+			},
+			{
+				Name:    "todo_tenant_id_position",
+				Unique:  false,
+				Columns: []*schema.Column{TodosColumns[19], TodosColumns[13]},
+			},
+			{
+				Name:    "todo_tenant_id_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{TodosColumns[19], TodosColumns[15]},
+			},
+			{
+				Name:    "todo_tenant_id_snoozed_until",
+				Unique:  false,
+				Columns: []*schema.Column{TodosColumns[19], TodosColumns[12]},
+			},
+		},
+	}
+	// TodoActivitiesColumns holds the columns for the "todo_activities" table.
+	TodoActivitiesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "action", Type: field.TypeEnum, Enums: []string{"snoozed", "unsnoozed"}},
+		{Name: "snoozed_until", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "tenant_id", Type: field.TypeString},
+		{Name: "todo_id", Type: field.TypeString},
+		{Name: "actor_id", Type: field.TypeString},
+	}
+	// TodoActivitiesTable holds the schema information for the "todo_activities" table.
+	TodoActivitiesTable = &schema.Table{
+		Name:       "todo_activities",
+		Columns:    TodoActivitiesColumns,
+		PrimaryKey: []*schema.Column{TodoActivitiesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "todo_activities_tenants_todo_activities",
+				Columns:    []*schema.Column{TodoActivitiesColumns[4]},
+				RefColumns: []*schema.Column{TenantsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "todo_activities_todos_activities",
+				Columns:    []*schema.Column{TodoActivitiesColumns[5]},
+				RefColumns: []*schema.Column{TodosColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "todo_activities_users_todo_activities",
+				Columns:    []*schema.Column{TodoActivitiesColumns[6]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "todoactivity_todo_id_created_at_id",
+				Unique:  false,
+				Columns: []*schema.Column{TodoActivitiesColumns[5], TodoActivitiesColumns[3], TodoActivitiesColumns[0]},
+			},
+		},
 	}
 	// UsersColumns holds the columns for the "users" table.
 	UsersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString},
-		{Name: "email", Type: field.TypeString},
+		{Name: "email", Type: field.TypeString, Unique: true},
 		{Name: "password_hash", Type: field.TypeString},
 		{Name: "name", Type: field.TypeString, Default: ""},
-		{Name: "role", Type: field.TypeEnum, Enums: []string{"admin", "member"}, Default: "member"},
+		{Name: "timezone", Type: field.TypeString, Default: "UTC"},
 		{Name: "email_verified", Type: field.TypeBool, Default: false},
 		{Name: "verification_token", Type: field.TypeString, Nullable: true},
 		{Name: "verification_token_expires_at", Type: field.TypeTime, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
-		{Name: "tenant_id", Type: field.TypeString},
 	}
 	// UsersTable holds the schema information for the "users" table.
 	UsersTable = &schema.Table{
 		Name:       "users",
 		Columns:    UsersColumns,
 		PrimaryKey: []*schema.Column{UsersColumns[0]},
+	}
+	// TagTodosColumns holds the columns for the "tag_todos" table.
+	TagTodosColumns = []*schema.Column{
+		{Name: "tag_id", Type: field.TypeString},
+		{Name: "todo_id", Type: field.TypeString},
+	}
+	// TagTodosTable holds the schema information for the "tag_todos" table.
+	TagTodosTable = &schema.Table{
+		Name:       "tag_todos",
+		Columns:    TagTodosColumns,
+		PrimaryKey: []*schema.Column{TagTodosColumns[0], TagTodosColumns[1]},
 		ForeignKeys: []*schema.ForeignKey{
 			{
-				Symbol:     "users_tenants_users",
-				Columns:    []*schema.Column{UsersColumns[10]},
-				RefColumns: []*schema.Column{TenantsColumns[0]},
-				OnDelete:   schema.NoAction,
+				Symbol:     "tag_todos_tag_id",
+				Columns:    []*schema.Column{TagTodosColumns[0]},
+				RefColumns: []*schema.Column{TagsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "tag_todos_todo_id",
+				Columns:    []*schema.Column{TagTodosColumns[1]},
+				RefColumns: []*schema.Column{TodosColumns[0]},
+				OnDelete:   schema.Cascade,
 			},
 		},
-		Indexes: []*schema.Index{
+	}
+	// TodoDependenciesColumns holds the columns for the "todo_dependencies" table.
+	TodoDependenciesColumns = []*schema.Column{
+		{Name: "todo_id", Type: field.TypeString},
+		{Name: "blocker_id", Type: field.TypeString},
+	}
+	// TodoDependenciesTable holds the schema information for the "todo_dependencies" table.
+	TodoDependenciesTable = &schema.Table{
+		Name:       "todo_dependencies",
+		Columns:    TodoDependenciesColumns,
+		PrimaryKey: []*schema.Column{TodoDependenciesColumns[0], TodoDependenciesColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
 			{
-				Name:    "user_tenant_id_email",
-				Unique:  true,
-				Columns: []*schema.Column{UsersColumns[10], UsersColumns[1]},
+				Symbol:     "todo_dependencies_todo_id",
+				Columns:    []*schema.Column{TodoDependenciesColumns[0]},
+				RefColumns: []*schema.Column{TodosColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "todo_dependencies_blocker_id",
+				Columns:    []*schema.Column{TodoDependenciesColumns[1]},
+				RefColumns: []*schema.Column{TodosColumns[0]},
+				OnDelete:   schema.Cascade,
 			},
 		},
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		CommentsTable,
+		MembershipsTable,
+		ProjectsTable,
+		RemindersTable,
+		TagsTable,
 		TenantsTable,
+		TenantDomainsTable,
+		TenantSlugHistoriesTable,
 		TodosTable,
+		TodoActivitiesTable,
 		UsersTable,
+		TagTodosTable,
+		TodoDependenciesTable,
 	}
 )
 
 func init() {
-	TodosTable.ForeignKeys[0].RefTable = TenantsTable
-	TodosTable.ForeignKeys[1].RefTable = UsersTable
-	UsersTable.ForeignKeys[0].RefTable = TenantsTable
+	CommentsTable.ForeignKeys[0].RefTable = TenantsTable
+	CommentsTable.ForeignKeys[1].RefTable = TodosTable
+	CommentsTable.ForeignKeys[2].RefTable = UsersTable
+	MembershipsTable.ForeignKeys[0].RefTable = TenantsTable
+	MembershipsTable.ForeignKeys[1].RefTable = UsersTable
+	ProjectsTable.ForeignKeys[0].RefTable = TenantsTable
+	ProjectsTable.ForeignKeys[1].RefTable = UsersTable
+	RemindersTable.ForeignKeys[0].RefTable = TenantsTable
+	RemindersTable.ForeignKeys[1].RefTable = TodosTable
+	TagsTable.ForeignKeys[0].RefTable = TenantsTable
+	TenantDomainsTable.ForeignKeys[0].RefTable = TenantsTable
+	TenantSlugHistoriesTable.ForeignKeys[0].RefTable = TenantsTable
+	TodosTable.ForeignKeys[0].RefTable = ProjectsTable
+	TodosTable.ForeignKeys[1].RefTable = TenantsTable
+	TodosTable.ForeignKeys[2].RefTable = TodosTable
+	TodosTable.ForeignKeys[3].RefTable = UsersTable
+	TodoActivitiesTable.ForeignKeys[0].RefTable = TenantsTable
+	TodoActivitiesTable.ForeignKeys[1].RefTable = TodosTable
+	TodoActivitiesTable.ForeignKeys[2].RefTable = UsersTable
+	TagTodosTable.ForeignKeys[0].RefTable = TagsTable
+	TagTodosTable.ForeignKeys[1].RefTable = TodosTable
+	TodoDependenciesTable.ForeignKeys[0].RefTable = TodosTable
+	TodoDependenciesTable.ForeignKeys[1].RefTable = TodosTable
 }
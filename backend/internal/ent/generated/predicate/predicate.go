@@ -6,11 +6,35 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// Comment is the predicate function for comment builders.
+type Comment func(*sql.Selector)
+
+// Membership is the predicate function for membership builders.
+type Membership func(*sql.Selector)
+
+// Project is the predicate function for project builders.
+type Project func(*sql.Selector)
+
+// Reminder is the predicate function for reminder builders.
+type Reminder func(*sql.Selector)
+
+// Tag is the predicate function for tag builders.
+type Tag func(*sql.Selector)
+
 // Tenant is the predicate function for tenant builders.
 type Tenant func(*sql.Selector)
 
+// TenantDomain is the predicate function for tenantdomain builders.
+type TenantDomain func(*sql.Selector)
+
+// TenantSlugHistory is the predicate function for tenantslughistory builders.
+type TenantSlugHistory func(*sql.Selector)
+
 // Todo is the predicate function for todo builders.
 type Todo func(*sql.Selector)
 
+// TodoActivity is the predicate function for todoactivity builders.
+type TodoActivity func(*sql.Selector)
+
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
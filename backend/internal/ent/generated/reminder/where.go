@@ -0,0 +1,722 @@
+// Code generated by ent, DO NOT EDIT.
+
+package reminder
+
+import (
+	"good-todo-go/internal/ent/generated/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.Reminder {
+	return predicate.Reminder(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.Reminder {
+	return predicate.Reminder(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.Reminder {
+	return predicate.Reminder(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.Reminder {
+	return predicate.Reminder(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.Reminder {
+	return predicate.Reminder(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.Reminder {
+	return predicate.Reminder(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.Reminder {
+	return predicate.Reminder(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.Reminder {
+	return predicate.Reminder(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.Reminder {
+	return predicate.Reminder(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.Reminder {
+	return predicate.Reminder(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.Reminder {
+	return predicate.Reminder(sql.FieldContainsFold(FieldID, id))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v string) predicate.Reminder {
+	return predicate.Reminder(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TodoID applies equality check predicate on the "todo_id" field. It's identical to TodoIDEQ.
+func TodoID(v string) predicate.Reminder {
+	return predicate.Reminder(sql.FieldEQ(FieldTodoID, v))
+}
+
+// RemindAt applies equality check predicate on the "remind_at" field. It's identical to RemindAtEQ.
+func RemindAt(v time.Time) predicate.Reminder {
+	return predicate.Reminder(sql.FieldEQ(FieldRemindAt, v))
+}
+
+// OffsetMinutes applies equality check predicate on the "offset_minutes" field. It's identical to OffsetMinutesEQ.
+func OffsetMinutes(v int) predicate.Reminder {
+	return predicate.Reminder(sql.FieldEQ(FieldOffsetMinutes, v))
+}
+
+// Attempts applies equality check predicate on the "attempts" field. It's identical to AttemptsEQ.
+func Attempts(v int) predicate.Reminder {
+	return predicate.Reminder(sql.FieldEQ(FieldAttempts, v))
+}
+
+// NextAttemptAt applies equality check predicate on the "next_attempt_at" field. It's identical to NextAttemptAtEQ.
+func NextAttemptAt(v time.Time) predicate.Reminder {
+	return predicate.Reminder(sql.FieldEQ(FieldNextAttemptAt, v))
+}
+
+// LastError applies equality check predicate on the "last_error" field. It's identical to LastErrorEQ.
+func LastError(v string) predicate.Reminder {
+	return predicate.Reminder(sql.FieldEQ(FieldLastError, v))
+}
+
+// DeliveredAt applies equality check predicate on the "delivered_at" field. It's identical to DeliveredAtEQ.
+func DeliveredAt(v time.Time) predicate.Reminder {
+	return predicate.Reminder(sql.FieldEQ(FieldDeliveredAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Reminder {
+	return predicate.Reminder(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Reminder {
+	return predicate.Reminder(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v string) predicate.Reminder {
+	return predicate.Reminder(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v string) predicate.Reminder {
+	return predicate.Reminder(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...string) predicate.Reminder {
+	return predicate.Reminder(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...string) predicate.Reminder {
+	return predicate.Reminder(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v string) predicate.Reminder {
+	return predicate.Reminder(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v string) predicate.Reminder {
+	return predicate.Reminder(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v string) predicate.Reminder {
+	return predicate.Reminder(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v string) predicate.Reminder {
+	return predicate.Reminder(sql.FieldLTE(FieldTenantID, v))
+}
+
+// TenantIDContains applies the Contains predicate on the "tenant_id" field.
+func TenantIDContains(v string) predicate.Reminder {
+	return predicate.Reminder(sql.FieldContains(FieldTenantID, v))
+}
+
+// TenantIDHasPrefix applies the HasPrefix predicate on the "tenant_id" field.
+func TenantIDHasPrefix(v string) predicate.Reminder {
+	return predicate.Reminder(sql.FieldHasPrefix(FieldTenantID, v))
+}
+
+// TenantIDHasSuffix applies the HasSuffix predicate on the "tenant_id" field.
+func TenantIDHasSuffix(v string) predicate.Reminder {
+	return predicate.Reminder(sql.FieldHasSuffix(FieldTenantID, v))
+}
+
+// TenantIDEqualFold applies the EqualFold predicate on the "tenant_id" field.
+func TenantIDEqualFold(v string) predicate.Reminder {
+	return predicate.Reminder(sql.FieldEqualFold(FieldTenantID, v))
+}
+
+// TenantIDContainsFold applies the ContainsFold predicate on the "tenant_id" field.
+func TenantIDContainsFold(v string) predicate.Reminder {
+	return predicate.Reminder(sql.FieldContainsFold(FieldTenantID, v))
+}
+
+// TodoIDEQ applies the EQ predicate on the "todo_id" field.
+func TodoIDEQ(v string) predicate.Reminder {
+	return predicate.Reminder(sql.FieldEQ(FieldTodoID, v))
+}
+
+// TodoIDNEQ applies the NEQ predicate on the "todo_id" field.
+func TodoIDNEQ(v string) predicate.Reminder {
+	return predicate.Reminder(sql.FieldNEQ(FieldTodoID, v))
+}
+
+// TodoIDIn applies the In predicate on the "todo_id" field.
+func TodoIDIn(vs ...string) predicate.Reminder {
+	return predicate.Reminder(sql.FieldIn(FieldTodoID, vs...))
+}
+
+// TodoIDNotIn applies the NotIn predicate on the "todo_id" field.
+func TodoIDNotIn(vs ...string) predicate.Reminder {
+	return predicate.Reminder(sql.FieldNotIn(FieldTodoID, vs...))
+}
+
+// TodoIDGT applies the GT predicate on the "todo_id" field.
+func TodoIDGT(v string) predicate.Reminder {
+	return predicate.Reminder(sql.FieldGT(FieldTodoID, v))
+}
+
+// TodoIDGTE applies the GTE predicate on the "todo_id" field.
+func TodoIDGTE(v string) predicate.Reminder {
+	return predicate.Reminder(sql.FieldGTE(FieldTodoID, v))
+}
+
+// TodoIDLT applies the LT predicate on the "todo_id" field.
+func TodoIDLT(v string) predicate.Reminder {
+	return predicate.Reminder(sql.FieldLT(FieldTodoID, v))
+}
+
+// TodoIDLTE applies the LTE predicate on the "todo_id" field.
+func TodoIDLTE(v string) predicate.Reminder {
+	return predicate.Reminder(sql.FieldLTE(FieldTodoID, v))
+}
+
+// TodoIDContains applies the Contains predicate on the "todo_id" field.
+func TodoIDContains(v string) predicate.Reminder {
+	return predicate.Reminder(sql.FieldContains(FieldTodoID, v))
+}
+
+// TodoIDHasPrefix applies the HasPrefix predicate on the "todo_id" field.
+func TodoIDHasPrefix(v string) predicate.Reminder {
+	return predicate.Reminder(sql.FieldHasPrefix(FieldTodoID, v))
+}
+
+// TodoIDHasSuffix applies the HasSuffix predicate on the "todo_id" field.
+func TodoIDHasSuffix(v string) predicate.Reminder {
+	return predicate.Reminder(sql.FieldHasSuffix(FieldTodoID, v))
+}
+
+// TodoIDEqualFold applies the EqualFold predicate on the "todo_id" field.
+func TodoIDEqualFold(v string) predicate.Reminder {
+	return predicate.Reminder(sql.FieldEqualFold(FieldTodoID, v))
+}
+
+// TodoIDContainsFold applies the ContainsFold predicate on the "todo_id" field.
+func TodoIDContainsFold(v string) predicate.Reminder {
+	return predicate.Reminder(sql.FieldContainsFold(FieldTodoID, v))
+}
+
+// RemindAtEQ applies the EQ predicate on the "remind_at" field.
+func RemindAtEQ(v time.Time) predicate.Reminder {
+	return predicate.Reminder(sql.FieldEQ(FieldRemindAt, v))
+}
+
+// RemindAtNEQ applies the NEQ predicate on the "remind_at" field.
+func RemindAtNEQ(v time.Time) predicate.Reminder {
+	return predicate.Reminder(sql.FieldNEQ(FieldRemindAt, v))
+}
+
+// RemindAtIn applies the In predicate on the "remind_at" field.
+func RemindAtIn(vs ...time.Time) predicate.Reminder {
+	return predicate.Reminder(sql.FieldIn(FieldRemindAt, vs...))
+}
+
+// RemindAtNotIn applies the NotIn predicate on the "remind_at" field.
+func RemindAtNotIn(vs ...time.Time) predicate.Reminder {
+	return predicate.Reminder(sql.FieldNotIn(FieldRemindAt, vs...))
+}
+
+// RemindAtGT applies the GT predicate on the "remind_at" field.
+func RemindAtGT(v time.Time) predicate.Reminder {
+	return predicate.Reminder(sql.FieldGT(FieldRemindAt, v))
+}
+
+// RemindAtGTE applies the GTE predicate on the "remind_at" field.
+func RemindAtGTE(v time.Time) predicate.Reminder {
+	return predicate.Reminder(sql.FieldGTE(FieldRemindAt, v))
+}
+
+// RemindAtLT applies the LT predicate on the "remind_at" field.
+func RemindAtLT(v time.Time) predicate.Reminder {
+	return predicate.Reminder(sql.FieldLT(FieldRemindAt, v))
+}
+
+// RemindAtLTE applies the LTE predicate on the "remind_at" field.
+func RemindAtLTE(v time.Time) predicate.Reminder {
+	return predicate.Reminder(sql.FieldLTE(FieldRemindAt, v))
+}
+
+// RemindAtIsNil applies the IsNil predicate on the "remind_at" field.
+func RemindAtIsNil() predicate.Reminder {
+	return predicate.Reminder(sql.FieldIsNull(FieldRemindAt))
+}
+
+// RemindAtNotNil applies the NotNil predicate on the "remind_at" field.
+func RemindAtNotNil() predicate.Reminder {
+	return predicate.Reminder(sql.FieldNotNull(FieldRemindAt))
+}
+
+// OffsetMinutesEQ applies the EQ predicate on the "offset_minutes" field.
+func OffsetMinutesEQ(v int) predicate.Reminder {
+	return predicate.Reminder(sql.FieldEQ(FieldOffsetMinutes, v))
+}
+
+// OffsetMinutesNEQ applies the NEQ predicate on the "offset_minutes" field.
+func OffsetMinutesNEQ(v int) predicate.Reminder {
+	return predicate.Reminder(sql.FieldNEQ(FieldOffsetMinutes, v))
+}
+
+// OffsetMinutesIn applies the In predicate on the "offset_minutes" field.
+func OffsetMinutesIn(vs ...int) predicate.Reminder {
+	return predicate.Reminder(sql.FieldIn(FieldOffsetMinutes, vs...))
+}
+
+// OffsetMinutesNotIn applies the NotIn predicate on the "offset_minutes" field.
+func OffsetMinutesNotIn(vs ...int) predicate.Reminder {
+	return predicate.Reminder(sql.FieldNotIn(FieldOffsetMinutes, vs...))
+}
+
+// OffsetMinutesGT applies the GT predicate on the "offset_minutes" field.
+func OffsetMinutesGT(v int) predicate.Reminder {
+	return predicate.Reminder(sql.FieldGT(FieldOffsetMinutes, v))
+}
+
+// OffsetMinutesGTE applies the GTE predicate on the "offset_minutes" field.
+func OffsetMinutesGTE(v int) predicate.Reminder {
+	return predicate.Reminder(sql.FieldGTE(FieldOffsetMinutes, v))
+}
+
+// OffsetMinutesLT applies the LT predicate on the "offset_minutes" field.
+func OffsetMinutesLT(v int) predicate.Reminder {
+	return predicate.Reminder(sql.FieldLT(FieldOffsetMinutes, v))
+}
+
+// OffsetMinutesLTE applies the LTE predicate on the "offset_minutes" field.
+func OffsetMinutesLTE(v int) predicate.Reminder {
+	return predicate.Reminder(sql.FieldLTE(FieldOffsetMinutes, v))
+}
+
+// OffsetMinutesIsNil applies the IsNil predicate on the "offset_minutes" field.
+func OffsetMinutesIsNil() predicate.Reminder {
+	return predicate.Reminder(sql.FieldIsNull(FieldOffsetMinutes))
+}
+
+// OffsetMinutesNotNil applies the NotNil predicate on the "offset_minutes" field.
+func OffsetMinutesNotNil() predicate.Reminder {
+	return predicate.Reminder(sql.FieldNotNull(FieldOffsetMinutes))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.Reminder {
+	return predicate.Reminder(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.Reminder {
+	return predicate.Reminder(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.Reminder {
+	return predicate.Reminder(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.Reminder {
+	return predicate.Reminder(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// AttemptsEQ applies the EQ predicate on the "attempts" field.
+func AttemptsEQ(v int) predicate.Reminder {
+	return predicate.Reminder(sql.FieldEQ(FieldAttempts, v))
+}
+
+// AttemptsNEQ applies the NEQ predicate on the "attempts" field.
+func AttemptsNEQ(v int) predicate.Reminder {
+	return predicate.Reminder(sql.FieldNEQ(FieldAttempts, v))
+}
+
+// AttemptsIn applies the In predicate on the "attempts" field.
+func AttemptsIn(vs ...int) predicate.Reminder {
+	return predicate.Reminder(sql.FieldIn(FieldAttempts, vs...))
+}
+
+// AttemptsNotIn applies the NotIn predicate on the "attempts" field.
+func AttemptsNotIn(vs ...int) predicate.Reminder {
+	return predicate.Reminder(sql.FieldNotIn(FieldAttempts, vs...))
+}
+
+// AttemptsGT applies the GT predicate on the "attempts" field.
+func AttemptsGT(v int) predicate.Reminder {
+	return predicate.Reminder(sql.FieldGT(FieldAttempts, v))
+}
+
+// AttemptsGTE applies the GTE predicate on the "attempts" field.
+func AttemptsGTE(v int) predicate.Reminder {
+	return predicate.Reminder(sql.FieldGTE(FieldAttempts, v))
+}
+
+// AttemptsLT applies the LT predicate on the "attempts" field.
+func AttemptsLT(v int) predicate.Reminder {
+	return predicate.Reminder(sql.FieldLT(FieldAttempts, v))
+}
+
+// AttemptsLTE applies the LTE predicate on the "attempts" field.
+func AttemptsLTE(v int) predicate.Reminder {
+	return predicate.Reminder(sql.FieldLTE(FieldAttempts, v))
+}
+
+// NextAttemptAtEQ applies the EQ predicate on the "next_attempt_at" field.
+func NextAttemptAtEQ(v time.Time) predicate.Reminder {
+	return predicate.Reminder(sql.FieldEQ(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtNEQ applies the NEQ predicate on the "next_attempt_at" field.
+func NextAttemptAtNEQ(v time.Time) predicate.Reminder {
+	return predicate.Reminder(sql.FieldNEQ(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtIn applies the In predicate on the "next_attempt_at" field.
+func NextAttemptAtIn(vs ...time.Time) predicate.Reminder {
+	return predicate.Reminder(sql.FieldIn(FieldNextAttemptAt, vs...))
+}
+
+// NextAttemptAtNotIn applies the NotIn predicate on the "next_attempt_at" field.
+func NextAttemptAtNotIn(vs ...time.Time) predicate.Reminder {
+	return predicate.Reminder(sql.FieldNotIn(FieldNextAttemptAt, vs...))
+}
+
+// NextAttemptAtGT applies the GT predicate on the "next_attempt_at" field.
+func NextAttemptAtGT(v time.Time) predicate.Reminder {
+	return predicate.Reminder(sql.FieldGT(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtGTE applies the GTE predicate on the "next_attempt_at" field.
+func NextAttemptAtGTE(v time.Time) predicate.Reminder {
+	return predicate.Reminder(sql.FieldGTE(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtLT applies the LT predicate on the "next_attempt_at" field.
+func NextAttemptAtLT(v time.Time) predicate.Reminder {
+	return predicate.Reminder(sql.FieldLT(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtLTE applies the LTE predicate on the "next_attempt_at" field.
+func NextAttemptAtLTE(v time.Time) predicate.Reminder {
+	return predicate.Reminder(sql.FieldLTE(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtIsNil applies the IsNil predicate on the "next_attempt_at" field.
+func NextAttemptAtIsNil() predicate.Reminder {
+	return predicate.Reminder(sql.FieldIsNull(FieldNextAttemptAt))
+}
+
+// NextAttemptAtNotNil applies the NotNil predicate on the "next_attempt_at" field.
+func NextAttemptAtNotNil() predicate.Reminder {
+	return predicate.Reminder(sql.FieldNotNull(FieldNextAttemptAt))
+}
+
+// LastErrorEQ applies the EQ predicate on the "last_error" field.
+func LastErrorEQ(v string) predicate.Reminder {
+	return predicate.Reminder(sql.FieldEQ(FieldLastError, v))
+}
+
+// LastErrorNEQ applies the NEQ predicate on the "last_error" field.
+func LastErrorNEQ(v string) predicate.Reminder {
+	return predicate.Reminder(sql.FieldNEQ(FieldLastError, v))
+}
+
+// LastErrorIn applies the In predicate on the "last_error" field.
+func LastErrorIn(vs ...string) predicate.Reminder {
+	return predicate.Reminder(sql.FieldIn(FieldLastError, vs...))
+}
+
+// LastErrorNotIn applies the NotIn predicate on the "last_error" field.
+func LastErrorNotIn(vs ...string) predicate.Reminder {
+	return predicate.Reminder(sql.FieldNotIn(FieldLastError, vs...))
+}
+
+// LastErrorGT applies the GT predicate on the "last_error" field.
+func LastErrorGT(v string) predicate.Reminder {
+	return predicate.Reminder(sql.FieldGT(FieldLastError, v))
+}
+
+// LastErrorGTE applies the GTE predicate on the "last_error" field.
+func LastErrorGTE(v string) predicate.Reminder {
+	return predicate.Reminder(sql.FieldGTE(FieldLastError, v))
+}
+
+// LastErrorLT applies the LT predicate on the "last_error" field.
+func LastErrorLT(v string) predicate.Reminder {
+	return predicate.Reminder(sql.FieldLT(FieldLastError, v))
+}
+
+// LastErrorLTE applies the LTE predicate on the "last_error" field.
+func LastErrorLTE(v string) predicate.Reminder {
+	return predicate.Reminder(sql.FieldLTE(FieldLastError, v))
+}
+
+// LastErrorContains applies the Contains predicate on the "last_error" field.
+func LastErrorContains(v string) predicate.Reminder {
+	return predicate.Reminder(sql.FieldContains(FieldLastError, v))
+}
+
+// LastErrorHasPrefix applies the HasPrefix predicate on the "last_error" field.
+func LastErrorHasPrefix(v string) predicate.Reminder {
+	return predicate.Reminder(sql.FieldHasPrefix(FieldLastError, v))
+}
+
+// LastErrorHasSuffix applies the HasSuffix predicate on the "last_error" field.
+func LastErrorHasSuffix(v string) predicate.Reminder {
+	return predicate.Reminder(sql.FieldHasSuffix(FieldLastError, v))
+}
+
+// LastErrorIsNil applies the IsNil predicate on the "last_error" field.
+func LastErrorIsNil() predicate.Reminder {
+	return predicate.Reminder(sql.FieldIsNull(FieldLastError))
+}
+
+// LastErrorNotNil applies the NotNil predicate on the "last_error" field.
+func LastErrorNotNil() predicate.Reminder {
+	return predicate.Reminder(sql.FieldNotNull(FieldLastError))
+}
+
+// LastErrorEqualFold applies the EqualFold predicate on the "last_error" field.
+func LastErrorEqualFold(v string) predicate.Reminder {
+	return predicate.Reminder(sql.FieldEqualFold(FieldLastError, v))
+}
+
+// LastErrorContainsFold applies the ContainsFold predicate on the "last_error" field.
+func LastErrorContainsFold(v string) predicate.Reminder {
+	return predicate.Reminder(sql.FieldContainsFold(FieldLastError, v))
+}
+
+// DeliveredAtEQ applies the EQ predicate on the "delivered_at" field.
+func DeliveredAtEQ(v time.Time) predicate.Reminder {
+	return predicate.Reminder(sql.FieldEQ(FieldDeliveredAt, v))
+}
+
+// DeliveredAtNEQ applies the NEQ predicate on the "delivered_at" field.
+func DeliveredAtNEQ(v time.Time) predicate.Reminder {
+	return predicate.Reminder(sql.FieldNEQ(FieldDeliveredAt, v))
+}
+
+// DeliveredAtIn applies the In predicate on the "delivered_at" field.
+func DeliveredAtIn(vs ...time.Time) predicate.Reminder {
+	return predicate.Reminder(sql.FieldIn(FieldDeliveredAt, vs...))
+}
+
+// DeliveredAtNotIn applies the NotIn predicate on the "delivered_at" field.
+func DeliveredAtNotIn(vs ...time.Time) predicate.Reminder {
+	return predicate.Reminder(sql.FieldNotIn(FieldDeliveredAt, vs...))
+}
+
+// DeliveredAtGT applies the GT predicate on the "delivered_at" field.
+func DeliveredAtGT(v time.Time) predicate.Reminder {
+	return predicate.Reminder(sql.FieldGT(FieldDeliveredAt, v))
+}
+
+// DeliveredAtGTE applies the GTE predicate on the "delivered_at" field.
+func DeliveredAtGTE(v time.Time) predicate.Reminder {
+	return predicate.Reminder(sql.FieldGTE(FieldDeliveredAt, v))
+}
+
+// DeliveredAtLT applies the LT predicate on the "delivered_at" field.
+func DeliveredAtLT(v time.Time) predicate.Reminder {
+	return predicate.Reminder(sql.FieldLT(FieldDeliveredAt, v))
+}
+
+// DeliveredAtLTE applies the LTE predicate on the "delivered_at" field.
+func DeliveredAtLTE(v time.Time) predicate.Reminder {
+	return predicate.Reminder(sql.FieldLTE(FieldDeliveredAt, v))
+}
+
+// DeliveredAtIsNil applies the IsNil predicate on the "delivered_at" field.
+func DeliveredAtIsNil() predicate.Reminder {
+	return predicate.Reminder(sql.FieldIsNull(FieldDeliveredAt))
+}
+
+// DeliveredAtNotNil applies the NotNil predicate on the "delivered_at" field.
+func DeliveredAtNotNil() predicate.Reminder {
+	return predicate.Reminder(sql.FieldNotNull(FieldDeliveredAt))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Reminder {
+	return predicate.Reminder(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Reminder {
+	return predicate.Reminder(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Reminder {
+	return predicate.Reminder(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Reminder {
+	return predicate.Reminder(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Reminder {
+	return predicate.Reminder(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Reminder {
+	return predicate.Reminder(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Reminder {
+	return predicate.Reminder(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Reminder {
+	return predicate.Reminder(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Reminder {
+	return predicate.Reminder(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Reminder {
+	return predicate.Reminder(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Reminder {
+	return predicate.Reminder(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Reminder {
+	return predicate.Reminder(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Reminder {
+	return predicate.Reminder(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Reminder {
+	return predicate.Reminder(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Reminder {
+	return predicate.Reminder(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Reminder {
+	return predicate.Reminder(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasTenant applies the HasEdge predicate on the "tenant" edge.
+func HasTenant() predicate.Reminder {
+	return predicate.Reminder(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, TenantTable, TenantColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTenantWith applies the HasEdge predicate on the "tenant" edge with a given conditions (other predicates).
+func HasTenantWith(preds ...predicate.Tenant) predicate.Reminder {
+	return predicate.Reminder(func(s *sql.Selector) {
+		step := newTenantStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTodo applies the HasEdge predicate on the "todo" edge.
+func HasTodo() predicate.Reminder {
+	return predicate.Reminder(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, TodoTable, TodoColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTodoWith applies the HasEdge predicate on the "todo" edge with a given conditions (other predicates).
+func HasTodoWith(preds ...predicate.Todo) predicate.Reminder {
+	return predicate.Reminder(func(s *sql.Selector) {
+		step := newTodoStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Reminder) predicate.Reminder {
+	return predicate.Reminder(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Reminder) predicate.Reminder {
+	return predicate.Reminder(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Reminder) predicate.Reminder {
+	return predicate.Reminder(sql.NotPredicates(p))
+}
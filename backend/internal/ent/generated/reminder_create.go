@@ -0,0 +1,1083 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"good-todo-go/internal/ent/generated/reminder"
+	"good-todo-go/internal/ent/generated/tenant"
+	"good-todo-go/internal/ent/generated/todo"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ReminderCreate is the builder for creating a Reminder entity.
+type ReminderCreate struct {
+	config
+	mutation *ReminderMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *ReminderCreate) SetTenantID(v string) *ReminderCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetTodoID sets the "todo_id" field.
+func (_c *ReminderCreate) SetTodoID(v string) *ReminderCreate {
+	_c.mutation.SetTodoID(v)
+	return _c
+}
+
+// SetRemindAt sets the "remind_at" field.
+func (_c *ReminderCreate) SetRemindAt(v time.Time) *ReminderCreate {
+	_c.mutation.SetRemindAt(v)
+	return _c
+}
+
+// SetNillableRemindAt sets the "remind_at" field if the given value is not nil.
+func (_c *ReminderCreate) SetNillableRemindAt(v *time.Time) *ReminderCreate {
+	if v != nil {
+		_c.SetRemindAt(*v)
+	}
+	return _c
+}
+
+// SetOffsetMinutes sets the "offset_minutes" field.
+func (_c *ReminderCreate) SetOffsetMinutes(v int) *ReminderCreate {
+	_c.mutation.SetOffsetMinutes(v)
+	return _c
+}
+
+// SetNillableOffsetMinutes sets the "offset_minutes" field if the given value is not nil.
+func (_c *ReminderCreate) SetNillableOffsetMinutes(v *int) *ReminderCreate {
+	if v != nil {
+		_c.SetOffsetMinutes(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *ReminderCreate) SetStatus(v reminder.Status) *ReminderCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *ReminderCreate) SetNillableStatus(v *reminder.Status) *ReminderCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetAttempts sets the "attempts" field.
+func (_c *ReminderCreate) SetAttempts(v int) *ReminderCreate {
+	_c.mutation.SetAttempts(v)
+	return _c
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_c *ReminderCreate) SetNillableAttempts(v *int) *ReminderCreate {
+	if v != nil {
+		_c.SetAttempts(*v)
+	}
+	return _c
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (_c *ReminderCreate) SetNextAttemptAt(v time.Time) *ReminderCreate {
+	_c.mutation.SetNextAttemptAt(v)
+	return _c
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (_c *ReminderCreate) SetNillableNextAttemptAt(v *time.Time) *ReminderCreate {
+	if v != nil {
+		_c.SetNextAttemptAt(*v)
+	}
+	return _c
+}
+
+// SetLastError sets the "last_error" field.
+func (_c *ReminderCreate) SetLastError(v string) *ReminderCreate {
+	_c.mutation.SetLastError(v)
+	return _c
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_c *ReminderCreate) SetNillableLastError(v *string) *ReminderCreate {
+	if v != nil {
+		_c.SetLastError(*v)
+	}
+	return _c
+}
+
+// SetDeliveredAt sets the "delivered_at" field.
+func (_c *ReminderCreate) SetDeliveredAt(v time.Time) *ReminderCreate {
+	_c.mutation.SetDeliveredAt(v)
+	return _c
+}
+
+// SetNillableDeliveredAt sets the "delivered_at" field if the given value is not nil.
+func (_c *ReminderCreate) SetNillableDeliveredAt(v *time.Time) *ReminderCreate {
+	if v != nil {
+		_c.SetDeliveredAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ReminderCreate) SetCreatedAt(v time.Time) *ReminderCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ReminderCreate) SetNillableCreatedAt(v *time.Time) *ReminderCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *ReminderCreate) SetUpdatedAt(v time.Time) *ReminderCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *ReminderCreate) SetNillableUpdatedAt(v *time.Time) *ReminderCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ReminderCreate) SetID(v string) *ReminderCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (_c *ReminderCreate) SetTenant(v *Tenant) *ReminderCreate {
+	return _c.SetTenantID(v.ID)
+}
+
+// SetTodo sets the "todo" edge to the Todo entity.
+func (_c *ReminderCreate) SetTodo(v *Todo) *ReminderCreate {
+	return _c.SetTodoID(v.ID)
+}
+
+// Mutation returns the ReminderMutation object of the builder.
+func (_c *ReminderCreate) Mutation() *ReminderMutation {
+	return _c.mutation
+}
+
+// Save creates the Reminder in the database.
+func (_c *ReminderCreate) Save(ctx context.Context) (*Reminder, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ReminderCreate) SaveX(ctx context.Context) *Reminder {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ReminderCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ReminderCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ReminderCreate) defaults() {
+	if _, ok := _c.mutation.Status(); !ok {
+		v := reminder.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.Attempts(); !ok {
+		v := reminder.DefaultAttempts
+		_c.mutation.SetAttempts(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := reminder.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := reminder.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ReminderCreate) check() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`generated: missing required field "Reminder.tenant_id"`)}
+	}
+	if v, ok := _c.mutation.TenantID(); ok {
+		if err := reminder.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`generated: validator failed for field "Reminder.tenant_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.TodoID(); !ok {
+		return &ValidationError{Name: "todo_id", err: errors.New(`generated: missing required field "Reminder.todo_id"`)}
+	}
+	if v, ok := _c.mutation.TodoID(); ok {
+		if err := reminder.TodoIDValidator(v); err != nil {
+			return &ValidationError{Name: "todo_id", err: fmt.Errorf(`generated: validator failed for field "Reminder.todo_id": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.OffsetMinutes(); ok {
+		if err := reminder.OffsetMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "offset_minutes", err: fmt.Errorf(`generated: validator failed for field "Reminder.offset_minutes": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`generated: missing required field "Reminder.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := reminder.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`generated: validator failed for field "Reminder.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Attempts(); !ok {
+		return &ValidationError{Name: "attempts", err: errors.New(`generated: missing required field "Reminder.attempts"`)}
+	}
+	if v, ok := _c.mutation.Attempts(); ok {
+		if err := reminder.AttemptsValidator(v); err != nil {
+			return &ValidationError{Name: "attempts", err: fmt.Errorf(`generated: validator failed for field "Reminder.attempts": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`generated: missing required field "Reminder.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`generated: missing required field "Reminder.updated_at"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := reminder.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`generated: validator failed for field "Reminder.id": %w`, err)}
+		}
+	}
+	if len(_c.mutation.TenantIDs()) == 0 {
+		return &ValidationError{Name: "tenant", err: errors.New(`generated: missing required edge "Reminder.tenant"`)}
+	}
+	if len(_c.mutation.TodoIDs()) == 0 {
+		return &ValidationError{Name: "todo", err: errors.New(`generated: missing required edge "Reminder.todo"`)}
+	}
+	return nil
+}
+
+func (_c *ReminderCreate) sqlSave(ctx context.Context) (*Reminder, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected Reminder.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ReminderCreate) createSpec() (*Reminder, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Reminder{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(reminder.Table, sqlgraph.NewFieldSpec(reminder.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.RemindAt(); ok {
+		_spec.SetField(reminder.FieldRemindAt, field.TypeTime, value)
+		_node.RemindAt = &value
+	}
+	if value, ok := _c.mutation.OffsetMinutes(); ok {
+		_spec.SetField(reminder.FieldOffsetMinutes, field.TypeInt, value)
+		_node.OffsetMinutes = &value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(reminder.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.Attempts(); ok {
+		_spec.SetField(reminder.FieldAttempts, field.TypeInt, value)
+		_node.Attempts = value
+	}
+	if value, ok := _c.mutation.NextAttemptAt(); ok {
+		_spec.SetField(reminder.FieldNextAttemptAt, field.TypeTime, value)
+		_node.NextAttemptAt = &value
+	}
+	if value, ok := _c.mutation.LastError(); ok {
+		_spec.SetField(reminder.FieldLastError, field.TypeString, value)
+		_node.LastError = &value
+	}
+	if value, ok := _c.mutation.DeliveredAt(); ok {
+		_spec.SetField(reminder.FieldDeliveredAt, field.TypeTime, value)
+		_node.DeliveredAt = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(reminder.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(reminder.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reminder.TenantTable,
+			Columns: []string{reminder.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TenantID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.TodoIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reminder.TodoTable,
+			Columns: []string{reminder.TodoColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todo.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TodoID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Reminder.Create().
+//		SetTenantID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ReminderUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ReminderCreate) OnConflict(opts ...sql.ConflictOption) *ReminderUpsertOne {
+	_c.conflict = opts
+	return &ReminderUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Reminder.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ReminderCreate) OnConflictColumns(columns ...string) *ReminderUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ReminderUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// ReminderUpsertOne is the builder for "upsert"-ing
+	//  one Reminder node.
+	ReminderUpsertOne struct {
+		create *ReminderCreate
+	}
+
+	// ReminderUpsert is the "OnConflict" setter.
+	ReminderUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetStatus sets the "status" field.
+func (u *ReminderUpsert) SetStatus(v reminder.Status) *ReminderUpsert {
+	u.Set(reminder.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ReminderUpsert) UpdateStatus() *ReminderUpsert {
+	u.SetExcluded(reminder.FieldStatus)
+	return u
+}
+
+// SetAttempts sets the "attempts" field.
+func (u *ReminderUpsert) SetAttempts(v int) *ReminderUpsert {
+	u.Set(reminder.FieldAttempts, v)
+	return u
+}
+
+// UpdateAttempts sets the "attempts" field to the value that was provided on create.
+func (u *ReminderUpsert) UpdateAttempts() *ReminderUpsert {
+	u.SetExcluded(reminder.FieldAttempts)
+	return u
+}
+
+// AddAttempts adds v to the "attempts" field.
+func (u *ReminderUpsert) AddAttempts(v int) *ReminderUpsert {
+	u.Add(reminder.FieldAttempts, v)
+	return u
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (u *ReminderUpsert) SetNextAttemptAt(v time.Time) *ReminderUpsert {
+	u.Set(reminder.FieldNextAttemptAt, v)
+	return u
+}
+
+// UpdateNextAttemptAt sets the "next_attempt_at" field to the value that was provided on create.
+func (u *ReminderUpsert) UpdateNextAttemptAt() *ReminderUpsert {
+	u.SetExcluded(reminder.FieldNextAttemptAt)
+	return u
+}
+
+// ClearNextAttemptAt clears the value of the "next_attempt_at" field.
+func (u *ReminderUpsert) ClearNextAttemptAt() *ReminderUpsert {
+	u.SetNull(reminder.FieldNextAttemptAt)
+	return u
+}
+
+// SetLastError sets the "last_error" field.
+func (u *ReminderUpsert) SetLastError(v string) *ReminderUpsert {
+	u.Set(reminder.FieldLastError, v)
+	return u
+}
+
+// UpdateLastError sets the "last_error" field to the value that was provided on create.
+func (u *ReminderUpsert) UpdateLastError() *ReminderUpsert {
+	u.SetExcluded(reminder.FieldLastError)
+	return u
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (u *ReminderUpsert) ClearLastError() *ReminderUpsert {
+	u.SetNull(reminder.FieldLastError)
+	return u
+}
+
+// SetDeliveredAt sets the "delivered_at" field.
+func (u *ReminderUpsert) SetDeliveredAt(v time.Time) *ReminderUpsert {
+	u.Set(reminder.FieldDeliveredAt, v)
+	return u
+}
+
+// UpdateDeliveredAt sets the "delivered_at" field to the value that was provided on create.
+func (u *ReminderUpsert) UpdateDeliveredAt() *ReminderUpsert {
+	u.SetExcluded(reminder.FieldDeliveredAt)
+	return u
+}
+
+// ClearDeliveredAt clears the value of the "delivered_at" field.
+func (u *ReminderUpsert) ClearDeliveredAt() *ReminderUpsert {
+	u.SetNull(reminder.FieldDeliveredAt)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ReminderUpsert) SetUpdatedAt(v time.Time) *ReminderUpsert {
+	u.Set(reminder.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ReminderUpsert) UpdateUpdatedAt() *ReminderUpsert {
+	u.SetExcluded(reminder.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Reminder.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(reminder.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ReminderUpsertOne) UpdateNewValues() *ReminderUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(reminder.FieldID)
+		}
+		if _, exists := u.create.mutation.TenantID(); exists {
+			s.SetIgnore(reminder.FieldTenantID)
+		}
+		if _, exists := u.create.mutation.TodoID(); exists {
+			s.SetIgnore(reminder.FieldTodoID)
+		}
+		if _, exists := u.create.mutation.RemindAt(); exists {
+			s.SetIgnore(reminder.FieldRemindAt)
+		}
+		if _, exists := u.create.mutation.OffsetMinutes(); exists {
+			s.SetIgnore(reminder.FieldOffsetMinutes)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(reminder.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Reminder.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ReminderUpsertOne) Ignore() *ReminderUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ReminderUpsertOne) DoNothing() *ReminderUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ReminderCreate.OnConflict
+// documentation for more info.
+func (u *ReminderUpsertOne) Update(set func(*ReminderUpsert)) *ReminderUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ReminderUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *ReminderUpsertOne) SetStatus(v reminder.Status) *ReminderUpsertOne {
+	return u.Update(func(s *ReminderUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ReminderUpsertOne) UpdateStatus() *ReminderUpsertOne {
+	return u.Update(func(s *ReminderUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetAttempts sets the "attempts" field.
+func (u *ReminderUpsertOne) SetAttempts(v int) *ReminderUpsertOne {
+	return u.Update(func(s *ReminderUpsert) {
+		s.SetAttempts(v)
+	})
+}
+
+// AddAttempts adds v to the "attempts" field.
+func (u *ReminderUpsertOne) AddAttempts(v int) *ReminderUpsertOne {
+	return u.Update(func(s *ReminderUpsert) {
+		s.AddAttempts(v)
+	})
+}
+
+// UpdateAttempts sets the "attempts" field to the value that was provided on create.
+func (u *ReminderUpsertOne) UpdateAttempts() *ReminderUpsertOne {
+	return u.Update(func(s *ReminderUpsert) {
+		s.UpdateAttempts()
+	})
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (u *ReminderUpsertOne) SetNextAttemptAt(v time.Time) *ReminderUpsertOne {
+	return u.Update(func(s *ReminderUpsert) {
+		s.SetNextAttemptAt(v)
+	})
+}
+
+// UpdateNextAttemptAt sets the "next_attempt_at" field to the value that was provided on create.
+func (u *ReminderUpsertOne) UpdateNextAttemptAt() *ReminderUpsertOne {
+	return u.Update(func(s *ReminderUpsert) {
+		s.UpdateNextAttemptAt()
+	})
+}
+
+// ClearNextAttemptAt clears the value of the "next_attempt_at" field.
+func (u *ReminderUpsertOne) ClearNextAttemptAt() *ReminderUpsertOne {
+	return u.Update(func(s *ReminderUpsert) {
+		s.ClearNextAttemptAt()
+	})
+}
+
+// SetLastError sets the "last_error" field.
+func (u *ReminderUpsertOne) SetLastError(v string) *ReminderUpsertOne {
+	return u.Update(func(s *ReminderUpsert) {
+		s.SetLastError(v)
+	})
+}
+
+// UpdateLastError sets the "last_error" field to the value that was provided on create.
+func (u *ReminderUpsertOne) UpdateLastError() *ReminderUpsertOne {
+	return u.Update(func(s *ReminderUpsert) {
+		s.UpdateLastError()
+	})
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (u *ReminderUpsertOne) ClearLastError() *ReminderUpsertOne {
+	return u.Update(func(s *ReminderUpsert) {
+		s.ClearLastError()
+	})
+}
+
+// SetDeliveredAt sets the "delivered_at" field.
+func (u *ReminderUpsertOne) SetDeliveredAt(v time.Time) *ReminderUpsertOne {
+	return u.Update(func(s *ReminderUpsert) {
+		s.SetDeliveredAt(v)
+	})
+}
+
+// UpdateDeliveredAt sets the "delivered_at" field to the value that was provided on create.
+func (u *ReminderUpsertOne) UpdateDeliveredAt() *ReminderUpsertOne {
+	return u.Update(func(s *ReminderUpsert) {
+		s.UpdateDeliveredAt()
+	})
+}
+
+// ClearDeliveredAt clears the value of the "delivered_at" field.
+func (u *ReminderUpsertOne) ClearDeliveredAt() *ReminderUpsertOne {
+	return u.Update(func(s *ReminderUpsert) {
+		s.ClearDeliveredAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ReminderUpsertOne) SetUpdatedAt(v time.Time) *ReminderUpsertOne {
+	return u.Update(func(s *ReminderUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ReminderUpsertOne) UpdateUpdatedAt() *ReminderUpsertOne {
+	return u.Update(func(s *ReminderUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ReminderUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("generated: missing options for ReminderCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ReminderUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ReminderUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("generated: ReminderUpsertOne.ID is not supported by MySQL driver. Use ReminderUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ReminderUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ReminderCreateBulk is the builder for creating many Reminder entities in bulk.
+type ReminderCreateBulk struct {
+	config
+	err      error
+	builders []*ReminderCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Reminder entities in the database.
+func (_c *ReminderCreateBulk) Save(ctx context.Context) ([]*Reminder, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Reminder, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ReminderMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ReminderCreateBulk) SaveX(ctx context.Context) []*Reminder {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ReminderCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ReminderCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Reminder.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ReminderUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ReminderCreateBulk) OnConflict(opts ...sql.ConflictOption) *ReminderUpsertBulk {
+	_c.conflict = opts
+	return &ReminderUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Reminder.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ReminderCreateBulk) OnConflictColumns(columns ...string) *ReminderUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ReminderUpsertBulk{
+		create: _c,
+	}
+}
+
+// ReminderUpsertBulk is the builder for "upsert"-ing
+// a bulk of Reminder nodes.
+type ReminderUpsertBulk struct {
+	create *ReminderCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Reminder.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(reminder.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ReminderUpsertBulk) UpdateNewValues() *ReminderUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(reminder.FieldID)
+			}
+			if _, exists := b.mutation.TenantID(); exists {
+				s.SetIgnore(reminder.FieldTenantID)
+			}
+			if _, exists := b.mutation.TodoID(); exists {
+				s.SetIgnore(reminder.FieldTodoID)
+			}
+			if _, exists := b.mutation.RemindAt(); exists {
+				s.SetIgnore(reminder.FieldRemindAt)
+			}
+			if _, exists := b.mutation.OffsetMinutes(); exists {
+				s.SetIgnore(reminder.FieldOffsetMinutes)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(reminder.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Reminder.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ReminderUpsertBulk) Ignore() *ReminderUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ReminderUpsertBulk) DoNothing() *ReminderUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ReminderCreateBulk.OnConflict
+// documentation for more info.
+func (u *ReminderUpsertBulk) Update(set func(*ReminderUpsert)) *ReminderUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ReminderUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *ReminderUpsertBulk) SetStatus(v reminder.Status) *ReminderUpsertBulk {
+	return u.Update(func(s *ReminderUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ReminderUpsertBulk) UpdateStatus() *ReminderUpsertBulk {
+	return u.Update(func(s *ReminderUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetAttempts sets the "attempts" field.
+func (u *ReminderUpsertBulk) SetAttempts(v int) *ReminderUpsertBulk {
+	return u.Update(func(s *ReminderUpsert) {
+		s.SetAttempts(v)
+	})
+}
+
+// AddAttempts adds v to the "attempts" field.
+func (u *ReminderUpsertBulk) AddAttempts(v int) *ReminderUpsertBulk {
+	return u.Update(func(s *ReminderUpsert) {
+		s.AddAttempts(v)
+	})
+}
+
+// UpdateAttempts sets the "attempts" field to the value that was provided on create.
+func (u *ReminderUpsertBulk) UpdateAttempts() *ReminderUpsertBulk {
+	return u.Update(func(s *ReminderUpsert) {
+		s.UpdateAttempts()
+	})
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (u *ReminderUpsertBulk) SetNextAttemptAt(v time.Time) *ReminderUpsertBulk {
+	return u.Update(func(s *ReminderUpsert) {
+		s.SetNextAttemptAt(v)
+	})
+}
+
+// UpdateNextAttemptAt sets the "next_attempt_at" field to the value that was provided on create.
+func (u *ReminderUpsertBulk) UpdateNextAttemptAt() *ReminderUpsertBulk {
+	return u.Update(func(s *ReminderUpsert) {
+		s.UpdateNextAttemptAt()
+	})
+}
+
+// ClearNextAttemptAt clears the value of the "next_attempt_at" field.
+func (u *ReminderUpsertBulk) ClearNextAttemptAt() *ReminderUpsertBulk {
+	return u.Update(func(s *ReminderUpsert) {
+		s.ClearNextAttemptAt()
+	})
+}
+
+// SetLastError sets the "last_error" field.
+func (u *ReminderUpsertBulk) SetLastError(v string) *ReminderUpsertBulk {
+	return u.Update(func(s *ReminderUpsert) {
+		s.SetLastError(v)
+	})
+}
+
+// UpdateLastError sets the "last_error" field to the value that was provided on create.
+func (u *ReminderUpsertBulk) UpdateLastError() *ReminderUpsertBulk {
+	return u.Update(func(s *ReminderUpsert) {
+		s.UpdateLastError()
+	})
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (u *ReminderUpsertBulk) ClearLastError() *ReminderUpsertBulk {
+	return u.Update(func(s *ReminderUpsert) {
+		s.ClearLastError()
+	})
+}
+
+// SetDeliveredAt sets the "delivered_at" field.
+func (u *ReminderUpsertBulk) SetDeliveredAt(v time.Time) *ReminderUpsertBulk {
+	return u.Update(func(s *ReminderUpsert) {
+		s.SetDeliveredAt(v)
+	})
+}
+
+// UpdateDeliveredAt sets the "delivered_at" field to the value that was provided on create.
+func (u *ReminderUpsertBulk) UpdateDeliveredAt() *ReminderUpsertBulk {
+	return u.Update(func(s *ReminderUpsert) {
+		s.UpdateDeliveredAt()
+	})
+}
+
+// ClearDeliveredAt clears the value of the "delivered_at" field.
+func (u *ReminderUpsertBulk) ClearDeliveredAt() *ReminderUpsertBulk {
+	return u.Update(func(s *ReminderUpsert) {
+		s.ClearDeliveredAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ReminderUpsertBulk) SetUpdatedAt(v time.Time) *ReminderUpsertBulk {
+	return u.Update(func(s *ReminderUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ReminderUpsertBulk) UpdateUpdatedAt() *ReminderUpsertBulk {
+	return u.Update(func(s *ReminderUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ReminderUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("generated: OnConflict was set for builder %d. Set it on the ReminderCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("generated: missing options for ReminderCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ReminderUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
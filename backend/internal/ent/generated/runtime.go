@@ -3,8 +3,16 @@
 package generated
 
 import (
+	"good-todo-go/internal/ent/generated/comment"
+	"good-todo-go/internal/ent/generated/membership"
+	"good-todo-go/internal/ent/generated/project"
+	"good-todo-go/internal/ent/generated/reminder"
+	"good-todo-go/internal/ent/generated/tag"
 	"good-todo-go/internal/ent/generated/tenant"
+	"good-todo-go/internal/ent/generated/tenantdomain"
+	"good-todo-go/internal/ent/generated/tenantslughistory"
 	"good-todo-go/internal/ent/generated/todo"
+	"good-todo-go/internal/ent/generated/todoactivity"
 	"good-todo-go/internal/ent/generated/user"
 	"good-todo-go/internal/ent/schema"
 	"time"
@@ -14,6 +22,176 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	commentFields := schema.Comment{}.Fields()
+	_ = commentFields
+	// commentDescTenantID is the schema descriptor for tenant_id field.
+	commentDescTenantID := commentFields[1].Descriptor()
+	// comment.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	comment.TenantIDValidator = commentDescTenantID.Validators[0].(func(string) error)
+	// commentDescTodoID is the schema descriptor for todo_id field.
+	commentDescTodoID := commentFields[2].Descriptor()
+	// comment.TodoIDValidator is a validator for the "todo_id" field. It is called by the builders before save.
+	comment.TodoIDValidator = commentDescTodoID.Validators[0].(func(string) error)
+	// commentDescAuthorID is the schema descriptor for author_id field.
+	commentDescAuthorID := commentFields[3].Descriptor()
+	// comment.AuthorIDValidator is a validator for the "author_id" field. It is called by the builders before save.
+	comment.AuthorIDValidator = commentDescAuthorID.Validators[0].(func(string) error)
+	// commentDescBody is the schema descriptor for body field.
+	commentDescBody := commentFields[4].Descriptor()
+	// comment.BodyValidator is a validator for the "body" field. It is called by the builders before save.
+	comment.BodyValidator = commentDescBody.Validators[0].(func(string) error)
+	// commentDescCreatedAt is the schema descriptor for created_at field.
+	commentDescCreatedAt := commentFields[6].Descriptor()
+	// comment.DefaultCreatedAt holds the default value on creation for the created_at field.
+	comment.DefaultCreatedAt = commentDescCreatedAt.Default.(func() time.Time)
+	// commentDescUpdatedAt is the schema descriptor for updated_at field.
+	commentDescUpdatedAt := commentFields[7].Descriptor()
+	// comment.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	comment.DefaultUpdatedAt = commentDescUpdatedAt.Default.(func() time.Time)
+	// comment.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	comment.UpdateDefaultUpdatedAt = commentDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// commentDescID is the schema descriptor for id field.
+	commentDescID := commentFields[0].Descriptor()
+	// comment.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	comment.IDValidator = commentDescID.Validators[0].(func(string) error)
+	membershipFields := schema.Membership{}.Fields()
+	_ = membershipFields
+	// membershipDescTenantID is the schema descriptor for tenant_id field.
+	membershipDescTenantID := membershipFields[1].Descriptor()
+	// membership.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	membership.TenantIDValidator = membershipDescTenantID.Validators[0].(func(string) error)
+	// membershipDescUserID is the schema descriptor for user_id field.
+	membershipDescUserID := membershipFields[2].Descriptor()
+	// membership.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	membership.UserIDValidator = membershipDescUserID.Validators[0].(func(string) error)
+	// membershipDescDigestEnabled is the schema descriptor for digest_enabled field.
+	membershipDescDigestEnabled := membershipFields[4].Descriptor()
+	// membership.DefaultDigestEnabled holds the default value on creation for the digest_enabled field.
+	membership.DefaultDigestEnabled = membershipDescDigestEnabled.Default.(bool)
+	// membershipDescDigestTime is the schema descriptor for digest_time field.
+	membershipDescDigestTime := membershipFields[5].Descriptor()
+	// membership.DefaultDigestTime holds the default value on creation for the digest_time field.
+	membership.DefaultDigestTime = membershipDescDigestTime.Default.(string)
+	// membershipDescCreatedAt is the schema descriptor for created_at field.
+	membershipDescCreatedAt := membershipFields[8].Descriptor()
+	// membership.DefaultCreatedAt holds the default value on creation for the created_at field.
+	membership.DefaultCreatedAt = membershipDescCreatedAt.Default.(func() time.Time)
+	// membershipDescUpdatedAt is the schema descriptor for updated_at field.
+	membershipDescUpdatedAt := membershipFields[9].Descriptor()
+	// membership.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	membership.DefaultUpdatedAt = membershipDescUpdatedAt.Default.(func() time.Time)
+	// membership.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	membership.UpdateDefaultUpdatedAt = membershipDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// membershipDescID is the schema descriptor for id field.
+	membershipDescID := membershipFields[0].Descriptor()
+	// membership.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	membership.IDValidator = membershipDescID.Validators[0].(func(string) error)
+	projectFields := schema.Project{}.Fields()
+	_ = projectFields
+	// projectDescTenantID is the schema descriptor for tenant_id field.
+	projectDescTenantID := projectFields[1].Descriptor()
+	// project.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	project.TenantIDValidator = projectDescTenantID.Validators[0].(func(string) error)
+	// projectDescUserID is the schema descriptor for user_id field.
+	projectDescUserID := projectFields[2].Descriptor()
+	// project.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	project.UserIDValidator = projectDescUserID.Validators[0].(func(string) error)
+	// projectDescName is the schema descriptor for name field.
+	projectDescName := projectFields[3].Descriptor()
+	// project.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	project.NameValidator = projectDescName.Validators[0].(func(string) error)
+	// projectDescDescription is the schema descriptor for description field.
+	projectDescDescription := projectFields[4].Descriptor()
+	// project.DefaultDescription holds the default value on creation for the description field.
+	project.DefaultDescription = projectDescDescription.Default.(string)
+	// projectDescColor is the schema descriptor for color field.
+	projectDescColor := projectFields[5].Descriptor()
+	// project.ColorValidator is a validator for the "color" field. It is called by the builders before save.
+	project.ColorValidator = projectDescColor.Validators[0].(func(string) error)
+	// projectDescArchived is the schema descriptor for archived field.
+	projectDescArchived := projectFields[6].Descriptor()
+	// project.DefaultArchived holds the default value on creation for the archived field.
+	project.DefaultArchived = projectDescArchived.Default.(bool)
+	// projectDescIsPublic is the schema descriptor for is_public field.
+	projectDescIsPublic := projectFields[7].Descriptor()
+	// project.DefaultIsPublic holds the default value on creation for the is_public field.
+	project.DefaultIsPublic = projectDescIsPublic.Default.(bool)
+	// projectDescCreatedAt is the schema descriptor for created_at field.
+	projectDescCreatedAt := projectFields[8].Descriptor()
+	// project.DefaultCreatedAt holds the default value on creation for the created_at field.
+	project.DefaultCreatedAt = projectDescCreatedAt.Default.(func() time.Time)
+	// projectDescUpdatedAt is the schema descriptor for updated_at field.
+	projectDescUpdatedAt := projectFields[9].Descriptor()
+	// project.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	project.DefaultUpdatedAt = projectDescUpdatedAt.Default.(func() time.Time)
+	// project.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	project.UpdateDefaultUpdatedAt = projectDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// projectDescID is the schema descriptor for id field.
+	projectDescID := projectFields[0].Descriptor()
+	// project.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	project.IDValidator = projectDescID.Validators[0].(func(string) error)
+	reminderFields := schema.Reminder{}.Fields()
+	_ = reminderFields
+	// reminderDescTenantID is the schema descriptor for tenant_id field.
+	reminderDescTenantID := reminderFields[1].Descriptor()
+	// reminder.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	reminder.TenantIDValidator = reminderDescTenantID.Validators[0].(func(string) error)
+	// reminderDescTodoID is the schema descriptor for todo_id field.
+	reminderDescTodoID := reminderFields[2].Descriptor()
+	// reminder.TodoIDValidator is a validator for the "todo_id" field. It is called by the builders before save.
+	reminder.TodoIDValidator = reminderDescTodoID.Validators[0].(func(string) error)
+	// reminderDescOffsetMinutes is the schema descriptor for offset_minutes field.
+	reminderDescOffsetMinutes := reminderFields[4].Descriptor()
+	// reminder.OffsetMinutesValidator is a validator for the "offset_minutes" field. It is called by the builders before save.
+	reminder.OffsetMinutesValidator = reminderDescOffsetMinutes.Validators[0].(func(int) error)
+	// reminderDescAttempts is the schema descriptor for attempts field.
+	reminderDescAttempts := reminderFields[6].Descriptor()
+	// reminder.DefaultAttempts holds the default value on creation for the attempts field.
+	reminder.DefaultAttempts = reminderDescAttempts.Default.(int)
+	// reminder.AttemptsValidator is a validator for the "attempts" field. It is called by the builders before save.
+	reminder.AttemptsValidator = reminderDescAttempts.Validators[0].(func(int) error)
+	// reminderDescCreatedAt is the schema descriptor for created_at field.
+	reminderDescCreatedAt := reminderFields[10].Descriptor()
+	// reminder.DefaultCreatedAt holds the default value on creation for the created_at field.
+	reminder.DefaultCreatedAt = reminderDescCreatedAt.Default.(func() time.Time)
+	// reminderDescUpdatedAt is the schema descriptor for updated_at field.
+	reminderDescUpdatedAt := reminderFields[11].Descriptor()
+	// reminder.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	reminder.DefaultUpdatedAt = reminderDescUpdatedAt.Default.(func() time.Time)
+	// reminder.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	reminder.UpdateDefaultUpdatedAt = reminderDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// reminderDescID is the schema descriptor for id field.
+	reminderDescID := reminderFields[0].Descriptor()
+	// reminder.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	reminder.IDValidator = reminderDescID.Validators[0].(func(string) error)
+	tagFields := schema.Tag{}.Fields()
+	_ = tagFields
+	// tagDescTenantID is the schema descriptor for tenant_id field.
+	tagDescTenantID := tagFields[1].Descriptor()
+	// tag.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	tag.TenantIDValidator = tagDescTenantID.Validators[0].(func(string) error)
+	// tagDescName is the schema descriptor for name field.
+	tagDescName := tagFields[2].Descriptor()
+	// tag.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	tag.NameValidator = tagDescName.Validators[0].(func(string) error)
+	// tagDescColor is the schema descriptor for color field.
+	tagDescColor := tagFields[3].Descriptor()
+	// tag.ColorValidator is a validator for the "color" field. It is called by the builders before save.
+	tag.ColorValidator = tagDescColor.Validators[0].(func(string) error)
+	// tagDescCreatedAt is the schema descriptor for created_at field.
+	tagDescCreatedAt := tagFields[4].Descriptor()
+	// tag.DefaultCreatedAt holds the default value on creation for the created_at field.
+	tag.DefaultCreatedAt = tagDescCreatedAt.Default.(func() time.Time)
+	// tagDescUpdatedAt is the schema descriptor for updated_at field.
+	tagDescUpdatedAt := tagFields[5].Descriptor()
+	// tag.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	tag.DefaultUpdatedAt = tagDescUpdatedAt.Default.(func() time.Time)
+	// tag.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	tag.UpdateDefaultUpdatedAt = tagDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// tagDescID is the schema descriptor for id field.
+	tagDescID := tagFields[0].Descriptor()
+	// tag.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	tag.IDValidator = tagDescID.Validators[0].(func(string) error)
 	tenantFields := schema.Tenant{}.Fields()
 	_ = tenantFields
 	// tenantDescName is the schema descriptor for name field.
@@ -24,12 +202,18 @@ func init() {
 	tenantDescSlug := tenantFields[2].Descriptor()
 	// tenant.SlugValidator is a validator for the "slug" field. It is called by the builders before save.
 	tenant.SlugValidator = tenantDescSlug.Validators[0].(func(string) error)
+	// tenantDescTrashRetentionDays is the schema descriptor for trash_retention_days field.
+	tenantDescTrashRetentionDays := tenantFields[3].Descriptor()
+	// tenant.DefaultTrashRetentionDays holds the default value on creation for the trash_retention_days field.
+	tenant.DefaultTrashRetentionDays = tenantDescTrashRetentionDays.Default.(int)
+	// tenant.TrashRetentionDaysValidator is a validator for the "trash_retention_days" field. It is called by the builders before save.
+	tenant.TrashRetentionDaysValidator = tenantDescTrashRetentionDays.Validators[0].(func(int) error)
 	// tenantDescCreatedAt is the schema descriptor for created_at field.
-	tenantDescCreatedAt := tenantFields[3].Descriptor()
+	tenantDescCreatedAt := tenantFields[4].Descriptor()
 	// tenant.DefaultCreatedAt holds the default value on creation for the created_at field.
 	tenant.DefaultCreatedAt = tenantDescCreatedAt.Default.(func() time.Time)
 	// tenantDescUpdatedAt is the schema descriptor for updated_at field.
-	tenantDescUpdatedAt := tenantFields[4].Descriptor()
+	tenantDescUpdatedAt := tenantFields[5].Descriptor()
 	// tenant.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	tenant.DefaultUpdatedAt = tenantDescUpdatedAt.Default.(func() time.Time)
 	// tenant.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -38,6 +222,42 @@ func init() {
 	tenantDescID := tenantFields[0].Descriptor()
 	// tenant.IDValidator is a validator for the "id" field. It is called by the builders before save.
 	tenant.IDValidator = tenantDescID.Validators[0].(func(string) error)
+	tenantdomainFields := schema.TenantDomain{}.Fields()
+	_ = tenantdomainFields
+	// tenantdomainDescTenantID is the schema descriptor for tenant_id field.
+	tenantdomainDescTenantID := tenantdomainFields[1].Descriptor()
+	// tenantdomain.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	tenantdomain.TenantIDValidator = tenantdomainDescTenantID.Validators[0].(func(string) error)
+	// tenantdomainDescDomain is the schema descriptor for domain field.
+	tenantdomainDescDomain := tenantdomainFields[2].Descriptor()
+	// tenantdomain.DomainValidator is a validator for the "domain" field. It is called by the builders before save.
+	tenantdomain.DomainValidator = tenantdomainDescDomain.Validators[0].(func(string) error)
+	// tenantdomainDescVerificationToken is the schema descriptor for verification_token field.
+	tenantdomainDescVerificationToken := tenantdomainFields[3].Descriptor()
+	// tenantdomain.VerificationTokenValidator is a validator for the "verification_token" field. It is called by the builders before save.
+	tenantdomain.VerificationTokenValidator = tenantdomainDescVerificationToken.Validators[0].(func(string) error)
+	// tenantdomainDescCreatedAt is the schema descriptor for created_at field.
+	tenantdomainDescCreatedAt := tenantdomainFields[5].Descriptor()
+	// tenantdomain.DefaultCreatedAt holds the default value on creation for the created_at field.
+	tenantdomain.DefaultCreatedAt = tenantdomainDescCreatedAt.Default.(func() time.Time)
+	// tenantdomainDescID is the schema descriptor for id field.
+	tenantdomainDescID := tenantdomainFields[0].Descriptor()
+	// tenantdomain.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	tenantdomain.IDValidator = tenantdomainDescID.Validators[0].(func(string) error)
+	tenantslughistoryFields := schema.TenantSlugHistory{}.Fields()
+	_ = tenantslughistoryFields
+	// tenantslughistoryDescTenantID is the schema descriptor for tenant_id field.
+	tenantslughistoryDescTenantID := tenantslughistoryFields[0].Descriptor()
+	// tenantslughistory.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	tenantslughistory.TenantIDValidator = tenantslughistoryDescTenantID.Validators[0].(func(string) error)
+	// tenantslughistoryDescSlug is the schema descriptor for slug field.
+	tenantslughistoryDescSlug := tenantslughistoryFields[1].Descriptor()
+	// tenantslughistory.SlugValidator is a validator for the "slug" field. It is called by the builders before save.
+	tenantslughistory.SlugValidator = tenantslughistoryDescSlug.Validators[0].(func(string) error)
+	// tenantslughistoryDescCreatedAt is the schema descriptor for created_at field.
+	tenantslughistoryDescCreatedAt := tenantslughistoryFields[3].Descriptor()
+	// tenantslughistory.DefaultCreatedAt holds the default value on creation for the created_at field.
+	tenantslughistory.DefaultCreatedAt = tenantslughistoryDescCreatedAt.Default.(func() time.Time)
 	todoFields := schema.Todo{}.Fields()
 	_ = todoFields
 	// todoDescTenantID is the schema descriptor for tenant_id field.
@@ -49,27 +269,41 @@ func init() {
 	// todo.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
 	todo.UserIDValidator = todoDescUserID.Validators[0].(func(string) error)
 	// todoDescTitle is the schema descriptor for title field.
-	todoDescTitle := todoFields[3].Descriptor()
+	todoDescTitle := todoFields[6].Descriptor()
 	// todo.TitleValidator is a validator for the "title" field. It is called by the builders before save.
 	todo.TitleValidator = todoDescTitle.Validators[0].(func(string) error)
 	// todoDescDescription is the schema descriptor for description field.
-	todoDescDescription := todoFields[4].Descriptor()
+	todoDescDescription := todoFields[7].Descriptor()
 	// todo.DefaultDescription holds the default value on creation for the description field.
 	todo.DefaultDescription = todoDescDescription.Default.(string)
 	// todoDescCompleted is the schema descriptor for completed field.
-	todoDescCompleted := todoFields[5].Descriptor()
+	todoDescCompleted := todoFields[8].Descriptor()
 	// todo.DefaultCompleted holds the default value on creation for the completed field.
 	todo.DefaultCompleted = todoDescCompleted.Default.(bool)
 	// todoDescIsPublic is the schema descriptor for is_public field.
-	todoDescIsPublic := todoFields[6].Descriptor()
+	todoDescIsPublic := todoFields[9].Descriptor()
 	// todo.DefaultIsPublic holds the default value on creation for the is_public field.
 	todo.DefaultIsPublic = todoDescIsPublic.Default.(bool)
+	// todoDescAutoComplete is the schema descriptor for auto_complete field.
+	todoDescAutoComplete := todoFields[10].Descriptor()
+	// todo.DefaultAutoComplete holds the default value on creation for the auto_complete field.
+	todo.DefaultAutoComplete = todoDescAutoComplete.Default.(bool)
+	// todoDescPosition is the schema descriptor for position field.
+	todoDescPosition := todoFields[17].Descriptor()
+	// todo.PositionValidator is a validator for the "position" field. It is called by the builders before save.
+	todo.PositionValidator = todoDescPosition.Validators[0].(func(string) error)
+	// todoDescVersion is the schema descriptor for version field.
+	todoDescVersion := todoFields[18].Descriptor()
+	// todo.DefaultVersion holds the default value on creation for the version field.
+	todo.DefaultVersion = todoDescVersion.Default.(int)
+	// todo.VersionValidator is a validator for the "version" field. It is called by the builders before save.
+	todo.VersionValidator = todoDescVersion.Validators[0].(func(int) error)
 	// todoDescCreatedAt is the schema descriptor for created_at field.
-	todoDescCreatedAt := todoFields[9].Descriptor()
+	todoDescCreatedAt := todoFields[20].Descriptor()
 	// todo.DefaultCreatedAt holds the default value on creation for the created_at field.
 	todo.DefaultCreatedAt = todoDescCreatedAt.Default.(func() time.Time)
 	// todoDescUpdatedAt is the schema descriptor for updated_at field.
-	todoDescUpdatedAt := todoFields[10].Descriptor()
+	todoDescUpdatedAt := todoFields[21].Descriptor()
 	// todo.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	todo.DefaultUpdatedAt = todoDescUpdatedAt.Default.(func() time.Time)
 	// todo.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -78,34 +312,56 @@ func init() {
 	todoDescID := todoFields[0].Descriptor()
 	// todo.IDValidator is a validator for the "id" field. It is called by the builders before save.
 	todo.IDValidator = todoDescID.Validators[0].(func(string) error)
+	todoactivityFields := schema.TodoActivity{}.Fields()
+	_ = todoactivityFields
+	// todoactivityDescTenantID is the schema descriptor for tenant_id field.
+	todoactivityDescTenantID := todoactivityFields[1].Descriptor()
+	// todoactivity.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	todoactivity.TenantIDValidator = todoactivityDescTenantID.Validators[0].(func(string) error)
+	// todoactivityDescTodoID is the schema descriptor for todo_id field.
+	todoactivityDescTodoID := todoactivityFields[2].Descriptor()
+	// todoactivity.TodoIDValidator is a validator for the "todo_id" field. It is called by the builders before save.
+	todoactivity.TodoIDValidator = todoactivityDescTodoID.Validators[0].(func(string) error)
+	// todoactivityDescActorID is the schema descriptor for actor_id field.
+	todoactivityDescActorID := todoactivityFields[3].Descriptor()
+	// todoactivity.ActorIDValidator is a validator for the "actor_id" field. It is called by the builders before save.
+	todoactivity.ActorIDValidator = todoactivityDescActorID.Validators[0].(func(string) error)
+	// todoactivityDescCreatedAt is the schema descriptor for created_at field.
+	todoactivityDescCreatedAt := todoactivityFields[6].Descriptor()
+	// todoactivity.DefaultCreatedAt holds the default value on creation for the created_at field.
+	todoactivity.DefaultCreatedAt = todoactivityDescCreatedAt.Default.(func() time.Time)
+	// todoactivityDescID is the schema descriptor for id field.
+	todoactivityDescID := todoactivityFields[0].Descriptor()
+	// todoactivity.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	todoactivity.IDValidator = todoactivityDescID.Validators[0].(func(string) error)
 	userFields := schema.User{}.Fields()
 	_ = userFields
-	// userDescTenantID is the schema descriptor for tenant_id field.
-	userDescTenantID := userFields[1].Descriptor()
-	// user.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
-	user.TenantIDValidator = userDescTenantID.Validators[0].(func(string) error)
 	// userDescEmail is the schema descriptor for email field.
-	userDescEmail := userFields[2].Descriptor()
+	userDescEmail := userFields[1].Descriptor()
 	// user.EmailValidator is a validator for the "email" field. It is called by the builders before save.
 	user.EmailValidator = userDescEmail.Validators[0].(func(string) error)
 	// userDescPasswordHash is the schema descriptor for password_hash field.
-	userDescPasswordHash := userFields[3].Descriptor()
+	userDescPasswordHash := userFields[2].Descriptor()
 	// user.PasswordHashValidator is a validator for the "password_hash" field. It is called by the builders before save.
 	user.PasswordHashValidator = userDescPasswordHash.Validators[0].(func(string) error)
 	// userDescName is the schema descriptor for name field.
-	userDescName := userFields[4].Descriptor()
+	userDescName := userFields[3].Descriptor()
 	// user.DefaultName holds the default value on creation for the name field.
 	user.DefaultName = userDescName.Default.(string)
+	// userDescTimezone is the schema descriptor for timezone field.
+	userDescTimezone := userFields[4].Descriptor()
+	// user.DefaultTimezone holds the default value on creation for the timezone field.
+	user.DefaultTimezone = userDescTimezone.Default.(string)
 	// userDescEmailVerified is the schema descriptor for email_verified field.
-	userDescEmailVerified := userFields[6].Descriptor()
+	userDescEmailVerified := userFields[5].Descriptor()
 	// user.DefaultEmailVerified holds the default value on creation for the email_verified field.
 	user.DefaultEmailVerified = userDescEmailVerified.Default.(bool)
 	// userDescCreatedAt is the schema descriptor for created_at field.
-	userDescCreatedAt := userFields[9].Descriptor()
+	userDescCreatedAt := userFields[8].Descriptor()
 	// user.DefaultCreatedAt holds the default value on creation for the created_at field.
 	user.DefaultCreatedAt = userDescCreatedAt.Default.(func() time.Time)
 	// userDescUpdatedAt is the schema descriptor for updated_at field.
-	userDescUpdatedAt := userFields[10].Descriptor()
+	userDescUpdatedAt := userFields[9].Descriptor()
 	// user.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	user.DefaultUpdatedAt = userDescUpdatedAt.Default.(func() time.Time)
 	// user.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
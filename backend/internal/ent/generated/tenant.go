@@ -21,6 +21,8 @@ type Tenant struct {
 	Name string `json:"name,omitempty"`
 	// Slug holds the value of the "slug" field.
 	Slug string `json:"slug,omitempty"`
+	// TrashRetentionDays holds the value of the "trash_retention_days" field.
+	TrashRetentionDays int `json:"trash_retention_days,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -33,22 +35,36 @@ type Tenant struct {
 
 // TenantEdges holds the relations/edges for other nodes in the graph.
 type TenantEdges struct {
-	// Users holds the value of the users edge.
-	Users []*User `json:"users,omitempty"`
+	// Memberships holds the value of the memberships edge.
+	Memberships []*Membership `json:"memberships,omitempty"`
 	// Todos holds the value of the todos edge.
 	Todos []*Todo `json:"todos,omitempty"`
+	// SlugHistories holds the value of the slug_histories edge.
+	SlugHistories []*TenantSlugHistory `json:"slug_histories,omitempty"`
+	// Domains holds the value of the domains edge.
+	Domains []*TenantDomain `json:"domains,omitempty"`
+	// Tags holds the value of the tags edge.
+	Tags []*Tag `json:"tags,omitempty"`
+	// Projects holds the value of the projects edge.
+	Projects []*Project `json:"projects,omitempty"`
+	// Reminders holds the value of the reminders edge.
+	Reminders []*Reminder `json:"reminders,omitempty"`
+	// Comments holds the value of the comments edge.
+	Comments []*Comment `json:"comments,omitempty"`
+	// TodoActivities holds the value of the todo_activities edge.
+	TodoActivities []*TodoActivity `json:"todo_activities,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [9]bool
 }
 
-// UsersOrErr returns the Users value or an error if the edge
+// MembershipsOrErr returns the Memberships value or an error if the edge
 // was not loaded in eager-loading.
-func (e TenantEdges) UsersOrErr() ([]*User, error) {
+func (e TenantEdges) MembershipsOrErr() ([]*Membership, error) {
 	if e.loadedTypes[0] {
-		return e.Users, nil
+		return e.Memberships, nil
 	}
-	return nil, &NotLoadedError{edge: "users"}
+	return nil, &NotLoadedError{edge: "memberships"}
 }
 
 // TodosOrErr returns the Todos value or an error if the edge
@@ -60,11 +76,76 @@ func (e TenantEdges) TodosOrErr() ([]*Todo, error) {
 	return nil, &NotLoadedError{edge: "todos"}
 }
 
+// SlugHistoriesOrErr returns the SlugHistories value or an error if the edge
+// was not loaded in eager-loading.
+func (e TenantEdges) SlugHistoriesOrErr() ([]*TenantSlugHistory, error) {
+	if e.loadedTypes[2] {
+		return e.SlugHistories, nil
+	}
+	return nil, &NotLoadedError{edge: "slug_histories"}
+}
+
+// DomainsOrErr returns the Domains value or an error if the edge
+// was not loaded in eager-loading.
+func (e TenantEdges) DomainsOrErr() ([]*TenantDomain, error) {
+	if e.loadedTypes[3] {
+		return e.Domains, nil
+	}
+	return nil, &NotLoadedError{edge: "domains"}
+}
+
+// TagsOrErr returns the Tags value or an error if the edge
+// was not loaded in eager-loading.
+func (e TenantEdges) TagsOrErr() ([]*Tag, error) {
+	if e.loadedTypes[4] {
+		return e.Tags, nil
+	}
+	return nil, &NotLoadedError{edge: "tags"}
+}
+
+// ProjectsOrErr returns the Projects value or an error if the edge
+// was not loaded in eager-loading.
+func (e TenantEdges) ProjectsOrErr() ([]*Project, error) {
+	if e.loadedTypes[5] {
+		return e.Projects, nil
+	}
+	return nil, &NotLoadedError{edge: "projects"}
+}
+
+// RemindersOrErr returns the Reminders value or an error if the edge
+// was not loaded in eager-loading.
+func (e TenantEdges) RemindersOrErr() ([]*Reminder, error) {
+	if e.loadedTypes[6] {
+		return e.Reminders, nil
+	}
+	return nil, &NotLoadedError{edge: "reminders"}
+}
+
+// CommentsOrErr returns the Comments value or an error if the edge
+// was not loaded in eager-loading.
+func (e TenantEdges) CommentsOrErr() ([]*Comment, error) {
+	if e.loadedTypes[7] {
+		return e.Comments, nil
+	}
+	return nil, &NotLoadedError{edge: "comments"}
+}
+
+// TodoActivitiesOrErr returns the TodoActivities value or an error if the edge
+// was not loaded in eager-loading.
+func (e TenantEdges) TodoActivitiesOrErr() ([]*TodoActivity, error) {
+	if e.loadedTypes[8] {
+		return e.TodoActivities, nil
+	}
+	return nil, &NotLoadedError{edge: "todo_activities"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Tenant) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case tenant.FieldTrashRetentionDays:
+			values[i] = new(sql.NullInt64)
 		case tenant.FieldID, tenant.FieldName, tenant.FieldSlug:
 			values[i] = new(sql.NullString)
 		case tenant.FieldCreatedAt, tenant.FieldUpdatedAt:
@@ -102,6 +183,12 @@ func (_m *Tenant) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Slug = value.String
 			}
+		case tenant.FieldTrashRetentionDays:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field trash_retention_days", values[i])
+			} else if value.Valid {
+				_m.TrashRetentionDays = int(value.Int64)
+			}
 		case tenant.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -127,9 +214,9 @@ func (_m *Tenant) Value(name string) (ent.Value, error) {
 	return _m.selectValues.Get(name)
 }
 
-// QueryUsers queries the "users" edge of the Tenant entity.
-func (_m *Tenant) QueryUsers() *UserQuery {
-	return NewTenantClient(_m.config).QueryUsers(_m)
+// QueryMemberships queries the "memberships" edge of the Tenant entity.
+func (_m *Tenant) QueryMemberships() *MembershipQuery {
+	return NewTenantClient(_m.config).QueryMemberships(_m)
 }
 
 // QueryTodos queries the "todos" edge of the Tenant entity.
@@ -137,6 +224,41 @@ func (_m *Tenant) QueryTodos() *TodoQuery {
 	return NewTenantClient(_m.config).QueryTodos(_m)
 }
 
+// QuerySlugHistories queries the "slug_histories" edge of the Tenant entity.
+func (_m *Tenant) QuerySlugHistories() *TenantSlugHistoryQuery {
+	return NewTenantClient(_m.config).QuerySlugHistories(_m)
+}
+
+// QueryDomains queries the "domains" edge of the Tenant entity.
+func (_m *Tenant) QueryDomains() *TenantDomainQuery {
+	return NewTenantClient(_m.config).QueryDomains(_m)
+}
+
+// QueryTags queries the "tags" edge of the Tenant entity.
+func (_m *Tenant) QueryTags() *TagQuery {
+	return NewTenantClient(_m.config).QueryTags(_m)
+}
+
+// QueryProjects queries the "projects" edge of the Tenant entity.
+func (_m *Tenant) QueryProjects() *ProjectQuery {
+	return NewTenantClient(_m.config).QueryProjects(_m)
+}
+
+// QueryReminders queries the "reminders" edge of the Tenant entity.
+func (_m *Tenant) QueryReminders() *ReminderQuery {
+	return NewTenantClient(_m.config).QueryReminders(_m)
+}
+
+// QueryComments queries the "comments" edge of the Tenant entity.
+func (_m *Tenant) QueryComments() *CommentQuery {
+	return NewTenantClient(_m.config).QueryComments(_m)
+}
+
+// QueryTodoActivities queries the "todo_activities" edge of the Tenant entity.
+func (_m *Tenant) QueryTodoActivities() *TodoActivityQuery {
+	return NewTenantClient(_m.config).QueryTodoActivities(_m)
+}
+
 // Update returns a builder for updating this Tenant.
 // Note that you need to call Tenant.Unwrap() before calling this method if this Tenant
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -166,6 +288,9 @@ func (_m *Tenant) String() string {
 	builder.WriteString("slug=")
 	builder.WriteString(_m.Slug)
 	builder.WriteString(", ")
+	builder.WriteString("trash_retention_days=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TrashRetentionDays))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -6,9 +6,16 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"good-todo-go/internal/ent/generated/comment"
+	"good-todo-go/internal/ent/generated/membership"
+	"good-todo-go/internal/ent/generated/project"
+	"good-todo-go/internal/ent/generated/reminder"
+	"good-todo-go/internal/ent/generated/tag"
 	"good-todo-go/internal/ent/generated/tenant"
+	"good-todo-go/internal/ent/generated/tenantdomain"
+	"good-todo-go/internal/ent/generated/tenantslughistory"
 	"good-todo-go/internal/ent/generated/todo"
-	"good-todo-go/internal/ent/generated/user"
+	"good-todo-go/internal/ent/generated/todoactivity"
 	"time"
 
 	"entgo.io/ent/dialect"
@@ -37,6 +44,20 @@ func (_c *TenantCreate) SetSlug(v string) *TenantCreate {
 	return _c
 }
 
+// SetTrashRetentionDays sets the "trash_retention_days" field.
+func (_c *TenantCreate) SetTrashRetentionDays(v int) *TenantCreate {
+	_c.mutation.SetTrashRetentionDays(v)
+	return _c
+}
+
+// SetNillableTrashRetentionDays sets the "trash_retention_days" field if the given value is not nil.
+func (_c *TenantCreate) SetNillableTrashRetentionDays(v *int) *TenantCreate {
+	if v != nil {
+		_c.SetTrashRetentionDays(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *TenantCreate) SetCreatedAt(v time.Time) *TenantCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -71,19 +92,19 @@ func (_c *TenantCreate) SetID(v string) *TenantCreate {
 	return _c
 }
 
-// AddUserIDs adds the "users" edge to the User entity by IDs.
-func (_c *TenantCreate) AddUserIDs(ids ...string) *TenantCreate {
-	_c.mutation.AddUserIDs(ids...)
+// AddMembershipIDs adds the "memberships" edge to the Membership entity by IDs.
+func (_c *TenantCreate) AddMembershipIDs(ids ...string) *TenantCreate {
+	_c.mutation.AddMembershipIDs(ids...)
 	return _c
 }
 
-// AddUsers adds the "users" edges to the User entity.
-func (_c *TenantCreate) AddUsers(v ...*User) *TenantCreate {
+// AddMemberships adds the "memberships" edges to the Membership entity.
+func (_c *TenantCreate) AddMemberships(v ...*Membership) *TenantCreate {
 	ids := make([]string, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _c.AddUserIDs(ids...)
+	return _c.AddMembershipIDs(ids...)
 }
 
 // AddTodoIDs adds the "todos" edge to the Todo entity by IDs.
@@ -101,6 +122,111 @@ func (_c *TenantCreate) AddTodos(v ...*Todo) *TenantCreate {
 	return _c.AddTodoIDs(ids...)
 }
 
+// AddSlugHistoryIDs adds the "slug_histories" edge to the TenantSlugHistory entity by IDs.
+func (_c *TenantCreate) AddSlugHistoryIDs(ids ...int) *TenantCreate {
+	_c.mutation.AddSlugHistoryIDs(ids...)
+	return _c
+}
+
+// AddSlugHistories adds the "slug_histories" edges to the TenantSlugHistory entity.
+func (_c *TenantCreate) AddSlugHistories(v ...*TenantSlugHistory) *TenantCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddSlugHistoryIDs(ids...)
+}
+
+// AddDomainIDs adds the "domains" edge to the TenantDomain entity by IDs.
+func (_c *TenantCreate) AddDomainIDs(ids ...string) *TenantCreate {
+	_c.mutation.AddDomainIDs(ids...)
+	return _c
+}
+
+// AddDomains adds the "domains" edges to the TenantDomain entity.
+func (_c *TenantCreate) AddDomains(v ...*TenantDomain) *TenantCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddDomainIDs(ids...)
+}
+
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (_c *TenantCreate) AddTagIDs(ids ...string) *TenantCreate {
+	_c.mutation.AddTagIDs(ids...)
+	return _c
+}
+
+// AddTags adds the "tags" edges to the Tag entity.
+func (_c *TenantCreate) AddTags(v ...*Tag) *TenantCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddTagIDs(ids...)
+}
+
+// AddProjectIDs adds the "projects" edge to the Project entity by IDs.
+func (_c *TenantCreate) AddProjectIDs(ids ...string) *TenantCreate {
+	_c.mutation.AddProjectIDs(ids...)
+	return _c
+}
+
+// AddProjects adds the "projects" edges to the Project entity.
+func (_c *TenantCreate) AddProjects(v ...*Project) *TenantCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddProjectIDs(ids...)
+}
+
+// AddReminderIDs adds the "reminders" edge to the Reminder entity by IDs.
+func (_c *TenantCreate) AddReminderIDs(ids ...string) *TenantCreate {
+	_c.mutation.AddReminderIDs(ids...)
+	return _c
+}
+
+// AddReminders adds the "reminders" edges to the Reminder entity.
+func (_c *TenantCreate) AddReminders(v ...*Reminder) *TenantCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddReminderIDs(ids...)
+}
+
+// AddCommentIDs adds the "comments" edge to the Comment entity by IDs.
+func (_c *TenantCreate) AddCommentIDs(ids ...string) *TenantCreate {
+	_c.mutation.AddCommentIDs(ids...)
+	return _c
+}
+
+// AddComments adds the "comments" edges to the Comment entity.
+func (_c *TenantCreate) AddComments(v ...*Comment) *TenantCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddCommentIDs(ids...)
+}
+
+// AddTodoActivityIDs adds the "todo_activities" edge to the TodoActivity entity by IDs.
+func (_c *TenantCreate) AddTodoActivityIDs(ids ...string) *TenantCreate {
+	_c.mutation.AddTodoActivityIDs(ids...)
+	return _c
+}
+
+// AddTodoActivities adds the "todo_activities" edges to the TodoActivity entity.
+func (_c *TenantCreate) AddTodoActivities(v ...*TodoActivity) *TenantCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddTodoActivityIDs(ids...)
+}
+
 // Mutation returns the TenantMutation object of the builder.
 func (_c *TenantCreate) Mutation() *TenantMutation {
 	return _c.mutation
@@ -136,6 +262,10 @@ func (_c *TenantCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (_c *TenantCreate) defaults() {
+	if _, ok := _c.mutation.TrashRetentionDays(); !ok {
+		v := tenant.DefaultTrashRetentionDays
+		_c.mutation.SetTrashRetentionDays(v)
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		v := tenant.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
@@ -164,6 +294,14 @@ func (_c *TenantCreate) check() error {
 			return &ValidationError{Name: "slug", err: fmt.Errorf(`generated: validator failed for field "Tenant.slug": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.TrashRetentionDays(); !ok {
+		return &ValidationError{Name: "trash_retention_days", err: errors.New(`generated: missing required field "Tenant.trash_retention_days"`)}
+	}
+	if v, ok := _c.mutation.TrashRetentionDays(); ok {
+		if err := tenant.TrashRetentionDaysValidator(v); err != nil {
+			return &ValidationError{Name: "trash_retention_days", err: fmt.Errorf(`generated: validator failed for field "Tenant.trash_retention_days": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`generated: missing required field "Tenant.created_at"`)}
 	}
@@ -219,6 +357,10 @@ func (_c *TenantCreate) createSpec() (*Tenant, *sqlgraph.CreateSpec) {
 		_spec.SetField(tenant.FieldSlug, field.TypeString, value)
 		_node.Slug = value
 	}
+	if value, ok := _c.mutation.TrashRetentionDays(); ok {
+		_spec.SetField(tenant.FieldTrashRetentionDays, field.TypeInt, value)
+		_node.TrashRetentionDays = value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(tenant.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -227,15 +369,15 @@ func (_c *TenantCreate) createSpec() (*Tenant, *sqlgraph.CreateSpec) {
 		_spec.SetField(tenant.FieldUpdatedAt, field.TypeTime, value)
 		_node.UpdatedAt = value
 	}
-	if nodes := _c.mutation.UsersIDs(); len(nodes) > 0 {
+	if nodes := _c.mutation.MembershipsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.UsersTable,
-			Columns: []string{tenant.UsersColumn},
+			Table:   tenant.MembershipsTable,
+			Columns: []string{tenant.MembershipsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(membership.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -259,6 +401,118 @@ func (_c *TenantCreate) createSpec() (*Tenant, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.SlugHistoriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.SlugHistoriesTable,
+			Columns: []string{tenant.SlugHistoriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenantslughistory.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.DomainsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.DomainsTable,
+			Columns: []string{tenant.DomainsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenantdomain.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.TagsTable,
+			Columns: []string{tenant.TagsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ProjectsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.ProjectsTable,
+			Columns: []string{tenant.ProjectsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.RemindersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.RemindersTable,
+			Columns: []string{tenant.RemindersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reminder.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.CommentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.CommentsTable,
+			Columns: []string{tenant.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.TodoActivitiesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.TodoActivitiesTable,
+			Columns: []string{tenant.TodoActivitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todoactivity.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -335,6 +589,24 @@ func (u *TenantUpsert) UpdateSlug() *TenantUpsert {
 	return u
 }
 
+// SetTrashRetentionDays sets the "trash_retention_days" field.
+func (u *TenantUpsert) SetTrashRetentionDays(v int) *TenantUpsert {
+	u.Set(tenant.FieldTrashRetentionDays, v)
+	return u
+}
+
+// UpdateTrashRetentionDays sets the "trash_retention_days" field to the value that was provided on create.
+func (u *TenantUpsert) UpdateTrashRetentionDays() *TenantUpsert {
+	u.SetExcluded(tenant.FieldTrashRetentionDays)
+	return u
+}
+
+// AddTrashRetentionDays adds v to the "trash_retention_days" field.
+func (u *TenantUpsert) AddTrashRetentionDays(v int) *TenantUpsert {
+	u.Add(tenant.FieldTrashRetentionDays, v)
+	return u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (u *TenantUpsert) SetUpdatedAt(v time.Time) *TenantUpsert {
 	u.Set(tenant.FieldUpdatedAt, v)
@@ -426,6 +698,27 @@ func (u *TenantUpsertOne) UpdateSlug() *TenantUpsertOne {
 	})
 }
 
+// SetTrashRetentionDays sets the "trash_retention_days" field.
+func (u *TenantUpsertOne) SetTrashRetentionDays(v int) *TenantUpsertOne {
+	return u.Update(func(s *TenantUpsert) {
+		s.SetTrashRetentionDays(v)
+	})
+}
+
+// AddTrashRetentionDays adds v to the "trash_retention_days" field.
+func (u *TenantUpsertOne) AddTrashRetentionDays(v int) *TenantUpsertOne {
+	return u.Update(func(s *TenantUpsert) {
+		s.AddTrashRetentionDays(v)
+	})
+}
+
+// UpdateTrashRetentionDays sets the "trash_retention_days" field to the value that was provided on create.
+func (u *TenantUpsertOne) UpdateTrashRetentionDays() *TenantUpsertOne {
+	return u.Update(func(s *TenantUpsert) {
+		s.UpdateTrashRetentionDays()
+	})
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (u *TenantUpsertOne) SetUpdatedAt(v time.Time) *TenantUpsertOne {
 	return u.Update(func(s *TenantUpsert) {
@@ -686,6 +979,27 @@ func (u *TenantUpsertBulk) UpdateSlug() *TenantUpsertBulk {
 	})
 }
 
+// SetTrashRetentionDays sets the "trash_retention_days" field.
+func (u *TenantUpsertBulk) SetTrashRetentionDays(v int) *TenantUpsertBulk {
+	return u.Update(func(s *TenantUpsert) {
+		s.SetTrashRetentionDays(v)
+	})
+}
+
+// AddTrashRetentionDays adds v to the "trash_retention_days" field.
+func (u *TenantUpsertBulk) AddTrashRetentionDays(v int) *TenantUpsertBulk {
+	return u.Update(func(s *TenantUpsert) {
+		s.AddTrashRetentionDays(v)
+	})
+}
+
+// UpdateTrashRetentionDays sets the "trash_retention_days" field to the value that was provided on create.
+func (u *TenantUpsertBulk) UpdateTrashRetentionDays() *TenantUpsertBulk {
+	return u.Update(func(s *TenantUpsert) {
+		s.UpdateTrashRetentionDays()
+	})
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (u *TenantUpsertBulk) SetUpdatedAt(v time.Time) *TenantUpsertBulk {
 	return u.Update(func(s *TenantUpsert) {
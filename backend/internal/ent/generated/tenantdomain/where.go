@@ -0,0 +1,414 @@
+// Code generated by ent, DO NOT EDIT.
+
+package tenantdomain
+
+import (
+	"good-todo-go/internal/ent/generated/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.TenantDomain {
+	return predicate.TenantDomain(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.TenantDomain {
+	return predicate.TenantDomain(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.TenantDomain {
+	return predicate.TenantDomain(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.TenantDomain {
+	return predicate.TenantDomain(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.TenantDomain {
+	return predicate.TenantDomain(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.TenantDomain {
+	return predicate.TenantDomain(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.TenantDomain {
+	return predicate.TenantDomain(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.TenantDomain {
+	return predicate.TenantDomain(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.TenantDomain {
+	return predicate.TenantDomain(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.TenantDomain {
+	return predicate.TenantDomain(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.TenantDomain {
+	return predicate.TenantDomain(sql.FieldContainsFold(FieldID, id))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v string) predicate.TenantDomain {
+	return predicate.TenantDomain(sql.FieldEQ(FieldTenantID, v))
+}
+
+// Domain applies equality check predicate on the "domain" field. It's identical to DomainEQ.
+func Domain(v string) predicate.TenantDomain {
+	return predicate.TenantDomain(sql.FieldEQ(FieldDomain, v))
+}
+
+// VerificationToken applies equality check predicate on the "verification_token" field. It's identical to VerificationTokenEQ.
+func VerificationToken(v string) predicate.TenantDomain {
+	return predicate.TenantDomain(sql.FieldEQ(FieldVerificationToken, v))
+}
+
+// VerifiedAt applies equality check predicate on the "verified_at" field. It's identical to VerifiedAtEQ.
+func VerifiedAt(v time.Time) predicate.TenantDomain {
+	return predicate.TenantDomain(sql.FieldEQ(FieldVerifiedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.TenantDomain {
+	return predicate.TenantDomain(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v string) predicate.TenantDomain {
+	return predicate.TenantDomain(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v string) predicate.TenantDomain {
+	return predicate.TenantDomain(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...string) predicate.TenantDomain {
+	return predicate.TenantDomain(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...string) predicate.TenantDomain {
+	return predicate.TenantDomain(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v string) predicate.TenantDomain {
+	return predicate.TenantDomain(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v string) predicate.TenantDomain {
+	return predicate.TenantDomain(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v string) predicate.TenantDomain {
+	return predicate.TenantDomain(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v string) predicate.TenantDomain {
+	return predicate.TenantDomain(sql.FieldLTE(FieldTenantID, v))
+}
+
+// TenantIDContains applies the Contains predicate on the "tenant_id" field.
+func TenantIDContains(v string) predicate.TenantDomain {
+	return predicate.TenantDomain(sql.FieldContains(FieldTenantID, v))
+}
+
+// TenantIDHasPrefix applies the HasPrefix predicate on the "tenant_id" field.
+func TenantIDHasPrefix(v string) predicate.TenantDomain {
+	return predicate.TenantDomain(sql.FieldHasPrefix(FieldTenantID, v))
+}
+
+// TenantIDHasSuffix applies the HasSuffix predicate on the "tenant_id" field.
+func TenantIDHasSuffix(v string) predicate.TenantDomain {
+	return predicate.TenantDomain(sql.FieldHasSuffix(FieldTenantID, v))
+}
+
+// TenantIDEqualFold applies the EqualFold predicate on the "tenant_id" field.
+func TenantIDEqualFold(v string) predicate.TenantDomain {
+	return predicate.TenantDomain(sql.FieldEqualFold(FieldTenantID, v))
+}
+
+// TenantIDContainsFold applies the ContainsFold predicate on the "tenant_id" field.
+func TenantIDContainsFold(v string) predicate.TenantDomain {
+	return predicate.TenantDomain(sql.FieldContainsFold(FieldTenantID, v))
+}
+
+// DomainEQ applies the EQ predicate on the "domain" field.
+func DomainEQ(v string) predicate.TenantDomain {
+	return predicate.TenantDomain(sql.FieldEQ(FieldDomain, v))
+}
+
+// DomainNEQ applies the NEQ predicate on the "domain" field.
+func DomainNEQ(v string) predicate.TenantDomain {
+	return predicate.TenantDomain(sql.FieldNEQ(FieldDomain, v))
+}
+
+// DomainIn applies the In predicate on the "domain" field.
+func DomainIn(vs ...string) predicate.TenantDomain {
+	return predicate.TenantDomain(sql.FieldIn(FieldDomain, vs...))
+}
+
+// DomainNotIn applies the NotIn predicate on the "domain" field.
+func DomainNotIn(vs ...string) predicate.TenantDomain {
+	return predicate.TenantDomain(sql.FieldNotIn(FieldDomain, vs...))
+}
+
+// DomainGT applies the GT predicate on the "domain" field.
+func DomainGT(v string) predicate.TenantDomain {
+	return predicate.TenantDomain(sql.FieldGT(FieldDomain, v))
+}
+
+// DomainGTE applies the GTE predicate on the "domain" field.
+func DomainGTE(v string) predicate.TenantDomain {
+	return predicate.TenantDomain(sql.FieldGTE(FieldDomain, v))
+}
+
+// DomainLT applies the LT predicate on the "domain" field.
+func DomainLT(v string) predicate.TenantDomain {
+	return predicate.TenantDomain(sql.FieldLT(FieldDomain, v))
+}
+
+// DomainLTE applies the LTE predicate on the "domain" field.
+func DomainLTE(v string) predicate.TenantDomain {
+	return predicate.TenantDomain(sql.FieldLTE(FieldDomain, v))
+}
+
+// DomainContains applies the Contains predicate on the "domain" field.
+func DomainContains(v string) predicate.TenantDomain {
+	return predicate.TenantDomain(sql.FieldContains(FieldDomain, v))
+}
+
+// DomainHasPrefix applies the HasPrefix predicate on the "domain" field.
+func DomainHasPrefix(v string) predicate.TenantDomain {
+	return predicate.TenantDomain(sql.FieldHasPrefix(FieldDomain, v))
+}
+
+// DomainHasSuffix applies the HasSuffix predicate on the "domain" field.
+func DomainHasSuffix(v string) predicate.TenantDomain {
+	return predicate.TenantDomain(sql.FieldHasSuffix(FieldDomain, v))
+}
+
+// DomainEqualFold applies the EqualFold predicate on the "domain" field.
+func DomainEqualFold(v string) predicate.TenantDomain {
+	return predicate.TenantDomain(sql.FieldEqualFold(FieldDomain, v))
+}
+
+// DomainContainsFold applies the ContainsFold predicate on the "domain" field.
+func DomainContainsFold(v string) predicate.TenantDomain {
+	return predicate.TenantDomain(sql.FieldContainsFold(FieldDomain, v))
+}
+
+// VerificationTokenEQ applies the EQ predicate on the "verification_token" field.
+func VerificationTokenEQ(v string) predicate.TenantDomain {
+	return predicate.TenantDomain(sql.FieldEQ(FieldVerificationToken, v))
+}
+
+// VerificationTokenNEQ applies the NEQ predicate on the "verification_token" field.
+func VerificationTokenNEQ(v string) predicate.TenantDomain {
+	return predicate.TenantDomain(sql.FieldNEQ(FieldVerificationToken, v))
+}
+
+// VerificationTokenIn applies the In predicate on the "verification_token" field.
+func VerificationTokenIn(vs ...string) predicate.TenantDomain {
+	return predicate.TenantDomain(sql.FieldIn(FieldVerificationToken, vs...))
+}
+
+// VerificationTokenNotIn applies the NotIn predicate on the "verification_token" field.
+func VerificationTokenNotIn(vs ...string) predicate.TenantDomain {
+	return predicate.TenantDomain(sql.FieldNotIn(FieldVerificationToken, vs...))
+}
+
+// VerificationTokenGT applies the GT predicate on the "verification_token" field.
+func VerificationTokenGT(v string) predicate.TenantDomain {
+	return predicate.TenantDomain(sql.FieldGT(FieldVerificationToken, v))
+}
+
+// VerificationTokenGTE applies the GTE predicate on the "verification_token" field.
+func VerificationTokenGTE(v string) predicate.TenantDomain {
+	return predicate.TenantDomain(sql.FieldGTE(FieldVerificationToken, v))
+}
+
+// VerificationTokenLT applies the LT predicate on the "verification_token" field.
+func VerificationTokenLT(v string) predicate.TenantDomain {
+	return predicate.TenantDomain(sql.FieldLT(FieldVerificationToken, v))
+}
+
+// VerificationTokenLTE applies the LTE predicate on the "verification_token" field.
+func VerificationTokenLTE(v string) predicate.TenantDomain {
+	return predicate.TenantDomain(sql.FieldLTE(FieldVerificationToken, v))
+}
+
+// VerificationTokenContains applies the Contains predicate on the "verification_token" field.
+func VerificationTokenContains(v string) predicate.TenantDomain {
+	return predicate.TenantDomain(sql.FieldContains(FieldVerificationToken, v))
+}
+
+// VerificationTokenHasPrefix applies the HasPrefix predicate on the "verification_token" field.
+func VerificationTokenHasPrefix(v string) predicate.TenantDomain {
+	return predicate.TenantDomain(sql.FieldHasPrefix(FieldVerificationToken, v))
+}
+
+// VerificationTokenHasSuffix applies the HasSuffix predicate on the "verification_token" field.
+func VerificationTokenHasSuffix(v string) predicate.TenantDomain {
+	return predicate.TenantDomain(sql.FieldHasSuffix(FieldVerificationToken, v))
+}
+
+// VerificationTokenEqualFold applies the EqualFold predicate on the "verification_token" field.
+func VerificationTokenEqualFold(v string) predicate.TenantDomain {
+	return predicate.TenantDomain(sql.FieldEqualFold(FieldVerificationToken, v))
+}
+
+// VerificationTokenContainsFold applies the ContainsFold predicate on the "verification_token" field.
+func VerificationTokenContainsFold(v string) predicate.TenantDomain {
+	return predicate.TenantDomain(sql.FieldContainsFold(FieldVerificationToken, v))
+}
+
+// VerifiedAtEQ applies the EQ predicate on the "verified_at" field.
+func VerifiedAtEQ(v time.Time) predicate.TenantDomain {
+	return predicate.TenantDomain(sql.FieldEQ(FieldVerifiedAt, v))
+}
+
+// VerifiedAtNEQ applies the NEQ predicate on the "verified_at" field.
+func VerifiedAtNEQ(v time.Time) predicate.TenantDomain {
+	return predicate.TenantDomain(sql.FieldNEQ(FieldVerifiedAt, v))
+}
+
+// VerifiedAtIn applies the In predicate on the "verified_at" field.
+func VerifiedAtIn(vs ...time.Time) predicate.TenantDomain {
+	return predicate.TenantDomain(sql.FieldIn(FieldVerifiedAt, vs...))
+}
+
+// VerifiedAtNotIn applies the NotIn predicate on the "verified_at" field.
+func VerifiedAtNotIn(vs ...time.Time) predicate.TenantDomain {
+	return predicate.TenantDomain(sql.FieldNotIn(FieldVerifiedAt, vs...))
+}
+
+// VerifiedAtGT applies the GT predicate on the "verified_at" field.
+func VerifiedAtGT(v time.Time) predicate.TenantDomain {
+	return predicate.TenantDomain(sql.FieldGT(FieldVerifiedAt, v))
+}
+
+// VerifiedAtGTE applies the GTE predicate on the "verified_at" field.
+func VerifiedAtGTE(v time.Time) predicate.TenantDomain {
+	return predicate.TenantDomain(sql.FieldGTE(FieldVerifiedAt, v))
+}
+
+// VerifiedAtLT applies the LT predicate on the "verified_at" field.
+func VerifiedAtLT(v time.Time) predicate.TenantDomain {
+	return predicate.TenantDomain(sql.FieldLT(FieldVerifiedAt, v))
+}
+
+// VerifiedAtLTE applies the LTE predicate on the "verified_at" field.
+func VerifiedAtLTE(v time.Time) predicate.TenantDomain {
+	return predicate.TenantDomain(sql.FieldLTE(FieldVerifiedAt, v))
+}
+
+// VerifiedAtIsNil applies the IsNil predicate on the "verified_at" field.
+func VerifiedAtIsNil() predicate.TenantDomain {
+	return predicate.TenantDomain(sql.FieldIsNull(FieldVerifiedAt))
+}
+
+// VerifiedAtNotNil applies the NotNil predicate on the "verified_at" field.
+func VerifiedAtNotNil() predicate.TenantDomain {
+	return predicate.TenantDomain(sql.FieldNotNull(FieldVerifiedAt))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.TenantDomain {
+	return predicate.TenantDomain(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.TenantDomain {
+	return predicate.TenantDomain(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.TenantDomain {
+	return predicate.TenantDomain(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.TenantDomain {
+	return predicate.TenantDomain(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.TenantDomain {
+	return predicate.TenantDomain(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.TenantDomain {
+	return predicate.TenantDomain(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.TenantDomain {
+	return predicate.TenantDomain(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.TenantDomain {
+	return predicate.TenantDomain(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasTenant applies the HasEdge predicate on the "tenant" edge.
+func HasTenant() predicate.TenantDomain {
+	return predicate.TenantDomain(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, TenantTable, TenantColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTenantWith applies the HasEdge predicate on the "tenant" edge with a given conditions (other predicates).
+func HasTenantWith(preds ...predicate.Tenant) predicate.TenantDomain {
+	return predicate.TenantDomain(func(s *sql.Selector) {
+		step := newTenantStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TenantDomain) predicate.TenantDomain {
+	return predicate.TenantDomain(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TenantDomain) predicate.TenantDomain {
+	return predicate.TenantDomain(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TenantDomain) predicate.TenantDomain {
+	return predicate.TenantDomain(sql.NotPredicates(p))
+}
@@ -0,0 +1,651 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"good-todo-go/internal/ent/generated/tenant"
+	"good-todo-go/internal/ent/generated/tenantdomain"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TenantDomainCreate is the builder for creating a TenantDomain entity.
+type TenantDomainCreate struct {
+	config
+	mutation *TenantDomainMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *TenantDomainCreate) SetTenantID(v string) *TenantDomainCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetDomain sets the "domain" field.
+func (_c *TenantDomainCreate) SetDomain(v string) *TenantDomainCreate {
+	_c.mutation.SetDomain(v)
+	return _c
+}
+
+// SetVerificationToken sets the "verification_token" field.
+func (_c *TenantDomainCreate) SetVerificationToken(v string) *TenantDomainCreate {
+	_c.mutation.SetVerificationToken(v)
+	return _c
+}
+
+// SetVerifiedAt sets the "verified_at" field.
+func (_c *TenantDomainCreate) SetVerifiedAt(v time.Time) *TenantDomainCreate {
+	_c.mutation.SetVerifiedAt(v)
+	return _c
+}
+
+// SetNillableVerifiedAt sets the "verified_at" field if the given value is not nil.
+func (_c *TenantDomainCreate) SetNillableVerifiedAt(v *time.Time) *TenantDomainCreate {
+	if v != nil {
+		_c.SetVerifiedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *TenantDomainCreate) SetCreatedAt(v time.Time) *TenantDomainCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *TenantDomainCreate) SetNillableCreatedAt(v *time.Time) *TenantDomainCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *TenantDomainCreate) SetID(v string) *TenantDomainCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (_c *TenantDomainCreate) SetTenant(v *Tenant) *TenantDomainCreate {
+	return _c.SetTenantID(v.ID)
+}
+
+// Mutation returns the TenantDomainMutation object of the builder.
+func (_c *TenantDomainCreate) Mutation() *TenantDomainMutation {
+	return _c.mutation
+}
+
+// Save creates the TenantDomain in the database.
+func (_c *TenantDomainCreate) Save(ctx context.Context) (*TenantDomain, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *TenantDomainCreate) SaveX(ctx context.Context) *TenantDomain {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TenantDomainCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TenantDomainCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *TenantDomainCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := tenantdomain.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *TenantDomainCreate) check() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`generated: missing required field "TenantDomain.tenant_id"`)}
+	}
+	if v, ok := _c.mutation.TenantID(); ok {
+		if err := tenantdomain.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`generated: validator failed for field "TenantDomain.tenant_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Domain(); !ok {
+		return &ValidationError{Name: "domain", err: errors.New(`generated: missing required field "TenantDomain.domain"`)}
+	}
+	if v, ok := _c.mutation.Domain(); ok {
+		if err := tenantdomain.DomainValidator(v); err != nil {
+			return &ValidationError{Name: "domain", err: fmt.Errorf(`generated: validator failed for field "TenantDomain.domain": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.VerificationToken(); !ok {
+		return &ValidationError{Name: "verification_token", err: errors.New(`generated: missing required field "TenantDomain.verification_token"`)}
+	}
+	if v, ok := _c.mutation.VerificationToken(); ok {
+		if err := tenantdomain.VerificationTokenValidator(v); err != nil {
+			return &ValidationError{Name: "verification_token", err: fmt.Errorf(`generated: validator failed for field "TenantDomain.verification_token": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`generated: missing required field "TenantDomain.created_at"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := tenantdomain.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`generated: validator failed for field "TenantDomain.id": %w`, err)}
+		}
+	}
+	if len(_c.mutation.TenantIDs()) == 0 {
+		return &ValidationError{Name: "tenant", err: errors.New(`generated: missing required edge "TenantDomain.tenant"`)}
+	}
+	return nil
+}
+
+func (_c *TenantDomainCreate) sqlSave(ctx context.Context) (*TenantDomain, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected TenantDomain.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *TenantDomainCreate) createSpec() (*TenantDomain, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TenantDomain{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(tenantdomain.Table, sqlgraph.NewFieldSpec(tenantdomain.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.Domain(); ok {
+		_spec.SetField(tenantdomain.FieldDomain, field.TypeString, value)
+		_node.Domain = value
+	}
+	if value, ok := _c.mutation.VerificationToken(); ok {
+		_spec.SetField(tenantdomain.FieldVerificationToken, field.TypeString, value)
+		_node.VerificationToken = value
+	}
+	if value, ok := _c.mutation.VerifiedAt(); ok {
+		_spec.SetField(tenantdomain.FieldVerifiedAt, field.TypeTime, value)
+		_node.VerifiedAt = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(tenantdomain.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   tenantdomain.TenantTable,
+			Columns: []string{tenantdomain.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TenantID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TenantDomain.Create().
+//		SetTenantID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TenantDomainUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *TenantDomainCreate) OnConflict(opts ...sql.ConflictOption) *TenantDomainUpsertOne {
+	_c.conflict = opts
+	return &TenantDomainUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TenantDomain.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *TenantDomainCreate) OnConflictColumns(columns ...string) *TenantDomainUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &TenantDomainUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// TenantDomainUpsertOne is the builder for "upsert"-ing
+	//  one TenantDomain node.
+	TenantDomainUpsertOne struct {
+		create *TenantDomainCreate
+	}
+
+	// TenantDomainUpsert is the "OnConflict" setter.
+	TenantDomainUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetVerifiedAt sets the "verified_at" field.
+func (u *TenantDomainUpsert) SetVerifiedAt(v time.Time) *TenantDomainUpsert {
+	u.Set(tenantdomain.FieldVerifiedAt, v)
+	return u
+}
+
+// UpdateVerifiedAt sets the "verified_at" field to the value that was provided on create.
+func (u *TenantDomainUpsert) UpdateVerifiedAt() *TenantDomainUpsert {
+	u.SetExcluded(tenantdomain.FieldVerifiedAt)
+	return u
+}
+
+// ClearVerifiedAt clears the value of the "verified_at" field.
+func (u *TenantDomainUpsert) ClearVerifiedAt() *TenantDomainUpsert {
+	u.SetNull(tenantdomain.FieldVerifiedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.TenantDomain.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(tenantdomain.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TenantDomainUpsertOne) UpdateNewValues() *TenantDomainUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(tenantdomain.FieldID)
+		}
+		if _, exists := u.create.mutation.TenantID(); exists {
+			s.SetIgnore(tenantdomain.FieldTenantID)
+		}
+		if _, exists := u.create.mutation.Domain(); exists {
+			s.SetIgnore(tenantdomain.FieldDomain)
+		}
+		if _, exists := u.create.mutation.VerificationToken(); exists {
+			s.SetIgnore(tenantdomain.FieldVerificationToken)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(tenantdomain.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TenantDomain.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *TenantDomainUpsertOne) Ignore() *TenantDomainUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TenantDomainUpsertOne) DoNothing() *TenantDomainUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TenantDomainCreate.OnConflict
+// documentation for more info.
+func (u *TenantDomainUpsertOne) Update(set func(*TenantDomainUpsert)) *TenantDomainUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TenantDomainUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetVerifiedAt sets the "verified_at" field.
+func (u *TenantDomainUpsertOne) SetVerifiedAt(v time.Time) *TenantDomainUpsertOne {
+	return u.Update(func(s *TenantDomainUpsert) {
+		s.SetVerifiedAt(v)
+	})
+}
+
+// UpdateVerifiedAt sets the "verified_at" field to the value that was provided on create.
+func (u *TenantDomainUpsertOne) UpdateVerifiedAt() *TenantDomainUpsertOne {
+	return u.Update(func(s *TenantDomainUpsert) {
+		s.UpdateVerifiedAt()
+	})
+}
+
+// ClearVerifiedAt clears the value of the "verified_at" field.
+func (u *TenantDomainUpsertOne) ClearVerifiedAt() *TenantDomainUpsertOne {
+	return u.Update(func(s *TenantDomainUpsert) {
+		s.ClearVerifiedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *TenantDomainUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("generated: missing options for TenantDomainCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TenantDomainUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *TenantDomainUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("generated: TenantDomainUpsertOne.ID is not supported by MySQL driver. Use TenantDomainUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *TenantDomainUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// TenantDomainCreateBulk is the builder for creating many TenantDomain entities in bulk.
+type TenantDomainCreateBulk struct {
+	config
+	err      error
+	builders []*TenantDomainCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the TenantDomain entities in the database.
+func (_c *TenantDomainCreateBulk) Save(ctx context.Context) ([]*TenantDomain, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*TenantDomain, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TenantDomainMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *TenantDomainCreateBulk) SaveX(ctx context.Context) []*TenantDomain {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TenantDomainCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TenantDomainCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TenantDomain.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TenantDomainUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *TenantDomainCreateBulk) OnConflict(opts ...sql.ConflictOption) *TenantDomainUpsertBulk {
+	_c.conflict = opts
+	return &TenantDomainUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TenantDomain.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *TenantDomainCreateBulk) OnConflictColumns(columns ...string) *TenantDomainUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &TenantDomainUpsertBulk{
+		create: _c,
+	}
+}
+
+// TenantDomainUpsertBulk is the builder for "upsert"-ing
+// a bulk of TenantDomain nodes.
+type TenantDomainUpsertBulk struct {
+	create *TenantDomainCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.TenantDomain.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(tenantdomain.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TenantDomainUpsertBulk) UpdateNewValues() *TenantDomainUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(tenantdomain.FieldID)
+			}
+			if _, exists := b.mutation.TenantID(); exists {
+				s.SetIgnore(tenantdomain.FieldTenantID)
+			}
+			if _, exists := b.mutation.Domain(); exists {
+				s.SetIgnore(tenantdomain.FieldDomain)
+			}
+			if _, exists := b.mutation.VerificationToken(); exists {
+				s.SetIgnore(tenantdomain.FieldVerificationToken)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(tenantdomain.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TenantDomain.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *TenantDomainUpsertBulk) Ignore() *TenantDomainUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TenantDomainUpsertBulk) DoNothing() *TenantDomainUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TenantDomainCreateBulk.OnConflict
+// documentation for more info.
+func (u *TenantDomainUpsertBulk) Update(set func(*TenantDomainUpsert)) *TenantDomainUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TenantDomainUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetVerifiedAt sets the "verified_at" field.
+func (u *TenantDomainUpsertBulk) SetVerifiedAt(v time.Time) *TenantDomainUpsertBulk {
+	return u.Update(func(s *TenantDomainUpsert) {
+		s.SetVerifiedAt(v)
+	})
+}
+
+// UpdateVerifiedAt sets the "verified_at" field to the value that was provided on create.
+func (u *TenantDomainUpsertBulk) UpdateVerifiedAt() *TenantDomainUpsertBulk {
+	return u.Update(func(s *TenantDomainUpsert) {
+		s.UpdateVerifiedAt()
+	})
+}
+
+// ClearVerifiedAt clears the value of the "verified_at" field.
+func (u *TenantDomainUpsertBulk) ClearVerifiedAt() *TenantDomainUpsertBulk {
+	return u.Update(func(s *TenantDomainUpsert) {
+		s.ClearVerifiedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *TenantDomainUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("generated: OnConflict was set for builder %d. Set it on the TenantDomainCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("generated: missing options for TenantDomainCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TenantDomainUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,250 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"good-todo-go/internal/ent/generated/predicate"
+	"good-todo-go/internal/ent/generated/tenantdomain"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TenantDomainUpdate is the builder for updating TenantDomain entities.
+type TenantDomainUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TenantDomainMutation
+}
+
+// Where appends a list predicates to the TenantDomainUpdate builder.
+func (_u *TenantDomainUpdate) Where(ps ...predicate.TenantDomain) *TenantDomainUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetVerifiedAt sets the "verified_at" field.
+func (_u *TenantDomainUpdate) SetVerifiedAt(v time.Time) *TenantDomainUpdate {
+	_u.mutation.SetVerifiedAt(v)
+	return _u
+}
+
+// SetNillableVerifiedAt sets the "verified_at" field if the given value is not nil.
+func (_u *TenantDomainUpdate) SetNillableVerifiedAt(v *time.Time) *TenantDomainUpdate {
+	if v != nil {
+		_u.SetVerifiedAt(*v)
+	}
+	return _u
+}
+
+// ClearVerifiedAt clears the value of the "verified_at" field.
+func (_u *TenantDomainUpdate) ClearVerifiedAt() *TenantDomainUpdate {
+	_u.mutation.ClearVerifiedAt()
+	return _u
+}
+
+// Mutation returns the TenantDomainMutation object of the builder.
+func (_u *TenantDomainUpdate) Mutation() *TenantDomainMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *TenantDomainUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TenantDomainUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *TenantDomainUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TenantDomainUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TenantDomainUpdate) check() error {
+	if _u.mutation.TenantCleared() && len(_u.mutation.TenantIDs()) > 0 {
+		return errors.New(`generated: clearing a required unique edge "TenantDomain.tenant"`)
+	}
+	return nil
+}
+
+func (_u *TenantDomainUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(tenantdomain.Table, tenantdomain.Columns, sqlgraph.NewFieldSpec(tenantdomain.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.VerifiedAt(); ok {
+		_spec.SetField(tenantdomain.FieldVerifiedAt, field.TypeTime, value)
+	}
+	if _u.mutation.VerifiedAtCleared() {
+		_spec.ClearField(tenantdomain.FieldVerifiedAt, field.TypeTime)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tenantdomain.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// TenantDomainUpdateOne is the builder for updating a single TenantDomain entity.
+type TenantDomainUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TenantDomainMutation
+}
+
+// SetVerifiedAt sets the "verified_at" field.
+func (_u *TenantDomainUpdateOne) SetVerifiedAt(v time.Time) *TenantDomainUpdateOne {
+	_u.mutation.SetVerifiedAt(v)
+	return _u
+}
+
+// SetNillableVerifiedAt sets the "verified_at" field if the given value is not nil.
+func (_u *TenantDomainUpdateOne) SetNillableVerifiedAt(v *time.Time) *TenantDomainUpdateOne {
+	if v != nil {
+		_u.SetVerifiedAt(*v)
+	}
+	return _u
+}
+
+// ClearVerifiedAt clears the value of the "verified_at" field.
+func (_u *TenantDomainUpdateOne) ClearVerifiedAt() *TenantDomainUpdateOne {
+	_u.mutation.ClearVerifiedAt()
+	return _u
+}
+
+// Mutation returns the TenantDomainMutation object of the builder.
+func (_u *TenantDomainUpdateOne) Mutation() *TenantDomainMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the TenantDomainUpdate builder.
+func (_u *TenantDomainUpdateOne) Where(ps ...predicate.TenantDomain) *TenantDomainUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *TenantDomainUpdateOne) Select(field string, fields ...string) *TenantDomainUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated TenantDomain entity.
+func (_u *TenantDomainUpdateOne) Save(ctx context.Context) (*TenantDomain, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TenantDomainUpdateOne) SaveX(ctx context.Context) *TenantDomain {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *TenantDomainUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TenantDomainUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TenantDomainUpdateOne) check() error {
+	if _u.mutation.TenantCleared() && len(_u.mutation.TenantIDs()) > 0 {
+		return errors.New(`generated: clearing a required unique edge "TenantDomain.tenant"`)
+	}
+	return nil
+}
+
+func (_u *TenantDomainUpdateOne) sqlSave(ctx context.Context) (_node *TenantDomain, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(tenantdomain.Table, tenantdomain.Columns, sqlgraph.NewFieldSpec(tenantdomain.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`generated: missing "TenantDomain.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, tenantdomain.FieldID)
+		for _, f := range fields {
+			if !tenantdomain.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("generated: invalid field %q for query", f)}
+			}
+			if f != tenantdomain.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.VerifiedAt(); ok {
+		_spec.SetField(tenantdomain.FieldVerifiedAt, field.TypeTime, value)
+	}
+	if _u.mutation.VerifiedAtCleared() {
+		_spec.ClearField(tenantdomain.FieldVerifiedAt, field.TypeTime)
+	}
+	_node = &TenantDomain{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tenantdomain.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
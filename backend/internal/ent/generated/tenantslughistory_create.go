@@ -0,0 +1,602 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"good-todo-go/internal/ent/generated/tenant"
+	"good-todo-go/internal/ent/generated/tenantslughistory"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TenantSlugHistoryCreate is the builder for creating a TenantSlugHistory entity.
+type TenantSlugHistoryCreate struct {
+	config
+	mutation *TenantSlugHistoryMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *TenantSlugHistoryCreate) SetTenantID(v string) *TenantSlugHistoryCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetSlug sets the "slug" field.
+func (_c *TenantSlugHistoryCreate) SetSlug(v string) *TenantSlugHistoryCreate {
+	_c.mutation.SetSlug(v)
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *TenantSlugHistoryCreate) SetExpiresAt(v time.Time) *TenantSlugHistoryCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *TenantSlugHistoryCreate) SetCreatedAt(v time.Time) *TenantSlugHistoryCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *TenantSlugHistoryCreate) SetNillableCreatedAt(v *time.Time) *TenantSlugHistoryCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (_c *TenantSlugHistoryCreate) SetTenant(v *Tenant) *TenantSlugHistoryCreate {
+	return _c.SetTenantID(v.ID)
+}
+
+// Mutation returns the TenantSlugHistoryMutation object of the builder.
+func (_c *TenantSlugHistoryCreate) Mutation() *TenantSlugHistoryMutation {
+	return _c.mutation
+}
+
+// Save creates the TenantSlugHistory in the database.
+func (_c *TenantSlugHistoryCreate) Save(ctx context.Context) (*TenantSlugHistory, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *TenantSlugHistoryCreate) SaveX(ctx context.Context) *TenantSlugHistory {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TenantSlugHistoryCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TenantSlugHistoryCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *TenantSlugHistoryCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := tenantslughistory.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *TenantSlugHistoryCreate) check() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`generated: missing required field "TenantSlugHistory.tenant_id"`)}
+	}
+	if v, ok := _c.mutation.TenantID(); ok {
+		if err := tenantslughistory.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`generated: validator failed for field "TenantSlugHistory.tenant_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Slug(); !ok {
+		return &ValidationError{Name: "slug", err: errors.New(`generated: missing required field "TenantSlugHistory.slug"`)}
+	}
+	if v, ok := _c.mutation.Slug(); ok {
+		if err := tenantslughistory.SlugValidator(v); err != nil {
+			return &ValidationError{Name: "slug", err: fmt.Errorf(`generated: validator failed for field "TenantSlugHistory.slug": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`generated: missing required field "TenantSlugHistory.expires_at"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`generated: missing required field "TenantSlugHistory.created_at"`)}
+	}
+	if len(_c.mutation.TenantIDs()) == 0 {
+		return &ValidationError{Name: "tenant", err: errors.New(`generated: missing required edge "TenantSlugHistory.tenant"`)}
+	}
+	return nil
+}
+
+func (_c *TenantSlugHistoryCreate) sqlSave(ctx context.Context) (*TenantSlugHistory, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *TenantSlugHistoryCreate) createSpec() (*TenantSlugHistory, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TenantSlugHistory{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(tenantslughistory.Table, sqlgraph.NewFieldSpec(tenantslughistory.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = _c.conflict
+	if value, ok := _c.mutation.Slug(); ok {
+		_spec.SetField(tenantslughistory.FieldSlug, field.TypeString, value)
+		_node.Slug = value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(tenantslughistory.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(tenantslughistory.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   tenantslughistory.TenantTable,
+			Columns: []string{tenantslughistory.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TenantID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TenantSlugHistory.Create().
+//		SetTenantID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TenantSlugHistoryUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *TenantSlugHistoryCreate) OnConflict(opts ...sql.ConflictOption) *TenantSlugHistoryUpsertOne {
+	_c.conflict = opts
+	return &TenantSlugHistoryUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TenantSlugHistory.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *TenantSlugHistoryCreate) OnConflictColumns(columns ...string) *TenantSlugHistoryUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &TenantSlugHistoryUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// TenantSlugHistoryUpsertOne is the builder for "upsert"-ing
+	//  one TenantSlugHistory node.
+	TenantSlugHistoryUpsertOne struct {
+		create *TenantSlugHistoryCreate
+	}
+
+	// TenantSlugHistoryUpsert is the "OnConflict" setter.
+	TenantSlugHistoryUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetSlug sets the "slug" field.
+func (u *TenantSlugHistoryUpsert) SetSlug(v string) *TenantSlugHistoryUpsert {
+	u.Set(tenantslughistory.FieldSlug, v)
+	return u
+}
+
+// UpdateSlug sets the "slug" field to the value that was provided on create.
+func (u *TenantSlugHistoryUpsert) UpdateSlug() *TenantSlugHistoryUpsert {
+	u.SetExcluded(tenantslughistory.FieldSlug)
+	return u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *TenantSlugHistoryUpsert) SetExpiresAt(v time.Time) *TenantSlugHistoryUpsert {
+	u.Set(tenantslughistory.FieldExpiresAt, v)
+	return u
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *TenantSlugHistoryUpsert) UpdateExpiresAt() *TenantSlugHistoryUpsert {
+	u.SetExcluded(tenantslughistory.FieldExpiresAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.TenantSlugHistory.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *TenantSlugHistoryUpsertOne) UpdateNewValues() *TenantSlugHistoryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.TenantID(); exists {
+			s.SetIgnore(tenantslughistory.FieldTenantID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(tenantslughistory.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TenantSlugHistory.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *TenantSlugHistoryUpsertOne) Ignore() *TenantSlugHistoryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TenantSlugHistoryUpsertOne) DoNothing() *TenantSlugHistoryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TenantSlugHistoryCreate.OnConflict
+// documentation for more info.
+func (u *TenantSlugHistoryUpsertOne) Update(set func(*TenantSlugHistoryUpsert)) *TenantSlugHistoryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TenantSlugHistoryUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetSlug sets the "slug" field.
+func (u *TenantSlugHistoryUpsertOne) SetSlug(v string) *TenantSlugHistoryUpsertOne {
+	return u.Update(func(s *TenantSlugHistoryUpsert) {
+		s.SetSlug(v)
+	})
+}
+
+// UpdateSlug sets the "slug" field to the value that was provided on create.
+func (u *TenantSlugHistoryUpsertOne) UpdateSlug() *TenantSlugHistoryUpsertOne {
+	return u.Update(func(s *TenantSlugHistoryUpsert) {
+		s.UpdateSlug()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *TenantSlugHistoryUpsertOne) SetExpiresAt(v time.Time) *TenantSlugHistoryUpsertOne {
+	return u.Update(func(s *TenantSlugHistoryUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *TenantSlugHistoryUpsertOne) UpdateExpiresAt() *TenantSlugHistoryUpsertOne {
+	return u.Update(func(s *TenantSlugHistoryUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// Exec executes the query.
+func (u *TenantSlugHistoryUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("generated: missing options for TenantSlugHistoryCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TenantSlugHistoryUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *TenantSlugHistoryUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *TenantSlugHistoryUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// TenantSlugHistoryCreateBulk is the builder for creating many TenantSlugHistory entities in bulk.
+type TenantSlugHistoryCreateBulk struct {
+	config
+	err      error
+	builders []*TenantSlugHistoryCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the TenantSlugHistory entities in the database.
+func (_c *TenantSlugHistoryCreateBulk) Save(ctx context.Context) ([]*TenantSlugHistory, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*TenantSlugHistory, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TenantSlugHistoryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *TenantSlugHistoryCreateBulk) SaveX(ctx context.Context) []*TenantSlugHistory {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TenantSlugHistoryCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TenantSlugHistoryCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TenantSlugHistory.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TenantSlugHistoryUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *TenantSlugHistoryCreateBulk) OnConflict(opts ...sql.ConflictOption) *TenantSlugHistoryUpsertBulk {
+	_c.conflict = opts
+	return &TenantSlugHistoryUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TenantSlugHistory.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *TenantSlugHistoryCreateBulk) OnConflictColumns(columns ...string) *TenantSlugHistoryUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &TenantSlugHistoryUpsertBulk{
+		create: _c,
+	}
+}
+
+// TenantSlugHistoryUpsertBulk is the builder for "upsert"-ing
+// a bulk of TenantSlugHistory nodes.
+type TenantSlugHistoryUpsertBulk struct {
+	create *TenantSlugHistoryCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.TenantSlugHistory.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *TenantSlugHistoryUpsertBulk) UpdateNewValues() *TenantSlugHistoryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.TenantID(); exists {
+				s.SetIgnore(tenantslughistory.FieldTenantID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(tenantslughistory.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TenantSlugHistory.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *TenantSlugHistoryUpsertBulk) Ignore() *TenantSlugHistoryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TenantSlugHistoryUpsertBulk) DoNothing() *TenantSlugHistoryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TenantSlugHistoryCreateBulk.OnConflict
+// documentation for more info.
+func (u *TenantSlugHistoryUpsertBulk) Update(set func(*TenantSlugHistoryUpsert)) *TenantSlugHistoryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TenantSlugHistoryUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetSlug sets the "slug" field.
+func (u *TenantSlugHistoryUpsertBulk) SetSlug(v string) *TenantSlugHistoryUpsertBulk {
+	return u.Update(func(s *TenantSlugHistoryUpsert) {
+		s.SetSlug(v)
+	})
+}
+
+// UpdateSlug sets the "slug" field to the value that was provided on create.
+func (u *TenantSlugHistoryUpsertBulk) UpdateSlug() *TenantSlugHistoryUpsertBulk {
+	return u.Update(func(s *TenantSlugHistoryUpsert) {
+		s.UpdateSlug()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *TenantSlugHistoryUpsertBulk) SetExpiresAt(v time.Time) *TenantSlugHistoryUpsertBulk {
+	return u.Update(func(s *TenantSlugHistoryUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *TenantSlugHistoryUpsertBulk) UpdateExpiresAt() *TenantSlugHistoryUpsertBulk {
+	return u.Update(func(s *TenantSlugHistoryUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// Exec executes the query.
+func (u *TenantSlugHistoryUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("generated: OnConflict was set for builder %d. Set it on the TenantSlugHistoryCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("generated: missing options for TenantSlugHistoryCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TenantSlugHistoryUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,276 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"good-todo-go/internal/ent/generated/predicate"
+	"good-todo-go/internal/ent/generated/tenantslughistory"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TenantSlugHistoryUpdate is the builder for updating TenantSlugHistory entities.
+type TenantSlugHistoryUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TenantSlugHistoryMutation
+}
+
+// Where appends a list predicates to the TenantSlugHistoryUpdate builder.
+func (_u *TenantSlugHistoryUpdate) Where(ps ...predicate.TenantSlugHistory) *TenantSlugHistoryUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetSlug sets the "slug" field.
+func (_u *TenantSlugHistoryUpdate) SetSlug(v string) *TenantSlugHistoryUpdate {
+	_u.mutation.SetSlug(v)
+	return _u
+}
+
+// SetNillableSlug sets the "slug" field if the given value is not nil.
+func (_u *TenantSlugHistoryUpdate) SetNillableSlug(v *string) *TenantSlugHistoryUpdate {
+	if v != nil {
+		_u.SetSlug(*v)
+	}
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *TenantSlugHistoryUpdate) SetExpiresAt(v time.Time) *TenantSlugHistoryUpdate {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *TenantSlugHistoryUpdate) SetNillableExpiresAt(v *time.Time) *TenantSlugHistoryUpdate {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the TenantSlugHistoryMutation object of the builder.
+func (_u *TenantSlugHistoryUpdate) Mutation() *TenantSlugHistoryMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *TenantSlugHistoryUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TenantSlugHistoryUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *TenantSlugHistoryUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TenantSlugHistoryUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TenantSlugHistoryUpdate) check() error {
+	if v, ok := _u.mutation.Slug(); ok {
+		if err := tenantslughistory.SlugValidator(v); err != nil {
+			return &ValidationError{Name: "slug", err: fmt.Errorf(`generated: validator failed for field "TenantSlugHistory.slug": %w`, err)}
+		}
+	}
+	if _u.mutation.TenantCleared() && len(_u.mutation.TenantIDs()) > 0 {
+		return errors.New(`generated: clearing a required unique edge "TenantSlugHistory.tenant"`)
+	}
+	return nil
+}
+
+func (_u *TenantSlugHistoryUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(tenantslughistory.Table, tenantslughistory.Columns, sqlgraph.NewFieldSpec(tenantslughistory.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Slug(); ok {
+		_spec.SetField(tenantslughistory.FieldSlug, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(tenantslughistory.FieldExpiresAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tenantslughistory.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// TenantSlugHistoryUpdateOne is the builder for updating a single TenantSlugHistory entity.
+type TenantSlugHistoryUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TenantSlugHistoryMutation
+}
+
+// SetSlug sets the "slug" field.
+func (_u *TenantSlugHistoryUpdateOne) SetSlug(v string) *TenantSlugHistoryUpdateOne {
+	_u.mutation.SetSlug(v)
+	return _u
+}
+
+// SetNillableSlug sets the "slug" field if the given value is not nil.
+func (_u *TenantSlugHistoryUpdateOne) SetNillableSlug(v *string) *TenantSlugHistoryUpdateOne {
+	if v != nil {
+		_u.SetSlug(*v)
+	}
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *TenantSlugHistoryUpdateOne) SetExpiresAt(v time.Time) *TenantSlugHistoryUpdateOne {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *TenantSlugHistoryUpdateOne) SetNillableExpiresAt(v *time.Time) *TenantSlugHistoryUpdateOne {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the TenantSlugHistoryMutation object of the builder.
+func (_u *TenantSlugHistoryUpdateOne) Mutation() *TenantSlugHistoryMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the TenantSlugHistoryUpdate builder.
+func (_u *TenantSlugHistoryUpdateOne) Where(ps ...predicate.TenantSlugHistory) *TenantSlugHistoryUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *TenantSlugHistoryUpdateOne) Select(field string, fields ...string) *TenantSlugHistoryUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated TenantSlugHistory entity.
+func (_u *TenantSlugHistoryUpdateOne) Save(ctx context.Context) (*TenantSlugHistory, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TenantSlugHistoryUpdateOne) SaveX(ctx context.Context) *TenantSlugHistory {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *TenantSlugHistoryUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TenantSlugHistoryUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TenantSlugHistoryUpdateOne) check() error {
+	if v, ok := _u.mutation.Slug(); ok {
+		if err := tenantslughistory.SlugValidator(v); err != nil {
+			return &ValidationError{Name: "slug", err: fmt.Errorf(`generated: validator failed for field "TenantSlugHistory.slug": %w`, err)}
+		}
+	}
+	if _u.mutation.TenantCleared() && len(_u.mutation.TenantIDs()) > 0 {
+		return errors.New(`generated: clearing a required unique edge "TenantSlugHistory.tenant"`)
+	}
+	return nil
+}
+
+func (_u *TenantSlugHistoryUpdateOne) sqlSave(ctx context.Context) (_node *TenantSlugHistory, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(tenantslughistory.Table, tenantslughistory.Columns, sqlgraph.NewFieldSpec(tenantslughistory.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`generated: missing "TenantSlugHistory.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, tenantslughistory.FieldID)
+		for _, f := range fields {
+			if !tenantslughistory.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("generated: invalid field %q for query", f)}
+			}
+			if f != tenantslughistory.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Slug(); ok {
+		_spec.SetField(tenantslughistory.FieldSlug, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(tenantslughistory.FieldExpiresAt, field.TypeTime, value)
+	}
+	_node = &TenantSlugHistory{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tenantslughistory.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
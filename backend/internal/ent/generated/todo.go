@@ -4,6 +4,7 @@ package generated
 
 import (
 	"fmt"
+	"good-todo-go/internal/ent/generated/project"
 	"good-todo-go/internal/ent/generated/tenant"
 	"good-todo-go/internal/ent/generated/todo"
 	"good-todo-go/internal/ent/generated/user"
@@ -23,6 +24,12 @@ type Todo struct {
 	TenantID string `json:"tenant_id,omitempty"`
 	// UserID holds the value of the "user_id" field.
 	UserID string `json:"user_id,omitempty"`
+	// AssigneeID holds the value of the "assignee_id" field.
+	AssigneeID *string `json:"assignee_id,omitempty"`
+	// ProjectID holds the value of the "project_id" field.
+	ProjectID *string `json:"project_id,omitempty"`
+	// ParentID holds the value of the "parent_id" field.
+	ParentID *string `json:"parent_id,omitempty"`
 	// Title holds the value of the "title" field.
 	Title string `json:"title,omitempty"`
 	// Description holds the value of the "description" field.
@@ -31,10 +38,26 @@ type Todo struct {
 	Completed bool `json:"completed,omitempty"`
 	// IsPublic holds the value of the "is_public" field.
 	IsPublic bool `json:"is_public,omitempty"`
+	// AutoComplete holds the value of the "auto_complete" field.
+	AutoComplete bool `json:"auto_complete,omitempty"`
+	// Priority holds the value of the "priority" field.
+	Priority todo.Priority `json:"priority,omitempty"`
 	// DueDate holds the value of the "due_date" field.
 	DueDate *time.Time `json:"due_date,omitempty"`
+	// RecurrenceRule holds the value of the "recurrence_rule" field.
+	RecurrenceRule *string `json:"recurrence_rule,omitempty"`
+	// RecurrenceTimezone holds the value of the "recurrence_timezone" field.
+	RecurrenceTimezone *string `json:"recurrence_timezone,omitempty"`
 	// CompletedAt holds the value of the "completed_at" field.
 	CompletedAt *time.Time `json:"completed_at,omitempty"`
+	// SnoozedUntil holds the value of the "snoozed_until" field.
+	SnoozedUntil *time.Time `json:"snoozed_until,omitempty"`
+	// Position holds the value of the "position" field.
+	Position string `json:"position,omitempty"`
+	// Version holds the value of the "version" field.
+	Version int `json:"version,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -51,9 +74,27 @@ type TodoEdges struct {
 	Tenant *Tenant `json:"tenant,omitempty"`
 	// User holds the value of the user edge.
 	User *User `json:"user,omitempty"`
+	// Tags holds the value of the tags edge.
+	Tags []*Tag `json:"tags,omitempty"`
+	// Project holds the value of the project edge.
+	Project *Project `json:"project,omitempty"`
+	// Parent holds the value of the parent edge.
+	Parent *Todo `json:"parent,omitempty"`
+	// Subtasks holds the value of the subtasks edge.
+	Subtasks []*Todo `json:"subtasks,omitempty"`
+	// Blockers holds the value of the blockers edge.
+	Blockers []*Todo `json:"blockers,omitempty"`
+	// Blocking holds the value of the blocking edge.
+	Blocking []*Todo `json:"blocking,omitempty"`
+	// Reminders holds the value of the reminders edge.
+	Reminders []*Reminder `json:"reminders,omitempty"`
+	// Comments holds the value of the comments edge.
+	Comments []*Comment `json:"comments,omitempty"`
+	// Activities holds the value of the activities edge.
+	Activities []*TodoActivity `json:"activities,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [11]bool
 }
 
 // TenantOrErr returns the Tenant value or an error if the edge
@@ -78,16 +119,103 @@ func (e TodoEdges) UserOrErr() (*User, error) {
 	return nil, &NotLoadedError{edge: "user"}
 }
 
+// TagsOrErr returns the Tags value or an error if the edge
+// was not loaded in eager-loading.
+func (e TodoEdges) TagsOrErr() ([]*Tag, error) {
+	if e.loadedTypes[2] {
+		return e.Tags, nil
+	}
+	return nil, &NotLoadedError{edge: "tags"}
+}
+
+// ProjectOrErr returns the Project value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TodoEdges) ProjectOrErr() (*Project, error) {
+	if e.Project != nil {
+		return e.Project, nil
+	} else if e.loadedTypes[3] {
+		return nil, &NotFoundError{label: project.Label}
+	}
+	return nil, &NotLoadedError{edge: "project"}
+}
+
+// ParentOrErr returns the Parent value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TodoEdges) ParentOrErr() (*Todo, error) {
+	if e.Parent != nil {
+		return e.Parent, nil
+	} else if e.loadedTypes[4] {
+		return nil, &NotFoundError{label: todo.Label}
+	}
+	return nil, &NotLoadedError{edge: "parent"}
+}
+
+// SubtasksOrErr returns the Subtasks value or an error if the edge
+// was not loaded in eager-loading.
+func (e TodoEdges) SubtasksOrErr() ([]*Todo, error) {
+	if e.loadedTypes[5] {
+		return e.Subtasks, nil
+	}
+	return nil, &NotLoadedError{edge: "subtasks"}
+}
+
+// BlockersOrErr returns the Blockers value or an error if the edge
+// was not loaded in eager-loading.
+func (e TodoEdges) BlockersOrErr() ([]*Todo, error) {
+	if e.loadedTypes[6] {
+		return e.Blockers, nil
+	}
+	return nil, &NotLoadedError{edge: "blockers"}
+}
+
+// BlockingOrErr returns the Blocking value or an error if the edge
+// was not loaded in eager-loading.
+func (e TodoEdges) BlockingOrErr() ([]*Todo, error) {
+	if e.loadedTypes[7] {
+		return e.Blocking, nil
+	}
+	return nil, &NotLoadedError{edge: "blocking"}
+}
+
+// RemindersOrErr returns the Reminders value or an error if the edge
+// was not loaded in eager-loading.
+func (e TodoEdges) RemindersOrErr() ([]*Reminder, error) {
+	if e.loadedTypes[8] {
+		return e.Reminders, nil
+	}
+	return nil, &NotLoadedError{edge: "reminders"}
+}
+
+// CommentsOrErr returns the Comments value or an error if the edge
+// was not loaded in eager-loading.
+func (e TodoEdges) CommentsOrErr() ([]*Comment, error) {
+	if e.loadedTypes[9] {
+		return e.Comments, nil
+	}
+	return nil, &NotLoadedError{edge: "comments"}
+}
+
+// ActivitiesOrErr returns the Activities value or an error if the edge
+// was not loaded in eager-loading.
+func (e TodoEdges) ActivitiesOrErr() ([]*TodoActivity, error) {
+	if e.loadedTypes[10] {
+		return e.Activities, nil
+	}
+	return nil, &NotLoadedError{edge: "activities"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Todo) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case todo.FieldCompleted, todo.FieldIsPublic:
+		case todo.FieldCompleted, todo.FieldIsPublic, todo.FieldAutoComplete:
 			values[i] = new(sql.NullBool)
-		case todo.FieldID, todo.FieldTenantID, todo.FieldUserID, todo.FieldTitle, todo.FieldDescription:
+		case todo.FieldVersion:
+			values[i] = new(sql.NullInt64)
+		case todo.FieldID, todo.FieldTenantID, todo.FieldUserID, todo.FieldAssigneeID, todo.FieldProjectID, todo.FieldParentID, todo.FieldTitle, todo.FieldDescription, todo.FieldPriority, todo.FieldRecurrenceRule, todo.FieldRecurrenceTimezone, todo.FieldPosition:
 			values[i] = new(sql.NullString)
-		case todo.FieldDueDate, todo.FieldCompletedAt, todo.FieldCreatedAt, todo.FieldUpdatedAt:
+		case todo.FieldDueDate, todo.FieldCompletedAt, todo.FieldSnoozedUntil, todo.FieldDeletedAt, todo.FieldCreatedAt, todo.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -122,6 +250,27 @@ func (_m *Todo) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.UserID = value.String
 			}
+		case todo.FieldAssigneeID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field assignee_id", values[i])
+			} else if value.Valid {
+				_m.AssigneeID = new(string)
+				*_m.AssigneeID = value.String
+			}
+		case todo.FieldProjectID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field project_id", values[i])
+			} else if value.Valid {
+				_m.ProjectID = new(string)
+				*_m.ProjectID = value.String
+			}
+		case todo.FieldParentID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field parent_id", values[i])
+			} else if value.Valid {
+				_m.ParentID = new(string)
+				*_m.ParentID = value.String
+			}
 		case todo.FieldTitle:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field title", values[i])
@@ -146,6 +295,18 @@ func (_m *Todo) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.IsPublic = value.Bool
 			}
+		case todo.FieldAutoComplete:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field auto_complete", values[i])
+			} else if value.Valid {
+				_m.AutoComplete = value.Bool
+			}
+		case todo.FieldPriority:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field priority", values[i])
+			} else if value.Valid {
+				_m.Priority = todo.Priority(value.String)
+			}
 		case todo.FieldDueDate:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field due_date", values[i])
@@ -153,6 +314,20 @@ func (_m *Todo) assignValues(columns []string, values []any) error {
 				_m.DueDate = new(time.Time)
 				*_m.DueDate = value.Time
 			}
+		case todo.FieldRecurrenceRule:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field recurrence_rule", values[i])
+			} else if value.Valid {
+				_m.RecurrenceRule = new(string)
+				*_m.RecurrenceRule = value.String
+			}
+		case todo.FieldRecurrenceTimezone:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field recurrence_timezone", values[i])
+			} else if value.Valid {
+				_m.RecurrenceTimezone = new(string)
+				*_m.RecurrenceTimezone = value.String
+			}
 		case todo.FieldCompletedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field completed_at", values[i])
@@ -160,6 +335,32 @@ func (_m *Todo) assignValues(columns []string, values []any) error {
 				_m.CompletedAt = new(time.Time)
 				*_m.CompletedAt = value.Time
 			}
+		case todo.FieldSnoozedUntil:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field snoozed_until", values[i])
+			} else if value.Valid {
+				_m.SnoozedUntil = new(time.Time)
+				*_m.SnoozedUntil = value.Time
+			}
+		case todo.FieldPosition:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field position", values[i])
+			} else if value.Valid {
+				_m.Position = value.String
+			}
+		case todo.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				_m.Version = int(value.Int64)
+			}
+		case todo.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = new(time.Time)
+				*_m.DeletedAt = value.Time
+			}
 		case todo.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -195,6 +396,51 @@ func (_m *Todo) QueryUser() *UserQuery {
 	return NewTodoClient(_m.config).QueryUser(_m)
 }
 
+// QueryTags queries the "tags" edge of the Todo entity.
+func (_m *Todo) QueryTags() *TagQuery {
+	return NewTodoClient(_m.config).QueryTags(_m)
+}
+
+// QueryProject queries the "project" edge of the Todo entity.
+func (_m *Todo) QueryProject() *ProjectQuery {
+	return NewTodoClient(_m.config).QueryProject(_m)
+}
+
+// QueryParent queries the "parent" edge of the Todo entity.
+func (_m *Todo) QueryParent() *TodoQuery {
+	return NewTodoClient(_m.config).QueryParent(_m)
+}
+
+// QuerySubtasks queries the "subtasks" edge of the Todo entity.
+func (_m *Todo) QuerySubtasks() *TodoQuery {
+	return NewTodoClient(_m.config).QuerySubtasks(_m)
+}
+
+// QueryBlockers queries the "blockers" edge of the Todo entity.
+func (_m *Todo) QueryBlockers() *TodoQuery {
+	return NewTodoClient(_m.config).QueryBlockers(_m)
+}
+
+// QueryBlocking queries the "blocking" edge of the Todo entity.
+func (_m *Todo) QueryBlocking() *TodoQuery {
+	return NewTodoClient(_m.config).QueryBlocking(_m)
+}
+
+// QueryReminders queries the "reminders" edge of the Todo entity.
+func (_m *Todo) QueryReminders() *ReminderQuery {
+	return NewTodoClient(_m.config).QueryReminders(_m)
+}
+
+// QueryComments queries the "comments" edge of the Todo entity.
+func (_m *Todo) QueryComments() *CommentQuery {
+	return NewTodoClient(_m.config).QueryComments(_m)
+}
+
+// QueryActivities queries the "activities" edge of the Todo entity.
+func (_m *Todo) QueryActivities() *TodoActivityQuery {
+	return NewTodoClient(_m.config).QueryActivities(_m)
+}
+
 // Update returns a builder for updating this Todo.
 // Note that you need to call Todo.Unwrap() before calling this method if this Todo
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -224,6 +470,21 @@ func (_m *Todo) String() string {
 	builder.WriteString("user_id=")
 	builder.WriteString(_m.UserID)
 	builder.WriteString(", ")
+	if v := _m.AssigneeID; v != nil {
+		builder.WriteString("assignee_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.ProjectID; v != nil {
+		builder.WriteString("project_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.ParentID; v != nil {
+		builder.WriteString("parent_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
 	builder.WriteString("title=")
 	builder.WriteString(_m.Title)
 	builder.WriteString(", ")
@@ -236,16 +497,48 @@ func (_m *Todo) String() string {
 	builder.WriteString("is_public=")
 	builder.WriteString(fmt.Sprintf("%v", _m.IsPublic))
 	builder.WriteString(", ")
+	builder.WriteString("auto_complete=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AutoComplete))
+	builder.WriteString(", ")
+	builder.WriteString("priority=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Priority))
+	builder.WriteString(", ")
 	if v := _m.DueDate; v != nil {
 		builder.WriteString("due_date=")
 		builder.WriteString(v.Format(time.ANSIC))
 	}
 	builder.WriteString(", ")
+	if v := _m.RecurrenceRule; v != nil {
+		builder.WriteString("recurrence_rule=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.RecurrenceTimezone; v != nil {
+		builder.WriteString("recurrence_timezone=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
 	if v := _m.CompletedAt; v != nil {
 		builder.WriteString("completed_at=")
 		builder.WriteString(v.Format(time.ANSIC))
 	}
 	builder.WriteString(", ")
+	if v := _m.SnoozedUntil; v != nil {
+		builder.WriteString("snoozed_until=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("position=")
+	builder.WriteString(_m.Position)
+	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Version))
+	builder.WriteString(", ")
+	if v := _m.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -75,6 +75,21 @@ func UserID(v string) predicate.Todo {
 	return predicate.Todo(sql.FieldEQ(FieldUserID, v))
 }
 
+// AssigneeID applies equality check predicate on the "assignee_id" field. It's identical to AssigneeIDEQ.
+func AssigneeID(v string) predicate.Todo {
+	return predicate.Todo(sql.FieldEQ(FieldAssigneeID, v))
+}
+
+// ProjectID applies equality check predicate on the "project_id" field. It's identical to ProjectIDEQ.
+func ProjectID(v string) predicate.Todo {
+	return predicate.Todo(sql.FieldEQ(FieldProjectID, v))
+}
+
+// ParentID applies equality check predicate on the "parent_id" field. It's identical to ParentIDEQ.
+func ParentID(v string) predicate.Todo {
+	return predicate.Todo(sql.FieldEQ(FieldParentID, v))
+}
+
 // Title applies equality check predicate on the "title" field. It's identical to TitleEQ.
 func Title(v string) predicate.Todo {
 	return predicate.Todo(sql.FieldEQ(FieldTitle, v))
@@ -95,16 +110,51 @@ func IsPublic(v bool) predicate.Todo {
 	return predicate.Todo(sql.FieldEQ(FieldIsPublic, v))
 }
 
+// AutoComplete applies equality check predicate on the "auto_complete" field. It's identical to AutoCompleteEQ.
+func AutoComplete(v bool) predicate.Todo {
+	return predicate.Todo(sql.FieldEQ(FieldAutoComplete, v))
+}
+
 // DueDate applies equality check predicate on the "due_date" field. It's identical to DueDateEQ.
 func DueDate(v time.Time) predicate.Todo {
 	return predicate.Todo(sql.FieldEQ(FieldDueDate, v))
 }
 
+// RecurrenceRule applies equality check predicate on the "recurrence_rule" field. It's identical to RecurrenceRuleEQ.
+func RecurrenceRule(v string) predicate.Todo {
+	return predicate.Todo(sql.FieldEQ(FieldRecurrenceRule, v))
+}
+
+// RecurrenceTimezone applies equality check predicate on the "recurrence_timezone" field. It's identical to RecurrenceTimezoneEQ.
+func RecurrenceTimezone(v string) predicate.Todo {
+	return predicate.Todo(sql.FieldEQ(FieldRecurrenceTimezone, v))
+}
+
 // CompletedAt applies equality check predicate on the "completed_at" field. It's identical to CompletedAtEQ.
 func CompletedAt(v time.Time) predicate.Todo {
 	return predicate.Todo(sql.FieldEQ(FieldCompletedAt, v))
 }
 
+// SnoozedUntil applies equality check predicate on the "snoozed_until" field. It's identical to SnoozedUntilEQ.
+func SnoozedUntil(v time.Time) predicate.Todo {
+	return predicate.Todo(sql.FieldEQ(FieldSnoozedUntil, v))
+}
+
+// Position applies equality check predicate on the "position" field. It's identical to PositionEQ.
+func Position(v string) predicate.Todo {
+	return predicate.Todo(sql.FieldEQ(FieldPosition, v))
+}
+
+// Version applies equality check predicate on the "version" field. It's identical to VersionEQ.
+func Version(v int) predicate.Todo {
+	return predicate.Todo(sql.FieldEQ(FieldVersion, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.Todo {
+	return predicate.Todo(sql.FieldEQ(FieldDeletedAt, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.Todo {
 	return predicate.Todo(sql.FieldEQ(FieldCreatedAt, v))
@@ -245,6 +295,231 @@ func UserIDContainsFold(v string) predicate.Todo {
 	return predicate.Todo(sql.FieldContainsFold(FieldUserID, v))
 }
 
+// AssigneeIDEQ applies the EQ predicate on the "assignee_id" field.
+func AssigneeIDEQ(v string) predicate.Todo {
+	return predicate.Todo(sql.FieldEQ(FieldAssigneeID, v))
+}
+
+// AssigneeIDNEQ applies the NEQ predicate on the "assignee_id" field.
+func AssigneeIDNEQ(v string) predicate.Todo {
+	return predicate.Todo(sql.FieldNEQ(FieldAssigneeID, v))
+}
+
+// AssigneeIDIn applies the In predicate on the "assignee_id" field.
+func AssigneeIDIn(vs ...string) predicate.Todo {
+	return predicate.Todo(sql.FieldIn(FieldAssigneeID, vs...))
+}
+
+// AssigneeIDNotIn applies the NotIn predicate on the "assignee_id" field.
+func AssigneeIDNotIn(vs ...string) predicate.Todo {
+	return predicate.Todo(sql.FieldNotIn(FieldAssigneeID, vs...))
+}
+
+// AssigneeIDGT applies the GT predicate on the "assignee_id" field.
+func AssigneeIDGT(v string) predicate.Todo {
+	return predicate.Todo(sql.FieldGT(FieldAssigneeID, v))
+}
+
+// AssigneeIDGTE applies the GTE predicate on the "assignee_id" field.
+func AssigneeIDGTE(v string) predicate.Todo {
+	return predicate.Todo(sql.FieldGTE(FieldAssigneeID, v))
+}
+
+// AssigneeIDLT applies the LT predicate on the "assignee_id" field.
+func AssigneeIDLT(v string) predicate.Todo {
+	return predicate.Todo(sql.FieldLT(FieldAssigneeID, v))
+}
+
+// AssigneeIDLTE applies the LTE predicate on the "assignee_id" field.
+func AssigneeIDLTE(v string) predicate.Todo {
+	return predicate.Todo(sql.FieldLTE(FieldAssigneeID, v))
+}
+
+// AssigneeIDContains applies the Contains predicate on the "assignee_id" field.
+func AssigneeIDContains(v string) predicate.Todo {
+	return predicate.Todo(sql.FieldContains(FieldAssigneeID, v))
+}
+
+// AssigneeIDHasPrefix applies the HasPrefix predicate on the "assignee_id" field.
+func AssigneeIDHasPrefix(v string) predicate.Todo {
+	return predicate.Todo(sql.FieldHasPrefix(FieldAssigneeID, v))
+}
+
+// AssigneeIDHasSuffix applies the HasSuffix predicate on the "assignee_id" field.
+func AssigneeIDHasSuffix(v string) predicate.Todo {
+	return predicate.Todo(sql.FieldHasSuffix(FieldAssigneeID, v))
+}
+
+// AssigneeIDIsNil applies the IsNil predicate on the "assignee_id" field.
+func AssigneeIDIsNil() predicate.Todo {
+	return predicate.Todo(sql.FieldIsNull(FieldAssigneeID))
+}
+
+// AssigneeIDNotNil applies the NotNil predicate on the "assignee_id" field.
+func AssigneeIDNotNil() predicate.Todo {
+	return predicate.Todo(sql.FieldNotNull(FieldAssigneeID))
+}
+
+// AssigneeIDEqualFold applies the EqualFold predicate on the "assignee_id" field.
+func AssigneeIDEqualFold(v string) predicate.Todo {
+	return predicate.Todo(sql.FieldEqualFold(FieldAssigneeID, v))
+}
+
+// AssigneeIDContainsFold applies the ContainsFold predicate on the "assignee_id" field.
+func AssigneeIDContainsFold(v string) predicate.Todo {
+	return predicate.Todo(sql.FieldContainsFold(FieldAssigneeID, v))
+}
+
+// ProjectIDEQ applies the EQ predicate on the "project_id" field.
+func ProjectIDEQ(v string) predicate.Todo {
+	return predicate.Todo(sql.FieldEQ(FieldProjectID, v))
+}
+
+// ProjectIDNEQ applies the NEQ predicate on the "project_id" field.
+func ProjectIDNEQ(v string) predicate.Todo {
+	return predicate.Todo(sql.FieldNEQ(FieldProjectID, v))
+}
+
+// ProjectIDIn applies the In predicate on the "project_id" field.
+func ProjectIDIn(vs ...string) predicate.Todo {
+	return predicate.Todo(sql.FieldIn(FieldProjectID, vs...))
+}
+
+// ProjectIDNotIn applies the NotIn predicate on the "project_id" field.
+func ProjectIDNotIn(vs ...string) predicate.Todo {
+	return predicate.Todo(sql.FieldNotIn(FieldProjectID, vs...))
+}
+
+// ProjectIDGT applies the GT predicate on the "project_id" field.
+func ProjectIDGT(v string) predicate.Todo {
+	return predicate.Todo(sql.FieldGT(FieldProjectID, v))
+}
+
+// ProjectIDGTE applies the GTE predicate on the "project_id" field.
+func ProjectIDGTE(v string) predicate.Todo {
+	return predicate.Todo(sql.FieldGTE(FieldProjectID, v))
+}
+
+// ProjectIDLT applies the LT predicate on the "project_id" field.
+func ProjectIDLT(v string) predicate.Todo {
+	return predicate.Todo(sql.FieldLT(FieldProjectID, v))
+}
+
+// ProjectIDLTE applies the LTE predicate on the "project_id" field.
+func ProjectIDLTE(v string) predicate.Todo {
+	return predicate.Todo(sql.FieldLTE(FieldProjectID, v))
+}
+
+// ProjectIDContains applies the Contains predicate on the "project_id" field.
+func ProjectIDContains(v string) predicate.Todo {
+	return predicate.Todo(sql.FieldContains(FieldProjectID, v))
+}
+
+// ProjectIDHasPrefix applies the HasPrefix predicate on the "project_id" field.
+func ProjectIDHasPrefix(v string) predicate.Todo {
+	return predicate.Todo(sql.FieldHasPrefix(FieldProjectID, v))
+}
+
+// ProjectIDHasSuffix applies the HasSuffix predicate on the "project_id" field.
+func ProjectIDHasSuffix(v string) predicate.Todo {
+	return predicate.Todo(sql.FieldHasSuffix(FieldProjectID, v))
+}
+
+// ProjectIDIsNil applies the IsNil predicate on the "project_id" field.
+func ProjectIDIsNil() predicate.Todo {
+	return predicate.Todo(sql.FieldIsNull(FieldProjectID))
+}
+
+// ProjectIDNotNil applies the NotNil predicate on the "project_id" field.
+func ProjectIDNotNil() predicate.Todo {
+	return predicate.Todo(sql.FieldNotNull(FieldProjectID))
+}
+
+// ProjectIDEqualFold applies the EqualFold predicate on the "project_id" field.
+func ProjectIDEqualFold(v string) predicate.Todo {
+	return predicate.Todo(sql.FieldEqualFold(FieldProjectID, v))
+}
+
+// ProjectIDContainsFold applies the ContainsFold predicate on the "project_id" field.
+func ProjectIDContainsFold(v string) predicate.Todo {
+	return predicate.Todo(sql.FieldContainsFold(FieldProjectID, v))
+}
+
+// ParentIDEQ applies the EQ predicate on the "parent_id" field.
+func ParentIDEQ(v string) predicate.Todo {
+	return predicate.Todo(sql.FieldEQ(FieldParentID, v))
+}
+
+// ParentIDNEQ applies the NEQ predicate on the "parent_id" field.
+func ParentIDNEQ(v string) predicate.Todo {
+	return predicate.Todo(sql.FieldNEQ(FieldParentID, v))
+}
+
+// ParentIDIn applies the In predicate on the "parent_id" field.
+func ParentIDIn(vs ...string) predicate.Todo {
+	return predicate.Todo(sql.FieldIn(FieldParentID, vs...))
+}
+
+// ParentIDNotIn applies the NotIn predicate on the "parent_id" field.
+func ParentIDNotIn(vs ...string) predicate.Todo {
+	return predicate.Todo(sql.FieldNotIn(FieldParentID, vs...))
+}
+
+// ParentIDGT applies the GT predicate on the "parent_id" field.
+func ParentIDGT(v string) predicate.Todo {
+	return predicate.Todo(sql.FieldGT(FieldParentID, v))
+}
+
+// ParentIDGTE applies the GTE predicate on the "parent_id" field.
+func ParentIDGTE(v string) predicate.Todo {
+	return predicate.Todo(sql.FieldGTE(FieldParentID, v))
+}
+
+// ParentIDLT applies the LT predicate on the "parent_id" field.
+func ParentIDLT(v string) predicate.Todo {
+	return predicate.Todo(sql.FieldLT(FieldParentID, v))
+}
+
+// ParentIDLTE applies the LTE predicate on the "parent_id" field.
+func ParentIDLTE(v string) predicate.Todo {
+	return predicate.Todo(sql.FieldLTE(FieldParentID, v))
+}
+
+// ParentIDContains applies the Contains predicate on the "parent_id" field.
+func ParentIDContains(v string) predicate.Todo {
+	return predicate.Todo(sql.FieldContains(FieldParentID, v))
+}
+
+// ParentIDHasPrefix applies the HasPrefix predicate on the "parent_id" field.
+func ParentIDHasPrefix(v string) predicate.Todo {
+	return predicate.Todo(sql.FieldHasPrefix(FieldParentID, v))
+}
+
+// ParentIDHasSuffix applies the HasSuffix predicate on the "parent_id" field.
+func ParentIDHasSuffix(v string) predicate.Todo {
+	return predicate.Todo(sql.FieldHasSuffix(FieldParentID, v))
+}
+
+// ParentIDIsNil applies the IsNil predicate on the "parent_id" field.
+func ParentIDIsNil() predicate.Todo {
+	return predicate.Todo(sql.FieldIsNull(FieldParentID))
+}
+
+// ParentIDNotNil applies the NotNil predicate on the "parent_id" field.
+func ParentIDNotNil() predicate.Todo {
+	return predicate.Todo(sql.FieldNotNull(FieldParentID))
+}
+
+// ParentIDEqualFold applies the EqualFold predicate on the "parent_id" field.
+func ParentIDEqualFold(v string) predicate.Todo {
+	return predicate.Todo(sql.FieldEqualFold(FieldParentID, v))
+}
+
+// ParentIDContainsFold applies the ContainsFold predicate on the "parent_id" field.
+func ParentIDContainsFold(v string) predicate.Todo {
+	return predicate.Todo(sql.FieldContainsFold(FieldParentID, v))
+}
+
 // TitleEQ applies the EQ predicate on the "title" field.
 func TitleEQ(v string) predicate.Todo {
 	return predicate.Todo(sql.FieldEQ(FieldTitle, v))
@@ -405,6 +680,36 @@ func IsPublicNEQ(v bool) predicate.Todo {
 	return predicate.Todo(sql.FieldNEQ(FieldIsPublic, v))
 }
 
+// AutoCompleteEQ applies the EQ predicate on the "auto_complete" field.
+func AutoCompleteEQ(v bool) predicate.Todo {
+	return predicate.Todo(sql.FieldEQ(FieldAutoComplete, v))
+}
+
+// AutoCompleteNEQ applies the NEQ predicate on the "auto_complete" field.
+func AutoCompleteNEQ(v bool) predicate.Todo {
+	return predicate.Todo(sql.FieldNEQ(FieldAutoComplete, v))
+}
+
+// PriorityEQ applies the EQ predicate on the "priority" field.
+func PriorityEQ(v Priority) predicate.Todo {
+	return predicate.Todo(sql.FieldEQ(FieldPriority, v))
+}
+
+// PriorityNEQ applies the NEQ predicate on the "priority" field.
+func PriorityNEQ(v Priority) predicate.Todo {
+	return predicate.Todo(sql.FieldNEQ(FieldPriority, v))
+}
+
+// PriorityIn applies the In predicate on the "priority" field.
+func PriorityIn(vs ...Priority) predicate.Todo {
+	return predicate.Todo(sql.FieldIn(FieldPriority, vs...))
+}
+
+// PriorityNotIn applies the NotIn predicate on the "priority" field.
+func PriorityNotIn(vs ...Priority) predicate.Todo {
+	return predicate.Todo(sql.FieldNotIn(FieldPriority, vs...))
+}
+
 // DueDateEQ applies the EQ predicate on the "due_date" field.
 func DueDateEQ(v time.Time) predicate.Todo {
 	return predicate.Todo(sql.FieldEQ(FieldDueDate, v))
@@ -455,6 +760,156 @@ func DueDateNotNil() predicate.Todo {
 	return predicate.Todo(sql.FieldNotNull(FieldDueDate))
 }
 
+// RecurrenceRuleEQ applies the EQ predicate on the "recurrence_rule" field.
+func RecurrenceRuleEQ(v string) predicate.Todo {
+	return predicate.Todo(sql.FieldEQ(FieldRecurrenceRule, v))
+}
+
+// RecurrenceRuleNEQ applies the NEQ predicate on the "recurrence_rule" field.
+func RecurrenceRuleNEQ(v string) predicate.Todo {
+	return predicate.Todo(sql.FieldNEQ(FieldRecurrenceRule, v))
+}
+
+// RecurrenceRuleIn applies the In predicate on the "recurrence_rule" field.
+func RecurrenceRuleIn(vs ...string) predicate.Todo {
+	return predicate.Todo(sql.FieldIn(FieldRecurrenceRule, vs...))
+}
+
+// RecurrenceRuleNotIn applies the NotIn predicate on the "recurrence_rule" field.
+func RecurrenceRuleNotIn(vs ...string) predicate.Todo {
+	return predicate.Todo(sql.FieldNotIn(FieldRecurrenceRule, vs...))
+}
+
+// RecurrenceRuleGT applies the GT predicate on the "recurrence_rule" field.
+func RecurrenceRuleGT(v string) predicate.Todo {
+	return predicate.Todo(sql.FieldGT(FieldRecurrenceRule, v))
+}
+
+// RecurrenceRuleGTE applies the GTE predicate on the "recurrence_rule" field.
+func RecurrenceRuleGTE(v string) predicate.Todo {
+	return predicate.Todo(sql.FieldGTE(FieldRecurrenceRule, v))
+}
+
+// RecurrenceRuleLT applies the LT predicate on the "recurrence_rule" field.
+func RecurrenceRuleLT(v string) predicate.Todo {
+	return predicate.Todo(sql.FieldLT(FieldRecurrenceRule, v))
+}
+
+// RecurrenceRuleLTE applies the LTE predicate on the "recurrence_rule" field.
+func RecurrenceRuleLTE(v string) predicate.Todo {
+	return predicate.Todo(sql.FieldLTE(FieldRecurrenceRule, v))
+}
+
+// RecurrenceRuleContains applies the Contains predicate on the "recurrence_rule" field.
+func RecurrenceRuleContains(v string) predicate.Todo {
+	return predicate.Todo(sql.FieldContains(FieldRecurrenceRule, v))
+}
+
+// RecurrenceRuleHasPrefix applies the HasPrefix predicate on the "recurrence_rule" field.
+func RecurrenceRuleHasPrefix(v string) predicate.Todo {
+	return predicate.Todo(sql.FieldHasPrefix(FieldRecurrenceRule, v))
+}
+
+// RecurrenceRuleHasSuffix applies the HasSuffix predicate on the "recurrence_rule" field.
+func RecurrenceRuleHasSuffix(v string) predicate.Todo {
+	return predicate.Todo(sql.FieldHasSuffix(FieldRecurrenceRule, v))
+}
+
+// RecurrenceRuleIsNil applies the IsNil predicate on the "recurrence_rule" field.
+func RecurrenceRuleIsNil() predicate.Todo {
+	return predicate.Todo(sql.FieldIsNull(FieldRecurrenceRule))
+}
+
+// RecurrenceRuleNotNil applies the NotNil predicate on the "recurrence_rule" field.
+func RecurrenceRuleNotNil() predicate.Todo {
+	return predicate.Todo(sql.FieldNotNull(FieldRecurrenceRule))
+}
+
+// RecurrenceRuleEqualFold applies the EqualFold predicate on the "recurrence_rule" field.
+func RecurrenceRuleEqualFold(v string) predicate.Todo {
+	return predicate.Todo(sql.FieldEqualFold(FieldRecurrenceRule, v))
+}
+
+// RecurrenceRuleContainsFold applies the ContainsFold predicate on the "recurrence_rule" field.
+func RecurrenceRuleContainsFold(v string) predicate.Todo {
+	return predicate.Todo(sql.FieldContainsFold(FieldRecurrenceRule, v))
+}
+
+// RecurrenceTimezoneEQ applies the EQ predicate on the "recurrence_timezone" field.
+func RecurrenceTimezoneEQ(v string) predicate.Todo {
+	return predicate.Todo(sql.FieldEQ(FieldRecurrenceTimezone, v))
+}
+
+// RecurrenceTimezoneNEQ applies the NEQ predicate on the "recurrence_timezone" field.
+func RecurrenceTimezoneNEQ(v string) predicate.Todo {
+	return predicate.Todo(sql.FieldNEQ(FieldRecurrenceTimezone, v))
+}
+
+// RecurrenceTimezoneIn applies the In predicate on the "recurrence_timezone" field.
+func RecurrenceTimezoneIn(vs ...string) predicate.Todo {
+	return predicate.Todo(sql.FieldIn(FieldRecurrenceTimezone, vs...))
+}
+
+// RecurrenceTimezoneNotIn applies the NotIn predicate on the "recurrence_timezone" field.
+func RecurrenceTimezoneNotIn(vs ...string) predicate.Todo {
+	return predicate.Todo(sql.FieldNotIn(FieldRecurrenceTimezone, vs...))
+}
+
+// RecurrenceTimezoneGT applies the GT predicate on the "recurrence_timezone" field.
+func RecurrenceTimezoneGT(v string) predicate.Todo {
+	return predicate.Todo(sql.FieldGT(FieldRecurrenceTimezone, v))
+}
+
+// RecurrenceTimezoneGTE applies the GTE predicate on the "recurrence_timezone" field.
+func RecurrenceTimezoneGTE(v string) predicate.Todo {
+	return predicate.Todo(sql.FieldGTE(FieldRecurrenceTimezone, v))
+}
+
+// RecurrenceTimezoneLT applies the LT predicate on the "recurrence_timezone" field.
+func RecurrenceTimezoneLT(v string) predicate.Todo {
+	return predicate.Todo(sql.FieldLT(FieldRecurrenceTimezone, v))
+}
+
+// RecurrenceTimezoneLTE applies the LTE predicate on the "recurrence_timezone" field.
+func RecurrenceTimezoneLTE(v string) predicate.Todo {
+	return predicate.Todo(sql.FieldLTE(FieldRecurrenceTimezone, v))
+}
+
+// RecurrenceTimezoneContains applies the Contains predicate on the "recurrence_timezone" field.
+func RecurrenceTimezoneContains(v string) predicate.Todo {
+	return predicate.Todo(sql.FieldContains(FieldRecurrenceTimezone, v))
+}
+
+// RecurrenceTimezoneHasPrefix applies the HasPrefix predicate on the "recurrence_timezone" field.
+func RecurrenceTimezoneHasPrefix(v string) predicate.Todo {
+	return predicate.Todo(sql.FieldHasPrefix(FieldRecurrenceTimezone, v))
+}
+
+// RecurrenceTimezoneHasSuffix applies the HasSuffix predicate on the "recurrence_timezone" field.
+func RecurrenceTimezoneHasSuffix(v string) predicate.Todo {
+	return predicate.Todo(sql.FieldHasSuffix(FieldRecurrenceTimezone, v))
+}
+
+// RecurrenceTimezoneIsNil applies the IsNil predicate on the "recurrence_timezone" field.
+func RecurrenceTimezoneIsNil() predicate.Todo {
+	return predicate.Todo(sql.FieldIsNull(FieldRecurrenceTimezone))
+}
+
+// RecurrenceTimezoneNotNil applies the NotNil predicate on the "recurrence_timezone" field.
+func RecurrenceTimezoneNotNil() predicate.Todo {
+	return predicate.Todo(sql.FieldNotNull(FieldRecurrenceTimezone))
+}
+
+// RecurrenceTimezoneEqualFold applies the EqualFold predicate on the "recurrence_timezone" field.
+func RecurrenceTimezoneEqualFold(v string) predicate.Todo {
+	return predicate.Todo(sql.FieldEqualFold(FieldRecurrenceTimezone, v))
+}
+
+// RecurrenceTimezoneContainsFold applies the ContainsFold predicate on the "recurrence_timezone" field.
+func RecurrenceTimezoneContainsFold(v string) predicate.Todo {
+	return predicate.Todo(sql.FieldContainsFold(FieldRecurrenceTimezone, v))
+}
+
 // CompletedAtEQ applies the EQ predicate on the "completed_at" field.
 func CompletedAtEQ(v time.Time) predicate.Todo {
 	return predicate.Todo(sql.FieldEQ(FieldCompletedAt, v))
@@ -505,6 +960,211 @@ func CompletedAtNotNil() predicate.Todo {
 	return predicate.Todo(sql.FieldNotNull(FieldCompletedAt))
 }
 
+// SnoozedUntilEQ applies the EQ predicate on the "snoozed_until" field.
+func SnoozedUntilEQ(v time.Time) predicate.Todo {
+	return predicate.Todo(sql.FieldEQ(FieldSnoozedUntil, v))
+}
+
+// SnoozedUntilNEQ applies the NEQ predicate on the "snoozed_until" field.
+func SnoozedUntilNEQ(v time.Time) predicate.Todo {
+	return predicate.Todo(sql.FieldNEQ(FieldSnoozedUntil, v))
+}
+
+// SnoozedUntilIn applies the In predicate on the "snoozed_until" field.
+func SnoozedUntilIn(vs ...time.Time) predicate.Todo {
+	return predicate.Todo(sql.FieldIn(FieldSnoozedUntil, vs...))
+}
+
+// SnoozedUntilNotIn applies the NotIn predicate on the "snoozed_until" field.
+func SnoozedUntilNotIn(vs ...time.Time) predicate.Todo {
+	return predicate.Todo(sql.FieldNotIn(FieldSnoozedUntil, vs...))
+}
+
+// SnoozedUntilGT applies the GT predicate on the "snoozed_until" field.
+func SnoozedUntilGT(v time.Time) predicate.Todo {
+	return predicate.Todo(sql.FieldGT(FieldSnoozedUntil, v))
+}
+
+// SnoozedUntilGTE applies the GTE predicate on the "snoozed_until" field.
+func SnoozedUntilGTE(v time.Time) predicate.Todo {
+	return predicate.Todo(sql.FieldGTE(FieldSnoozedUntil, v))
+}
+
+// SnoozedUntilLT applies the LT predicate on the "snoozed_until" field.
+func SnoozedUntilLT(v time.Time) predicate.Todo {
+	return predicate.Todo(sql.FieldLT(FieldSnoozedUntil, v))
+}
+
+// SnoozedUntilLTE applies the LTE predicate on the "snoozed_until" field.
+func SnoozedUntilLTE(v time.Time) predicate.Todo {
+	return predicate.Todo(sql.FieldLTE(FieldSnoozedUntil, v))
+}
+
+// SnoozedUntilIsNil applies the IsNil predicate on the "snoozed_until" field.
+func SnoozedUntilIsNil() predicate.Todo {
+	return predicate.Todo(sql.FieldIsNull(FieldSnoozedUntil))
+}
+
+// SnoozedUntilNotNil applies the NotNil predicate on the "snoozed_until" field.
+func SnoozedUntilNotNil() predicate.Todo {
+	return predicate.Todo(sql.FieldNotNull(FieldSnoozedUntil))
+}
+
+// PositionEQ applies the EQ predicate on the "position" field.
+func PositionEQ(v string) predicate.Todo {
+	return predicate.Todo(sql.FieldEQ(FieldPosition, v))
+}
+
+// PositionNEQ applies the NEQ predicate on the "position" field.
+func PositionNEQ(v string) predicate.Todo {
+	return predicate.Todo(sql.FieldNEQ(FieldPosition, v))
+}
+
+// PositionIn applies the In predicate on the "position" field.
+func PositionIn(vs ...string) predicate.Todo {
+	return predicate.Todo(sql.FieldIn(FieldPosition, vs...))
+}
+
+// PositionNotIn applies the NotIn predicate on the "position" field.
+func PositionNotIn(vs ...string) predicate.Todo {
+	return predicate.Todo(sql.FieldNotIn(FieldPosition, vs...))
+}
+
+// PositionGT applies the GT predicate on the "position" field.
+func PositionGT(v string) predicate.Todo {
+	return predicate.Todo(sql.FieldGT(FieldPosition, v))
+}
+
+// PositionGTE applies the GTE predicate on the "position" field.
+func PositionGTE(v string) predicate.Todo {
+	return predicate.Todo(sql.FieldGTE(FieldPosition, v))
+}
+
+// PositionLT applies the LT predicate on the "position" field.
+func PositionLT(v string) predicate.Todo {
+	return predicate.Todo(sql.FieldLT(FieldPosition, v))
+}
+
+// PositionLTE applies the LTE predicate on the "position" field.
+func PositionLTE(v string) predicate.Todo {
+	return predicate.Todo(sql.FieldLTE(FieldPosition, v))
+}
+
+// PositionContains applies the Contains predicate on the "position" field.
+func PositionContains(v string) predicate.Todo {
+	return predicate.Todo(sql.FieldContains(FieldPosition, v))
+}
+
+// PositionHasPrefix applies the HasPrefix predicate on the "position" field.
+func PositionHasPrefix(v string) predicate.Todo {
+	return predicate.Todo(sql.FieldHasPrefix(FieldPosition, v))
+}
+
+// PositionHasSuffix applies the HasSuffix predicate on the "position" field.
+func PositionHasSuffix(v string) predicate.Todo {
+	return predicate.Todo(sql.FieldHasSuffix(FieldPosition, v))
+}
+
+// PositionEqualFold applies the EqualFold predicate on the "position" field.
+func PositionEqualFold(v string) predicate.Todo {
+	return predicate.Todo(sql.FieldEqualFold(FieldPosition, v))
+}
+
+// PositionContainsFold applies the ContainsFold predicate on the "position" field.
+func PositionContainsFold(v string) predicate.Todo {
+	return predicate.Todo(sql.FieldContainsFold(FieldPosition, v))
+}
+
+// VersionEQ applies the EQ predicate on the "version" field.
+func VersionEQ(v int) predicate.Todo {
+	return predicate.Todo(sql.FieldEQ(FieldVersion, v))
+}
+
+// VersionNEQ applies the NEQ predicate on the "version" field.
+func VersionNEQ(v int) predicate.Todo {
+	return predicate.Todo(sql.FieldNEQ(FieldVersion, v))
+}
+
+// VersionIn applies the In predicate on the "version" field.
+func VersionIn(vs ...int) predicate.Todo {
+	return predicate.Todo(sql.FieldIn(FieldVersion, vs...))
+}
+
+// VersionNotIn applies the NotIn predicate on the "version" field.
+func VersionNotIn(vs ...int) predicate.Todo {
+	return predicate.Todo(sql.FieldNotIn(FieldVersion, vs...))
+}
+
+// VersionGT applies the GT predicate on the "version" field.
+func VersionGT(v int) predicate.Todo {
+	return predicate.Todo(sql.FieldGT(FieldVersion, v))
+}
+
+// VersionGTE applies the GTE predicate on the "version" field.
+func VersionGTE(v int) predicate.Todo {
+	return predicate.Todo(sql.FieldGTE(FieldVersion, v))
+}
+
+// VersionLT applies the LT predicate on the "version" field.
+func VersionLT(v int) predicate.Todo {
+	return predicate.Todo(sql.FieldLT(FieldVersion, v))
+}
+
+// VersionLTE applies the LTE predicate on the "version" field.
+func VersionLTE(v int) predicate.Todo {
+	return predicate.Todo(sql.FieldLTE(FieldVersion, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.Todo {
+	return predicate.Todo(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.Todo {
+	return predicate.Todo(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.Todo {
+	return predicate.Todo(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.Todo {
+	return predicate.Todo(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.Todo {
+	return predicate.Todo(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.Todo {
+	return predicate.Todo(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.Todo {
+	return predicate.Todo(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.Todo {
+	return predicate.Todo(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.Todo {
+	return predicate.Todo(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.Todo {
+	return predicate.Todo(sql.FieldNotNull(FieldDeletedAt))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Todo {
 	return predicate.Todo(sql.FieldEQ(FieldCreatedAt, v))
@@ -631,6 +1291,213 @@ func HasUserWith(preds ...predicate.User) predicate.Todo {
 	})
 }
 
+// HasTags applies the HasEdge predicate on the "tags" edge.
+func HasTags() predicate.Todo {
+	return predicate.Todo(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, TagsTable, TagsPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTagsWith applies the HasEdge predicate on the "tags" edge with a given conditions (other predicates).
+func HasTagsWith(preds ...predicate.Tag) predicate.Todo {
+	return predicate.Todo(func(s *sql.Selector) {
+		step := newTagsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasProject applies the HasEdge predicate on the "project" edge.
+func HasProject() predicate.Todo {
+	return predicate.Todo(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ProjectTable, ProjectColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasProjectWith applies the HasEdge predicate on the "project" edge with a given conditions (other predicates).
+func HasProjectWith(preds ...predicate.Project) predicate.Todo {
+	return predicate.Todo(func(s *sql.Selector) {
+		step := newProjectStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasParent applies the HasEdge predicate on the "parent" edge.
+func HasParent() predicate.Todo {
+	return predicate.Todo(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ParentTable, ParentColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasParentWith applies the HasEdge predicate on the "parent" edge with a given conditions (other predicates).
+func HasParentWith(preds ...predicate.Todo) predicate.Todo {
+	return predicate.Todo(func(s *sql.Selector) {
+		step := newParentStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSubtasks applies the HasEdge predicate on the "subtasks" edge.
+func HasSubtasks() predicate.Todo {
+	return predicate.Todo(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SubtasksTable, SubtasksColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSubtasksWith applies the HasEdge predicate on the "subtasks" edge with a given conditions (other predicates).
+func HasSubtasksWith(preds ...predicate.Todo) predicate.Todo {
+	return predicate.Todo(func(s *sql.Selector) {
+		step := newSubtasksStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasBlockers applies the HasEdge predicate on the "blockers" edge.
+func HasBlockers() predicate.Todo {
+	return predicate.Todo(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, BlockersTable, BlockersPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBlockersWith applies the HasEdge predicate on the "blockers" edge with a given conditions (other predicates).
+func HasBlockersWith(preds ...predicate.Todo) predicate.Todo {
+	return predicate.Todo(func(s *sql.Selector) {
+		step := newBlockersStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasBlocking applies the HasEdge predicate on the "blocking" edge.
+func HasBlocking() predicate.Todo {
+	return predicate.Todo(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, BlockingTable, BlockingPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBlockingWith applies the HasEdge predicate on the "blocking" edge with a given conditions (other predicates).
+func HasBlockingWith(preds ...predicate.Todo) predicate.Todo {
+	return predicate.Todo(func(s *sql.Selector) {
+		step := newBlockingStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasReminders applies the HasEdge predicate on the "reminders" edge.
+func HasReminders() predicate.Todo {
+	return predicate.Todo(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RemindersTable, RemindersColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRemindersWith applies the HasEdge predicate on the "reminders" edge with a given conditions (other predicates).
+func HasRemindersWith(preds ...predicate.Reminder) predicate.Todo {
+	return predicate.Todo(func(s *sql.Selector) {
+		step := newRemindersStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasComments applies the HasEdge predicate on the "comments" edge.
+func HasComments() predicate.Todo {
+	return predicate.Todo(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, CommentsTable, CommentsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCommentsWith applies the HasEdge predicate on the "comments" edge with a given conditions (other predicates).
+func HasCommentsWith(preds ...predicate.Comment) predicate.Todo {
+	return predicate.Todo(func(s *sql.Selector) {
+		step := newCommentsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasActivities applies the HasEdge predicate on the "activities" edge.
+func HasActivities() predicate.Todo {
+	return predicate.Todo(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ActivitiesTable, ActivitiesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasActivitiesWith applies the HasEdge predicate on the "activities" edge with a given conditions (other predicates).
+func HasActivitiesWith(preds ...predicate.TodoActivity) predicate.Todo {
+	return predicate.Todo(func(s *sql.Selector) {
+		step := newActivitiesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Todo) predicate.Todo {
 	return predicate.Todo(sql.AndPredicates(predicates...))
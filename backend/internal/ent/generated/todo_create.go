@@ -6,8 +6,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"good-todo-go/internal/ent/generated/comment"
+	"good-todo-go/internal/ent/generated/project"
+	"good-todo-go/internal/ent/generated/reminder"
+	"good-todo-go/internal/ent/generated/tag"
 	"good-todo-go/internal/ent/generated/tenant"
 	"good-todo-go/internal/ent/generated/todo"
+	"good-todo-go/internal/ent/generated/todoactivity"
 	"good-todo-go/internal/ent/generated/user"
 	"time"
 
@@ -37,6 +42,48 @@ func (_c *TodoCreate) SetUserID(v string) *TodoCreate {
 	return _c
 }
 
+// SetAssigneeID sets the "assignee_id" field.
+func (_c *TodoCreate) SetAssigneeID(v string) *TodoCreate {
+	_c.mutation.SetAssigneeID(v)
+	return _c
+}
+
+// SetNillableAssigneeID sets the "assignee_id" field if the given value is not nil.
+func (_c *TodoCreate) SetNillableAssigneeID(v *string) *TodoCreate {
+	if v != nil {
+		_c.SetAssigneeID(*v)
+	}
+	return _c
+}
+
+// SetProjectID sets the "project_id" field.
+func (_c *TodoCreate) SetProjectID(v string) *TodoCreate {
+	_c.mutation.SetProjectID(v)
+	return _c
+}
+
+// SetNillableProjectID sets the "project_id" field if the given value is not nil.
+func (_c *TodoCreate) SetNillableProjectID(v *string) *TodoCreate {
+	if v != nil {
+		_c.SetProjectID(*v)
+	}
+	return _c
+}
+
+// SetParentID sets the "parent_id" field.
+func (_c *TodoCreate) SetParentID(v string) *TodoCreate {
+	_c.mutation.SetParentID(v)
+	return _c
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (_c *TodoCreate) SetNillableParentID(v *string) *TodoCreate {
+	if v != nil {
+		_c.SetParentID(*v)
+	}
+	return _c
+}
+
 // SetTitle sets the "title" field.
 func (_c *TodoCreate) SetTitle(v string) *TodoCreate {
 	_c.mutation.SetTitle(v)
@@ -85,6 +132,34 @@ func (_c *TodoCreate) SetNillableIsPublic(v *bool) *TodoCreate {
 	return _c
 }
 
+// SetAutoComplete sets the "auto_complete" field.
+func (_c *TodoCreate) SetAutoComplete(v bool) *TodoCreate {
+	_c.mutation.SetAutoComplete(v)
+	return _c
+}
+
+// SetNillableAutoComplete sets the "auto_complete" field if the given value is not nil.
+func (_c *TodoCreate) SetNillableAutoComplete(v *bool) *TodoCreate {
+	if v != nil {
+		_c.SetAutoComplete(*v)
+	}
+	return _c
+}
+
+// SetPriority sets the "priority" field.
+func (_c *TodoCreate) SetPriority(v todo.Priority) *TodoCreate {
+	_c.mutation.SetPriority(v)
+	return _c
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (_c *TodoCreate) SetNillablePriority(v *todo.Priority) *TodoCreate {
+	if v != nil {
+		_c.SetPriority(*v)
+	}
+	return _c
+}
+
 // SetDueDate sets the "due_date" field.
 func (_c *TodoCreate) SetDueDate(v time.Time) *TodoCreate {
 	_c.mutation.SetDueDate(v)
@@ -99,6 +174,34 @@ func (_c *TodoCreate) SetNillableDueDate(v *time.Time) *TodoCreate {
 	return _c
 }
 
+// SetRecurrenceRule sets the "recurrence_rule" field.
+func (_c *TodoCreate) SetRecurrenceRule(v string) *TodoCreate {
+	_c.mutation.SetRecurrenceRule(v)
+	return _c
+}
+
+// SetNillableRecurrenceRule sets the "recurrence_rule" field if the given value is not nil.
+func (_c *TodoCreate) SetNillableRecurrenceRule(v *string) *TodoCreate {
+	if v != nil {
+		_c.SetRecurrenceRule(*v)
+	}
+	return _c
+}
+
+// SetRecurrenceTimezone sets the "recurrence_timezone" field.
+func (_c *TodoCreate) SetRecurrenceTimezone(v string) *TodoCreate {
+	_c.mutation.SetRecurrenceTimezone(v)
+	return _c
+}
+
+// SetNillableRecurrenceTimezone sets the "recurrence_timezone" field if the given value is not nil.
+func (_c *TodoCreate) SetNillableRecurrenceTimezone(v *string) *TodoCreate {
+	if v != nil {
+		_c.SetRecurrenceTimezone(*v)
+	}
+	return _c
+}
+
 // SetCompletedAt sets the "completed_at" field.
 func (_c *TodoCreate) SetCompletedAt(v time.Time) *TodoCreate {
 	_c.mutation.SetCompletedAt(v)
@@ -113,6 +216,54 @@ func (_c *TodoCreate) SetNillableCompletedAt(v *time.Time) *TodoCreate {
 	return _c
 }
 
+// SetSnoozedUntil sets the "snoozed_until" field.
+func (_c *TodoCreate) SetSnoozedUntil(v time.Time) *TodoCreate {
+	_c.mutation.SetSnoozedUntil(v)
+	return _c
+}
+
+// SetNillableSnoozedUntil sets the "snoozed_until" field if the given value is not nil.
+func (_c *TodoCreate) SetNillableSnoozedUntil(v *time.Time) *TodoCreate {
+	if v != nil {
+		_c.SetSnoozedUntil(*v)
+	}
+	return _c
+}
+
+// SetPosition sets the "position" field.
+func (_c *TodoCreate) SetPosition(v string) *TodoCreate {
+	_c.mutation.SetPosition(v)
+	return _c
+}
+
+// SetVersion sets the "version" field.
+func (_c *TodoCreate) SetVersion(v int) *TodoCreate {
+	_c.mutation.SetVersion(v)
+	return _c
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_c *TodoCreate) SetNillableVersion(v *int) *TodoCreate {
+	if v != nil {
+		_c.SetVersion(*v)
+	}
+	return _c
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *TodoCreate) SetDeletedAt(v time.Time) *TodoCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *TodoCreate) SetNillableDeletedAt(v *time.Time) *TodoCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *TodoCreate) SetCreatedAt(v time.Time) *TodoCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -157,6 +308,121 @@ func (_c *TodoCreate) SetUser(v *User) *TodoCreate {
 	return _c.SetUserID(v.ID)
 }
 
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (_c *TodoCreate) AddTagIDs(ids ...string) *TodoCreate {
+	_c.mutation.AddTagIDs(ids...)
+	return _c
+}
+
+// AddTags adds the "tags" edges to the Tag entity.
+func (_c *TodoCreate) AddTags(v ...*Tag) *TodoCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddTagIDs(ids...)
+}
+
+// SetProject sets the "project" edge to the Project entity.
+func (_c *TodoCreate) SetProject(v *Project) *TodoCreate {
+	return _c.SetProjectID(v.ID)
+}
+
+// SetParent sets the "parent" edge to the Todo entity.
+func (_c *TodoCreate) SetParent(v *Todo) *TodoCreate {
+	return _c.SetParentID(v.ID)
+}
+
+// AddSubtaskIDs adds the "subtasks" edge to the Todo entity by IDs.
+func (_c *TodoCreate) AddSubtaskIDs(ids ...string) *TodoCreate {
+	_c.mutation.AddSubtaskIDs(ids...)
+	return _c
+}
+
+// AddSubtasks adds the "subtasks" edges to the Todo entity.
+func (_c *TodoCreate) AddSubtasks(v ...*Todo) *TodoCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddSubtaskIDs(ids...)
+}
+
+// AddBlockerIDs adds the "blockers" edge to the Todo entity by IDs.
+func (_c *TodoCreate) AddBlockerIDs(ids ...string) *TodoCreate {
+	_c.mutation.AddBlockerIDs(ids...)
+	return _c
+}
+
+// AddBlockers adds the "blockers" edges to the Todo entity.
+func (_c *TodoCreate) AddBlockers(v ...*Todo) *TodoCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddBlockerIDs(ids...)
+}
+
+// AddBlockingIDs adds the "blocking" edge to the Todo entity by IDs.
+func (_c *TodoCreate) AddBlockingIDs(ids ...string) *TodoCreate {
+	_c.mutation.AddBlockingIDs(ids...)
+	return _c
+}
+
+// AddBlocking adds the "blocking" edges to the Todo entity.
+func (_c *TodoCreate) AddBlocking(v ...*Todo) *TodoCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddBlockingIDs(ids...)
+}
+
+// AddReminderIDs adds the "reminders" edge to the Reminder entity by IDs.
+func (_c *TodoCreate) AddReminderIDs(ids ...string) *TodoCreate {
+	_c.mutation.AddReminderIDs(ids...)
+	return _c
+}
+
+// AddReminders adds the "reminders" edges to the Reminder entity.
+func (_c *TodoCreate) AddReminders(v ...*Reminder) *TodoCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddReminderIDs(ids...)
+}
+
+// AddCommentIDs adds the "comments" edge to the Comment entity by IDs.
+func (_c *TodoCreate) AddCommentIDs(ids ...string) *TodoCreate {
+	_c.mutation.AddCommentIDs(ids...)
+	return _c
+}
+
+// AddComments adds the "comments" edges to the Comment entity.
+func (_c *TodoCreate) AddComments(v ...*Comment) *TodoCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddCommentIDs(ids...)
+}
+
+// AddActivityIDs adds the "activities" edge to the TodoActivity entity by IDs.
+func (_c *TodoCreate) AddActivityIDs(ids ...string) *TodoCreate {
+	_c.mutation.AddActivityIDs(ids...)
+	return _c
+}
+
+// AddActivities adds the "activities" edges to the TodoActivity entity.
+func (_c *TodoCreate) AddActivities(v ...*TodoActivity) *TodoCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddActivityIDs(ids...)
+}
+
 // Mutation returns the TodoMutation object of the builder.
 func (_c *TodoCreate) Mutation() *TodoMutation {
 	return _c.mutation
@@ -204,6 +470,18 @@ func (_c *TodoCreate) defaults() {
 		v := todo.DefaultIsPublic
 		_c.mutation.SetIsPublic(v)
 	}
+	if _, ok := _c.mutation.AutoComplete(); !ok {
+		v := todo.DefaultAutoComplete
+		_c.mutation.SetAutoComplete(v)
+	}
+	if _, ok := _c.mutation.Priority(); !ok {
+		v := todo.DefaultPriority
+		_c.mutation.SetPriority(v)
+	}
+	if _, ok := _c.mutation.Version(); !ok {
+		v := todo.DefaultVersion
+		_c.mutation.SetVersion(v)
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		v := todo.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
@@ -246,6 +524,33 @@ func (_c *TodoCreate) check() error {
 	if _, ok := _c.mutation.IsPublic(); !ok {
 		return &ValidationError{Name: "is_public", err: errors.New(`generated: missing required field "Todo.is_public"`)}
 	}
+	if _, ok := _c.mutation.AutoComplete(); !ok {
+		return &ValidationError{Name: "auto_complete", err: errors.New(`generated: missing required field "Todo.auto_complete"`)}
+	}
+	if _, ok := _c.mutation.Priority(); !ok {
+		return &ValidationError{Name: "priority", err: errors.New(`generated: missing required field "Todo.priority"`)}
+	}
+	if v, ok := _c.mutation.Priority(); ok {
+		if err := todo.PriorityValidator(v); err != nil {
+			return &ValidationError{Name: "priority", err: fmt.Errorf(`generated: validator failed for field "Todo.priority": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Position(); !ok {
+		return &ValidationError{Name: "position", err: errors.New(`generated: missing required field "Todo.position"`)}
+	}
+	if v, ok := _c.mutation.Position(); ok {
+		if err := todo.PositionValidator(v); err != nil {
+			return &ValidationError{Name: "position", err: fmt.Errorf(`generated: validator failed for field "Todo.position": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`generated: missing required field "Todo.version"`)}
+	}
+	if v, ok := _c.mutation.Version(); ok {
+		if err := todo.VersionValidator(v); err != nil {
+			return &ValidationError{Name: "version", err: fmt.Errorf(`generated: validator failed for field "Todo.version": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`generated: missing required field "Todo.created_at"`)}
 	}
@@ -299,6 +604,10 @@ func (_c *TodoCreate) createSpec() (*Todo, *sqlgraph.CreateSpec) {
 		_node.ID = id
 		_spec.ID.Value = id
 	}
+	if value, ok := _c.mutation.AssigneeID(); ok {
+		_spec.SetField(todo.FieldAssigneeID, field.TypeString, value)
+		_node.AssigneeID = &value
+	}
 	if value, ok := _c.mutation.Title(); ok {
 		_spec.SetField(todo.FieldTitle, field.TypeString, value)
 		_node.Title = value
@@ -315,14 +624,46 @@ func (_c *TodoCreate) createSpec() (*Todo, *sqlgraph.CreateSpec) {
 		_spec.SetField(todo.FieldIsPublic, field.TypeBool, value)
 		_node.IsPublic = value
 	}
+	if value, ok := _c.mutation.AutoComplete(); ok {
+		_spec.SetField(todo.FieldAutoComplete, field.TypeBool, value)
+		_node.AutoComplete = value
+	}
+	if value, ok := _c.mutation.Priority(); ok {
+		_spec.SetField(todo.FieldPriority, field.TypeEnum, value)
+		_node.Priority = value
+	}
 	if value, ok := _c.mutation.DueDate(); ok {
 		_spec.SetField(todo.FieldDueDate, field.TypeTime, value)
 		_node.DueDate = &value
 	}
+	if value, ok := _c.mutation.RecurrenceRule(); ok {
+		_spec.SetField(todo.FieldRecurrenceRule, field.TypeString, value)
+		_node.RecurrenceRule = &value
+	}
+	if value, ok := _c.mutation.RecurrenceTimezone(); ok {
+		_spec.SetField(todo.FieldRecurrenceTimezone, field.TypeString, value)
+		_node.RecurrenceTimezone = &value
+	}
 	if value, ok := _c.mutation.CompletedAt(); ok {
 		_spec.SetField(todo.FieldCompletedAt, field.TypeTime, value)
 		_node.CompletedAt = &value
 	}
+	if value, ok := _c.mutation.SnoozedUntil(); ok {
+		_spec.SetField(todo.FieldSnoozedUntil, field.TypeTime, value)
+		_node.SnoozedUntil = &value
+	}
+	if value, ok := _c.mutation.Position(); ok {
+		_spec.SetField(todo.FieldPosition, field.TypeString, value)
+		_node.Position = value
+	}
+	if value, ok := _c.mutation.Version(); ok {
+		_spec.SetField(todo.FieldVersion, field.TypeInt, value)
+		_node.Version = value
+	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(todo.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(todo.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -365,6 +706,152 @@ func (_c *TodoCreate) createSpec() (*Todo, *sqlgraph.CreateSpec) {
 		_node.UserID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   todo.TagsTable,
+			Columns: todo.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ProjectIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todo.ProjectTable,
+			Columns: []string{todo.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ProjectID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todo.ParentTable,
+			Columns: []string{todo.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todo.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ParentID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.SubtasksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.SubtasksTable,
+			Columns: []string{todo.SubtasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todo.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.BlockersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   todo.BlockersTable,
+			Columns: todo.BlockersPrimaryKey,
+			Bidi:    true,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todo.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.BlockingIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   todo.BlockingTable,
+			Columns: todo.BlockingPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todo.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.RemindersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.RemindersTable,
+			Columns: []string{todo.RemindersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reminder.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.CommentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.CommentsTable,
+			Columns: []string{todo.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ActivitiesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.ActivitiesTable,
+			Columns: []string{todo.ActivitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todoactivity.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -417,6 +904,60 @@ type (
 	}
 )
 
+// SetAssigneeID sets the "assignee_id" field.
+func (u *TodoUpsert) SetAssigneeID(v string) *TodoUpsert {
+	u.Set(todo.FieldAssigneeID, v)
+	return u
+}
+
+// UpdateAssigneeID sets the "assignee_id" field to the value that was provided on create.
+func (u *TodoUpsert) UpdateAssigneeID() *TodoUpsert {
+	u.SetExcluded(todo.FieldAssigneeID)
+	return u
+}
+
+// ClearAssigneeID clears the value of the "assignee_id" field.
+func (u *TodoUpsert) ClearAssigneeID() *TodoUpsert {
+	u.SetNull(todo.FieldAssigneeID)
+	return u
+}
+
+// SetProjectID sets the "project_id" field.
+func (u *TodoUpsert) SetProjectID(v string) *TodoUpsert {
+	u.Set(todo.FieldProjectID, v)
+	return u
+}
+
+// UpdateProjectID sets the "project_id" field to the value that was provided on create.
+func (u *TodoUpsert) UpdateProjectID() *TodoUpsert {
+	u.SetExcluded(todo.FieldProjectID)
+	return u
+}
+
+// ClearProjectID clears the value of the "project_id" field.
+func (u *TodoUpsert) ClearProjectID() *TodoUpsert {
+	u.SetNull(todo.FieldProjectID)
+	return u
+}
+
+// SetParentID sets the "parent_id" field.
+func (u *TodoUpsert) SetParentID(v string) *TodoUpsert {
+	u.Set(todo.FieldParentID, v)
+	return u
+}
+
+// UpdateParentID sets the "parent_id" field to the value that was provided on create.
+func (u *TodoUpsert) UpdateParentID() *TodoUpsert {
+	u.SetExcluded(todo.FieldParentID)
+	return u
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (u *TodoUpsert) ClearParentID() *TodoUpsert {
+	u.SetNull(todo.FieldParentID)
+	return u
+}
+
 // SetTitle sets the "title" field.
 func (u *TodoUpsert) SetTitle(v string) *TodoUpsert {
 	u.Set(todo.FieldTitle, v)
@@ -471,39 +1012,165 @@ func (u *TodoUpsert) UpdateIsPublic() *TodoUpsert {
 	return u
 }
 
+// SetAutoComplete sets the "auto_complete" field.
+func (u *TodoUpsert) SetAutoComplete(v bool) *TodoUpsert {
+	u.Set(todo.FieldAutoComplete, v)
+	return u
+}
+
+// UpdateAutoComplete sets the "auto_complete" field to the value that was provided on create.
+func (u *TodoUpsert) UpdateAutoComplete() *TodoUpsert {
+	u.SetExcluded(todo.FieldAutoComplete)
+	return u
+}
+
+// SetPriority sets the "priority" field.
+func (u *TodoUpsert) SetPriority(v todo.Priority) *TodoUpsert {
+	u.Set(todo.FieldPriority, v)
+	return u
+}
+
+// UpdatePriority sets the "priority" field to the value that was provided on create.
+func (u *TodoUpsert) UpdatePriority() *TodoUpsert {
+	u.SetExcluded(todo.FieldPriority)
+	return u
+}
+
 // SetDueDate sets the "due_date" field.
 func (u *TodoUpsert) SetDueDate(v time.Time) *TodoUpsert {
 	u.Set(todo.FieldDueDate, v)
 	return u
 }
 
-// UpdateDueDate sets the "due_date" field to the value that was provided on create.
-func (u *TodoUpsert) UpdateDueDate() *TodoUpsert {
-	u.SetExcluded(todo.FieldDueDate)
+// UpdateDueDate sets the "due_date" field to the value that was provided on create.
+func (u *TodoUpsert) UpdateDueDate() *TodoUpsert {
+	u.SetExcluded(todo.FieldDueDate)
+	return u
+}
+
+// ClearDueDate clears the value of the "due_date" field.
+func (u *TodoUpsert) ClearDueDate() *TodoUpsert {
+	u.SetNull(todo.FieldDueDate)
+	return u
+}
+
+// SetRecurrenceRule sets the "recurrence_rule" field.
+func (u *TodoUpsert) SetRecurrenceRule(v string) *TodoUpsert {
+	u.Set(todo.FieldRecurrenceRule, v)
+	return u
+}
+
+// UpdateRecurrenceRule sets the "recurrence_rule" field to the value that was provided on create.
+func (u *TodoUpsert) UpdateRecurrenceRule() *TodoUpsert {
+	u.SetExcluded(todo.FieldRecurrenceRule)
+	return u
+}
+
+// ClearRecurrenceRule clears the value of the "recurrence_rule" field.
+func (u *TodoUpsert) ClearRecurrenceRule() *TodoUpsert {
+	u.SetNull(todo.FieldRecurrenceRule)
+	return u
+}
+
+// SetRecurrenceTimezone sets the "recurrence_timezone" field.
+func (u *TodoUpsert) SetRecurrenceTimezone(v string) *TodoUpsert {
+	u.Set(todo.FieldRecurrenceTimezone, v)
+	return u
+}
+
+// UpdateRecurrenceTimezone sets the "recurrence_timezone" field to the value that was provided on create.
+func (u *TodoUpsert) UpdateRecurrenceTimezone() *TodoUpsert {
+	u.SetExcluded(todo.FieldRecurrenceTimezone)
+	return u
+}
+
+// ClearRecurrenceTimezone clears the value of the "recurrence_timezone" field.
+func (u *TodoUpsert) ClearRecurrenceTimezone() *TodoUpsert {
+	u.SetNull(todo.FieldRecurrenceTimezone)
+	return u
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (u *TodoUpsert) SetCompletedAt(v time.Time) *TodoUpsert {
+	u.Set(todo.FieldCompletedAt, v)
+	return u
+}
+
+// UpdateCompletedAt sets the "completed_at" field to the value that was provided on create.
+func (u *TodoUpsert) UpdateCompletedAt() *TodoUpsert {
+	u.SetExcluded(todo.FieldCompletedAt)
+	return u
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (u *TodoUpsert) ClearCompletedAt() *TodoUpsert {
+	u.SetNull(todo.FieldCompletedAt)
+	return u
+}
+
+// SetSnoozedUntil sets the "snoozed_until" field.
+func (u *TodoUpsert) SetSnoozedUntil(v time.Time) *TodoUpsert {
+	u.Set(todo.FieldSnoozedUntil, v)
+	return u
+}
+
+// UpdateSnoozedUntil sets the "snoozed_until" field to the value that was provided on create.
+func (u *TodoUpsert) UpdateSnoozedUntil() *TodoUpsert {
+	u.SetExcluded(todo.FieldSnoozedUntil)
+	return u
+}
+
+// ClearSnoozedUntil clears the value of the "snoozed_until" field.
+func (u *TodoUpsert) ClearSnoozedUntil() *TodoUpsert {
+	u.SetNull(todo.FieldSnoozedUntil)
+	return u
+}
+
+// SetPosition sets the "position" field.
+func (u *TodoUpsert) SetPosition(v string) *TodoUpsert {
+	u.Set(todo.FieldPosition, v)
+	return u
+}
+
+// UpdatePosition sets the "position" field to the value that was provided on create.
+func (u *TodoUpsert) UpdatePosition() *TodoUpsert {
+	u.SetExcluded(todo.FieldPosition)
+	return u
+}
+
+// SetVersion sets the "version" field.
+func (u *TodoUpsert) SetVersion(v int) *TodoUpsert {
+	u.Set(todo.FieldVersion, v)
+	return u
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *TodoUpsert) UpdateVersion() *TodoUpsert {
+	u.SetExcluded(todo.FieldVersion)
 	return u
 }
 
-// ClearDueDate clears the value of the "due_date" field.
-func (u *TodoUpsert) ClearDueDate() *TodoUpsert {
-	u.SetNull(todo.FieldDueDate)
+// AddVersion adds v to the "version" field.
+func (u *TodoUpsert) AddVersion(v int) *TodoUpsert {
+	u.Add(todo.FieldVersion, v)
 	return u
 }
 
-// SetCompletedAt sets the "completed_at" field.
-func (u *TodoUpsert) SetCompletedAt(v time.Time) *TodoUpsert {
-	u.Set(todo.FieldCompletedAt, v)
+// SetDeletedAt sets the "deleted_at" field.
+func (u *TodoUpsert) SetDeletedAt(v time.Time) *TodoUpsert {
+	u.Set(todo.FieldDeletedAt, v)
 	return u
 }
 
-// UpdateCompletedAt sets the "completed_at" field to the value that was provided on create.
-func (u *TodoUpsert) UpdateCompletedAt() *TodoUpsert {
-	u.SetExcluded(todo.FieldCompletedAt)
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *TodoUpsert) UpdateDeletedAt() *TodoUpsert {
+	u.SetExcluded(todo.FieldDeletedAt)
 	return u
 }
 
-// ClearCompletedAt clears the value of the "completed_at" field.
-func (u *TodoUpsert) ClearCompletedAt() *TodoUpsert {
-	u.SetNull(todo.FieldCompletedAt)
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *TodoUpsert) ClearDeletedAt() *TodoUpsert {
+	u.SetNull(todo.FieldDeletedAt)
 	return u
 }
 
@@ -576,6 +1243,69 @@ func (u *TodoUpsertOne) Update(set func(*TodoUpsert)) *TodoUpsertOne {
 	return u
 }
 
+// SetAssigneeID sets the "assignee_id" field.
+func (u *TodoUpsertOne) SetAssigneeID(v string) *TodoUpsertOne {
+	return u.Update(func(s *TodoUpsert) {
+		s.SetAssigneeID(v)
+	})
+}
+
+// UpdateAssigneeID sets the "assignee_id" field to the value that was provided on create.
+func (u *TodoUpsertOne) UpdateAssigneeID() *TodoUpsertOne {
+	return u.Update(func(s *TodoUpsert) {
+		s.UpdateAssigneeID()
+	})
+}
+
+// ClearAssigneeID clears the value of the "assignee_id" field.
+func (u *TodoUpsertOne) ClearAssigneeID() *TodoUpsertOne {
+	return u.Update(func(s *TodoUpsert) {
+		s.ClearAssigneeID()
+	})
+}
+
+// SetProjectID sets the "project_id" field.
+func (u *TodoUpsertOne) SetProjectID(v string) *TodoUpsertOne {
+	return u.Update(func(s *TodoUpsert) {
+		s.SetProjectID(v)
+	})
+}
+
+// UpdateProjectID sets the "project_id" field to the value that was provided on create.
+func (u *TodoUpsertOne) UpdateProjectID() *TodoUpsertOne {
+	return u.Update(func(s *TodoUpsert) {
+		s.UpdateProjectID()
+	})
+}
+
+// ClearProjectID clears the value of the "project_id" field.
+func (u *TodoUpsertOne) ClearProjectID() *TodoUpsertOne {
+	return u.Update(func(s *TodoUpsert) {
+		s.ClearProjectID()
+	})
+}
+
+// SetParentID sets the "parent_id" field.
+func (u *TodoUpsertOne) SetParentID(v string) *TodoUpsertOne {
+	return u.Update(func(s *TodoUpsert) {
+		s.SetParentID(v)
+	})
+}
+
+// UpdateParentID sets the "parent_id" field to the value that was provided on create.
+func (u *TodoUpsertOne) UpdateParentID() *TodoUpsertOne {
+	return u.Update(func(s *TodoUpsert) {
+		s.UpdateParentID()
+	})
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (u *TodoUpsertOne) ClearParentID() *TodoUpsertOne {
+	return u.Update(func(s *TodoUpsert) {
+		s.ClearParentID()
+	})
+}
+
 // SetTitle sets the "title" field.
 func (u *TodoUpsertOne) SetTitle(v string) *TodoUpsertOne {
 	return u.Update(func(s *TodoUpsert) {
@@ -639,6 +1369,34 @@ func (u *TodoUpsertOne) UpdateIsPublic() *TodoUpsertOne {
 	})
 }
 
+// SetAutoComplete sets the "auto_complete" field.
+func (u *TodoUpsertOne) SetAutoComplete(v bool) *TodoUpsertOne {
+	return u.Update(func(s *TodoUpsert) {
+		s.SetAutoComplete(v)
+	})
+}
+
+// UpdateAutoComplete sets the "auto_complete" field to the value that was provided on create.
+func (u *TodoUpsertOne) UpdateAutoComplete() *TodoUpsertOne {
+	return u.Update(func(s *TodoUpsert) {
+		s.UpdateAutoComplete()
+	})
+}
+
+// SetPriority sets the "priority" field.
+func (u *TodoUpsertOne) SetPriority(v todo.Priority) *TodoUpsertOne {
+	return u.Update(func(s *TodoUpsert) {
+		s.SetPriority(v)
+	})
+}
+
+// UpdatePriority sets the "priority" field to the value that was provided on create.
+func (u *TodoUpsertOne) UpdatePriority() *TodoUpsertOne {
+	return u.Update(func(s *TodoUpsert) {
+		s.UpdatePriority()
+	})
+}
+
 // SetDueDate sets the "due_date" field.
 func (u *TodoUpsertOne) SetDueDate(v time.Time) *TodoUpsertOne {
 	return u.Update(func(s *TodoUpsert) {
@@ -660,6 +1418,48 @@ func (u *TodoUpsertOne) ClearDueDate() *TodoUpsertOne {
 	})
 }
 
+// SetRecurrenceRule sets the "recurrence_rule" field.
+func (u *TodoUpsertOne) SetRecurrenceRule(v string) *TodoUpsertOne {
+	return u.Update(func(s *TodoUpsert) {
+		s.SetRecurrenceRule(v)
+	})
+}
+
+// UpdateRecurrenceRule sets the "recurrence_rule" field to the value that was provided on create.
+func (u *TodoUpsertOne) UpdateRecurrenceRule() *TodoUpsertOne {
+	return u.Update(func(s *TodoUpsert) {
+		s.UpdateRecurrenceRule()
+	})
+}
+
+// ClearRecurrenceRule clears the value of the "recurrence_rule" field.
+func (u *TodoUpsertOne) ClearRecurrenceRule() *TodoUpsertOne {
+	return u.Update(func(s *TodoUpsert) {
+		s.ClearRecurrenceRule()
+	})
+}
+
+// SetRecurrenceTimezone sets the "recurrence_timezone" field.
+func (u *TodoUpsertOne) SetRecurrenceTimezone(v string) *TodoUpsertOne {
+	return u.Update(func(s *TodoUpsert) {
+		s.SetRecurrenceTimezone(v)
+	})
+}
+
+// UpdateRecurrenceTimezone sets the "recurrence_timezone" field to the value that was provided on create.
+func (u *TodoUpsertOne) UpdateRecurrenceTimezone() *TodoUpsertOne {
+	return u.Update(func(s *TodoUpsert) {
+		s.UpdateRecurrenceTimezone()
+	})
+}
+
+// ClearRecurrenceTimezone clears the value of the "recurrence_timezone" field.
+func (u *TodoUpsertOne) ClearRecurrenceTimezone() *TodoUpsertOne {
+	return u.Update(func(s *TodoUpsert) {
+		s.ClearRecurrenceTimezone()
+	})
+}
+
 // SetCompletedAt sets the "completed_at" field.
 func (u *TodoUpsertOne) SetCompletedAt(v time.Time) *TodoUpsertOne {
 	return u.Update(func(s *TodoUpsert) {
@@ -681,6 +1481,83 @@ func (u *TodoUpsertOne) ClearCompletedAt() *TodoUpsertOne {
 	})
 }
 
+// SetSnoozedUntil sets the "snoozed_until" field.
+func (u *TodoUpsertOne) SetSnoozedUntil(v time.Time) *TodoUpsertOne {
+	return u.Update(func(s *TodoUpsert) {
+		s.SetSnoozedUntil(v)
+	})
+}
+
+// UpdateSnoozedUntil sets the "snoozed_until" field to the value that was provided on create.
+func (u *TodoUpsertOne) UpdateSnoozedUntil() *TodoUpsertOne {
+	return u.Update(func(s *TodoUpsert) {
+		s.UpdateSnoozedUntil()
+	})
+}
+
+// ClearSnoozedUntil clears the value of the "snoozed_until" field.
+func (u *TodoUpsertOne) ClearSnoozedUntil() *TodoUpsertOne {
+	return u.Update(func(s *TodoUpsert) {
+		s.ClearSnoozedUntil()
+	})
+}
+
+// SetPosition sets the "position" field.
+func (u *TodoUpsertOne) SetPosition(v string) *TodoUpsertOne {
+	return u.Update(func(s *TodoUpsert) {
+		s.SetPosition(v)
+	})
+}
+
+// UpdatePosition sets the "position" field to the value that was provided on create.
+func (u *TodoUpsertOne) UpdatePosition() *TodoUpsertOne {
+	return u.Update(func(s *TodoUpsert) {
+		s.UpdatePosition()
+	})
+}
+
+// SetVersion sets the "version" field.
+func (u *TodoUpsertOne) SetVersion(v int) *TodoUpsertOne {
+	return u.Update(func(s *TodoUpsert) {
+		s.SetVersion(v)
+	})
+}
+
+// AddVersion adds v to the "version" field.
+func (u *TodoUpsertOne) AddVersion(v int) *TodoUpsertOne {
+	return u.Update(func(s *TodoUpsert) {
+		s.AddVersion(v)
+	})
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *TodoUpsertOne) UpdateVersion() *TodoUpsertOne {
+	return u.Update(func(s *TodoUpsert) {
+		s.UpdateVersion()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *TodoUpsertOne) SetDeletedAt(v time.Time) *TodoUpsertOne {
+	return u.Update(func(s *TodoUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *TodoUpsertOne) UpdateDeletedAt() *TodoUpsertOne {
+	return u.Update(func(s *TodoUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *TodoUpsertOne) ClearDeletedAt() *TodoUpsertOne {
+	return u.Update(func(s *TodoUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (u *TodoUpsertOne) SetUpdatedAt(v time.Time) *TodoUpsertOne {
 	return u.Update(func(s *TodoUpsert) {
@@ -919,6 +1796,69 @@ func (u *TodoUpsertBulk) Update(set func(*TodoUpsert)) *TodoUpsertBulk {
 	return u
 }
 
+// SetAssigneeID sets the "assignee_id" field.
+func (u *TodoUpsertBulk) SetAssigneeID(v string) *TodoUpsertBulk {
+	return u.Update(func(s *TodoUpsert) {
+		s.SetAssigneeID(v)
+	})
+}
+
+// UpdateAssigneeID sets the "assignee_id" field to the value that was provided on create.
+func (u *TodoUpsertBulk) UpdateAssigneeID() *TodoUpsertBulk {
+	return u.Update(func(s *TodoUpsert) {
+		s.UpdateAssigneeID()
+	})
+}
+
+// ClearAssigneeID clears the value of the "assignee_id" field.
+func (u *TodoUpsertBulk) ClearAssigneeID() *TodoUpsertBulk {
+	return u.Update(func(s *TodoUpsert) {
+		s.ClearAssigneeID()
+	})
+}
+
+// SetProjectID sets the "project_id" field.
+func (u *TodoUpsertBulk) SetProjectID(v string) *TodoUpsertBulk {
+	return u.Update(func(s *TodoUpsert) {
+		s.SetProjectID(v)
+	})
+}
+
+// UpdateProjectID sets the "project_id" field to the value that was provided on create.
+func (u *TodoUpsertBulk) UpdateProjectID() *TodoUpsertBulk {
+	return u.Update(func(s *TodoUpsert) {
+		s.UpdateProjectID()
+	})
+}
+
+// ClearProjectID clears the value of the "project_id" field.
+func (u *TodoUpsertBulk) ClearProjectID() *TodoUpsertBulk {
+	return u.Update(func(s *TodoUpsert) {
+		s.ClearProjectID()
+	})
+}
+
+// SetParentID sets the "parent_id" field.
+func (u *TodoUpsertBulk) SetParentID(v string) *TodoUpsertBulk {
+	return u.Update(func(s *TodoUpsert) {
+		s.SetParentID(v)
+	})
+}
+
+// UpdateParentID sets the "parent_id" field to the value that was provided on create.
+func (u *TodoUpsertBulk) UpdateParentID() *TodoUpsertBulk {
+	return u.Update(func(s *TodoUpsert) {
+		s.UpdateParentID()
+	})
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (u *TodoUpsertBulk) ClearParentID() *TodoUpsertBulk {
+	return u.Update(func(s *TodoUpsert) {
+		s.ClearParentID()
+	})
+}
+
 // SetTitle sets the "title" field.
 func (u *TodoUpsertBulk) SetTitle(v string) *TodoUpsertBulk {
 	return u.Update(func(s *TodoUpsert) {
@@ -982,6 +1922,34 @@ func (u *TodoUpsertBulk) UpdateIsPublic() *TodoUpsertBulk {
 	})
 }
 
+// SetAutoComplete sets the "auto_complete" field.
+func (u *TodoUpsertBulk) SetAutoComplete(v bool) *TodoUpsertBulk {
+	return u.Update(func(s *TodoUpsert) {
+		s.SetAutoComplete(v)
+	})
+}
+
+// UpdateAutoComplete sets the "auto_complete" field to the value that was provided on create.
+func (u *TodoUpsertBulk) UpdateAutoComplete() *TodoUpsertBulk {
+	return u.Update(func(s *TodoUpsert) {
+		s.UpdateAutoComplete()
+	})
+}
+
+// SetPriority sets the "priority" field.
+func (u *TodoUpsertBulk) SetPriority(v todo.Priority) *TodoUpsertBulk {
+	return u.Update(func(s *TodoUpsert) {
+		s.SetPriority(v)
+	})
+}
+
+// UpdatePriority sets the "priority" field to the value that was provided on create.
+func (u *TodoUpsertBulk) UpdatePriority() *TodoUpsertBulk {
+	return u.Update(func(s *TodoUpsert) {
+		s.UpdatePriority()
+	})
+}
+
 // SetDueDate sets the "due_date" field.
 func (u *TodoUpsertBulk) SetDueDate(v time.Time) *TodoUpsertBulk {
 	return u.Update(func(s *TodoUpsert) {
@@ -1003,6 +1971,48 @@ func (u *TodoUpsertBulk) ClearDueDate() *TodoUpsertBulk {
 	})
 }
 
+// SetRecurrenceRule sets the "recurrence_rule" field.
+func (u *TodoUpsertBulk) SetRecurrenceRule(v string) *TodoUpsertBulk {
+	return u.Update(func(s *TodoUpsert) {
+		s.SetRecurrenceRule(v)
+	})
+}
+
+// UpdateRecurrenceRule sets the "recurrence_rule" field to the value that was provided on create.
+func (u *TodoUpsertBulk) UpdateRecurrenceRule() *TodoUpsertBulk {
+	return u.Update(func(s *TodoUpsert) {
+		s.UpdateRecurrenceRule()
+	})
+}
+
+// ClearRecurrenceRule clears the value of the "recurrence_rule" field.
+func (u *TodoUpsertBulk) ClearRecurrenceRule() *TodoUpsertBulk {
+	return u.Update(func(s *TodoUpsert) {
+		s.ClearRecurrenceRule()
+	})
+}
+
+// SetRecurrenceTimezone sets the "recurrence_timezone" field.
+func (u *TodoUpsertBulk) SetRecurrenceTimezone(v string) *TodoUpsertBulk {
+	return u.Update(func(s *TodoUpsert) {
+		s.SetRecurrenceTimezone(v)
+	})
+}
+
+// UpdateRecurrenceTimezone sets the "recurrence_timezone" field to the value that was provided on create.
+func (u *TodoUpsertBulk) UpdateRecurrenceTimezone() *TodoUpsertBulk {
+	return u.Update(func(s *TodoUpsert) {
+		s.UpdateRecurrenceTimezone()
+	})
+}
+
+// ClearRecurrenceTimezone clears the value of the "recurrence_timezone" field.
+func (u *TodoUpsertBulk) ClearRecurrenceTimezone() *TodoUpsertBulk {
+	return u.Update(func(s *TodoUpsert) {
+		s.ClearRecurrenceTimezone()
+	})
+}
+
 // SetCompletedAt sets the "completed_at" field.
 func (u *TodoUpsertBulk) SetCompletedAt(v time.Time) *TodoUpsertBulk {
 	return u.Update(func(s *TodoUpsert) {
@@ -1024,6 +2034,83 @@ func (u *TodoUpsertBulk) ClearCompletedAt() *TodoUpsertBulk {
 	})
 }
 
+// SetSnoozedUntil sets the "snoozed_until" field.
+func (u *TodoUpsertBulk) SetSnoozedUntil(v time.Time) *TodoUpsertBulk {
+	return u.Update(func(s *TodoUpsert) {
+		s.SetSnoozedUntil(v)
+	})
+}
+
+// UpdateSnoozedUntil sets the "snoozed_until" field to the value that was provided on create.
+func (u *TodoUpsertBulk) UpdateSnoozedUntil() *TodoUpsertBulk {
+	return u.Update(func(s *TodoUpsert) {
+		s.UpdateSnoozedUntil()
+	})
+}
+
+// ClearSnoozedUntil clears the value of the "snoozed_until" field.
+func (u *TodoUpsertBulk) ClearSnoozedUntil() *TodoUpsertBulk {
+	return u.Update(func(s *TodoUpsert) {
+		s.ClearSnoozedUntil()
+	})
+}
+
+// SetPosition sets the "position" field.
+func (u *TodoUpsertBulk) SetPosition(v string) *TodoUpsertBulk {
+	return u.Update(func(s *TodoUpsert) {
+		s.SetPosition(v)
+	})
+}
+
+// UpdatePosition sets the "position" field to the value that was provided on create.
+func (u *TodoUpsertBulk) UpdatePosition() *TodoUpsertBulk {
+	return u.Update(func(s *TodoUpsert) {
+		s.UpdatePosition()
+	})
+}
+
+// SetVersion sets the "version" field.
+func (u *TodoUpsertBulk) SetVersion(v int) *TodoUpsertBulk {
+	return u.Update(func(s *TodoUpsert) {
+		s.SetVersion(v)
+	})
+}
+
+// AddVersion adds v to the "version" field.
+func (u *TodoUpsertBulk) AddVersion(v int) *TodoUpsertBulk {
+	return u.Update(func(s *TodoUpsert) {
+		s.AddVersion(v)
+	})
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *TodoUpsertBulk) UpdateVersion() *TodoUpsertBulk {
+	return u.Update(func(s *TodoUpsert) {
+		s.UpdateVersion()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *TodoUpsertBulk) SetDeletedAt(v time.Time) *TodoUpsertBulk {
+	return u.Update(func(s *TodoUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *TodoUpsertBulk) UpdateDeletedAt() *TodoUpsertBulk {
+	return u.Update(func(s *TodoUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *TodoUpsertBulk) ClearDeletedAt() *TodoUpsertBulk {
+	return u.Update(func(s *TodoUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (u *TodoUpsertBulk) SetUpdatedAt(v time.Time) *TodoUpsertBulk {
 	return u.Update(func(s *TodoUpsert) {
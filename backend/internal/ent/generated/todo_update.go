@@ -6,8 +6,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"good-todo-go/internal/ent/generated/comment"
 	"good-todo-go/internal/ent/generated/predicate"
+	"good-todo-go/internal/ent/generated/project"
+	"good-todo-go/internal/ent/generated/reminder"
+	"good-todo-go/internal/ent/generated/tag"
 	"good-todo-go/internal/ent/generated/todo"
+	"good-todo-go/internal/ent/generated/todoactivity"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -28,6 +33,66 @@ func (_u *TodoUpdate) Where(ps ...predicate.Todo) *TodoUpdate {
 	return _u
 }
 
+// SetAssigneeID sets the "assignee_id" field.
+func (_u *TodoUpdate) SetAssigneeID(v string) *TodoUpdate {
+	_u.mutation.SetAssigneeID(v)
+	return _u
+}
+
+// SetNillableAssigneeID sets the "assignee_id" field if the given value is not nil.
+func (_u *TodoUpdate) SetNillableAssigneeID(v *string) *TodoUpdate {
+	if v != nil {
+		_u.SetAssigneeID(*v)
+	}
+	return _u
+}
+
+// ClearAssigneeID clears the value of the "assignee_id" field.
+func (_u *TodoUpdate) ClearAssigneeID() *TodoUpdate {
+	_u.mutation.ClearAssigneeID()
+	return _u
+}
+
+// SetProjectID sets the "project_id" field.
+func (_u *TodoUpdate) SetProjectID(v string) *TodoUpdate {
+	_u.mutation.SetProjectID(v)
+	return _u
+}
+
+// SetNillableProjectID sets the "project_id" field if the given value is not nil.
+func (_u *TodoUpdate) SetNillableProjectID(v *string) *TodoUpdate {
+	if v != nil {
+		_u.SetProjectID(*v)
+	}
+	return _u
+}
+
+// ClearProjectID clears the value of the "project_id" field.
+func (_u *TodoUpdate) ClearProjectID() *TodoUpdate {
+	_u.mutation.ClearProjectID()
+	return _u
+}
+
+// SetParentID sets the "parent_id" field.
+func (_u *TodoUpdate) SetParentID(v string) *TodoUpdate {
+	_u.mutation.SetParentID(v)
+	return _u
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (_u *TodoUpdate) SetNillableParentID(v *string) *TodoUpdate {
+	if v != nil {
+		_u.SetParentID(*v)
+	}
+	return _u
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (_u *TodoUpdate) ClearParentID() *TodoUpdate {
+	_u.mutation.ClearParentID()
+	return _u
+}
+
 // SetTitle sets the "title" field.
 func (_u *TodoUpdate) SetTitle(v string) *TodoUpdate {
 	_u.mutation.SetTitle(v)
@@ -90,6 +155,34 @@ func (_u *TodoUpdate) SetNillableIsPublic(v *bool) *TodoUpdate {
 	return _u
 }
 
+// SetAutoComplete sets the "auto_complete" field.
+func (_u *TodoUpdate) SetAutoComplete(v bool) *TodoUpdate {
+	_u.mutation.SetAutoComplete(v)
+	return _u
+}
+
+// SetNillableAutoComplete sets the "auto_complete" field if the given value is not nil.
+func (_u *TodoUpdate) SetNillableAutoComplete(v *bool) *TodoUpdate {
+	if v != nil {
+		_u.SetAutoComplete(*v)
+	}
+	return _u
+}
+
+// SetPriority sets the "priority" field.
+func (_u *TodoUpdate) SetPriority(v todo.Priority) *TodoUpdate {
+	_u.mutation.SetPriority(v)
+	return _u
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (_u *TodoUpdate) SetNillablePriority(v *todo.Priority) *TodoUpdate {
+	if v != nil {
+		_u.SetPriority(*v)
+	}
+	return _u
+}
+
 // SetDueDate sets the "due_date" field.
 func (_u *TodoUpdate) SetDueDate(v time.Time) *TodoUpdate {
 	_u.mutation.SetDueDate(v)
@@ -110,6 +203,46 @@ func (_u *TodoUpdate) ClearDueDate() *TodoUpdate {
 	return _u
 }
 
+// SetRecurrenceRule sets the "recurrence_rule" field.
+func (_u *TodoUpdate) SetRecurrenceRule(v string) *TodoUpdate {
+	_u.mutation.SetRecurrenceRule(v)
+	return _u
+}
+
+// SetNillableRecurrenceRule sets the "recurrence_rule" field if the given value is not nil.
+func (_u *TodoUpdate) SetNillableRecurrenceRule(v *string) *TodoUpdate {
+	if v != nil {
+		_u.SetRecurrenceRule(*v)
+	}
+	return _u
+}
+
+// ClearRecurrenceRule clears the value of the "recurrence_rule" field.
+func (_u *TodoUpdate) ClearRecurrenceRule() *TodoUpdate {
+	_u.mutation.ClearRecurrenceRule()
+	return _u
+}
+
+// SetRecurrenceTimezone sets the "recurrence_timezone" field.
+func (_u *TodoUpdate) SetRecurrenceTimezone(v string) *TodoUpdate {
+	_u.mutation.SetRecurrenceTimezone(v)
+	return _u
+}
+
+// SetNillableRecurrenceTimezone sets the "recurrence_timezone" field if the given value is not nil.
+func (_u *TodoUpdate) SetNillableRecurrenceTimezone(v *string) *TodoUpdate {
+	if v != nil {
+		_u.SetRecurrenceTimezone(*v)
+	}
+	return _u
+}
+
+// ClearRecurrenceTimezone clears the value of the "recurrence_timezone" field.
+func (_u *TodoUpdate) ClearRecurrenceTimezone() *TodoUpdate {
+	_u.mutation.ClearRecurrenceTimezone()
+	return _u
+}
+
 // SetCompletedAt sets the "completed_at" field.
 func (_u *TodoUpdate) SetCompletedAt(v time.Time) *TodoUpdate {
 	_u.mutation.SetCompletedAt(v)
@@ -130,17 +263,366 @@ func (_u *TodoUpdate) ClearCompletedAt() *TodoUpdate {
 	return _u
 }
 
+// SetSnoozedUntil sets the "snoozed_until" field.
+func (_u *TodoUpdate) SetSnoozedUntil(v time.Time) *TodoUpdate {
+	_u.mutation.SetSnoozedUntil(v)
+	return _u
+}
+
+// SetNillableSnoozedUntil sets the "snoozed_until" field if the given value is not nil.
+func (_u *TodoUpdate) SetNillableSnoozedUntil(v *time.Time) *TodoUpdate {
+	if v != nil {
+		_u.SetSnoozedUntil(*v)
+	}
+	return _u
+}
+
+// ClearSnoozedUntil clears the value of the "snoozed_until" field.
+func (_u *TodoUpdate) ClearSnoozedUntil() *TodoUpdate {
+	_u.mutation.ClearSnoozedUntil()
+	return _u
+}
+
+// SetPosition sets the "position" field.
+func (_u *TodoUpdate) SetPosition(v string) *TodoUpdate {
+	_u.mutation.SetPosition(v)
+	return _u
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (_u *TodoUpdate) SetNillablePosition(v *string) *TodoUpdate {
+	if v != nil {
+		_u.SetPosition(*v)
+	}
+	return _u
+}
+
+// SetVersion sets the "version" field.
+func (_u *TodoUpdate) SetVersion(v int) *TodoUpdate {
+	_u.mutation.ResetVersion()
+	_u.mutation.SetVersion(v)
+	return _u
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_u *TodoUpdate) SetNillableVersion(v *int) *TodoUpdate {
+	if v != nil {
+		_u.SetVersion(*v)
+	}
+	return _u
+}
+
+// AddVersion adds value to the "version" field.
+func (_u *TodoUpdate) AddVersion(v int) *TodoUpdate {
+	_u.mutation.AddVersion(v)
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *TodoUpdate) SetDeletedAt(v time.Time) *TodoUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *TodoUpdate) SetNillableDeletedAt(v *time.Time) *TodoUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *TodoUpdate) ClearDeletedAt() *TodoUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *TodoUpdate) SetUpdatedAt(v time.Time) *TodoUpdate {
 	_u.mutation.SetUpdatedAt(v)
 	return _u
 }
 
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (_u *TodoUpdate) AddTagIDs(ids ...string) *TodoUpdate {
+	_u.mutation.AddTagIDs(ids...)
+	return _u
+}
+
+// AddTags adds the "tags" edges to the Tag entity.
+func (_u *TodoUpdate) AddTags(v ...*Tag) *TodoUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTagIDs(ids...)
+}
+
+// SetProject sets the "project" edge to the Project entity.
+func (_u *TodoUpdate) SetProject(v *Project) *TodoUpdate {
+	return _u.SetProjectID(v.ID)
+}
+
+// SetParent sets the "parent" edge to the Todo entity.
+func (_u *TodoUpdate) SetParent(v *Todo) *TodoUpdate {
+	return _u.SetParentID(v.ID)
+}
+
+// AddSubtaskIDs adds the "subtasks" edge to the Todo entity by IDs.
+func (_u *TodoUpdate) AddSubtaskIDs(ids ...string) *TodoUpdate {
+	_u.mutation.AddSubtaskIDs(ids...)
+	return _u
+}
+
+// AddSubtasks adds the "subtasks" edges to the Todo entity.
+func (_u *TodoUpdate) AddSubtasks(v ...*Todo) *TodoUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSubtaskIDs(ids...)
+}
+
+// AddBlockerIDs adds the "blockers" edge to the Todo entity by IDs.
+func (_u *TodoUpdate) AddBlockerIDs(ids ...string) *TodoUpdate {
+	_u.mutation.AddBlockerIDs(ids...)
+	return _u
+}
+
+// AddBlockers adds the "blockers" edges to the Todo entity.
+func (_u *TodoUpdate) AddBlockers(v ...*Todo) *TodoUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddBlockerIDs(ids...)
+}
+
+// AddBlockingIDs adds the "blocking" edge to the Todo entity by IDs.
+func (_u *TodoUpdate) AddBlockingIDs(ids ...string) *TodoUpdate {
+	_u.mutation.AddBlockingIDs(ids...)
+	return _u
+}
+
+// AddBlocking adds the "blocking" edges to the Todo entity.
+func (_u *TodoUpdate) AddBlocking(v ...*Todo) *TodoUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddBlockingIDs(ids...)
+}
+
+// AddReminderIDs adds the "reminders" edge to the Reminder entity by IDs.
+func (_u *TodoUpdate) AddReminderIDs(ids ...string) *TodoUpdate {
+	_u.mutation.AddReminderIDs(ids...)
+	return _u
+}
+
+// AddReminders adds the "reminders" edges to the Reminder entity.
+func (_u *TodoUpdate) AddReminders(v ...*Reminder) *TodoUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddReminderIDs(ids...)
+}
+
+// AddCommentIDs adds the "comments" edge to the Comment entity by IDs.
+func (_u *TodoUpdate) AddCommentIDs(ids ...string) *TodoUpdate {
+	_u.mutation.AddCommentIDs(ids...)
+	return _u
+}
+
+// AddComments adds the "comments" edges to the Comment entity.
+func (_u *TodoUpdate) AddComments(v ...*Comment) *TodoUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddCommentIDs(ids...)
+}
+
+// AddActivityIDs adds the "activities" edge to the TodoActivity entity by IDs.
+func (_u *TodoUpdate) AddActivityIDs(ids ...string) *TodoUpdate {
+	_u.mutation.AddActivityIDs(ids...)
+	return _u
+}
+
+// AddActivities adds the "activities" edges to the TodoActivity entity.
+func (_u *TodoUpdate) AddActivities(v ...*TodoActivity) *TodoUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddActivityIDs(ids...)
+}
+
 // Mutation returns the TodoMutation object of the builder.
 func (_u *TodoUpdate) Mutation() *TodoMutation {
 	return _u.mutation
 }
 
+// ClearTags clears all "tags" edges to the Tag entity.
+func (_u *TodoUpdate) ClearTags() *TodoUpdate {
+	_u.mutation.ClearTags()
+	return _u
+}
+
+// RemoveTagIDs removes the "tags" edge to Tag entities by IDs.
+func (_u *TodoUpdate) RemoveTagIDs(ids ...string) *TodoUpdate {
+	_u.mutation.RemoveTagIDs(ids...)
+	return _u
+}
+
+// RemoveTags removes "tags" edges to Tag entities.
+func (_u *TodoUpdate) RemoveTags(v ...*Tag) *TodoUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTagIDs(ids...)
+}
+
+// ClearProject clears the "project" edge to the Project entity.
+func (_u *TodoUpdate) ClearProject() *TodoUpdate {
+	_u.mutation.ClearProject()
+	return _u
+}
+
+// ClearParent clears the "parent" edge to the Todo entity.
+func (_u *TodoUpdate) ClearParent() *TodoUpdate {
+	_u.mutation.ClearParent()
+	return _u
+}
+
+// ClearSubtasks clears all "subtasks" edges to the Todo entity.
+func (_u *TodoUpdate) ClearSubtasks() *TodoUpdate {
+	_u.mutation.ClearSubtasks()
+	return _u
+}
+
+// RemoveSubtaskIDs removes the "subtasks" edge to Todo entities by IDs.
+func (_u *TodoUpdate) RemoveSubtaskIDs(ids ...string) *TodoUpdate {
+	_u.mutation.RemoveSubtaskIDs(ids...)
+	return _u
+}
+
+// RemoveSubtasks removes "subtasks" edges to Todo entities.
+func (_u *TodoUpdate) RemoveSubtasks(v ...*Todo) *TodoUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSubtaskIDs(ids...)
+}
+
+// ClearBlockers clears all "blockers" edges to the Todo entity.
+func (_u *TodoUpdate) ClearBlockers() *TodoUpdate {
+	_u.mutation.ClearBlockers()
+	return _u
+}
+
+// RemoveBlockerIDs removes the "blockers" edge to Todo entities by IDs.
+func (_u *TodoUpdate) RemoveBlockerIDs(ids ...string) *TodoUpdate {
+	_u.mutation.RemoveBlockerIDs(ids...)
+	return _u
+}
+
+// RemoveBlockers removes "blockers" edges to Todo entities.
+func (_u *TodoUpdate) RemoveBlockers(v ...*Todo) *TodoUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveBlockerIDs(ids...)
+}
+
+// ClearBlocking clears all "blocking" edges to the Todo entity.
+func (_u *TodoUpdate) ClearBlocking() *TodoUpdate {
+	_u.mutation.ClearBlocking()
+	return _u
+}
+
+// RemoveBlockingIDs removes the "blocking" edge to Todo entities by IDs.
+func (_u *TodoUpdate) RemoveBlockingIDs(ids ...string) *TodoUpdate {
+	_u.mutation.RemoveBlockingIDs(ids...)
+	return _u
+}
+
+// RemoveBlocking removes "blocking" edges to Todo entities.
+func (_u *TodoUpdate) RemoveBlocking(v ...*Todo) *TodoUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveBlockingIDs(ids...)
+}
+
+// ClearReminders clears all "reminders" edges to the Reminder entity.
+func (_u *TodoUpdate) ClearReminders() *TodoUpdate {
+	_u.mutation.ClearReminders()
+	return _u
+}
+
+// RemoveReminderIDs removes the "reminders" edge to Reminder entities by IDs.
+func (_u *TodoUpdate) RemoveReminderIDs(ids ...string) *TodoUpdate {
+	_u.mutation.RemoveReminderIDs(ids...)
+	return _u
+}
+
+// RemoveReminders removes "reminders" edges to Reminder entities.
+func (_u *TodoUpdate) RemoveReminders(v ...*Reminder) *TodoUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveReminderIDs(ids...)
+}
+
+// ClearComments clears all "comments" edges to the Comment entity.
+func (_u *TodoUpdate) ClearComments() *TodoUpdate {
+	_u.mutation.ClearComments()
+	return _u
+}
+
+// RemoveCommentIDs removes the "comments" edge to Comment entities by IDs.
+func (_u *TodoUpdate) RemoveCommentIDs(ids ...string) *TodoUpdate {
+	_u.mutation.RemoveCommentIDs(ids...)
+	return _u
+}
+
+// RemoveComments removes "comments" edges to Comment entities.
+func (_u *TodoUpdate) RemoveComments(v ...*Comment) *TodoUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveCommentIDs(ids...)
+}
+
+// ClearActivities clears all "activities" edges to the TodoActivity entity.
+func (_u *TodoUpdate) ClearActivities() *TodoUpdate {
+	_u.mutation.ClearActivities()
+	return _u
+}
+
+// RemoveActivityIDs removes the "activities" edge to TodoActivity entities by IDs.
+func (_u *TodoUpdate) RemoveActivityIDs(ids ...string) *TodoUpdate {
+	_u.mutation.RemoveActivityIDs(ids...)
+	return _u
+}
+
+// RemoveActivities removes "activities" edges to TodoActivity entities.
+func (_u *TodoUpdate) RemoveActivities(v ...*TodoActivity) *TodoUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveActivityIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *TodoUpdate) Save(ctx context.Context) (int, error) {
 	_u.defaults()
@@ -184,6 +666,21 @@ func (_u *TodoUpdate) check() error {
 			return &ValidationError{Name: "title", err: fmt.Errorf(`generated: validator failed for field "Todo.title": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Priority(); ok {
+		if err := todo.PriorityValidator(v); err != nil {
+			return &ValidationError{Name: "priority", err: fmt.Errorf(`generated: validator failed for field "Todo.priority": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Position(); ok {
+		if err := todo.PositionValidator(v); err != nil {
+			return &ValidationError{Name: "position", err: fmt.Errorf(`generated: validator failed for field "Todo.position": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Version(); ok {
+		if err := todo.VersionValidator(v); err != nil {
+			return &ValidationError{Name: "version", err: fmt.Errorf(`generated: validator failed for field "Todo.version": %w`, err)}
+		}
+	}
 	if _u.mutation.TenantCleared() && len(_u.mutation.TenantIDs()) > 0 {
 		return errors.New(`generated: clearing a required unique edge "Todo.tenant"`)
 	}
@@ -205,6 +702,12 @@ func (_u *TodoUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 			}
 		}
 	}
+	if value, ok := _u.mutation.AssigneeID(); ok {
+		_spec.SetField(todo.FieldAssigneeID, field.TypeString, value)
+	}
+	if _u.mutation.AssigneeIDCleared() {
+		_spec.ClearField(todo.FieldAssigneeID, field.TypeString)
+	}
 	if value, ok := _u.mutation.Title(); ok {
 		_spec.SetField(todo.FieldTitle, field.TypeString, value)
 	}
@@ -220,31 +723,443 @@ func (_u *TodoUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.IsPublic(); ok {
 		_spec.SetField(todo.FieldIsPublic, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.AutoComplete(); ok {
+		_spec.SetField(todo.FieldAutoComplete, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Priority(); ok {
+		_spec.SetField(todo.FieldPriority, field.TypeEnum, value)
+	}
 	if value, ok := _u.mutation.DueDate(); ok {
 		_spec.SetField(todo.FieldDueDate, field.TypeTime, value)
 	}
 	if _u.mutation.DueDateCleared() {
 		_spec.ClearField(todo.FieldDueDate, field.TypeTime)
 	}
+	if value, ok := _u.mutation.RecurrenceRule(); ok {
+		_spec.SetField(todo.FieldRecurrenceRule, field.TypeString, value)
+	}
+	if _u.mutation.RecurrenceRuleCleared() {
+		_spec.ClearField(todo.FieldRecurrenceRule, field.TypeString)
+	}
+	if value, ok := _u.mutation.RecurrenceTimezone(); ok {
+		_spec.SetField(todo.FieldRecurrenceTimezone, field.TypeString, value)
+	}
+	if _u.mutation.RecurrenceTimezoneCleared() {
+		_spec.ClearField(todo.FieldRecurrenceTimezone, field.TypeString)
+	}
 	if value, ok := _u.mutation.CompletedAt(); ok {
 		_spec.SetField(todo.FieldCompletedAt, field.TypeTime, value)
 	}
 	if _u.mutation.CompletedAtCleared() {
 		_spec.ClearField(todo.FieldCompletedAt, field.TypeTime)
 	}
+	if value, ok := _u.mutation.SnoozedUntil(); ok {
+		_spec.SetField(todo.FieldSnoozedUntil, field.TypeTime, value)
+	}
+	if _u.mutation.SnoozedUntilCleared() {
+		_spec.ClearField(todo.FieldSnoozedUntil, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Position(); ok {
+		_spec.SetField(todo.FieldPosition, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Version(); ok {
+		_spec.SetField(todo.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedVersion(); ok {
+		_spec.AddField(todo.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(todo.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(todo.FieldDeletedAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(todo.FieldUpdatedAt, field.TypeTime, value)
 	}
-	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{todo.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+	if _u.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   todo.TagsTable,
+			Columns: todo.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeString),
+			},
 		}
-		return 0, err
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	_u.mutation.done = true
-	return _node, nil
+	if nodes := _u.mutation.RemovedTagsIDs(); len(nodes) > 0 && !_u.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   todo.TagsTable,
+			Columns: todo.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   todo.TagsTable,
+			Columns: todo.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ProjectCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todo.ProjectTable,
+			Columns: []string{todo.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ProjectIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todo.ProjectTable,
+			Columns: []string{todo.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ParentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todo.ParentTable,
+			Columns: []string{todo.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todo.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todo.ParentTable,
+			Columns: []string{todo.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todo.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SubtasksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.SubtasksTable,
+			Columns: []string{todo.SubtasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todo.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSubtasksIDs(); len(nodes) > 0 && !_u.mutation.SubtasksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.SubtasksTable,
+			Columns: []string{todo.SubtasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todo.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SubtasksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.SubtasksTable,
+			Columns: []string{todo.SubtasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todo.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.BlockersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   todo.BlockersTable,
+			Columns: todo.BlockersPrimaryKey,
+			Bidi:    true,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todo.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedBlockersIDs(); len(nodes) > 0 && !_u.mutation.BlockersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   todo.BlockersTable,
+			Columns: todo.BlockersPrimaryKey,
+			Bidi:    true,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todo.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BlockersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   todo.BlockersTable,
+			Columns: todo.BlockersPrimaryKey,
+			Bidi:    true,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todo.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.BlockingCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   todo.BlockingTable,
+			Columns: todo.BlockingPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todo.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedBlockingIDs(); len(nodes) > 0 && !_u.mutation.BlockingCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   todo.BlockingTable,
+			Columns: todo.BlockingPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todo.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BlockingIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   todo.BlockingTable,
+			Columns: todo.BlockingPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todo.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.RemindersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.RemindersTable,
+			Columns: []string{todo.RemindersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reminder.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRemindersIDs(); len(nodes) > 0 && !_u.mutation.RemindersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.RemindersTable,
+			Columns: []string{todo.RemindersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reminder.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemindersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.RemindersTable,
+			Columns: []string{todo.RemindersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reminder.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.CommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.CommentsTable,
+			Columns: []string{todo.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedCommentsIDs(); len(nodes) > 0 && !_u.mutation.CommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.CommentsTable,
+			Columns: []string{todo.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CommentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.CommentsTable,
+			Columns: []string{todo.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ActivitiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.ActivitiesTable,
+			Columns: []string{todo.ActivitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todoactivity.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedActivitiesIDs(); len(nodes) > 0 && !_u.mutation.ActivitiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.ActivitiesTable,
+			Columns: []string{todo.ActivitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todoactivity.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ActivitiesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.ActivitiesTable,
+			Columns: []string{todo.ActivitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todoactivity.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{todo.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
 }
 
 // TodoUpdateOne is the builder for updating a single Todo entity.
@@ -255,6 +1170,66 @@ type TodoUpdateOne struct {
 	mutation *TodoMutation
 }
 
+// SetAssigneeID sets the "assignee_id" field.
+func (_u *TodoUpdateOne) SetAssigneeID(v string) *TodoUpdateOne {
+	_u.mutation.SetAssigneeID(v)
+	return _u
+}
+
+// SetNillableAssigneeID sets the "assignee_id" field if the given value is not nil.
+func (_u *TodoUpdateOne) SetNillableAssigneeID(v *string) *TodoUpdateOne {
+	if v != nil {
+		_u.SetAssigneeID(*v)
+	}
+	return _u
+}
+
+// ClearAssigneeID clears the value of the "assignee_id" field.
+func (_u *TodoUpdateOne) ClearAssigneeID() *TodoUpdateOne {
+	_u.mutation.ClearAssigneeID()
+	return _u
+}
+
+// SetProjectID sets the "project_id" field.
+func (_u *TodoUpdateOne) SetProjectID(v string) *TodoUpdateOne {
+	_u.mutation.SetProjectID(v)
+	return _u
+}
+
+// SetNillableProjectID sets the "project_id" field if the given value is not nil.
+func (_u *TodoUpdateOne) SetNillableProjectID(v *string) *TodoUpdateOne {
+	if v != nil {
+		_u.SetProjectID(*v)
+	}
+	return _u
+}
+
+// ClearProjectID clears the value of the "project_id" field.
+func (_u *TodoUpdateOne) ClearProjectID() *TodoUpdateOne {
+	_u.mutation.ClearProjectID()
+	return _u
+}
+
+// SetParentID sets the "parent_id" field.
+func (_u *TodoUpdateOne) SetParentID(v string) *TodoUpdateOne {
+	_u.mutation.SetParentID(v)
+	return _u
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (_u *TodoUpdateOne) SetNillableParentID(v *string) *TodoUpdateOne {
+	if v != nil {
+		_u.SetParentID(*v)
+	}
+	return _u
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (_u *TodoUpdateOne) ClearParentID() *TodoUpdateOne {
+	_u.mutation.ClearParentID()
+	return _u
+}
+
 // SetTitle sets the "title" field.
 func (_u *TodoUpdateOne) SetTitle(v string) *TodoUpdateOne {
 	_u.mutation.SetTitle(v)
@@ -317,6 +1292,34 @@ func (_u *TodoUpdateOne) SetNillableIsPublic(v *bool) *TodoUpdateOne {
 	return _u
 }
 
+// SetAutoComplete sets the "auto_complete" field.
+func (_u *TodoUpdateOne) SetAutoComplete(v bool) *TodoUpdateOne {
+	_u.mutation.SetAutoComplete(v)
+	return _u
+}
+
+// SetNillableAutoComplete sets the "auto_complete" field if the given value is not nil.
+func (_u *TodoUpdateOne) SetNillableAutoComplete(v *bool) *TodoUpdateOne {
+	if v != nil {
+		_u.SetAutoComplete(*v)
+	}
+	return _u
+}
+
+// SetPriority sets the "priority" field.
+func (_u *TodoUpdateOne) SetPriority(v todo.Priority) *TodoUpdateOne {
+	_u.mutation.SetPriority(v)
+	return _u
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (_u *TodoUpdateOne) SetNillablePriority(v *todo.Priority) *TodoUpdateOne {
+	if v != nil {
+		_u.SetPriority(*v)
+	}
+	return _u
+}
+
 // SetDueDate sets the "due_date" field.
 func (_u *TodoUpdateOne) SetDueDate(v time.Time) *TodoUpdateOne {
 	_u.mutation.SetDueDate(v)
@@ -337,6 +1340,46 @@ func (_u *TodoUpdateOne) ClearDueDate() *TodoUpdateOne {
 	return _u
 }
 
+// SetRecurrenceRule sets the "recurrence_rule" field.
+func (_u *TodoUpdateOne) SetRecurrenceRule(v string) *TodoUpdateOne {
+	_u.mutation.SetRecurrenceRule(v)
+	return _u
+}
+
+// SetNillableRecurrenceRule sets the "recurrence_rule" field if the given value is not nil.
+func (_u *TodoUpdateOne) SetNillableRecurrenceRule(v *string) *TodoUpdateOne {
+	if v != nil {
+		_u.SetRecurrenceRule(*v)
+	}
+	return _u
+}
+
+// ClearRecurrenceRule clears the value of the "recurrence_rule" field.
+func (_u *TodoUpdateOne) ClearRecurrenceRule() *TodoUpdateOne {
+	_u.mutation.ClearRecurrenceRule()
+	return _u
+}
+
+// SetRecurrenceTimezone sets the "recurrence_timezone" field.
+func (_u *TodoUpdateOne) SetRecurrenceTimezone(v string) *TodoUpdateOne {
+	_u.mutation.SetRecurrenceTimezone(v)
+	return _u
+}
+
+// SetNillableRecurrenceTimezone sets the "recurrence_timezone" field if the given value is not nil.
+func (_u *TodoUpdateOne) SetNillableRecurrenceTimezone(v *string) *TodoUpdateOne {
+	if v != nil {
+		_u.SetRecurrenceTimezone(*v)
+	}
+	return _u
+}
+
+// ClearRecurrenceTimezone clears the value of the "recurrence_timezone" field.
+func (_u *TodoUpdateOne) ClearRecurrenceTimezone() *TodoUpdateOne {
+	_u.mutation.ClearRecurrenceTimezone()
+	return _u
+}
+
 // SetCompletedAt sets the "completed_at" field.
 func (_u *TodoUpdateOne) SetCompletedAt(v time.Time) *TodoUpdateOne {
 	_u.mutation.SetCompletedAt(v)
@@ -357,17 +1400,366 @@ func (_u *TodoUpdateOne) ClearCompletedAt() *TodoUpdateOne {
 	return _u
 }
 
+// SetSnoozedUntil sets the "snoozed_until" field.
+func (_u *TodoUpdateOne) SetSnoozedUntil(v time.Time) *TodoUpdateOne {
+	_u.mutation.SetSnoozedUntil(v)
+	return _u
+}
+
+// SetNillableSnoozedUntil sets the "snoozed_until" field if the given value is not nil.
+func (_u *TodoUpdateOne) SetNillableSnoozedUntil(v *time.Time) *TodoUpdateOne {
+	if v != nil {
+		_u.SetSnoozedUntil(*v)
+	}
+	return _u
+}
+
+// ClearSnoozedUntil clears the value of the "snoozed_until" field.
+func (_u *TodoUpdateOne) ClearSnoozedUntil() *TodoUpdateOne {
+	_u.mutation.ClearSnoozedUntil()
+	return _u
+}
+
+// SetPosition sets the "position" field.
+func (_u *TodoUpdateOne) SetPosition(v string) *TodoUpdateOne {
+	_u.mutation.SetPosition(v)
+	return _u
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (_u *TodoUpdateOne) SetNillablePosition(v *string) *TodoUpdateOne {
+	if v != nil {
+		_u.SetPosition(*v)
+	}
+	return _u
+}
+
+// SetVersion sets the "version" field.
+func (_u *TodoUpdateOne) SetVersion(v int) *TodoUpdateOne {
+	_u.mutation.ResetVersion()
+	_u.mutation.SetVersion(v)
+	return _u
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_u *TodoUpdateOne) SetNillableVersion(v *int) *TodoUpdateOne {
+	if v != nil {
+		_u.SetVersion(*v)
+	}
+	return _u
+}
+
+// AddVersion adds value to the "version" field.
+func (_u *TodoUpdateOne) AddVersion(v int) *TodoUpdateOne {
+	_u.mutation.AddVersion(v)
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *TodoUpdateOne) SetDeletedAt(v time.Time) *TodoUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *TodoUpdateOne) SetNillableDeletedAt(v *time.Time) *TodoUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *TodoUpdateOne) ClearDeletedAt() *TodoUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *TodoUpdateOne) SetUpdatedAt(v time.Time) *TodoUpdateOne {
 	_u.mutation.SetUpdatedAt(v)
 	return _u
 }
 
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (_u *TodoUpdateOne) AddTagIDs(ids ...string) *TodoUpdateOne {
+	_u.mutation.AddTagIDs(ids...)
+	return _u
+}
+
+// AddTags adds the "tags" edges to the Tag entity.
+func (_u *TodoUpdateOne) AddTags(v ...*Tag) *TodoUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTagIDs(ids...)
+}
+
+// SetProject sets the "project" edge to the Project entity.
+func (_u *TodoUpdateOne) SetProject(v *Project) *TodoUpdateOne {
+	return _u.SetProjectID(v.ID)
+}
+
+// SetParent sets the "parent" edge to the Todo entity.
+func (_u *TodoUpdateOne) SetParent(v *Todo) *TodoUpdateOne {
+	return _u.SetParentID(v.ID)
+}
+
+// AddSubtaskIDs adds the "subtasks" edge to the Todo entity by IDs.
+func (_u *TodoUpdateOne) AddSubtaskIDs(ids ...string) *TodoUpdateOne {
+	_u.mutation.AddSubtaskIDs(ids...)
+	return _u
+}
+
+// AddSubtasks adds the "subtasks" edges to the Todo entity.
+func (_u *TodoUpdateOne) AddSubtasks(v ...*Todo) *TodoUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSubtaskIDs(ids...)
+}
+
+// AddBlockerIDs adds the "blockers" edge to the Todo entity by IDs.
+func (_u *TodoUpdateOne) AddBlockerIDs(ids ...string) *TodoUpdateOne {
+	_u.mutation.AddBlockerIDs(ids...)
+	return _u
+}
+
+// AddBlockers adds the "blockers" edges to the Todo entity.
+func (_u *TodoUpdateOne) AddBlockers(v ...*Todo) *TodoUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddBlockerIDs(ids...)
+}
+
+// AddBlockingIDs adds the "blocking" edge to the Todo entity by IDs.
+func (_u *TodoUpdateOne) AddBlockingIDs(ids ...string) *TodoUpdateOne {
+	_u.mutation.AddBlockingIDs(ids...)
+	return _u
+}
+
+// AddBlocking adds the "blocking" edges to the Todo entity.
+func (_u *TodoUpdateOne) AddBlocking(v ...*Todo) *TodoUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddBlockingIDs(ids...)
+}
+
+// AddReminderIDs adds the "reminders" edge to the Reminder entity by IDs.
+func (_u *TodoUpdateOne) AddReminderIDs(ids ...string) *TodoUpdateOne {
+	_u.mutation.AddReminderIDs(ids...)
+	return _u
+}
+
+// AddReminders adds the "reminders" edges to the Reminder entity.
+func (_u *TodoUpdateOne) AddReminders(v ...*Reminder) *TodoUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddReminderIDs(ids...)
+}
+
+// AddCommentIDs adds the "comments" edge to the Comment entity by IDs.
+func (_u *TodoUpdateOne) AddCommentIDs(ids ...string) *TodoUpdateOne {
+	_u.mutation.AddCommentIDs(ids...)
+	return _u
+}
+
+// AddComments adds the "comments" edges to the Comment entity.
+func (_u *TodoUpdateOne) AddComments(v ...*Comment) *TodoUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddCommentIDs(ids...)
+}
+
+// AddActivityIDs adds the "activities" edge to the TodoActivity entity by IDs.
+func (_u *TodoUpdateOne) AddActivityIDs(ids ...string) *TodoUpdateOne {
+	_u.mutation.AddActivityIDs(ids...)
+	return _u
+}
+
+// AddActivities adds the "activities" edges to the TodoActivity entity.
+func (_u *TodoUpdateOne) AddActivities(v ...*TodoActivity) *TodoUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddActivityIDs(ids...)
+}
+
 // Mutation returns the TodoMutation object of the builder.
 func (_u *TodoUpdateOne) Mutation() *TodoMutation {
 	return _u.mutation
 }
 
+// ClearTags clears all "tags" edges to the Tag entity.
+func (_u *TodoUpdateOne) ClearTags() *TodoUpdateOne {
+	_u.mutation.ClearTags()
+	return _u
+}
+
+// RemoveTagIDs removes the "tags" edge to Tag entities by IDs.
+func (_u *TodoUpdateOne) RemoveTagIDs(ids ...string) *TodoUpdateOne {
+	_u.mutation.RemoveTagIDs(ids...)
+	return _u
+}
+
+// RemoveTags removes "tags" edges to Tag entities.
+func (_u *TodoUpdateOne) RemoveTags(v ...*Tag) *TodoUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTagIDs(ids...)
+}
+
+// ClearProject clears the "project" edge to the Project entity.
+func (_u *TodoUpdateOne) ClearProject() *TodoUpdateOne {
+	_u.mutation.ClearProject()
+	return _u
+}
+
+// ClearParent clears the "parent" edge to the Todo entity.
+func (_u *TodoUpdateOne) ClearParent() *TodoUpdateOne {
+	_u.mutation.ClearParent()
+	return _u
+}
+
+// ClearSubtasks clears all "subtasks" edges to the Todo entity.
+func (_u *TodoUpdateOne) ClearSubtasks() *TodoUpdateOne {
+	_u.mutation.ClearSubtasks()
+	return _u
+}
+
+// RemoveSubtaskIDs removes the "subtasks" edge to Todo entities by IDs.
+func (_u *TodoUpdateOne) RemoveSubtaskIDs(ids ...string) *TodoUpdateOne {
+	_u.mutation.RemoveSubtaskIDs(ids...)
+	return _u
+}
+
+// RemoveSubtasks removes "subtasks" edges to Todo entities.
+func (_u *TodoUpdateOne) RemoveSubtasks(v ...*Todo) *TodoUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSubtaskIDs(ids...)
+}
+
+// ClearBlockers clears all "blockers" edges to the Todo entity.
+func (_u *TodoUpdateOne) ClearBlockers() *TodoUpdateOne {
+	_u.mutation.ClearBlockers()
+	return _u
+}
+
+// RemoveBlockerIDs removes the "blockers" edge to Todo entities by IDs.
+func (_u *TodoUpdateOne) RemoveBlockerIDs(ids ...string) *TodoUpdateOne {
+	_u.mutation.RemoveBlockerIDs(ids...)
+	return _u
+}
+
+// RemoveBlockers removes "blockers" edges to Todo entities.
+func (_u *TodoUpdateOne) RemoveBlockers(v ...*Todo) *TodoUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveBlockerIDs(ids...)
+}
+
+// ClearBlocking clears all "blocking" edges to the Todo entity.
+func (_u *TodoUpdateOne) ClearBlocking() *TodoUpdateOne {
+	_u.mutation.ClearBlocking()
+	return _u
+}
+
+// RemoveBlockingIDs removes the "blocking" edge to Todo entities by IDs.
+func (_u *TodoUpdateOne) RemoveBlockingIDs(ids ...string) *TodoUpdateOne {
+	_u.mutation.RemoveBlockingIDs(ids...)
+	return _u
+}
+
+// RemoveBlocking removes "blocking" edges to Todo entities.
+func (_u *TodoUpdateOne) RemoveBlocking(v ...*Todo) *TodoUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveBlockingIDs(ids...)
+}
+
+// ClearReminders clears all "reminders" edges to the Reminder entity.
+func (_u *TodoUpdateOne) ClearReminders() *TodoUpdateOne {
+	_u.mutation.ClearReminders()
+	return _u
+}
+
+// RemoveReminderIDs removes the "reminders" edge to Reminder entities by IDs.
+func (_u *TodoUpdateOne) RemoveReminderIDs(ids ...string) *TodoUpdateOne {
+	_u.mutation.RemoveReminderIDs(ids...)
+	return _u
+}
+
+// RemoveReminders removes "reminders" edges to Reminder entities.
+func (_u *TodoUpdateOne) RemoveReminders(v ...*Reminder) *TodoUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveReminderIDs(ids...)
+}
+
+// ClearComments clears all "comments" edges to the Comment entity.
+func (_u *TodoUpdateOne) ClearComments() *TodoUpdateOne {
+	_u.mutation.ClearComments()
+	return _u
+}
+
+// RemoveCommentIDs removes the "comments" edge to Comment entities by IDs.
+func (_u *TodoUpdateOne) RemoveCommentIDs(ids ...string) *TodoUpdateOne {
+	_u.mutation.RemoveCommentIDs(ids...)
+	return _u
+}
+
+// RemoveComments removes "comments" edges to Comment entities.
+func (_u *TodoUpdateOne) RemoveComments(v ...*Comment) *TodoUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveCommentIDs(ids...)
+}
+
+// ClearActivities clears all "activities" edges to the TodoActivity entity.
+func (_u *TodoUpdateOne) ClearActivities() *TodoUpdateOne {
+	_u.mutation.ClearActivities()
+	return _u
+}
+
+// RemoveActivityIDs removes the "activities" edge to TodoActivity entities by IDs.
+func (_u *TodoUpdateOne) RemoveActivityIDs(ids ...string) *TodoUpdateOne {
+	_u.mutation.RemoveActivityIDs(ids...)
+	return _u
+}
+
+// RemoveActivities removes "activities" edges to TodoActivity entities.
+func (_u *TodoUpdateOne) RemoveActivities(v ...*TodoActivity) *TodoUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveActivityIDs(ids...)
+}
+
 // Where appends a list predicates to the TodoUpdate builder.
 func (_u *TodoUpdateOne) Where(ps ...predicate.Todo) *TodoUpdateOne {
 	_u.mutation.Where(ps...)
@@ -424,6 +1816,21 @@ func (_u *TodoUpdateOne) check() error {
 			return &ValidationError{Name: "title", err: fmt.Errorf(`generated: validator failed for field "Todo.title": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Priority(); ok {
+		if err := todo.PriorityValidator(v); err != nil {
+			return &ValidationError{Name: "priority", err: fmt.Errorf(`generated: validator failed for field "Todo.priority": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Position(); ok {
+		if err := todo.PositionValidator(v); err != nil {
+			return &ValidationError{Name: "position", err: fmt.Errorf(`generated: validator failed for field "Todo.position": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Version(); ok {
+		if err := todo.VersionValidator(v); err != nil {
+			return &ValidationError{Name: "version", err: fmt.Errorf(`generated: validator failed for field "Todo.version": %w`, err)}
+		}
+	}
 	if _u.mutation.TenantCleared() && len(_u.mutation.TenantIDs()) > 0 {
 		return errors.New(`generated: clearing a required unique edge "Todo.tenant"`)
 	}
@@ -462,6 +1869,12 @@ func (_u *TodoUpdateOne) sqlSave(ctx context.Context) (_node *Todo, err error) {
 			}
 		}
 	}
+	if value, ok := _u.mutation.AssigneeID(); ok {
+		_spec.SetField(todo.FieldAssigneeID, field.TypeString, value)
+	}
+	if _u.mutation.AssigneeIDCleared() {
+		_spec.ClearField(todo.FieldAssigneeID, field.TypeString)
+	}
 	if value, ok := _u.mutation.Title(); ok {
 		_spec.SetField(todo.FieldTitle, field.TypeString, value)
 	}
@@ -477,21 +1890,433 @@ func (_u *TodoUpdateOne) sqlSave(ctx context.Context) (_node *Todo, err error) {
 	if value, ok := _u.mutation.IsPublic(); ok {
 		_spec.SetField(todo.FieldIsPublic, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.AutoComplete(); ok {
+		_spec.SetField(todo.FieldAutoComplete, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Priority(); ok {
+		_spec.SetField(todo.FieldPriority, field.TypeEnum, value)
+	}
 	if value, ok := _u.mutation.DueDate(); ok {
 		_spec.SetField(todo.FieldDueDate, field.TypeTime, value)
 	}
 	if _u.mutation.DueDateCleared() {
 		_spec.ClearField(todo.FieldDueDate, field.TypeTime)
 	}
+	if value, ok := _u.mutation.RecurrenceRule(); ok {
+		_spec.SetField(todo.FieldRecurrenceRule, field.TypeString, value)
+	}
+	if _u.mutation.RecurrenceRuleCleared() {
+		_spec.ClearField(todo.FieldRecurrenceRule, field.TypeString)
+	}
+	if value, ok := _u.mutation.RecurrenceTimezone(); ok {
+		_spec.SetField(todo.FieldRecurrenceTimezone, field.TypeString, value)
+	}
+	if _u.mutation.RecurrenceTimezoneCleared() {
+		_spec.ClearField(todo.FieldRecurrenceTimezone, field.TypeString)
+	}
 	if value, ok := _u.mutation.CompletedAt(); ok {
 		_spec.SetField(todo.FieldCompletedAt, field.TypeTime, value)
 	}
 	if _u.mutation.CompletedAtCleared() {
 		_spec.ClearField(todo.FieldCompletedAt, field.TypeTime)
 	}
+	if value, ok := _u.mutation.SnoozedUntil(); ok {
+		_spec.SetField(todo.FieldSnoozedUntil, field.TypeTime, value)
+	}
+	if _u.mutation.SnoozedUntilCleared() {
+		_spec.ClearField(todo.FieldSnoozedUntil, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Position(); ok {
+		_spec.SetField(todo.FieldPosition, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Version(); ok {
+		_spec.SetField(todo.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedVersion(); ok {
+		_spec.AddField(todo.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(todo.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(todo.FieldDeletedAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(todo.FieldUpdatedAt, field.TypeTime, value)
 	}
+	if _u.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   todo.TagsTable,
+			Columns: todo.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedTagsIDs(); len(nodes) > 0 && !_u.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   todo.TagsTable,
+			Columns: todo.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   todo.TagsTable,
+			Columns: todo.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ProjectCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todo.ProjectTable,
+			Columns: []string{todo.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ProjectIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todo.ProjectTable,
+			Columns: []string{todo.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ParentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todo.ParentTable,
+			Columns: []string{todo.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todo.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todo.ParentTable,
+			Columns: []string{todo.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todo.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SubtasksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.SubtasksTable,
+			Columns: []string{todo.SubtasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todo.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSubtasksIDs(); len(nodes) > 0 && !_u.mutation.SubtasksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.SubtasksTable,
+			Columns: []string{todo.SubtasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todo.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SubtasksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.SubtasksTable,
+			Columns: []string{todo.SubtasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todo.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.BlockersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   todo.BlockersTable,
+			Columns: todo.BlockersPrimaryKey,
+			Bidi:    true,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todo.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedBlockersIDs(); len(nodes) > 0 && !_u.mutation.BlockersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   todo.BlockersTable,
+			Columns: todo.BlockersPrimaryKey,
+			Bidi:    true,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todo.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BlockersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   todo.BlockersTable,
+			Columns: todo.BlockersPrimaryKey,
+			Bidi:    true,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todo.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.BlockingCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   todo.BlockingTable,
+			Columns: todo.BlockingPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todo.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedBlockingIDs(); len(nodes) > 0 && !_u.mutation.BlockingCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   todo.BlockingTable,
+			Columns: todo.BlockingPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todo.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BlockingIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   todo.BlockingTable,
+			Columns: todo.BlockingPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todo.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.RemindersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.RemindersTable,
+			Columns: []string{todo.RemindersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reminder.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRemindersIDs(); len(nodes) > 0 && !_u.mutation.RemindersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.RemindersTable,
+			Columns: []string{todo.RemindersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reminder.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemindersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.RemindersTable,
+			Columns: []string{todo.RemindersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reminder.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.CommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.CommentsTable,
+			Columns: []string{todo.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedCommentsIDs(); len(nodes) > 0 && !_u.mutation.CommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.CommentsTable,
+			Columns: []string{todo.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CommentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.CommentsTable,
+			Columns: []string{todo.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ActivitiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.ActivitiesTable,
+			Columns: []string{todo.ActivitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todoactivity.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedActivitiesIDs(); len(nodes) > 0 && !_u.mutation.ActivitiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.ActivitiesTable,
+			Columns: []string{todo.ActivitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todoactivity.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ActivitiesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.ActivitiesTable,
+			Columns: []string{todo.ActivitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todoactivity.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Todo{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
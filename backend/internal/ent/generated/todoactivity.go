@@ -0,0 +1,229 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"fmt"
+	"good-todo-go/internal/ent/generated/tenant"
+	"good-todo-go/internal/ent/generated/todo"
+	"good-todo-go/internal/ent/generated/todoactivity"
+	"good-todo-go/internal/ent/generated/user"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// TodoActivity is the model entity for the TodoActivity schema.
+type TodoActivity struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// TenantID holds the value of the "tenant_id" field.
+	TenantID string `json:"tenant_id,omitempty"`
+	// TodoID holds the value of the "todo_id" field.
+	TodoID string `json:"todo_id,omitempty"`
+	// ActorID holds the value of the "actor_id" field.
+	ActorID string `json:"actor_id,omitempty"`
+	// Action holds the value of the "action" field.
+	Action todoactivity.Action `json:"action,omitempty"`
+	// SnoozedUntil holds the value of the "snoozed_until" field.
+	SnoozedUntil *time.Time `json:"snoozed_until,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TodoActivityQuery when eager-loading is set.
+	Edges        TodoActivityEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// TodoActivityEdges holds the relations/edges for other nodes in the graph.
+type TodoActivityEdges struct {
+	// Tenant holds the value of the tenant edge.
+	Tenant *Tenant `json:"tenant,omitempty"`
+	// Todo holds the value of the todo edge.
+	Todo *Todo `json:"todo,omitempty"`
+	// Actor holds the value of the actor edge.
+	Actor *User `json:"actor,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// TenantOrErr returns the Tenant value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TodoActivityEdges) TenantOrErr() (*Tenant, error) {
+	if e.Tenant != nil {
+		return e.Tenant, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: tenant.Label}
+	}
+	return nil, &NotLoadedError{edge: "tenant"}
+}
+
+// TodoOrErr returns the Todo value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TodoActivityEdges) TodoOrErr() (*Todo, error) {
+	if e.Todo != nil {
+		return e.Todo, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: todo.Label}
+	}
+	return nil, &NotLoadedError{edge: "todo"}
+}
+
+// ActorOrErr returns the Actor value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TodoActivityEdges) ActorOrErr() (*User, error) {
+	if e.Actor != nil {
+		return e.Actor, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "actor"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TodoActivity) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case todoactivity.FieldID, todoactivity.FieldTenantID, todoactivity.FieldTodoID, todoactivity.FieldActorID, todoactivity.FieldAction:
+			values[i] = new(sql.NullString)
+		case todoactivity.FieldSnoozedUntil, todoactivity.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TodoActivity fields.
+func (_m *TodoActivity) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case todoactivity.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case todoactivity.FieldTenantID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				_m.TenantID = value.String
+			}
+		case todoactivity.FieldTodoID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field todo_id", values[i])
+			} else if value.Valid {
+				_m.TodoID = value.String
+			}
+		case todoactivity.FieldActorID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field actor_id", values[i])
+			} else if value.Valid {
+				_m.ActorID = value.String
+			}
+		case todoactivity.FieldAction:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field action", values[i])
+			} else if value.Valid {
+				_m.Action = todoactivity.Action(value.String)
+			}
+		case todoactivity.FieldSnoozedUntil:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field snoozed_until", values[i])
+			} else if value.Valid {
+				_m.SnoozedUntil = new(time.Time)
+				*_m.SnoozedUntil = value.Time
+			}
+		case todoactivity.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TodoActivity.
+// This includes values selected through modifiers, order, etc.
+func (_m *TodoActivity) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryTenant queries the "tenant" edge of the TodoActivity entity.
+func (_m *TodoActivity) QueryTenant() *TenantQuery {
+	return NewTodoActivityClient(_m.config).QueryTenant(_m)
+}
+
+// QueryTodo queries the "todo" edge of the TodoActivity entity.
+func (_m *TodoActivity) QueryTodo() *TodoQuery {
+	return NewTodoActivityClient(_m.config).QueryTodo(_m)
+}
+
+// QueryActor queries the "actor" edge of the TodoActivity entity.
+func (_m *TodoActivity) QueryActor() *UserQuery {
+	return NewTodoActivityClient(_m.config).QueryActor(_m)
+}
+
+// Update returns a builder for updating this TodoActivity.
+// Note that you need to call TodoActivity.Unwrap() before calling this method if this TodoActivity
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *TodoActivity) Update() *TodoActivityUpdateOne {
+	return NewTodoActivityClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the TodoActivity entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *TodoActivity) Unwrap() *TodoActivity {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("generated: TodoActivity is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *TodoActivity) String() string {
+	var builder strings.Builder
+	builder.WriteString("TodoActivity(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(_m.TenantID)
+	builder.WriteString(", ")
+	builder.WriteString("todo_id=")
+	builder.WriteString(_m.TodoID)
+	builder.WriteString(", ")
+	builder.WriteString("actor_id=")
+	builder.WriteString(_m.ActorID)
+	builder.WriteString(", ")
+	builder.WriteString("action=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Action))
+	builder.WriteString(", ")
+	if v := _m.SnoozedUntil; v != nil {
+		builder.WriteString("snoozed_until=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TodoActivities is a parsable slice of TodoActivity.
+type TodoActivities []*TodoActivity
@@ -0,0 +1,480 @@
+// Code generated by ent, DO NOT EDIT.
+
+package todoactivity
+
+import (
+	"good-todo-go/internal/ent/generated/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.TodoActivity {
+	return predicate.TodoActivity(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.TodoActivity {
+	return predicate.TodoActivity(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.TodoActivity {
+	return predicate.TodoActivity(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.TodoActivity {
+	return predicate.TodoActivity(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.TodoActivity {
+	return predicate.TodoActivity(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.TodoActivity {
+	return predicate.TodoActivity(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.TodoActivity {
+	return predicate.TodoActivity(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.TodoActivity {
+	return predicate.TodoActivity(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.TodoActivity {
+	return predicate.TodoActivity(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.TodoActivity {
+	return predicate.TodoActivity(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.TodoActivity {
+	return predicate.TodoActivity(sql.FieldContainsFold(FieldID, id))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v string) predicate.TodoActivity {
+	return predicate.TodoActivity(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TodoID applies equality check predicate on the "todo_id" field. It's identical to TodoIDEQ.
+func TodoID(v string) predicate.TodoActivity {
+	return predicate.TodoActivity(sql.FieldEQ(FieldTodoID, v))
+}
+
+// ActorID applies equality check predicate on the "actor_id" field. It's identical to ActorIDEQ.
+func ActorID(v string) predicate.TodoActivity {
+	return predicate.TodoActivity(sql.FieldEQ(FieldActorID, v))
+}
+
+// SnoozedUntil applies equality check predicate on the "snoozed_until" field. It's identical to SnoozedUntilEQ.
+func SnoozedUntil(v time.Time) predicate.TodoActivity {
+	return predicate.TodoActivity(sql.FieldEQ(FieldSnoozedUntil, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.TodoActivity {
+	return predicate.TodoActivity(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v string) predicate.TodoActivity {
+	return predicate.TodoActivity(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v string) predicate.TodoActivity {
+	return predicate.TodoActivity(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...string) predicate.TodoActivity {
+	return predicate.TodoActivity(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...string) predicate.TodoActivity {
+	return predicate.TodoActivity(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v string) predicate.TodoActivity {
+	return predicate.TodoActivity(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v string) predicate.TodoActivity {
+	return predicate.TodoActivity(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v string) predicate.TodoActivity {
+	return predicate.TodoActivity(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v string) predicate.TodoActivity {
+	return predicate.TodoActivity(sql.FieldLTE(FieldTenantID, v))
+}
+
+// TenantIDContains applies the Contains predicate on the "tenant_id" field.
+func TenantIDContains(v string) predicate.TodoActivity {
+	return predicate.TodoActivity(sql.FieldContains(FieldTenantID, v))
+}
+
+// TenantIDHasPrefix applies the HasPrefix predicate on the "tenant_id" field.
+func TenantIDHasPrefix(v string) predicate.TodoActivity {
+	return predicate.TodoActivity(sql.FieldHasPrefix(FieldTenantID, v))
+}
+
+// TenantIDHasSuffix applies the HasSuffix predicate on the "tenant_id" field.
+func TenantIDHasSuffix(v string) predicate.TodoActivity {
+	return predicate.TodoActivity(sql.FieldHasSuffix(FieldTenantID, v))
+}
+
+// TenantIDEqualFold applies the EqualFold predicate on the "tenant_id" field.
+func TenantIDEqualFold(v string) predicate.TodoActivity {
+	return predicate.TodoActivity(sql.FieldEqualFold(FieldTenantID, v))
+}
+
+// TenantIDContainsFold applies the ContainsFold predicate on the "tenant_id" field.
+func TenantIDContainsFold(v string) predicate.TodoActivity {
+	return predicate.TodoActivity(sql.FieldContainsFold(FieldTenantID, v))
+}
+
+// TodoIDEQ applies the EQ predicate on the "todo_id" field.
+func TodoIDEQ(v string) predicate.TodoActivity {
+	return predicate.TodoActivity(sql.FieldEQ(FieldTodoID, v))
+}
+
+// TodoIDNEQ applies the NEQ predicate on the "todo_id" field.
+func TodoIDNEQ(v string) predicate.TodoActivity {
+	return predicate.TodoActivity(sql.FieldNEQ(FieldTodoID, v))
+}
+
+// TodoIDIn applies the In predicate on the "todo_id" field.
+func TodoIDIn(vs ...string) predicate.TodoActivity {
+	return predicate.TodoActivity(sql.FieldIn(FieldTodoID, vs...))
+}
+
+// TodoIDNotIn applies the NotIn predicate on the "todo_id" field.
+func TodoIDNotIn(vs ...string) predicate.TodoActivity {
+	return predicate.TodoActivity(sql.FieldNotIn(FieldTodoID, vs...))
+}
+
+// TodoIDGT applies the GT predicate on the "todo_id" field.
+func TodoIDGT(v string) predicate.TodoActivity {
+	return predicate.TodoActivity(sql.FieldGT(FieldTodoID, v))
+}
+
+// TodoIDGTE applies the GTE predicate on the "todo_id" field.
+func TodoIDGTE(v string) predicate.TodoActivity {
+	return predicate.TodoActivity(sql.FieldGTE(FieldTodoID, v))
+}
+
+// TodoIDLT applies the LT predicate on the "todo_id" field.
+func TodoIDLT(v string) predicate.TodoActivity {
+	return predicate.TodoActivity(sql.FieldLT(FieldTodoID, v))
+}
+
+// TodoIDLTE applies the LTE predicate on the "todo_id" field.
+func TodoIDLTE(v string) predicate.TodoActivity {
+	return predicate.TodoActivity(sql.FieldLTE(FieldTodoID, v))
+}
+
+// TodoIDContains applies the Contains predicate on the "todo_id" field.
+func TodoIDContains(v string) predicate.TodoActivity {
+	return predicate.TodoActivity(sql.FieldContains(FieldTodoID, v))
+}
+
+// TodoIDHasPrefix applies the HasPrefix predicate on the "todo_id" field.
+func TodoIDHasPrefix(v string) predicate.TodoActivity {
+	return predicate.TodoActivity(sql.FieldHasPrefix(FieldTodoID, v))
+}
+
+// TodoIDHasSuffix applies the HasSuffix predicate on the "todo_id" field.
+func TodoIDHasSuffix(v string) predicate.TodoActivity {
+	return predicate.TodoActivity(sql.FieldHasSuffix(FieldTodoID, v))
+}
+
+// TodoIDEqualFold applies the EqualFold predicate on the "todo_id" field.
+func TodoIDEqualFold(v string) predicate.TodoActivity {
+	return predicate.TodoActivity(sql.FieldEqualFold(FieldTodoID, v))
+}
+
+// TodoIDContainsFold applies the ContainsFold predicate on the "todo_id" field.
+func TodoIDContainsFold(v string) predicate.TodoActivity {
+	return predicate.TodoActivity(sql.FieldContainsFold(FieldTodoID, v))
+}
+
+// ActorIDEQ applies the EQ predicate on the "actor_id" field.
+func ActorIDEQ(v string) predicate.TodoActivity {
+	return predicate.TodoActivity(sql.FieldEQ(FieldActorID, v))
+}
+
+// ActorIDNEQ applies the NEQ predicate on the "actor_id" field.
+func ActorIDNEQ(v string) predicate.TodoActivity {
+	return predicate.TodoActivity(sql.FieldNEQ(FieldActorID, v))
+}
+
+// ActorIDIn applies the In predicate on the "actor_id" field.
+func ActorIDIn(vs ...string) predicate.TodoActivity {
+	return predicate.TodoActivity(sql.FieldIn(FieldActorID, vs...))
+}
+
+// ActorIDNotIn applies the NotIn predicate on the "actor_id" field.
+func ActorIDNotIn(vs ...string) predicate.TodoActivity {
+	return predicate.TodoActivity(sql.FieldNotIn(FieldActorID, vs...))
+}
+
+// ActorIDGT applies the GT predicate on the "actor_id" field.
+func ActorIDGT(v string) predicate.TodoActivity {
+	return predicate.TodoActivity(sql.FieldGT(FieldActorID, v))
+}
+
+// ActorIDGTE applies the GTE predicate on the "actor_id" field.
+func ActorIDGTE(v string) predicate.TodoActivity {
+	return predicate.TodoActivity(sql.FieldGTE(FieldActorID, v))
+}
+
+// ActorIDLT applies the LT predicate on the "actor_id" field.
+func ActorIDLT(v string) predicate.TodoActivity {
+	return predicate.TodoActivity(sql.FieldLT(FieldActorID, v))
+}
+
+// ActorIDLTE applies the LTE predicate on the "actor_id" field.
+func ActorIDLTE(v string) predicate.TodoActivity {
+	return predicate.TodoActivity(sql.FieldLTE(FieldActorID, v))
+}
+
+// ActorIDContains applies the Contains predicate on the "actor_id" field.
+func ActorIDContains(v string) predicate.TodoActivity {
+	return predicate.TodoActivity(sql.FieldContains(FieldActorID, v))
+}
+
+// ActorIDHasPrefix applies the HasPrefix predicate on the "actor_id" field.
+func ActorIDHasPrefix(v string) predicate.TodoActivity {
+	return predicate.TodoActivity(sql.FieldHasPrefix(FieldActorID, v))
+}
+
+// ActorIDHasSuffix applies the HasSuffix predicate on the "actor_id" field.
+func ActorIDHasSuffix(v string) predicate.TodoActivity {
+	return predicate.TodoActivity(sql.FieldHasSuffix(FieldActorID, v))
+}
+
+// ActorIDEqualFold applies the EqualFold predicate on the "actor_id" field.
+func ActorIDEqualFold(v string) predicate.TodoActivity {
+	return predicate.TodoActivity(sql.FieldEqualFold(FieldActorID, v))
+}
+
+// ActorIDContainsFold applies the ContainsFold predicate on the "actor_id" field.
+func ActorIDContainsFold(v string) predicate.TodoActivity {
+	return predicate.TodoActivity(sql.FieldContainsFold(FieldActorID, v))
+}
+
+// ActionEQ applies the EQ predicate on the "action" field.
+func ActionEQ(v Action) predicate.TodoActivity {
+	return predicate.TodoActivity(sql.FieldEQ(FieldAction, v))
+}
+
+// ActionNEQ applies the NEQ predicate on the "action" field.
+func ActionNEQ(v Action) predicate.TodoActivity {
+	return predicate.TodoActivity(sql.FieldNEQ(FieldAction, v))
+}
+
+// ActionIn applies the In predicate on the "action" field.
+func ActionIn(vs ...Action) predicate.TodoActivity {
+	return predicate.TodoActivity(sql.FieldIn(FieldAction, vs...))
+}
+
+// ActionNotIn applies the NotIn predicate on the "action" field.
+func ActionNotIn(vs ...Action) predicate.TodoActivity {
+	return predicate.TodoActivity(sql.FieldNotIn(FieldAction, vs...))
+}
+
+// SnoozedUntilEQ applies the EQ predicate on the "snoozed_until" field.
+func SnoozedUntilEQ(v time.Time) predicate.TodoActivity {
+	return predicate.TodoActivity(sql.FieldEQ(FieldSnoozedUntil, v))
+}
+
+// SnoozedUntilNEQ applies the NEQ predicate on the "snoozed_until" field.
+func SnoozedUntilNEQ(v time.Time) predicate.TodoActivity {
+	return predicate.TodoActivity(sql.FieldNEQ(FieldSnoozedUntil, v))
+}
+
+// SnoozedUntilIn applies the In predicate on the "snoozed_until" field.
+func SnoozedUntilIn(vs ...time.Time) predicate.TodoActivity {
+	return predicate.TodoActivity(sql.FieldIn(FieldSnoozedUntil, vs...))
+}
+
+// SnoozedUntilNotIn applies the NotIn predicate on the "snoozed_until" field.
+func SnoozedUntilNotIn(vs ...time.Time) predicate.TodoActivity {
+	return predicate.TodoActivity(sql.FieldNotIn(FieldSnoozedUntil, vs...))
+}
+
+// SnoozedUntilGT applies the GT predicate on the "snoozed_until" field.
+func SnoozedUntilGT(v time.Time) predicate.TodoActivity {
+	return predicate.TodoActivity(sql.FieldGT(FieldSnoozedUntil, v))
+}
+
+// SnoozedUntilGTE applies the GTE predicate on the "snoozed_until" field.
+func SnoozedUntilGTE(v time.Time) predicate.TodoActivity {
+	return predicate.TodoActivity(sql.FieldGTE(FieldSnoozedUntil, v))
+}
+
+// SnoozedUntilLT applies the LT predicate on the "snoozed_until" field.
+func SnoozedUntilLT(v time.Time) predicate.TodoActivity {
+	return predicate.TodoActivity(sql.FieldLT(FieldSnoozedUntil, v))
+}
+
+// SnoozedUntilLTE applies the LTE predicate on the "snoozed_until" field.
+func SnoozedUntilLTE(v time.Time) predicate.TodoActivity {
+	return predicate.TodoActivity(sql.FieldLTE(FieldSnoozedUntil, v))
+}
+
+// SnoozedUntilIsNil applies the IsNil predicate on the "snoozed_until" field.
+func SnoozedUntilIsNil() predicate.TodoActivity {
+	return predicate.TodoActivity(sql.FieldIsNull(FieldSnoozedUntil))
+}
+
+// SnoozedUntilNotNil applies the NotNil predicate on the "snoozed_until" field.
+func SnoozedUntilNotNil() predicate.TodoActivity {
+	return predicate.TodoActivity(sql.FieldNotNull(FieldSnoozedUntil))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.TodoActivity {
+	return predicate.TodoActivity(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.TodoActivity {
+	return predicate.TodoActivity(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.TodoActivity {
+	return predicate.TodoActivity(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.TodoActivity {
+	return predicate.TodoActivity(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.TodoActivity {
+	return predicate.TodoActivity(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.TodoActivity {
+	return predicate.TodoActivity(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.TodoActivity {
+	return predicate.TodoActivity(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.TodoActivity {
+	return predicate.TodoActivity(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasTenant applies the HasEdge predicate on the "tenant" edge.
+func HasTenant() predicate.TodoActivity {
+	return predicate.TodoActivity(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, TenantTable, TenantColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTenantWith applies the HasEdge predicate on the "tenant" edge with a given conditions (other predicates).
+func HasTenantWith(preds ...predicate.Tenant) predicate.TodoActivity {
+	return predicate.TodoActivity(func(s *sql.Selector) {
+		step := newTenantStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTodo applies the HasEdge predicate on the "todo" edge.
+func HasTodo() predicate.TodoActivity {
+	return predicate.TodoActivity(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, TodoTable, TodoColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTodoWith applies the HasEdge predicate on the "todo" edge with a given conditions (other predicates).
+func HasTodoWith(preds ...predicate.Todo) predicate.TodoActivity {
+	return predicate.TodoActivity(func(s *sql.Selector) {
+		step := newTodoStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasActor applies the HasEdge predicate on the "actor" edge.
+func HasActor() predicate.TodoActivity {
+	return predicate.TodoActivity(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ActorTable, ActorColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasActorWith applies the HasEdge predicate on the "actor" edge with a given conditions (other predicates).
+func HasActorWith(preds ...predicate.User) predicate.TodoActivity {
+	return predicate.TodoActivity(func(s *sql.Selector) {
+		step := newActorStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TodoActivity) predicate.TodoActivity {
+	return predicate.TodoActivity(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TodoActivity) predicate.TodoActivity {
+	return predicate.TodoActivity(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TodoActivity) predicate.TodoActivity {
+	return predicate.TodoActivity(sql.NotPredicates(p))
+}
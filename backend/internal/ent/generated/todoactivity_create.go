@@ -0,0 +1,665 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"good-todo-go/internal/ent/generated/tenant"
+	"good-todo-go/internal/ent/generated/todo"
+	"good-todo-go/internal/ent/generated/todoactivity"
+	"good-todo-go/internal/ent/generated/user"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TodoActivityCreate is the builder for creating a TodoActivity entity.
+type TodoActivityCreate struct {
+	config
+	mutation *TodoActivityMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *TodoActivityCreate) SetTenantID(v string) *TodoActivityCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetTodoID sets the "todo_id" field.
+func (_c *TodoActivityCreate) SetTodoID(v string) *TodoActivityCreate {
+	_c.mutation.SetTodoID(v)
+	return _c
+}
+
+// SetActorID sets the "actor_id" field.
+func (_c *TodoActivityCreate) SetActorID(v string) *TodoActivityCreate {
+	_c.mutation.SetActorID(v)
+	return _c
+}
+
+// SetAction sets the "action" field.
+func (_c *TodoActivityCreate) SetAction(v todoactivity.Action) *TodoActivityCreate {
+	_c.mutation.SetAction(v)
+	return _c
+}
+
+// SetSnoozedUntil sets the "snoozed_until" field.
+func (_c *TodoActivityCreate) SetSnoozedUntil(v time.Time) *TodoActivityCreate {
+	_c.mutation.SetSnoozedUntil(v)
+	return _c
+}
+
+// SetNillableSnoozedUntil sets the "snoozed_until" field if the given value is not nil.
+func (_c *TodoActivityCreate) SetNillableSnoozedUntil(v *time.Time) *TodoActivityCreate {
+	if v != nil {
+		_c.SetSnoozedUntil(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *TodoActivityCreate) SetCreatedAt(v time.Time) *TodoActivityCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *TodoActivityCreate) SetNillableCreatedAt(v *time.Time) *TodoActivityCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *TodoActivityCreate) SetID(v string) *TodoActivityCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (_c *TodoActivityCreate) SetTenant(v *Tenant) *TodoActivityCreate {
+	return _c.SetTenantID(v.ID)
+}
+
+// SetTodo sets the "todo" edge to the Todo entity.
+func (_c *TodoActivityCreate) SetTodo(v *Todo) *TodoActivityCreate {
+	return _c.SetTodoID(v.ID)
+}
+
+// SetActor sets the "actor" edge to the User entity.
+func (_c *TodoActivityCreate) SetActor(v *User) *TodoActivityCreate {
+	return _c.SetActorID(v.ID)
+}
+
+// Mutation returns the TodoActivityMutation object of the builder.
+func (_c *TodoActivityCreate) Mutation() *TodoActivityMutation {
+	return _c.mutation
+}
+
+// Save creates the TodoActivity in the database.
+func (_c *TodoActivityCreate) Save(ctx context.Context) (*TodoActivity, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *TodoActivityCreate) SaveX(ctx context.Context) *TodoActivity {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TodoActivityCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TodoActivityCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *TodoActivityCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := todoactivity.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *TodoActivityCreate) check() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`generated: missing required field "TodoActivity.tenant_id"`)}
+	}
+	if v, ok := _c.mutation.TenantID(); ok {
+		if err := todoactivity.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`generated: validator failed for field "TodoActivity.tenant_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.TodoID(); !ok {
+		return &ValidationError{Name: "todo_id", err: errors.New(`generated: missing required field "TodoActivity.todo_id"`)}
+	}
+	if v, ok := _c.mutation.TodoID(); ok {
+		if err := todoactivity.TodoIDValidator(v); err != nil {
+			return &ValidationError{Name: "todo_id", err: fmt.Errorf(`generated: validator failed for field "TodoActivity.todo_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ActorID(); !ok {
+		return &ValidationError{Name: "actor_id", err: errors.New(`generated: missing required field "TodoActivity.actor_id"`)}
+	}
+	if v, ok := _c.mutation.ActorID(); ok {
+		if err := todoactivity.ActorIDValidator(v); err != nil {
+			return &ValidationError{Name: "actor_id", err: fmt.Errorf(`generated: validator failed for field "TodoActivity.actor_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Action(); !ok {
+		return &ValidationError{Name: "action", err: errors.New(`generated: missing required field "TodoActivity.action"`)}
+	}
+	if v, ok := _c.mutation.Action(); ok {
+		if err := todoactivity.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`generated: validator failed for field "TodoActivity.action": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`generated: missing required field "TodoActivity.created_at"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := todoactivity.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`generated: validator failed for field "TodoActivity.id": %w`, err)}
+		}
+	}
+	if len(_c.mutation.TenantIDs()) == 0 {
+		return &ValidationError{Name: "tenant", err: errors.New(`generated: missing required edge "TodoActivity.tenant"`)}
+	}
+	if len(_c.mutation.TodoIDs()) == 0 {
+		return &ValidationError{Name: "todo", err: errors.New(`generated: missing required edge "TodoActivity.todo"`)}
+	}
+	if len(_c.mutation.ActorIDs()) == 0 {
+		return &ValidationError{Name: "actor", err: errors.New(`generated: missing required edge "TodoActivity.actor"`)}
+	}
+	return nil
+}
+
+func (_c *TodoActivityCreate) sqlSave(ctx context.Context) (*TodoActivity, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected TodoActivity.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *TodoActivityCreate) createSpec() (*TodoActivity, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TodoActivity{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(todoactivity.Table, sqlgraph.NewFieldSpec(todoactivity.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.Action(); ok {
+		_spec.SetField(todoactivity.FieldAction, field.TypeEnum, value)
+		_node.Action = value
+	}
+	if value, ok := _c.mutation.SnoozedUntil(); ok {
+		_spec.SetField(todoactivity.FieldSnoozedUntil, field.TypeTime, value)
+		_node.SnoozedUntil = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(todoactivity.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todoactivity.TenantTable,
+			Columns: []string{todoactivity.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TenantID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.TodoIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todoactivity.TodoTable,
+			Columns: []string{todoactivity.TodoColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todo.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TodoID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ActorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todoactivity.ActorTable,
+			Columns: []string{todoactivity.ActorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ActorID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TodoActivity.Create().
+//		SetTenantID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TodoActivityUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *TodoActivityCreate) OnConflict(opts ...sql.ConflictOption) *TodoActivityUpsertOne {
+	_c.conflict = opts
+	return &TodoActivityUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TodoActivity.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *TodoActivityCreate) OnConflictColumns(columns ...string) *TodoActivityUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &TodoActivityUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// TodoActivityUpsertOne is the builder for "upsert"-ing
+	//  one TodoActivity node.
+	TodoActivityUpsertOne struct {
+		create *TodoActivityCreate
+	}
+
+	// TodoActivityUpsert is the "OnConflict" setter.
+	TodoActivityUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.TodoActivity.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(todoactivity.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TodoActivityUpsertOne) UpdateNewValues() *TodoActivityUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(todoactivity.FieldID)
+		}
+		if _, exists := u.create.mutation.TenantID(); exists {
+			s.SetIgnore(todoactivity.FieldTenantID)
+		}
+		if _, exists := u.create.mutation.TodoID(); exists {
+			s.SetIgnore(todoactivity.FieldTodoID)
+		}
+		if _, exists := u.create.mutation.ActorID(); exists {
+			s.SetIgnore(todoactivity.FieldActorID)
+		}
+		if _, exists := u.create.mutation.Action(); exists {
+			s.SetIgnore(todoactivity.FieldAction)
+		}
+		if _, exists := u.create.mutation.SnoozedUntil(); exists {
+			s.SetIgnore(todoactivity.FieldSnoozedUntil)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(todoactivity.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TodoActivity.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *TodoActivityUpsertOne) Ignore() *TodoActivityUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TodoActivityUpsertOne) DoNothing() *TodoActivityUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TodoActivityCreate.OnConflict
+// documentation for more info.
+func (u *TodoActivityUpsertOne) Update(set func(*TodoActivityUpsert)) *TodoActivityUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TodoActivityUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *TodoActivityUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("generated: missing options for TodoActivityCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TodoActivityUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *TodoActivityUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("generated: TodoActivityUpsertOne.ID is not supported by MySQL driver. Use TodoActivityUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *TodoActivityUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// TodoActivityCreateBulk is the builder for creating many TodoActivity entities in bulk.
+type TodoActivityCreateBulk struct {
+	config
+	err      error
+	builders []*TodoActivityCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the TodoActivity entities in the database.
+func (_c *TodoActivityCreateBulk) Save(ctx context.Context) ([]*TodoActivity, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*TodoActivity, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TodoActivityMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *TodoActivityCreateBulk) SaveX(ctx context.Context) []*TodoActivity {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TodoActivityCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TodoActivityCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TodoActivity.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TodoActivityUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *TodoActivityCreateBulk) OnConflict(opts ...sql.ConflictOption) *TodoActivityUpsertBulk {
+	_c.conflict = opts
+	return &TodoActivityUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TodoActivity.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *TodoActivityCreateBulk) OnConflictColumns(columns ...string) *TodoActivityUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &TodoActivityUpsertBulk{
+		create: _c,
+	}
+}
+
+// TodoActivityUpsertBulk is the builder for "upsert"-ing
+// a bulk of TodoActivity nodes.
+type TodoActivityUpsertBulk struct {
+	create *TodoActivityCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.TodoActivity.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(todoactivity.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TodoActivityUpsertBulk) UpdateNewValues() *TodoActivityUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(todoactivity.FieldID)
+			}
+			if _, exists := b.mutation.TenantID(); exists {
+				s.SetIgnore(todoactivity.FieldTenantID)
+			}
+			if _, exists := b.mutation.TodoID(); exists {
+				s.SetIgnore(todoactivity.FieldTodoID)
+			}
+			if _, exists := b.mutation.ActorID(); exists {
+				s.SetIgnore(todoactivity.FieldActorID)
+			}
+			if _, exists := b.mutation.Action(); exists {
+				s.SetIgnore(todoactivity.FieldAction)
+			}
+			if _, exists := b.mutation.SnoozedUntil(); exists {
+				s.SetIgnore(todoactivity.FieldSnoozedUntil)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(todoactivity.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TodoActivity.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *TodoActivityUpsertBulk) Ignore() *TodoActivityUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TodoActivityUpsertBulk) DoNothing() *TodoActivityUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TodoActivityCreateBulk.OnConflict
+// documentation for more info.
+func (u *TodoActivityUpsertBulk) Update(set func(*TodoActivityUpsert)) *TodoActivityUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TodoActivityUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *TodoActivityUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("generated: OnConflict was set for builder %d. Set it on the TodoActivityCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("generated: missing options for TodoActivityCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TodoActivityUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,215 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"good-todo-go/internal/ent/generated/predicate"
+	"good-todo-go/internal/ent/generated/todoactivity"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TodoActivityUpdate is the builder for updating TodoActivity entities.
+type TodoActivityUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TodoActivityMutation
+}
+
+// Where appends a list predicates to the TodoActivityUpdate builder.
+func (_u *TodoActivityUpdate) Where(ps ...predicate.TodoActivity) *TodoActivityUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Mutation returns the TodoActivityMutation object of the builder.
+func (_u *TodoActivityUpdate) Mutation() *TodoActivityMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *TodoActivityUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TodoActivityUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *TodoActivityUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TodoActivityUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TodoActivityUpdate) check() error {
+	if _u.mutation.TenantCleared() && len(_u.mutation.TenantIDs()) > 0 {
+		return errors.New(`generated: clearing a required unique edge "TodoActivity.tenant"`)
+	}
+	if _u.mutation.TodoCleared() && len(_u.mutation.TodoIDs()) > 0 {
+		return errors.New(`generated: clearing a required unique edge "TodoActivity.todo"`)
+	}
+	if _u.mutation.ActorCleared() && len(_u.mutation.ActorIDs()) > 0 {
+		return errors.New(`generated: clearing a required unique edge "TodoActivity.actor"`)
+	}
+	return nil
+}
+
+func (_u *TodoActivityUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(todoactivity.Table, todoactivity.Columns, sqlgraph.NewFieldSpec(todoactivity.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.SnoozedUntilCleared() {
+		_spec.ClearField(todoactivity.FieldSnoozedUntil, field.TypeTime)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{todoactivity.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// TodoActivityUpdateOne is the builder for updating a single TodoActivity entity.
+type TodoActivityUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TodoActivityMutation
+}
+
+// Mutation returns the TodoActivityMutation object of the builder.
+func (_u *TodoActivityUpdateOne) Mutation() *TodoActivityMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the TodoActivityUpdate builder.
+func (_u *TodoActivityUpdateOne) Where(ps ...predicate.TodoActivity) *TodoActivityUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *TodoActivityUpdateOne) Select(field string, fields ...string) *TodoActivityUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated TodoActivity entity.
+func (_u *TodoActivityUpdateOne) Save(ctx context.Context) (*TodoActivity, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TodoActivityUpdateOne) SaveX(ctx context.Context) *TodoActivity {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *TodoActivityUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TodoActivityUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TodoActivityUpdateOne) check() error {
+	if _u.mutation.TenantCleared() && len(_u.mutation.TenantIDs()) > 0 {
+		return errors.New(`generated: clearing a required unique edge "TodoActivity.tenant"`)
+	}
+	if _u.mutation.TodoCleared() && len(_u.mutation.TodoIDs()) > 0 {
+		return errors.New(`generated: clearing a required unique edge "TodoActivity.todo"`)
+	}
+	if _u.mutation.ActorCleared() && len(_u.mutation.ActorIDs()) > 0 {
+		return errors.New(`generated: clearing a required unique edge "TodoActivity.actor"`)
+	}
+	return nil
+}
+
+func (_u *TodoActivityUpdateOne) sqlSave(ctx context.Context) (_node *TodoActivity, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(todoactivity.Table, todoactivity.Columns, sqlgraph.NewFieldSpec(todoactivity.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`generated: missing "TodoActivity.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, todoactivity.FieldID)
+		for _, f := range fields {
+			if !todoactivity.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("generated: invalid field %q for query", f)}
+			}
+			if f != todoactivity.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.SnoozedUntilCleared() {
+		_spec.ClearField(todoactivity.FieldSnoozedUntil, field.TypeTime)
+	}
+	_node = &TodoActivity{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{todoactivity.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,14 @@
+-- Create "tenant_slug_histories" table
+CREATE TABLE "tenant_slug_histories" (
+  "id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "slug" character varying NOT NULL,
+  "expires_at" timestamptz NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  "tenant_id" character varying NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "tenant_slug_histories_tenants_slug_histories" FOREIGN KEY ("tenant_id") REFERENCES "tenants" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION
+);
+-- Create index "tenant_slug_histories_slug_key" to table: "tenant_slug_histories"
+CREATE UNIQUE INDEX "tenant_slug_histories_slug_key" ON "tenant_slug_histories" ("slug");
+
+-- Like "tenants", slug history is read before the tenant is known (login), so RLS is not enabled
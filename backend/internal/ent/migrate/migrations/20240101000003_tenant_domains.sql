@@ -0,0 +1,15 @@
+-- Create "tenant_domains" table
+CREATE TABLE "tenant_domains" (
+  "id" character varying NOT NULL,
+  "domain" character varying NOT NULL,
+  "verification_token" character varying NOT NULL,
+  "verified_at" timestamptz NULL,
+  "created_at" timestamptz NOT NULL,
+  "tenant_id" character varying NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "tenant_domains_tenants_domains" FOREIGN KEY ("tenant_id") REFERENCES "tenants" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION
+);
+-- Create index "tenant_domains_domain_key" to table: "tenant_domains"
+CREATE UNIQUE INDEX "tenant_domains_domain_key" ON "tenant_domains" ("domain");
+
+-- Custom domains are resolved from the Host header before the tenant is known, so RLS is not enabled
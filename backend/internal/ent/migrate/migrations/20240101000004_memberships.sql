@@ -0,0 +1,102 @@
+-- Create "memberships" table
+CREATE TABLE "memberships" (
+  "id" character varying NOT NULL,
+  "role" character varying NOT NULL DEFAULT 'member',
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  "tenant_id" character varying NOT NULL,
+  "user_id" character varying NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "memberships_tenants_memberships" FOREIGN KEY ("tenant_id") REFERENCES "tenants" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION,
+  CONSTRAINT "memberships_users_memberships" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION DEFERRABLE INITIALLY DEFERRED
+);
+-- Create index "membership_tenant_id_user_id" to table: "memberships"
+CREATE UNIQUE INDEX "membership_tenant_id_user_id" ON "memberships" ("tenant_id", "user_id");
+
+-- Users become global identities keyed by email. Accounts sharing an email across tenants
+-- are merged into one: the first verified account, or the first one if none is verified,
+-- survives with its password and name, and takes over the others' tenants and todos.
+CREATE TEMPORARY TABLE "user_merges" AS
+SELECT "id", first_value("id") OVER (
+    PARTITION BY "email" ORDER BY "email_verified" DESC, "created_at", "id"
+) AS "survivor_id"
+FROM "users";
+
+-- Every existing user becomes a member of the tenant it was created in, keeping its role.
+-- Merged accounts are unique per tenant, so the survivor gets one membership per tenant.
+INSERT INTO "memberships" ("id", "role", "created_at", "updated_at", "tenant_id", "user_id")
+SELECT gen_random_uuid()::text, u."role", u."created_at", u."updated_at", u."tenant_id", m."survivor_id"
+FROM "users" u
+JOIN "user_merges" m ON m."id" = u."id";
+
+-- Todos of merged accounts move to the survivor. RLS is lifted for the migration owner
+-- while rewriting them, as no tenant is set.
+ALTER TABLE "todos" NO FORCE ROW LEVEL SECURITY;
+UPDATE "todos" t SET "user_id" = m."survivor_id"
+FROM "user_merges" m
+WHERE t."user_id" = m."id" AND m."id" <> m."survivor_id";
+ALTER TABLE "todos" FORCE ROW LEVEL SECURITY;
+
+DELETE FROM "users" u
+USING "user_merges" m
+WHERE u."id" = m."id" AND m."id" <> m."survivor_id";
+DROP TABLE "user_merges";
+
+-- Users are now global identities
+DROP POLICY "users_tenant_isolation" ON "users";
+DROP INDEX "user_tenant_id_email";
+ALTER TABLE "users" DROP CONSTRAINT "users_tenants_users", DROP COLUMN "tenant_id", DROP COLUMN "role";
+-- Create index "users_email_key" to table: "users"
+CREATE UNIQUE INDEX "users_email_key" ON "users" ("email");
+
+-- Enable RLS on memberships table
+ALTER TABLE "memberships" ENABLE ROW LEVEL SECURITY;
+
+-- Memberships table policy (allowing empty tenant_id to list a user's tenants before one is chosen)
+CREATE POLICY "memberships_tenant_isolation" ON "memberships"
+    FOR ALL
+    USING (
+        "tenant_id" = current_setting('app.current_tenant_id', true)
+        OR
+        current_setting('app.current_tenant_id', true) = ''
+        OR
+        current_setting('app.current_tenant_id', true) IS NULL
+    )
+    WITH CHECK (
+        "tenant_id" = current_setting('app.current_tenant_id', true)
+    );
+
+-- A user is visible in a tenant it is a member of. The foreign key from memberships to
+-- users is deferred, so a registration inserts the membership first and the user passes.
+-- SECURITY DEFINER lets the check see memberships of other tenants despite their RLS.
+CREATE FUNCTION "user_visible_in_current_tenant"(uid character varying) RETURNS boolean
+    LANGUAGE sql STABLE SECURITY DEFINER SET search_path = public
+AS $$
+    SELECT EXISTS (
+        SELECT 1 FROM "memberships" m
+        WHERE m."user_id" = uid
+          AND m."tenant_id" = current_setting('app.current_tenant_id', true)
+    )
+$$;
+
+-- Users table policy (allowing empty tenant_id for login and email verification).
+-- A tenant session only writes users that are members of its tenant.
+CREATE POLICY "users_membership_isolation" ON "users"
+    FOR ALL
+    USING (
+        "user_visible_in_current_tenant"("id")
+        OR
+        current_setting('app.current_tenant_id', true) = ''
+        OR
+        current_setting('app.current_tenant_id', true) IS NULL
+    )
+    WITH CHECK (
+        "user_visible_in_current_tenant"("id")
+        OR
+        current_setting('app.current_tenant_id', true) = ''
+        OR
+        current_setting('app.current_tenant_id', true) IS NULL
+    );
+
+-- Force RLS for app_user (bypass for table owner/superuser)
+ALTER TABLE "memberships" FORCE ROW LEVEL SECURITY;
@@ -0,0 +1,4 @@
+-- Create index "todo_tenant_id_user_id_created_at_id" to table: "todos"
+CREATE INDEX "todo_tenant_id_user_id_created_at_id" ON "todos" ("tenant_id", "user_id", "created_at", "id");
+-- Create index "todo_tenant_id_is_public_created_at_id" to table: "todos"
+CREATE INDEX "todo_tenant_id_is_public_created_at_id" ON "todos" ("tenant_id", "is_public", "created_at", "id");
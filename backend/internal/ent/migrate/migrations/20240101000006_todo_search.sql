@@ -0,0 +1,16 @@
+-- Trigram matching backs the search fallback for short or partial words
+CREATE EXTENSION IF NOT EXISTS "pg_trgm";
+
+-- Add generated column "search_vector" to table: "todos"
+-- Maintained by PostgreSQL and not part of the Ent schema, so Ent never writes it
+ALTER TABLE "todos" ADD COLUMN "search_vector" tsvector GENERATED ALWAYS AS (
+  setweight(to_tsvector('simple', coalesce("title", '')), 'A') ||
+  setweight(to_tsvector('simple', coalesce("description", '')), 'B')
+) STORED;
+
+-- Create index "todos_search_vector_idx" to table: "todos"
+CREATE INDEX "todos_search_vector_idx" ON "todos" USING GIN ("search_vector");
+-- Create index "todos_title_trgm_idx" to table: "todos"
+CREATE INDEX "todos_title_trgm_idx" ON "todos" USING GIN ("title" gin_trgm_ops);
+-- Create index "todos_description_trgm_idx" to table: "todos"
+CREATE INDEX "todos_description_trgm_idx" ON "todos" USING GIN ("description" gin_trgm_ops);
@@ -0,0 +1,2 @@
+-- Add column "version" to table: "todos"
+ALTER TABLE "todos" ADD COLUMN "version" bigint NOT NULL DEFAULT 1;
@@ -0,0 +1,6 @@
+-- Add column "deleted_at" to table: "todos"
+ALTER TABLE "todos" ADD COLUMN "deleted_at" timestamptz NULL;
+-- Create index "todo_tenant_id_deleted_at" to table: "todos"
+CREATE INDEX "todo_tenant_id_deleted_at" ON "todos" ("tenant_id", "deleted_at");
+-- Add column "trash_retention_days" to table: "tenants"
+ALTER TABLE "tenants" ADD COLUMN "trash_retention_days" bigint NOT NULL DEFAULT 30;
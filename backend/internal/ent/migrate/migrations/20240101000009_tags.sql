@@ -0,0 +1,56 @@
+-- Create "tags" table
+CREATE TABLE "tags" (
+  "id" character varying NOT NULL,
+  "name" character varying NOT NULL,
+  "color" character varying NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  "tenant_id" character varying NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "tags_tenants_tags" FOREIGN KEY ("tenant_id") REFERENCES "tenants" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION
+);
+-- Create index "tags_tenant_id_lower_name_key" to table: "tags"
+-- Tag names are unique per tenant ignoring case; Ent cannot express an index on an expression
+CREATE UNIQUE INDEX "tags_tenant_id_lower_name_key" ON "tags" ("tenant_id", lower("name"));
+
+-- Create "tag_todos" table
+CREATE TABLE "tag_todos" (
+  "tag_id" character varying NOT NULL,
+  "todo_id" character varying NOT NULL,
+  PRIMARY KEY ("tag_id", "todo_id"),
+  CONSTRAINT "tag_todos_tag_id" FOREIGN KEY ("tag_id") REFERENCES "tags" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "tag_todos_todo_id" FOREIGN KEY ("todo_id") REFERENCES "todos" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "tag_todos_todo_id" to table: "tag_todos"
+-- Loading the tags of a page of todos looks the join rows up by todo
+CREATE INDEX "tag_todos_todo_id" ON "tag_todos" ("todo_id");
+
+-- Enable RLS on tags table
+ALTER TABLE "tags" ENABLE ROW LEVEL SECURITY;
+
+-- Tags table policy
+CREATE POLICY "tags_tenant_isolation" ON "tags"
+    FOR ALL
+    USING ("tenant_id" = current_setting('app.current_tenant_id', true))
+    WITH CHECK ("tenant_id" = current_setting('app.current_tenant_id', true));
+
+-- Enable RLS on tag_todos table
+ALTER TABLE "tag_todos" ENABLE ROW LEVEL SECURITY;
+
+-- The join table has no tenant_id of its own. Both lookups below run under the RLS of
+-- "tags" and "todos", so a row is only visible, and can only be written, when the tag
+-- and the todo both belong to the current tenant.
+CREATE POLICY "tag_todos_tenant_isolation" ON "tag_todos"
+    FOR ALL
+    USING (
+        EXISTS (SELECT 1 FROM "tags" t WHERE t."id" = "tag_id")
+    )
+    WITH CHECK (
+        EXISTS (SELECT 1 FROM "tags" t WHERE t."id" = "tag_id")
+        AND
+        EXISTS (SELECT 1 FROM "todos" t WHERE t."id" = "todo_id")
+    );
+
+-- Force RLS for app_user (bypass for table owner/superuser)
+ALTER TABLE "tags" FORCE ROW LEVEL SECURITY;
+ALTER TABLE "tag_todos" FORCE ROW LEVEL SECURITY;
@@ -0,0 +1,39 @@
+-- Create "projects" table
+CREATE TABLE "projects" (
+  "id" character varying NOT NULL,
+  "name" character varying NOT NULL,
+  "description" text NULL DEFAULT '',
+  "color" character varying NOT NULL,
+  "archived" boolean NOT NULL DEFAULT false,
+  "is_public" boolean NOT NULL DEFAULT false,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  "tenant_id" character varying NOT NULL,
+  "user_id" character varying NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "projects_tenants_projects" FOREIGN KEY ("tenant_id") REFERENCES "tenants" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION,
+  CONSTRAINT "projects_users_projects" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION
+);
+-- Create index "project_tenant_id_user_id" to table: "projects"
+CREATE INDEX "project_tenant_id_user_id" ON "projects" ("tenant_id", "user_id");
+-- Create index "project_tenant_id_is_public" to table: "projects"
+CREATE INDEX "project_tenant_id_is_public" ON "projects" ("tenant_id", "is_public");
+
+-- Add column "project_id" to table: "todos"
+-- Deleting a project moves its todos back to the inbox
+ALTER TABLE "todos" ADD COLUMN "project_id" character varying NULL,
+  ADD CONSTRAINT "todos_projects_todos" FOREIGN KEY ("project_id") REFERENCES "projects" ("id") ON UPDATE NO ACTION ON DELETE SET NULL;
+-- Create index "todo_project_id" to table: "todos"
+CREATE INDEX "todo_project_id" ON "todos" ("project_id");
+
+-- Enable RLS on projects table
+ALTER TABLE "projects" ENABLE ROW LEVEL SECURITY;
+
+-- Projects table policy
+CREATE POLICY "projects_tenant_isolation" ON "projects"
+    FOR ALL
+    USING ("tenant_id" = current_setting('app.current_tenant_id', true))
+    WITH CHECK ("tenant_id" = current_setting('app.current_tenant_id', true));
+
+-- Force RLS for app_user (bypass for table owner/superuser)
+ALTER TABLE "projects" FORCE ROW LEVEL SECURITY;
@@ -0,0 +1,7 @@
+-- Add columns "parent_id" and "auto_complete" to table: "todos"
+-- Purging a parent turns its remaining subtasks into top-level todos
+ALTER TABLE "todos" ADD COLUMN "auto_complete" boolean NOT NULL DEFAULT false,
+  ADD COLUMN "parent_id" character varying NULL,
+  ADD CONSTRAINT "todos_todos_subtasks" FOREIGN KEY ("parent_id") REFERENCES "todos" ("id") ON UPDATE NO ACTION ON DELETE SET NULL;
+-- Create index "todo_parent_id" to table: "todos"
+CREATE INDEX "todo_parent_id" ON "todos" ("parent_id");
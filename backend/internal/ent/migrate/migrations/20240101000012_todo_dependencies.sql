@@ -0,0 +1,31 @@
+-- Create "todo_dependencies" table
+-- A row means todo_id is blocked by blocker_id. The repository rejects rows that would close a cycle.
+CREATE TABLE "todo_dependencies" (
+  "todo_id" character varying NOT NULL,
+  "blocker_id" character varying NOT NULL,
+  PRIMARY KEY ("todo_id", "blocker_id"),
+  CONSTRAINT "todo_dependencies_todo_id" FOREIGN KEY ("todo_id") REFERENCES "todos" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "todo_dependencies_blocker_id" FOREIGN KEY ("blocker_id") REFERENCES "todos" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "todo_dependencies_blocker_id" to table: "todo_dependencies"
+-- Counting the todos a todo blocks looks the rows up by blocker
+CREATE INDEX "todo_dependencies_blocker_id" ON "todo_dependencies" ("blocker_id");
+
+-- Enable RLS on todo_dependencies table
+ALTER TABLE "todo_dependencies" ENABLE ROW LEVEL SECURITY;
+
+-- Like tag_todos, the table has no tenant_id of its own. A row is visible when its todo is,
+-- and can only be written when both todos belong to the current tenant.
+CREATE POLICY "todo_dependencies_tenant_isolation" ON "todo_dependencies"
+    FOR ALL
+    USING (
+        EXISTS (SELECT 1 FROM "todos" t WHERE t."id" = "todo_id")
+    )
+    WITH CHECK (
+        EXISTS (SELECT 1 FROM "todos" t WHERE t."id" = "todo_id")
+        AND
+        EXISTS (SELECT 1 FROM "todos" t WHERE t."id" = "blocker_id")
+    );
+
+-- Force RLS for app_user (bypass for table owner/superuser)
+ALTER TABLE "todo_dependencies" FORCE ROW LEVEL SECURITY;
@@ -0,0 +1,14 @@
+-- Add column "position" to table: "todos"
+-- Keys compare byte-wise, so the column uses the "C" collation whatever the database default
+ALTER TABLE "todos" ADD COLUMN "position" character varying COLLATE "C" NOT NULL DEFAULT '';
+-- Number existing todos newest first, as they were listed; "g" heads a seven-digit integer key
+-- and hex digits are valid base-62 digits in the same order
+UPDATE "todos" SET "position" = 'g' || lpad(to_hex(ranked.n), 7, '0')
+FROM (
+  SELECT "id", row_number() OVER (PARTITION BY "tenant_id" ORDER BY "created_at" DESC, "id" DESC) AS n
+  FROM "todos"
+) AS ranked
+WHERE "todos"."id" = ranked."id";
+ALTER TABLE "todos" ALTER COLUMN "position" DROP DEFAULT;
+-- Create index "todo_tenant_id_position" to table: "todos"
+CREATE INDEX "todo_tenant_id_position" ON "todos" ("tenant_id", "position");
@@ -0,0 +1,8 @@
+-- Create enum type "todo_priority"; values are declared lowest first so they sort by urgency
+CREATE TYPE "todo_priority" AS ENUM ('none', 'low', 'medium', 'high', 'urgent');
+-- Add column "priority" to table: "todos"
+ALTER TABLE "todos" ADD COLUMN "priority" "todo_priority" NOT NULL DEFAULT 'none';
+-- Create index "todo_tenant_id_user_id_priority" to table: "todos"
+CREATE INDEX "todo_tenant_id_user_id_priority" ON "todos" ("tenant_id", "user_id", "priority");
+-- Add column "timezone" to table: "users"
+ALTER TABLE "users" ADD COLUMN "timezone" character varying NOT NULL DEFAULT 'UTC';
@@ -0,0 +1,3 @@
+-- Add columns "recurrence_rule" and "recurrence_timezone" to table: "todos"
+-- The rule is an RFC 5545 RRULE expanded from due_date in the wall clock of the timezone
+ALTER TABLE "todos" ADD COLUMN "recurrence_rule" character varying NULL, ADD COLUMN "recurrence_timezone" character varying NULL;
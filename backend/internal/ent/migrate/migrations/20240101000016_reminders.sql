@@ -0,0 +1,36 @@
+-- Create "reminders" table
+-- Reminders go with their todo when it is purged
+CREATE TABLE "reminders" (
+  "id" character varying NOT NULL,
+  "remind_at" timestamptz NULL,
+  "offset_minutes" bigint NULL,
+  "status" character varying NOT NULL DEFAULT 'pending',
+  "attempts" bigint NOT NULL DEFAULT 0,
+  "next_attempt_at" timestamptz NULL,
+  "last_error" text NULL,
+  "delivered_at" timestamptz NULL,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  "tenant_id" character varying NOT NULL,
+  "todo_id" character varying NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "reminders_tenants_reminders" FOREIGN KEY ("tenant_id") REFERENCES "tenants" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION,
+  CONSTRAINT "reminders_todos_reminders" FOREIGN KEY ("todo_id") REFERENCES "todos" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "reminders_when" CHECK (("remind_at" IS NULL) <> ("offset_minutes" IS NULL))
+);
+-- Create index "reminder_tenant_id_status" to table: "reminders"
+CREATE INDEX "reminder_tenant_id_status" ON "reminders" ("tenant_id", "status");
+-- Create index "reminder_todo_id" to table: "reminders"
+CREATE INDEX "reminder_todo_id" ON "reminders" ("todo_id");
+
+-- Enable RLS on reminders table
+ALTER TABLE "reminders" ENABLE ROW LEVEL SECURITY;
+
+-- Reminders table policy
+CREATE POLICY "reminders_tenant_isolation" ON "reminders"
+    FOR ALL
+    USING ("tenant_id" = current_setting('app.current_tenant_id', true))
+    WITH CHECK ("tenant_id" = current_setting('app.current_tenant_id', true));
+
+-- Force RLS for app_user (bypass for table owner/superuser)
+ALTER TABLE "reminders" FORCE ROW LEVEL SECURITY;
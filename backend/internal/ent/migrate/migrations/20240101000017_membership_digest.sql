@@ -0,0 +1,5 @@
+-- Add columns "digest_enabled", "digest_time", "digest_timezone" and "digest_last_sent_on" to table: "memberships"
+ALTER TABLE "memberships" ADD COLUMN "digest_enabled" boolean NOT NULL DEFAULT false,
+  ADD COLUMN "digest_time" character varying NOT NULL DEFAULT '08:00',
+  ADD COLUMN "digest_timezone" character varying NULL,
+  ADD COLUMN "digest_last_sent_on" date NULL;
@@ -0,0 +1,4 @@
+-- Add column "snoozed_until" to table: "todos"
+ALTER TABLE "todos" ADD COLUMN "snoozed_until" timestamptz NULL;
+-- Create index "todo_tenant_id_snoozed_until" to table: "todos"
+CREATE INDEX "todo_tenant_id_snoozed_until" ON "todos" ("tenant_id", "snoozed_until");
@@ -0,0 +1,6 @@
+-- Add column "assignee_id" to table: "todos"
+ALTER TABLE "todos" ADD COLUMN "assignee_id" character varying NULL;
+-- Assignees must be members of the todo's tenant; losing the membership unassigns the todo
+ALTER TABLE "todos" ADD CONSTRAINT "todos_memberships_assignee" FOREIGN KEY ("tenant_id", "assignee_id") REFERENCES "memberships" ("tenant_id", "user_id") ON UPDATE NO ACTION ON DELETE SET NULL ("assignee_id");
+-- Create index "todo_tenant_id_assignee_id_created_at_id" to table: "todos"
+CREATE INDEX "todo_tenant_id_assignee_id_created_at_id" ON "todos" ("tenant_id", "assignee_id", "created_at", "id");
@@ -0,0 +1,30 @@
+-- Create "comments" table
+-- Comments go with their todo when it is purged
+CREATE TABLE "comments" (
+  "id" character varying NOT NULL,
+  "body" text NOT NULL,
+  "edited_at" timestamptz NULL,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  "tenant_id" character varying NOT NULL,
+  "todo_id" character varying NOT NULL,
+  "author_id" character varying NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "comments_tenants_comments" FOREIGN KEY ("tenant_id") REFERENCES "tenants" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION,
+  CONSTRAINT "comments_todos_comments" FOREIGN KEY ("todo_id") REFERENCES "todos" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "comments_users_comments" FOREIGN KEY ("author_id") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION
+);
+-- Create index "comment_todo_id_created_at_id" to table: "comments"
+CREATE INDEX "comment_todo_id_created_at_id" ON "comments" ("todo_id", "created_at", "id");
+
+-- Enable RLS on comments table
+ALTER TABLE "comments" ENABLE ROW LEVEL SECURITY;
+
+-- Comments table policy
+CREATE POLICY "comments_tenant_isolation" ON "comments"
+    FOR ALL
+    USING ("tenant_id" = current_setting('app.current_tenant_id', true))
+    WITH CHECK ("tenant_id" = current_setting('app.current_tenant_id', true));
+
+-- Force RLS for app_user (bypass for table owner/superuser)
+ALTER TABLE "comments" FORCE ROW LEVEL SECURITY;
@@ -0,0 +1,29 @@
+-- Create "todo_activities" table
+-- A todo's history goes with it when it is purged
+CREATE TABLE "todo_activities" (
+  "id" character varying NOT NULL,
+  "action" character varying NOT NULL,
+  "snoozed_until" timestamptz NULL,
+  "created_at" timestamptz NOT NULL,
+  "tenant_id" character varying NOT NULL,
+  "todo_id" character varying NOT NULL,
+  "actor_id" character varying NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "todo_activities_tenants_todo_activities" FOREIGN KEY ("tenant_id") REFERENCES "tenants" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION,
+  CONSTRAINT "todo_activities_todos_activities" FOREIGN KEY ("todo_id") REFERENCES "todos" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "todo_activities_users_todo_activities" FOREIGN KEY ("actor_id") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION
+);
+-- Create index "todoactivity_todo_id_created_at_id" to table: "todo_activities"
+CREATE INDEX "todoactivity_todo_id_created_at_id" ON "todo_activities" ("todo_id", "created_at", "id");
+
+-- Enable RLS on todo_activities table
+ALTER TABLE "todo_activities" ENABLE ROW LEVEL SECURITY;
+
+-- Todo activities table policy
+CREATE POLICY "todo_activities_tenant_isolation" ON "todo_activities"
+    FOR ALL
+    USING ("tenant_id" = current_setting('app.current_tenant_id', true))
+    WITH CHECK ("tenant_id" = current_setting('app.current_tenant_id', true));
+
+-- Force RLS for app_user (bypass for table owner/superuser)
+ALTER TABLE "todo_activities" FORCE ROW LEVEL SECURITY;
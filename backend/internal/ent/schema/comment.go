@@ -0,0 +1,64 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Comment holds the schema definition for the Comment entity.
+// A comment is a markdown note a member leaves on a todo. Only whoever can see the todo
+// can see its comments, and they go with the todo when it is purged.
+type Comment struct {
+	ent.Schema
+}
+
+// Fields of the Comment.
+func (Comment) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").NotEmpty().Immutable(),
+		field.String("tenant_id").NotEmpty().Immutable(),
+		field.String("todo_id").NotEmpty().Immutable(),
+		field.String("author_id").NotEmpty().Immutable(),
+		field.Text("body").NotEmpty(),
+		// edited_at is null until the body is first changed
+		field.Time("edited_at").Optional().Nillable(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the Comment.
+func (Comment) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("tenant", Tenant.Type).
+			Ref("comments").
+			Field("tenant_id").
+			Required().
+			Unique().
+			Immutable(),
+		edge.From("todo", Todo.Type).
+			Ref("comments").
+			Field("todo_id").
+			Required().
+			Unique().
+			Immutable(),
+		edge.From("author", User.Type).
+			Ref("comments").
+			Field("author_id").
+			Required().
+			Unique().
+			Immutable(),
+	}
+}
+
+// Indexes of the Comment.
+func (Comment) Indexes() []ent.Index {
+	return []ent.Index{
+		// Keyset pagination over (created_at, id) for a todo's comments
+		index.Fields("todo_id", "created_at", "id"),
+	}
+}
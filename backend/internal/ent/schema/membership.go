@@ -0,0 +1,62 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Membership holds the schema definition for the Membership entity.
+// A membership grants a user a role in a tenant; one user can belong to many tenants.
+type Membership struct {
+	ent.Schema
+}
+
+// Fields of the Membership.
+func (Membership) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").NotEmpty().Immutable(),
+		field.String("tenant_id").NotEmpty().Immutable(),
+		field.String("user_id").NotEmpty().Immutable(),
+		field.Enum("role").Values("admin", "member").Default("member"),
+		// digest_enabled opts the member into a daily email of their due and overdue todos,
+		// sent once digest_time ("15:04") has passed in digest_timezone, or the user's own when null
+		field.Bool("digest_enabled").Default(false),
+		field.String("digest_time").Default("08:00"),
+		field.String("digest_timezone").Optional().Nillable(),
+		// digest_last_sent_on is the member's local date of the last digest, so none is sent twice a day
+		field.Time("digest_last_sent_on").Optional().Nillable().
+			SchemaType(map[string]string{dialect.Postgres: "date"}),
+		field.Time("created_at").Default(time.Now).Immutable(),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the Membership.
+func (Membership) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("tenant", Tenant.Type).
+			Ref("memberships").
+			Field("tenant_id").
+			Required().
+			Unique().
+			Immutable(),
+		edge.From("user", User.Type).
+			Ref("memberships").
+			Field("user_id").
+			Required().
+			Unique().
+			Immutable(),
+	}
+}
+
+// Indexes of the Membership.
+func (Membership) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "user_id").Unique(),
+	}
+}
@@ -0,0 +1,61 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Project holds the schema definition for the Project entity.
+// A project groups todos. Like todos, it belongs to its owner unless is_public shares it
+// with the whole tenant.
+type Project struct {
+	ent.Schema
+}
+
+// Fields of the Project.
+func (Project) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").NotEmpty().Immutable(),
+		field.String("tenant_id").NotEmpty().Immutable(),
+		field.String("user_id").NotEmpty().Immutable(),
+		field.String("name").NotEmpty(),
+		field.Text("description").Optional().Default(""),
+		field.String("color").NotEmpty(),
+		field.Bool("archived").Default(false),
+		field.Bool("is_public").Default(false),
+		field.Time("created_at").Default(time.Now).Immutable(),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the Project.
+func (Project) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("tenant", Tenant.Type).
+			Ref("projects").
+			Field("tenant_id").
+			Required().
+			Unique().
+			Immutable(),
+		edge.From("user", User.Type).
+			Ref("projects").
+			Field("user_id").
+			Required().
+			Unique().
+			Immutable(),
+		edge.To("todos", Todo.Type),
+	}
+}
+
+// Indexes of the Project.
+func (Project) Indexes() []ent.Index {
+	return []ent.Index{
+		// Listing the projects a user can see: their own and the tenant's public ones
+		index.Fields("tenant_id", "user_id"),
+		index.Fields("tenant_id", "is_public"),
+	}
+}
@@ -0,0 +1,65 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Reminder holds the schema definition for the Reminder entity.
+// A reminder emails a todo's owner at remind_at, or offset_minutes before the todo is
+// due. The scheduler claims due reminders and records how delivery went.
+type Reminder struct {
+	ent.Schema
+}
+
+// Fields of the Reminder.
+func (Reminder) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").NotEmpty().Immutable(),
+		field.String("tenant_id").NotEmpty().Immutable(),
+		field.String("todo_id").NotEmpty().Immutable(),
+		// Exactly one of remind_at and offset_minutes is set
+		field.Time("remind_at").Optional().Nillable().Immutable(),
+		field.Int("offset_minutes").Optional().Nillable().NonNegative().Immutable(),
+		field.Enum("status").Values("pending", "delivered", "failed").Default("pending"),
+		// attempts counts sends tried; next_attempt_at holds back the retry after a failed one
+		field.Int("attempts").Default(0).NonNegative(),
+		field.Time("next_attempt_at").Optional().Nillable(),
+		field.Text("last_error").Optional().Nillable(),
+		field.Time("delivered_at").Optional().Nillable(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the Reminder.
+func (Reminder) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("tenant", Tenant.Type).
+			Ref("reminders").
+			Field("tenant_id").
+			Required().
+			Unique().
+			Immutable(),
+		edge.From("todo", Todo.Type).
+			Ref("reminders").
+			Field("todo_id").
+			Required().
+			Unique().
+			Immutable(),
+	}
+}
+
+// Indexes of the Reminder.
+func (Reminder) Indexes() []ent.Index {
+	return []ent.Index{
+		// The scheduler looks for a tenant's pending reminders
+		index.Fields("tenant_id", "status"),
+		// Listing a todo's reminders
+		index.Fields("todo_id"),
+	}
+}
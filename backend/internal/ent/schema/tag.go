@@ -0,0 +1,41 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Tag holds the schema definition for the Tag entity.
+// Tags are shared by everyone in a tenant. Names are unique per tenant ignoring case,
+// which the migration enforces with an index on lower(name).
+type Tag struct {
+	ent.Schema
+}
+
+// Fields of the Tag.
+func (Tag) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").NotEmpty().Immutable(),
+		field.String("tenant_id").NotEmpty().Immutable(),
+		field.String("name").NotEmpty(),
+		field.String("color").NotEmpty(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the Tag.
+func (Tag) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("tenant", Tenant.Type).
+			Ref("tags").
+			Field("tenant_id").
+			Required().
+			Unique().
+			Immutable(),
+		edge.To("todos", Todo.Type),
+	}
+}
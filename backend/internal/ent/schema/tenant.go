@@ -19,6 +19,8 @@ func (Tenant) Fields() []ent.Field {
 		field.String("id").NotEmpty().Immutable(),
 		field.String("name").NotEmpty(),
 		field.String("slug").NotEmpty().Unique(),
+		// trash_retention_days is how long deleted todos stay restorable before they are purged
+		field.Int("trash_retention_days").Default(30).Range(1, 365),
 		field.Time("created_at").Default(time.Now).Immutable(),
 		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
 	}
@@ -27,7 +29,14 @@ func (Tenant) Fields() []ent.Field {
 // Edges of the Tenant.
 func (Tenant) Edges() []ent.Edge {
 	return []ent.Edge{
-		edge.To("users", User.Type),
+		edge.To("memberships", Membership.Type),
 		edge.To("todos", Todo.Type),
+		edge.To("slug_histories", TenantSlugHistory.Type),
+		edge.To("domains", TenantDomain.Type),
+		edge.To("tags", Tag.Type),
+		edge.To("projects", Project.Type),
+		edge.To("reminders", Reminder.Type),
+		edge.To("comments", Comment.Type),
+		edge.To("todo_activities", TodoActivity.Type),
 	}
 }
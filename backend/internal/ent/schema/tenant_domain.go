@@ -0,0 +1,39 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// TenantDomain holds the schema definition for the TenantDomain entity.
+// It maps a custom domain to a tenant once its ownership has been verified.
+type TenantDomain struct {
+	ent.Schema
+}
+
+// Fields of the TenantDomain.
+func (TenantDomain) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").NotEmpty().Immutable(),
+		field.String("tenant_id").NotEmpty().Immutable(),
+		field.String("domain").NotEmpty().Unique().Immutable(),
+		field.String("verification_token").NotEmpty().Immutable(),
+		field.Time("verified_at").Optional().Nillable(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the TenantDomain.
+func (TenantDomain) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("tenant", Tenant.Type).
+			Ref("domains").
+			Field("tenant_id").
+			Required().
+			Unique().
+			Immutable(),
+	}
+}
@@ -0,0 +1,37 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// TenantSlugHistory holds the schema definition for the TenantSlugHistory entity.
+// It keeps previous tenant slugs resolvable for a grace period after a rename.
+type TenantSlugHistory struct {
+	ent.Schema
+}
+
+// Fields of the TenantSlugHistory.
+func (TenantSlugHistory) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("tenant_id").NotEmpty().Immutable(),
+		field.String("slug").NotEmpty().Unique(),
+		field.Time("expires_at"),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the TenantSlugHistory.
+func (TenantSlugHistory) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("tenant", Tenant.Type).
+			Ref("slug_histories").
+			Field("tenant_id").
+			Required().
+			Unique().
+			Immutable(),
+	}
+}
@@ -4,8 +4,11 @@ import (
 	"time"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 )
 
 // Todo holds the schema definition for the Todo entity.
@@ -19,12 +22,38 @@ func (Todo) Fields() []ent.Field {
 		field.String("id").NotEmpty().Immutable(),
 		field.String("tenant_id").NotEmpty().Immutable(),
 		field.String("user_id").NotEmpty().Immutable(),
+		// assignee_id is the tenant member the todo is delegated to; a composite foreign key onto
+		// memberships (tenant_id, user_id), written in the migration, keeps it within the tenant
+		field.String("assignee_id").Optional().Nillable(),
+		// project_id is null for todos in the inbox
+		field.String("project_id").Optional().Nillable(),
+		// parent_id is null for top-level todos; subtasks nest up to a configured depth
+		field.String("parent_id").Optional().Nillable(),
 		field.String("title").NotEmpty(),
 		field.Text("description").Optional().Default(""),
 		field.Bool("completed").Default(false),
 		field.Bool("is_public").Default(false),
+		// auto_complete completes the todo once all of its subtasks are done
+		field.Bool("auto_complete").Default(false),
+		// priority is a postgres enum so it sorts from none up to urgent rather than by name
+		field.Enum("priority").
+			Values("none", "low", "medium", "high", "urgent").
+			Default("none").
+			SchemaType(map[string]string{dialect.Postgres: "todo_priority"}),
 		field.Time("due_date").Optional().Nillable(),
+		// recurrence_rule is an RFC 5545 RRULE expanded from due_date; completing the todo creates the next occurrence
+		field.String("recurrence_rule").Optional().Nillable(),
+		// recurrence_timezone is the IANA zone whose wall clock the occurrences keep
+		field.String("recurrence_timezone").Optional().Nillable(),
 		field.Time("completed_at").Optional().Nillable(),
+		// snoozed_until hides the todo from listings until then; it comes back by itself once it passes
+		field.Time("snoozed_until").Optional().Nillable(),
+		// position is a fracindex key ordering the tenant's todos manually; it collates as "C"
+		field.String("position").NotEmpty(),
+		// version increments on every update and backs the ETag for optimistic concurrency
+		field.Int("version").Default(1).NonNegative(),
+		// deleted_at marks a todo as in the trash; the purger removes it once the tenant's retention passes
+		field.Time("deleted_at").Optional().Nillable(),
 		field.Time("created_at").Default(time.Now).Immutable(),
 		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
 	}
@@ -45,5 +74,48 @@ func (Todo) Edges() []ent.Edge {
 			Required().
 			Unique().
 			Immutable(),
+		edge.From("tags", Tag.Type).
+			Ref("todos"),
+		edge.From("project", Project.Type).
+			Ref("todos").
+			Field("project_id").
+			Unique(),
+		edge.To("subtasks", Todo.Type).
+			From("parent").
+			Field("parent_id").
+			Unique(),
+		// blockers must be completed before the todo can be; the cycle check lives in the repository
+		edge.To("blockers", Todo.Type).
+			StorageKey(edge.Table("todo_dependencies"), edge.Columns("todo_id", "blocker_id")),
+		edge.From("blocking", Todo.Type).
+			Ref("blockers"),
+		edge.To("reminders", Reminder.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("comments", Comment.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("activities", TodoActivity.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+// Indexes of the Todo.
+func (Todo) Indexes() []ent.Index {
+	return []ent.Index{
+		// Keyset pagination over (created_at, id) for a user's todos and a tenant's public todos
+		index.Fields("tenant_id", "user_id", "created_at", "id"),
+		index.Fields("tenant_id", "is_public", "created_at", "id"),
+		index.Fields("tenant_id", "assignee_id", "created_at", "id"),
+		// Listing a project's todos
+		index.Fields("project_id"),
+		// Loading and counting a todo's subtasks
+		index.Fields("parent_id"),
+		// Smart views list a user's open todos by priority
+		index.Fields("tenant_id", "user_id", "priority"),
+		// Finding a todo's neighbours in the manual order
+		index.Fields("tenant_id", "position"),
+		// Purging scans each tenant's trash by deletion time
+		index.Fields("tenant_id", "deleted_at"),
+		// Listings leave out todos snoozed past now
+		index.Fields("tenant_id", "snoozed_until"),
 	}
 }
@@ -0,0 +1,64 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// TodoActivity holds the schema definition for the TodoActivity entity.
+// An activity records a change someone made to a todo, such as snoozing it. Changes
+// that happen by themselves, like a snooze running out, are not recorded. Entries are
+// never edited and go with the todo when it is purged.
+type TodoActivity struct {
+	ent.Schema
+}
+
+// Fields of the TodoActivity.
+func (TodoActivity) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").NotEmpty().Immutable(),
+		field.String("tenant_id").NotEmpty().Immutable(),
+		field.String("todo_id").NotEmpty().Immutable(),
+		field.String("actor_id").NotEmpty().Immutable(),
+		field.Enum("action").Values("snoozed", "unsnoozed").Immutable(),
+		// snoozed_until is when a snoozed todo wakes, and null for other actions
+		field.Time("snoozed_until").Optional().Nillable().Immutable(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the TodoActivity.
+func (TodoActivity) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("tenant", Tenant.Type).
+			Ref("todo_activities").
+			Field("tenant_id").
+			Required().
+			Unique().
+			Immutable(),
+		edge.From("todo", Todo.Type).
+			Ref("activities").
+			Field("todo_id").
+			Required().
+			Unique().
+			Immutable(),
+		edge.From("actor", User.Type).
+			Ref("todo_activities").
+			Field("actor_id").
+			Required().
+			Unique().
+			Immutable(),
+	}
+}
+
+// Indexes of the TodoActivity.
+func (TodoActivity) Indexes() []ent.Index {
+	return []ent.Index{
+		// A todo's history in order
+		index.Fields("todo_id", "created_at", "id"),
+	}
+}
@@ -6,10 +6,10 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
-	"entgo.io/ent/schema/index"
 )
 
 // User holds the schema definition for the User entity.
+// Users are global identities; tenant access and roles come from memberships.
 type User struct {
 	ent.Schema
 }
@@ -18,11 +18,11 @@ type User struct {
 func (User) Fields() []ent.Field {
 	return []ent.Field{
 		field.String("id").NotEmpty().Immutable(),
-		field.String("tenant_id").NotEmpty().Immutable(),
-		field.String("email").NotEmpty(),
+		field.String("email").NotEmpty().Unique(),
 		field.String("password_hash").NotEmpty().Sensitive(),
 		field.String("name").Default(""),
-		field.Enum("role").Values("admin", "member").Default("member"),
+		// timezone is an IANA name; day-based todo views follow it
+		field.String("timezone").Default("UTC"),
 		field.Bool("email_verified").Default(false),
 		field.String("verification_token").Optional().Nillable(),
 		field.Time("verification_token_expires_at").Optional().Nillable(),
@@ -34,19 +34,10 @@ func (User) Fields() []ent.Field {
 // Edges of the User.
 func (User) Edges() []ent.Edge {
 	return []ent.Edge{
-		edge.From("tenant", Tenant.Type).
-			Ref("users").
-			Field("tenant_id").
-			Required().
-			Unique().
-			Immutable(),
+		edge.To("memberships", Membership.Type),
 		edge.To("todos", Todo.Type),
-	}
-}
-
-// Indexes of the User.
-func (User) Indexes() []ent.Index {
-	return []ent.Index{
-		index.Fields("tenant_id", "email").Unique(),
+		edge.To("projects", Project.Type),
+		edge.To("comments", Comment.Type),
+		edge.To("todo_activities", TodoActivity.Type),
 	}
 }
@@ -25,14 +25,14 @@ func NewTenantContext(ctx context.Context, db *sql.DB, tenantID string) (*Tenant
 		return nil, fmt.Errorf("failed to begin transaction: %w", err)
 	}
 
-	// Set tenant context for RLS
-	if _, err := tx.ExecContext(ctx, fmt.Sprintf("SET LOCAL app.current_tenant_id = '%s'", tenantID)); err != nil {
+	// Set tenant context for RLS (equivalent to SET LOCAL, scoped to this transaction)
+	if _, err := tx.ExecContext(ctx, "SELECT set_config('app.current_tenant_id', $1, true)", tenantID); err != nil {
 		tx.Rollback()
 		return nil, fmt.Errorf("failed to set tenant context: %w", err)
 	}
 
 	// Create Ent client with transaction
-	drv := entsql.OpenDB(dialect.Postgres, &txDB{tx: tx})
+	drv := entsql.NewDriver(dialect.Postgres, entsql.Conn{ExecQuerier: tx})
 	client := generated.NewClient(generated.Driver(drv))
 
 	return &TenantContext{
@@ -56,29 +56,3 @@ func (tc *TenantContext) Rollback() error {
 func (tc *TenantContext) Close() error {
 	return tc.tx.Rollback()
 }
-
-// txDB wraps a transaction to implement the sql.DB interface needed by ent
-type txDB struct {
-	tx *sql.Tx
-}
-
-func (t *txDB) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
-	return t.tx.ExecContext(ctx, query, args...)
-}
-
-func (t *txDB) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
-	return t.tx.QueryContext(ctx, query, args...)
-}
-
-func (t *txDB) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
-	return t.tx.QueryRowContext(ctx, query, args...)
-}
-
-func (t *txDB) BeginTx(ctx context.Context, opts *sql.TxOptions) (*sql.Tx, error) {
-	// Return the existing transaction since we're already in one
-	return t.tx, nil
-}
-
-func (t *txDB) Close() error {
-	return nil
-}
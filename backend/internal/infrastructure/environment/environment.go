@@ -23,27 +23,49 @@ type Environment struct {
 
 	// Server
 	PublicAPIPort string
+	// BaseDomain is the domain whose subdomains identify tenants (e.g. acme.<BaseDomain>)
+	BaseDomain string
 
 	// Mail
 	SMTPHost string
 	SMTPPort string
+
+	// Jobs
+	// TrashPurgeInterval is how often trashed todos past retention are purged, as a Go duration
+	TrashPurgeInterval string
+	// PositionRebalanceInterval is how often over-long manual order keys are rewritten, as a Go duration
+	PositionRebalanceInterval string
+	// ReminderInterval is how often due reminders are emailed, as a Go duration
+	ReminderInterval string
+	// DigestInterval is how often members whose daily digest is due are emailed, as a Go duration
+	DigestInterval string
+
+	// Todos
+	// MaxSubtaskDepth is how many levels todos may nest, counting the top-level todo
+	MaxSubtaskDepth string
 }
 
 func NewEnvironment() *Environment {
 	_ = godotenv.Load()
 
 	return &Environment{
-		PostgresDBUser:      getEnv("POSTGRES_DB_USER", "postgres"),
-		PostgresDBPassword:  getEnv("POSTGRES_DB_PASSWORD", "postgres"),
-		PostgresDBName:      getEnv("POSTGRES_DB_NAME", "good_todo_go"),
-		PostgresDBPort:      getEnv("POSTGRES_DB_PORT", "5432"),
-		PostgresDBHost:      getEnv("POSTGRES_DB_HOST", "localhost"),
-		PostgresAppUser:     getEnv("POSTGRES_APP_USER", "app_user"),
-		PostgresAppPassword: getEnv("POSTGRES_APP_PASSWORD", "app_password"),
-		JWTSecret:           getEnv("JWT_SECRET", "your-super-secret-jwt-key-change-in-production"),
-		PublicAPIPort:       getEnv("PUBLIC_API_PORT", "8000"),
-		SMTPHost:            getEnv("SMTP_HOST", "localhost"),
-		SMTPPort:            getEnv("SMTP_PORT", "1025"),
+		PostgresDBUser:            getEnv("POSTGRES_DB_USER", "postgres"),
+		PostgresDBPassword:        getEnv("POSTGRES_DB_PASSWORD", "postgres"),
+		PostgresDBName:            getEnv("POSTGRES_DB_NAME", "good_todo_go"),
+		PostgresDBPort:            getEnv("POSTGRES_DB_PORT", "5432"),
+		PostgresDBHost:            getEnv("POSTGRES_DB_HOST", "localhost"),
+		PostgresAppUser:           getEnv("POSTGRES_APP_USER", "app_user"),
+		PostgresAppPassword:       getEnv("POSTGRES_APP_PASSWORD", "app_password"),
+		JWTSecret:                 getEnv("JWT_SECRET", "your-super-secret-jwt-key-change-in-production"),
+		PublicAPIPort:             getEnv("PUBLIC_API_PORT", "8000"),
+		BaseDomain:                getEnv("BASE_DOMAIN", "localhost"),
+		SMTPHost:                  getEnv("SMTP_HOST", "localhost"),
+		SMTPPort:                  getEnv("SMTP_PORT", "1025"),
+		TrashPurgeInterval:        getEnv("TRASH_PURGE_INTERVAL", "1h"),
+		PositionRebalanceInterval: getEnv("POSITION_REBALANCE_INTERVAL", "1h"),
+		ReminderInterval:          getEnv("REMINDER_INTERVAL", "1m"),
+		DigestInterval:            getEnv("DIGEST_INTERVAL", "5m"),
+		MaxSubtaskDepth:           getEnv("MAX_SUBTASK_DEPTH", "3"),
 	}
 }
 
@@ -0,0 +1,112 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/domain/repository"
+	"good-todo-go/internal/ent/generated"
+	"good-todo-go/internal/ent/generated/comment"
+)
+
+type CommentRepository struct {
+	client *generated.Client
+}
+
+func NewCommentRepository(client *generated.Client) repository.ICommentRepository {
+	return &CommentRepository{client: client}
+}
+
+func (r *CommentRepository) conn(ctx context.Context) *generated.Client {
+	return clientFromContext(ctx, r.client)
+}
+
+func (r *CommentRepository) Create(ctx context.Context, m *model.Comment) (*model.Comment, error) {
+	created, err := r.conn(ctx).Comment.Create().
+		SetID(m.ID).
+		SetTenantID(m.TenantID).
+		SetTodoID(m.TodoID).
+		SetAuthorID(m.AuthorID).
+		SetBody(m.Body).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create comment: %w", err)
+	}
+	return toModelComment(created), nil
+}
+
+func (r *CommentRepository) FindByID(ctx context.Context, tenantID, id string) (*model.Comment, error) {
+	found, err := r.conn(ctx).Comment.Query().
+		Where(
+			comment.IDEQ(id),
+			comment.TenantIDEQ(tenantID),
+		).
+		Only(ctx)
+	if err != nil {
+		if generated.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to find comment by id: %w", err)
+	}
+	return toModelComment(found), nil
+}
+
+func (r *CommentRepository) FindByTodoID(ctx context.Context, tenantID, todoID string, after *repository.CommentCursor, limit int) ([]*model.Comment, error) {
+	q := r.conn(ctx).Comment.Query().
+		Where(
+			comment.TenantIDEQ(tenantID),
+			comment.TodoIDEQ(todoID),
+		)
+	if after != nil {
+		q = q.Where(comment.Or(
+			comment.CreatedAtGT(after.CreatedAt),
+			comment.And(comment.CreatedAtEQ(after.CreatedAt), comment.IDGT(after.ID)),
+		))
+	}
+	comments, err := q.
+		Order(comment.ByCreatedAt(), comment.ByID()).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list comments: %w", err)
+	}
+
+	result := make([]*model.Comment, len(comments))
+	for i, m := range comments {
+		result[i] = toModelComment(m)
+	}
+	return result, nil
+}
+
+func (r *CommentRepository) Update(ctx context.Context, m *model.Comment) (*model.Comment, error) {
+	updated, err := r.conn(ctx).Comment.UpdateOneID(m.ID).
+		SetBody(m.Body).
+		SetNillableEditedAt(m.EditedAt).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update comment: %w", err)
+	}
+	return toModelComment(updated), nil
+}
+
+func (r *CommentRepository) Delete(ctx context.Context, id string) error {
+	err := r.conn(ctx).Comment.DeleteOneID(id).Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to delete comment: %w", err)
+	}
+	return nil
+}
+
+func toModelComment(m *generated.Comment) *model.Comment {
+	return &model.Comment{
+		ID:        m.ID,
+		TenantID:  m.TenantID,
+		TodoID:    m.TodoID,
+		AuthorID:  m.AuthorID,
+		Body:      m.Body,
+		EditedAt:  m.EditedAt,
+		CreatedAt: m.CreatedAt,
+		UpdatedAt: m.UpdatedAt,
+	}
+}
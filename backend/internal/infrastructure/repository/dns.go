@@ -0,0 +1,30 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+
+	"good-todo-go/internal/domain/repository"
+)
+
+type DNSRepository struct {
+	resolver *net.Resolver
+}
+
+func NewDNSRepository() repository.IDNSRepository {
+	return &DNSRepository{resolver: net.DefaultResolver}
+}
+
+func (r *DNSRepository) LookupTXT(ctx context.Context, name string) ([]string, error) {
+	records, err := r.resolver.LookupTXT(ctx, name)
+	if err != nil {
+		var dnsErr *net.DNSError
+		if errors.As(err, &dnsErr) && dnsErr.IsNotFound {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to look up TXT records: %w", err)
+	}
+	return records, nil
+}
@@ -0,0 +1,27 @@
+package repository
+
+import (
+	"errors"
+
+	"github.com/lib/pq"
+)
+
+// uniqueViolationCode is the PostgreSQL SQLSTATE for unique_violation.
+const uniqueViolationCode = "23505"
+
+// Unique constraints that map to domain errors.
+const (
+	constraintUserEmail    = "users_email_key"
+	constraintTenantSlug   = "tenants_slug_key"
+	constraintTenantDomain = "tenant_domains_domain_key"
+	constraintTagName      = "tags_tenant_id_lower_name_key"
+)
+
+// uniqueViolation returns the name of the violated unique constraint, if err is a unique violation.
+func uniqueViolation(err error) (string, bool) {
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == uniqueViolationCode {
+		return pqErr.Constraint, true
+	}
+	return "", false
+}
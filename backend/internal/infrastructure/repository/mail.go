@@ -0,0 +1,243 @@
+package repository
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	htmltemplate "html/template"
+	"mime/multipart"
+	"net/smtp"
+	"net/textproto"
+	"strings"
+	texttemplate "text/template"
+	"time"
+
+	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/domain/repository"
+	"good-todo-go/internal/infrastructure/environment"
+)
+
+const mailFrom = "noreply@good-todo-go.local"
+
+// headerEscaper keeps user text on one header line so a title cannot add headers.
+var headerEscaper = strings.NewReplacer("\r\n", " ", "\r", " ", "\n", " ")
+
+// digestSection is one part of a digest as its templates render it.
+type digestSection struct {
+	Title string
+	Todos []digestTodo
+	More  bool
+}
+
+type digestTodo struct {
+	Title string
+	Due   string
+}
+
+var digestTextTemplate = texttemplate.Must(texttemplate.New("digest").Parse(`Hello {{.Name}},
+
+Here is your summary for {{.Date}} in {{.TenantName}}.
+{{range .Sections}}
+{{.Title}}
+{{range .Todos}}- {{.Title}} (due {{.Due}})
+{{end}}{{if .More}}- and more
+{{end}}{{end}}
+Best regards,
+Good Todo Go Team
+`))
+
+var digestHTMLTemplate = htmltemplate.Must(htmltemplate.New("digest").Parse(`<!DOCTYPE html>
+<html>
+<body>
+<p>Hello {{.Name}},</p>
+<p>Here is your summary for {{.Date}} in {{.TenantName}}.</p>
+{{range .Sections}}<h2>{{.Title}}</h2>
+<ul>
+{{range .Todos}}<li>{{.Title}} <small>(due {{.Due}})</small></li>
+{{end}}{{if .More}}<li>and more</li>
+{{end}}</ul>
+{{end}}<p>Best regards,<br>Good Todo Go Team</p>
+</body>
+</html>
+`))
+
+type MailRepository struct {
+	env *environment.Environment
+}
+
+func NewMailRepository(env *environment.Environment) repository.IMailRepository {
+	return &MailRepository{env: env}
+}
+
+func (r *MailRepository) SendReminder(ctx context.Context, reminder *model.DueReminder) error {
+	from := mailFrom
+	to := []string{reminder.Email}
+	subject := "Reminder: " + headerEscaper.Replace(reminder.TodoTitle)
+
+	due := "It has no due date."
+	if reminder.DueDate != nil {
+		loc, err := time.LoadLocation(reminder.Timezone)
+		if err != nil {
+			loc = time.UTC
+		}
+		due = "It is due " + reminder.DueDate.In(loc).Format("Mon, 2 Jan 2006 15:04 MST") + "."
+	}
+	body := fmt.Sprintf(`Hello %s,
+
+This is a reminder about your todo:
+
+%s
+
+%s
+
+Best regards,
+Good Todo Go Team`, reminder.Name, reminder.TodoTitle, due)
+
+	msg := []byte(fmt.Sprintf("From: %s\r\nTo: %s\r\nSubject: %s\r\n\r\n%s",
+		from, reminder.Email, subject, body))
+
+	addr := fmt.Sprintf("%s:%s", r.env.SMTPHost, r.env.SMTPPort)
+	err := smtp.SendMail(addr, nil, from, to, msg)
+	if err != nil {
+		return fmt.Errorf("failed to send reminder email: %w", err)
+	}
+
+	return nil
+}
+
+func (r *MailRepository) SendAssignment(ctx context.Context, assignment *model.TodoAssignment) error {
+	from := mailFrom
+	to := []string{assignment.Email}
+	subject := "Assigned to you: " + headerEscaper.Replace(assignment.TodoTitle)
+
+	due := "It has no due date."
+	if assignment.DueDate != nil {
+		loc, err := time.LoadLocation(assignment.Timezone)
+		if err != nil {
+			loc = time.UTC
+		}
+		due = "It is due " + assignment.DueDate.In(loc).Format("Mon, 2 Jan 2006 15:04 MST") + "."
+	}
+	body := fmt.Sprintf(`Hello %s,
+
+%s assigned a todo to you:
+
+%s
+
+%s
+
+Best regards,
+Good Todo Go Team`, assignment.Name, assignment.AssignedBy, assignment.TodoTitle, due)
+
+	msg := []byte(fmt.Sprintf("From: %s\r\nTo: %s\r\nSubject: %s\r\n\r\n%s",
+		from, assignment.Email, subject, body))
+
+	addr := fmt.Sprintf("%s:%s", r.env.SMTPHost, r.env.SMTPPort)
+	err := smtp.SendMail(addr, nil, from, to, msg)
+	if err != nil {
+		return fmt.Errorf("failed to send assignment email: %w", err)
+	}
+
+	return nil
+}
+
+func (r *MailRepository) SendMention(ctx context.Context, mention *model.CommentMention) error {
+	from := mailFrom
+	to := []string{mention.Email}
+	subject := "Mentioned on: " + headerEscaper.Replace(mention.TodoTitle)
+
+	body := fmt.Sprintf(`Hello %s,
+
+%s mentioned you in a comment on %s:
+
+%s
+
+Best regards,
+Good Todo Go Team`, mention.Name, mention.MentionedBy, mention.TodoTitle, mention.CommentBody)
+
+	msg := []byte(fmt.Sprintf("From: %s\r\nTo: %s\r\nSubject: %s\r\n\r\n%s",
+		from, mention.Email, subject, body))
+
+	addr := fmt.Sprintf("%s:%s", r.env.SMTPHost, r.env.SMTPPort)
+	err := smtp.SendMail(addr, nil, from, to, msg)
+	if err != nil {
+		return fmt.Errorf("failed to send mention email: %w", err)
+	}
+
+	return nil
+}
+
+func (r *MailRepository) SendDigest(ctx context.Context, digest *model.Digest) error {
+	data := struct {
+		Name       string
+		TenantName string
+		Date       string
+		Sections   []digestSection
+	}{
+		Name:       digest.Name,
+		TenantName: digest.TenantName,
+		Date:       digest.Date.Format("Monday, 2 January"),
+	}
+	for _, section := range []struct {
+		title string
+		model.DigestSection
+	}{
+		{"Overdue", digest.Overdue},
+		{"Due today", digest.DueToday},
+		{"Due this week", digest.DueThisWeek},
+	} {
+		if len(section.Todos) == 0 {
+			continue
+		}
+		s := digestSection{Title: section.title, More: section.More}
+		for _, t := range section.Todos {
+			s.Todos = append(s.Todos, digestTodo{
+				Title: t.Title,
+				Due:   t.DueDate.In(digest.Date.Location()).Format("Mon 2 Jan 15:04"),
+			})
+		}
+		data.Sections = append(data.Sections, s)
+	}
+
+	var text, html bytes.Buffer
+	if err := digestTextTemplate.Execute(&text, data); err != nil {
+		return fmt.Errorf("failed to render digest email: %w", err)
+	}
+	if err := digestHTMLTemplate.Execute(&html, data); err != nil {
+		return fmt.Errorf("failed to render digest email: %w", err)
+	}
+
+	var body bytes.Buffer
+	parts := multipart.NewWriter(&body)
+	for _, part := range []struct {
+		contentType string
+		content     []byte
+	}{
+		// Clients show the last alternative they support, so HTML goes last
+		{"text/plain; charset=utf-8", text.Bytes()},
+		{"text/html; charset=utf-8", html.Bytes()},
+	} {
+		w, err := parts.CreatePart(textproto.MIMEHeader{"Content-Type": {part.contentType}})
+		if err != nil {
+			return fmt.Errorf("failed to build digest email: %w", err)
+		}
+		if _, err := w.Write(part.content); err != nil {
+			return fmt.Errorf("failed to build digest email: %w", err)
+		}
+	}
+	if err := parts.Close(); err != nil {
+		return fmt.Errorf("failed to build digest email: %w", err)
+	}
+
+	subject := "Your daily summary for " + headerEscaper.Replace(digest.TenantName)
+	msg := []byte(fmt.Sprintf("From: %s\r\nTo: %s\r\nSubject: %s\r\nMIME-Version: 1.0\r\nContent-Type: multipart/alternative; boundary=%s\r\n\r\n%s",
+		mailFrom, digest.Email, subject, parts.Boundary(), body.String()))
+
+	addr := fmt.Sprintf("%s:%s", r.env.SMTPHost, r.env.SMTPPort)
+	err := smtp.SendMail(addr, nil, mailFrom, []string{digest.Email}, msg)
+	if err != nil {
+		return fmt.Errorf("failed to send digest email: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,144 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/domain/repository"
+	"good-todo-go/internal/ent/generated"
+	"good-todo-go/internal/ent/generated/membership"
+)
+
+type MembershipRepository struct {
+	client *generated.Client
+}
+
+func NewMembershipRepository(client *generated.Client) repository.IMembershipRepository {
+	return &MembershipRepository{client: client}
+}
+
+func (r *MembershipRepository) conn(ctx context.Context) *generated.Client {
+	return clientFromContext(ctx, r.client)
+}
+
+func (r *MembershipRepository) Create(ctx context.Context, m *model.Membership) (*model.Membership, error) {
+	created, err := r.conn(ctx).Membership.Create().
+		SetID(m.ID).
+		SetTenantID(m.TenantID).
+		SetUserID(m.UserID).
+		SetRole(membership.Role(m.Role)).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create membership: %w", err)
+	}
+	return toModelMembership(created), nil
+}
+
+func (r *MembershipRepository) FindByUserAndTenant(ctx context.Context, userID, tenantID string) (*model.Membership, error) {
+	m, err := r.conn(ctx).Membership.Query().
+		Where(
+			membership.UserIDEQ(userID),
+			membership.TenantIDEQ(tenantID),
+		).
+		Only(ctx)
+	if err != nil {
+		if generated.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to find membership: %w", err)
+	}
+	return toModelMembership(m), nil
+}
+
+func (r *MembershipRepository) ListByUser(ctx context.Context, userID string) ([]*model.Membership, error) {
+	memberships, err := r.conn(ctx).Membership.Query().
+		Where(membership.UserIDEQ(userID)).
+		WithTenant().
+		Order(generated.Asc(membership.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list memberships: %w", err)
+	}
+
+	result := make([]*model.Membership, len(memberships))
+	for i, m := range memberships {
+		result[i] = toModelMembership(m)
+		if m.Edges.Tenant != nil {
+			result[i].Tenant = toModelTenant(m.Edges.Tenant)
+		}
+	}
+	return result, nil
+}
+
+func (r *MembershipRepository) UpdateDigest(ctx context.Context, m *model.Membership) (*model.Membership, error) {
+	builder := r.conn(ctx).Membership.UpdateOneID(m.ID).
+		SetDigestEnabled(m.DigestEnabled).
+		SetDigestTime(m.DigestTime)
+	if m.DigestTimezone != nil {
+		builder.SetDigestTimezone(*m.DigestTimezone)
+	} else {
+		builder.ClearDigestTimezone()
+	}
+
+	updated, err := builder.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update digest preference: %w", err)
+	}
+	return toModelMembership(updated), nil
+}
+
+func (r *MembershipRepository) ListDigestSubscribers(ctx context.Context, tenantID string) ([]*model.Membership, error) {
+	memberships, err := r.conn(ctx).Membership.Query().
+		Where(
+			membership.TenantIDEQ(tenantID),
+			membership.DigestEnabledEQ(true),
+		).
+		WithUser().
+		Order(membership.ByID()).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list digest subscribers: %w", err)
+	}
+
+	result := make([]*model.Membership, len(memberships))
+	for i, m := range memberships {
+		result[i] = toModelMembership(m)
+		if m.Edges.User != nil {
+			result[i].User = toModelUser(m.Edges.User)
+		}
+	}
+	return result, nil
+}
+
+func (r *MembershipRepository) MarkDigestSent(ctx context.Context, id string, day time.Time) (bool, error) {
+	// The date is passed as text so the session timezone cannot shift it
+	res, err := r.conn(ctx).ExecContext(ctx, `
+UPDATE memberships SET digest_last_sent_on = $2::date
+WHERE id = $1 AND (digest_last_sent_on IS NULL OR digest_last_sent_on < $2::date)`,
+		id, day.Format(time.DateOnly))
+	if err != nil {
+		return false, fmt.Errorf("failed to mark digest sent: %w", err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to mark digest sent: %w", err)
+	}
+	return n > 0, nil
+}
+
+func toModelMembership(m *generated.Membership) *model.Membership {
+	return &model.Membership{
+		ID:               m.ID,
+		TenantID:         m.TenantID,
+		UserID:           m.UserID,
+		Role:             model.UserRole(m.Role),
+		DigestEnabled:    m.DigestEnabled,
+		DigestTime:       m.DigestTime,
+		DigestTimezone:   m.DigestTimezone,
+		DigestLastSentOn: m.DigestLastSentOn,
+		CreatedAt:        m.CreatedAt,
+		UpdatedAt:        m.UpdatedAt,
+	}
+}
@@ -0,0 +1,117 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/domain/repository"
+	"good-todo-go/internal/ent/generated"
+	"good-todo-go/internal/ent/generated/project"
+)
+
+type ProjectRepository struct {
+	client *generated.Client
+}
+
+func NewProjectRepository(client *generated.Client) repository.IProjectRepository {
+	return &ProjectRepository{client: client}
+}
+
+func (r *ProjectRepository) conn(ctx context.Context) *generated.Client {
+	return clientFromContext(ctx, r.client)
+}
+
+func (r *ProjectRepository) Create(ctx context.Context, p *model.Project) (*model.Project, error) {
+	created, err := r.conn(ctx).Project.Create().
+		SetID(p.ID).
+		SetTenantID(p.TenantID).
+		SetUserID(p.UserID).
+		SetName(p.Name).
+		SetDescription(p.Description).
+		SetColor(p.Color).
+		SetArchived(p.Archived).
+		SetIsPublic(p.IsPublic).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create project: %w", err)
+	}
+	return toModelProject(created), nil
+}
+
+func (r *ProjectRepository) FindByID(ctx context.Context, tenantID, id string) (*model.Project, error) {
+	p, err := r.conn(ctx).Project.Query().
+		Where(
+			project.IDEQ(id),
+			project.TenantIDEQ(tenantID),
+		).
+		Only(ctx)
+	if err != nil {
+		if generated.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to find project by id: %w", err)
+	}
+	return toModelProject(p), nil
+}
+
+func (r *ProjectRepository) FindVisible(ctx context.Context, tenantID, userID string, includeArchived bool) ([]*model.Project, error) {
+	q := r.conn(ctx).Project.Query().
+		Where(
+			project.TenantIDEQ(tenantID),
+			project.Or(project.UserIDEQ(userID), project.IsPublicEQ(true)),
+		).
+		Order(project.ByName(), project.ByID())
+	if !includeArchived {
+		q = q.Where(project.ArchivedEQ(false))
+	}
+
+	projects, err := q.All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list projects: %w", err)
+	}
+
+	result := make([]*model.Project, len(projects))
+	for i, p := range projects {
+		result[i] = toModelProject(p)
+	}
+	return result, nil
+}
+
+func (r *ProjectRepository) Update(ctx context.Context, p *model.Project) (*model.Project, error) {
+	updated, err := r.conn(ctx).Project.UpdateOneID(p.ID).
+		SetName(p.Name).
+		SetDescription(p.Description).
+		SetColor(p.Color).
+		SetArchived(p.Archived).
+		SetIsPublic(p.IsPublic).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update project: %w", err)
+	}
+	return toModelProject(updated), nil
+}
+
+func (r *ProjectRepository) Delete(ctx context.Context, id string) error {
+	// todos.project_id is ON DELETE SET NULL, which moves the todos to the inbox
+	err := r.conn(ctx).Project.DeleteOneID(id).Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to delete project: %w", err)
+	}
+	return nil
+}
+
+func toModelProject(p *generated.Project) *model.Project {
+	return &model.Project{
+		ID:          p.ID,
+		TenantID:    p.TenantID,
+		UserID:      p.UserID,
+		Name:        p.Name,
+		Description: p.Description,
+		Color:       p.Color,
+		Archived:    p.Archived,
+		IsPublic:    p.IsPublic,
+		CreatedAt:   p.CreatedAt,
+		UpdatedAt:   p.UpdatedAt,
+	}
+}
@@ -0,0 +1,201 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/domain/repository"
+	"good-todo-go/internal/ent/generated"
+	"good-todo-go/internal/ent/generated/reminder"
+)
+
+// reminderClaimQuery locks the tenant's due reminders with the todo and owner their email
+// needs. An offset reminder is due offset_minutes before its todo, and reminders of a
+// snoozed todo wait until it wakes. SKIP LOCKED passes
+// over reminders another scheduler has already claimed.
+//
+//	$1 tenant, $2 now, $3 limit
+const reminderClaimQuery = `
+SELECT r.id, r.tenant_id, r.todo_id, r.remind_at, r.offset_minutes, r.status, r.attempts,
+       r.next_attempt_at, r.last_error, r.delivered_at, r.created_at, r.updated_at,
+       t.title, t.due_date, u.email, u.name, u.timezone
+FROM reminders r
+JOIN todos t ON t.id = r.todo_id
+JOIN users u ON u.id = t.user_id
+WHERE r.tenant_id = $1
+  AND r.status = 'pending'
+  AND (r.next_attempt_at IS NULL OR r.next_attempt_at <= $2)
+  AND t.completed = false
+  AND t.deleted_at IS NULL
+  AND (t.snoozed_until IS NULL OR t.snoozed_until <= $2)
+  AND coalesce(r.remind_at, t.due_date - make_interval(mins => r.offset_minutes::int)) <= $2
+ORDER BY coalesce(r.remind_at, t.due_date - make_interval(mins => r.offset_minutes::int)), r.id
+LIMIT $3
+FOR UPDATE OF r SKIP LOCKED`
+
+type ReminderRepository struct {
+	client *generated.Client
+}
+
+func NewReminderRepository(client *generated.Client) repository.IReminderRepository {
+	return &ReminderRepository{client: client}
+}
+
+func (r *ReminderRepository) conn(ctx context.Context) *generated.Client {
+	return clientFromContext(ctx, r.client)
+}
+
+func (r *ReminderRepository) Create(ctx context.Context, m *model.Reminder) (*model.Reminder, error) {
+	created, err := r.conn(ctx).Reminder.Create().
+		SetID(m.ID).
+		SetTenantID(m.TenantID).
+		SetTodoID(m.TodoID).
+		SetNillableRemindAt(m.RemindAt).
+		SetNillableOffsetMinutes(m.OffsetMinutes).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create reminder: %w", err)
+	}
+	return toModelReminder(created), nil
+}
+
+func (r *ReminderRepository) FindByID(ctx context.Context, tenantID, id string) (*model.Reminder, error) {
+	found, err := r.conn(ctx).Reminder.Query().
+		Where(
+			reminder.IDEQ(id),
+			reminder.TenantIDEQ(tenantID),
+		).
+		Only(ctx)
+	if err != nil {
+		if generated.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to find reminder by id: %w", err)
+	}
+	return toModelReminder(found), nil
+}
+
+func (r *ReminderRepository) FindByTodoID(ctx context.Context, tenantID, todoID string) ([]*model.Reminder, error) {
+	reminders, err := r.conn(ctx).Reminder.Query().
+		Where(
+			reminder.TenantIDEQ(tenantID),
+			reminder.TodoIDEQ(todoID),
+		).
+		Order(reminder.ByCreatedAt(), reminder.ByID()).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list reminders: %w", err)
+	}
+
+	result := make([]*model.Reminder, len(reminders))
+	for i, m := range reminders {
+		result[i] = toModelReminder(m)
+	}
+	return result, nil
+}
+
+func (r *ReminderRepository) Delete(ctx context.Context, id string) error {
+	err := r.conn(ctx).Reminder.DeleteOneID(id).Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to delete reminder: %w", err)
+	}
+	return nil
+}
+
+func (r *ReminderRepository) ClaimDue(ctx context.Context, tenantID string, now time.Time, limit int) ([]*model.DueReminder, error) {
+	rows, err := r.conn(ctx).QueryContext(ctx, reminderClaimQuery, tenantID, now, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim due reminders: %w", err)
+	}
+	defer rows.Close()
+
+	var claimed []*model.DueReminder
+	for rows.Next() {
+		var (
+			m                                model.Reminder
+			due                              model.DueReminder
+			remindAt, nextAttemptAt, dueDate sql.NullTime
+			deliveredAt                      sql.NullTime
+			offsetMinutes                    sql.NullInt64
+			lastError                        sql.NullString
+		)
+		if err := rows.Scan(
+			&m.ID, &m.TenantID, &m.TodoID, &remindAt, &offsetMinutes, &m.Status, &m.Attempts,
+			&nextAttemptAt, &lastError, &deliveredAt, &m.CreatedAt, &m.UpdatedAt,
+			&due.TodoTitle, &dueDate, &due.Email, &due.Name, &due.Timezone,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan due reminder: %w", err)
+		}
+		if remindAt.Valid {
+			m.RemindAt = &remindAt.Time
+		}
+		if offsetMinutes.Valid {
+			offset := int(offsetMinutes.Int64)
+			m.OffsetMinutes = &offset
+		}
+		if nextAttemptAt.Valid {
+			m.NextAttemptAt = &nextAttemptAt.Time
+		}
+		if lastError.Valid {
+			m.LastError = &lastError.String
+		}
+		if deliveredAt.Valid {
+			m.DeliveredAt = &deliveredAt.Time
+		}
+		if dueDate.Valid {
+			due.DueDate = &dueDate.Time
+		}
+		due.Reminder = &m
+		claimed = append(claimed, &due)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to claim due reminders: %w", err)
+	}
+	return claimed, nil
+}
+
+func (r *ReminderRepository) UpdateDelivery(ctx context.Context, m *model.Reminder) error {
+	builder := r.conn(ctx).Reminder.UpdateOneID(m.ID).
+		SetStatus(reminder.Status(m.Status)).
+		SetAttempts(m.Attempts)
+	if m.NextAttemptAt != nil {
+		builder.SetNextAttemptAt(*m.NextAttemptAt)
+	} else {
+		builder.ClearNextAttemptAt()
+	}
+	if m.LastError != nil {
+		builder.SetLastError(*m.LastError)
+	} else {
+		builder.ClearLastError()
+	}
+	if m.DeliveredAt != nil {
+		builder.SetDeliveredAt(*m.DeliveredAt)
+	} else {
+		builder.ClearDeliveredAt()
+	}
+
+	if err := builder.Exec(ctx); err != nil {
+		return fmt.Errorf("failed to update reminder delivery: %w", err)
+	}
+	return nil
+}
+
+func toModelReminder(m *generated.Reminder) *model.Reminder {
+	return &model.Reminder{
+		ID:            m.ID,
+		TenantID:      m.TenantID,
+		TodoID:        m.TodoID,
+		RemindAt:      m.RemindAt,
+		OffsetMinutes: m.OffsetMinutes,
+		Status:        model.ReminderStatus(m.Status),
+		Attempts:      m.Attempts,
+		NextAttemptAt: m.NextAttemptAt,
+		LastError:     m.LastError,
+		DeliveredAt:   m.DeliveredAt,
+		CreatedAt:     m.CreatedAt,
+		UpdatedAt:     m.UpdatedAt,
+	}
+}
@@ -0,0 +1,122 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/domain/repository"
+	"good-todo-go/internal/ent/generated"
+	"good-todo-go/internal/ent/generated/tag"
+)
+
+type TagRepository struct {
+	client *generated.Client
+}
+
+func NewTagRepository(client *generated.Client) repository.ITagRepository {
+	return &TagRepository{client: client}
+}
+
+func (r *TagRepository) conn(ctx context.Context) *generated.Client {
+	return clientFromContext(ctx, r.client)
+}
+
+func (r *TagRepository) Create(ctx context.Context, t *model.Tag) (*model.Tag, error) {
+	created, err := r.conn(ctx).Tag.Create().
+		SetID(t.ID).
+		SetTenantID(t.TenantID).
+		SetName(t.Name).
+		SetColor(t.Color).
+		Save(ctx)
+	if err != nil {
+		if constraint, ok := uniqueViolation(err); ok && constraint == constraintTagName {
+			return nil, repository.ErrTagNameConflict
+		}
+		return nil, fmt.Errorf("failed to create tag: %w", err)
+	}
+	return toModelTag(created), nil
+}
+
+func (r *TagRepository) FindByID(ctx context.Context, tenantID, id string) (*model.Tag, error) {
+	t, err := r.conn(ctx).Tag.Query().
+		Where(
+			tag.IDEQ(id),
+			tag.TenantIDEQ(tenantID),
+		).
+		Only(ctx)
+	if err != nil {
+		if generated.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to find tag by id: %w", err)
+	}
+	return toModelTag(t), nil
+}
+
+func (r *TagRepository) FindByIDs(ctx context.Context, tenantID string, ids []string) ([]*model.Tag, error) {
+	tags, err := r.conn(ctx).Tag.Query().
+		Where(
+			tag.IDIn(ids...),
+			tag.TenantIDEQ(tenantID),
+		).
+		Order(tag.ByName()).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find tags by ids: %w", err)
+	}
+	return toModelTags(tags), nil
+}
+
+func (r *TagRepository) ListByTenant(ctx context.Context, tenantID string) ([]*model.Tag, error) {
+	tags, err := r.conn(ctx).Tag.Query().
+		Where(tag.TenantIDEQ(tenantID)).
+		Order(tag.ByName()).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tags: %w", err)
+	}
+	return toModelTags(tags), nil
+}
+
+func (r *TagRepository) Update(ctx context.Context, t *model.Tag) (*model.Tag, error) {
+	updated, err := r.conn(ctx).Tag.UpdateOneID(t.ID).
+		SetName(t.Name).
+		SetColor(t.Color).
+		Save(ctx)
+	if err != nil {
+		if constraint, ok := uniqueViolation(err); ok && constraint == constraintTagName {
+			return nil, repository.ErrTagNameConflict
+		}
+		return nil, fmt.Errorf("failed to update tag: %w", err)
+	}
+	return toModelTag(updated), nil
+}
+
+func (r *TagRepository) Delete(ctx context.Context, id string) error {
+	// The join rows go with the tag through ON DELETE CASCADE
+	err := r.conn(ctx).Tag.DeleteOneID(id).Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to delete tag: %w", err)
+	}
+	return nil
+}
+
+func toModelTag(t *generated.Tag) *model.Tag {
+	return &model.Tag{
+		ID:        t.ID,
+		TenantID:  t.TenantID,
+		Name:      t.Name,
+		Color:     t.Color,
+		CreatedAt: t.CreatedAt,
+		UpdatedAt: t.UpdatedAt,
+	}
+}
+
+func toModelTags(tags []*generated.Tag) []*model.Tag {
+	result := make([]*model.Tag, len(tags))
+	for i, t := range tags {
+		result[i] = toModelTag(t)
+	}
+	return result
+}
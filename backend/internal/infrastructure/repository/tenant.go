@@ -3,11 +3,14 @@ package repository
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"good-todo-go/internal/domain/model"
 	"good-todo-go/internal/domain/repository"
 	"good-todo-go/internal/ent/generated"
 	"good-todo-go/internal/ent/generated/tenant"
+	"good-todo-go/internal/ent/generated/tenantdomain"
+	"good-todo-go/internal/ent/generated/tenantslughistory"
 )
 
 type TenantRepository struct {
@@ -18,20 +21,27 @@ func NewTenantRepository(client *generated.Client) repository.ITenantRepository
 	return &TenantRepository{client: client}
 }
 
+func (r *TenantRepository) conn(ctx context.Context) *generated.Client {
+	return clientFromContext(ctx, r.client)
+}
+
 func (r *TenantRepository) Create(ctx context.Context, t *model.Tenant) (*model.Tenant, error) {
-	created, err := r.client.Tenant.Create().
+	created, err := r.conn(ctx).Tenant.Create().
 		SetID(t.ID).
 		SetName(t.Name).
 		SetSlug(t.Slug).
 		Save(ctx)
 	if err != nil {
+		if constraint, ok := uniqueViolation(err); ok && constraint == constraintTenantSlug {
+			return nil, repository.ErrTenantSlugConflict
+		}
 		return nil, fmt.Errorf("failed to create tenant: %w", err)
 	}
 	return toModelTenant(created), nil
 }
 
 func (r *TenantRepository) FindByID(ctx context.Context, id string) (*model.Tenant, error) {
-	t, err := r.client.Tenant.Get(ctx, id)
+	t, err := r.conn(ctx).Tenant.Get(ctx, id)
 	if err != nil {
 		if generated.IsNotFound(err) {
 			return nil, nil
@@ -42,24 +52,139 @@ func (r *TenantRepository) FindByID(ctx context.Context, id string) (*model.Tena
 }
 
 func (r *TenantRepository) FindBySlug(ctx context.Context, slug string) (*model.Tenant, error) {
-	t, err := r.client.Tenant.Query().
+	t, err := r.conn(ctx).Tenant.Query().
 		Where(tenant.SlugEQ(slug)).
 		Only(ctx)
+	if err == nil {
+		return toModelTenant(t), nil
+	}
+	if !generated.IsNotFound(err) {
+		return nil, fmt.Errorf("failed to find tenant by slug: %w", err)
+	}
+
+	// Fall back to a previous slug that is still within its grace period
+	t, err = r.conn(ctx).TenantSlugHistory.Query().
+		Where(
+			tenantslughistory.SlugEQ(slug),
+			tenantslughistory.ExpiresAtGT(time.Now()),
+		).
+		QueryTenant().
+		Only(ctx)
 	if err != nil {
 		if generated.IsNotFound(err) {
 			return nil, nil
 		}
-		return nil, fmt.Errorf("failed to find tenant by slug: %w", err)
+		return nil, fmt.Errorf("failed to find tenant by previous slug: %w", err)
+	}
+	return toModelTenant(t), nil
+}
+
+func (r *TenantRepository) FindByDomain(ctx context.Context, domain string) (*model.Tenant, error) {
+	t, err := r.conn(ctx).TenantDomain.Query().
+		Where(
+			tenantdomain.DomainEQ(domain),
+			tenantdomain.VerifiedAtNotNil(),
+		).
+		QueryTenant().
+		Only(ctx)
+	if err != nil {
+		if generated.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to find tenant by domain: %w", err)
 	}
 	return toModelTenant(t), nil
 }
 
+func (r *TenantRepository) FindAll(ctx context.Context) ([]*model.Tenant, error) {
+	tenants, err := r.conn(ctx).Tenant.Query().
+		Order(tenant.ByID()).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tenants: %w", err)
+	}
+
+	result := make([]*model.Tenant, len(tenants))
+	for i, t := range tenants {
+		result[i] = toModelTenant(t)
+	}
+	return result, nil
+}
+
+func (r *TenantRepository) Update(ctx context.Context, t *model.Tenant) (*model.Tenant, error) {
+	updated, err := r.conn(ctx).Tenant.UpdateOneID(t.ID).
+		SetName(t.Name).
+		SetTrashRetentionDays(t.TrashRetentionDays).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update tenant: %w", err)
+	}
+	return toModelTenant(updated), nil
+}
+
+func (r *TenantRepository) ChangeSlug(ctx context.Context, tenantID, slug string, keepPreviousUntil time.Time) (*model.Tenant, error) {
+	client := r.conn(ctx)
+
+	current, err := client.Tenant.Get(ctx, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find tenant by id: %w", err)
+	}
+	if current.Slug == slug {
+		return toModelTenant(current), nil
+	}
+
+	// Release the new and current slugs from history if they are our own or expired
+	_, err = client.TenantSlugHistory.Delete().
+		Where(
+			tenantslughistory.SlugIn(slug, current.Slug),
+			tenantslughistory.Or(
+				tenantslughistory.TenantIDEQ(tenantID),
+				tenantslughistory.ExpiresAtLTE(time.Now()),
+			),
+		).
+		Exec(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to release tenant slug: %w", err)
+	}
+
+	reserved, err := client.TenantSlugHistory.Query().
+		Where(tenantslughistory.SlugEQ(slug)).
+		Exist(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check tenant slug history: %w", err)
+	}
+	if reserved {
+		return nil, repository.ErrTenantSlugConflict
+	}
+
+	err = client.TenantSlugHistory.Create().
+		SetTenantID(tenantID).
+		SetSlug(current.Slug).
+		SetExpiresAt(keepPreviousUntil).
+		Exec(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to record previous tenant slug: %w", err)
+	}
+
+	updated, err := client.Tenant.UpdateOneID(tenantID).
+		SetSlug(slug).
+		Save(ctx)
+	if err != nil {
+		if constraint, ok := uniqueViolation(err); ok && constraint == constraintTenantSlug {
+			return nil, repository.ErrTenantSlugConflict
+		}
+		return nil, fmt.Errorf("failed to change tenant slug: %w", err)
+	}
+	return toModelTenant(updated), nil
+}
+
 func toModelTenant(t *generated.Tenant) *model.Tenant {
 	return &model.Tenant{
-		ID:        t.ID,
-		Name:      t.Name,
-		Slug:      t.Slug,
-		CreatedAt: t.CreatedAt,
-		UpdatedAt: t.UpdatedAt,
+		ID:                 t.ID,
+		Name:               t.Name,
+		Slug:               t.Slug,
+		TrashRetentionDays: t.TrashRetentionDays,
+		CreatedAt:          t.CreatedAt,
+		UpdatedAt:          t.UpdatedAt,
 	}
 }
@@ -0,0 +1,101 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/domain/repository"
+	"good-todo-go/internal/ent/generated"
+	"good-todo-go/internal/ent/generated/tenantdomain"
+)
+
+type TenantDomainRepository struct {
+	client *generated.Client
+}
+
+func NewTenantDomainRepository(client *generated.Client) repository.ITenantDomainRepository {
+	return &TenantDomainRepository{client: client}
+}
+
+func (r *TenantDomainRepository) conn(ctx context.Context) *generated.Client {
+	return clientFromContext(ctx, r.client)
+}
+
+func (r *TenantDomainRepository) Create(ctx context.Context, d *model.TenantDomain) (*model.TenantDomain, error) {
+	created, err := r.conn(ctx).TenantDomain.Create().
+		SetID(d.ID).
+		SetTenantID(d.TenantID).
+		SetDomain(d.Domain).
+		SetVerificationToken(d.VerificationToken).
+		Save(ctx)
+	if err != nil {
+		if constraint, ok := uniqueViolation(err); ok && constraint == constraintTenantDomain {
+			return nil, repository.ErrTenantDomainConflict
+		}
+		return nil, fmt.Errorf("failed to create tenant domain: %w", err)
+	}
+	return toModelTenantDomain(created), nil
+}
+
+func (r *TenantDomainRepository) FindByID(ctx context.Context, tenantID, id string) (*model.TenantDomain, error) {
+	d, err := r.conn(ctx).TenantDomain.Query().
+		Where(
+			tenantdomain.IDEQ(id),
+			tenantdomain.TenantIDEQ(tenantID),
+		).
+		Only(ctx)
+	if err != nil {
+		if generated.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to find tenant domain by id: %w", err)
+	}
+	return toModelTenantDomain(d), nil
+}
+
+func (r *TenantDomainRepository) ListByTenant(ctx context.Context, tenantID string) ([]*model.TenantDomain, error) {
+	domains, err := r.conn(ctx).TenantDomain.Query().
+		Where(tenantdomain.TenantIDEQ(tenantID)).
+		Order(generated.Asc(tenantdomain.FieldDomain)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tenant domains: %w", err)
+	}
+
+	result := make([]*model.TenantDomain, len(domains))
+	for i, d := range domains {
+		result[i] = toModelTenantDomain(d)
+	}
+	return result, nil
+}
+
+func (r *TenantDomainRepository) MarkVerified(ctx context.Context, id string, verifiedAt time.Time) (*model.TenantDomain, error) {
+	updated, err := r.conn(ctx).TenantDomain.UpdateOneID(id).
+		SetVerifiedAt(verifiedAt).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to mark tenant domain verified: %w", err)
+	}
+	return toModelTenantDomain(updated), nil
+}
+
+func (r *TenantDomainRepository) Delete(ctx context.Context, id string) error {
+	err := r.conn(ctx).TenantDomain.DeleteOneID(id).Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to delete tenant domain: %w", err)
+	}
+	return nil
+}
+
+func toModelTenantDomain(d *generated.TenantDomain) *model.TenantDomain {
+	return &model.TenantDomain{
+		ID:                d.ID,
+		TenantID:          d.TenantID,
+		Domain:            d.Domain,
+		VerificationToken: d.VerificationToken,
+		VerifiedAt:        d.VerifiedAt,
+		CreatedAt:         d.CreatedAt,
+	}
+}
@@ -3,11 +3,16 @@ package repository
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"good-todo-go/internal/domain/model"
 	"good-todo-go/internal/domain/repository"
 	"good-todo-go/internal/ent/generated"
+	"good-todo-go/internal/ent/generated/predicate"
+	"good-todo-go/internal/ent/generated/tag"
 	"good-todo-go/internal/ent/generated/todo"
+
+	"entgo.io/ent/dialect/sql"
 )
 
 type TodoRepository struct {
@@ -18,15 +23,28 @@ func NewTodoRepository(client *generated.Client) repository.ITodoRepository {
 	return &TodoRepository{client: client}
 }
 
+func (r *TodoRepository) conn(ctx context.Context) *generated.Client {
+	return clientFromContext(ctx, r.client)
+}
+
 func (r *TodoRepository) Create(ctx context.Context, t *model.Todo) (*model.Todo, error) {
-	builder := r.client.Todo.Create().
+	builder := r.conn(ctx).Todo.Create().
 		SetID(t.ID).
 		SetTenantID(t.TenantID).
 		SetUserID(t.UserID).
 		SetTitle(t.Title).
 		SetDescription(t.Description).
 		SetCompleted(t.Completed).
-		SetIsPublic(t.IsPublic)
+		SetIsPublic(t.IsPublic).
+		SetAutoComplete(t.AutoComplete).
+		SetPriority(todo.Priority(t.Priority)).
+		SetPosition(t.Position).
+		SetNillableAssigneeID(t.AssigneeID).
+		SetNillableProjectID(t.ProjectID).
+		SetNillableParentID(t.ParentID).
+		SetNillableRecurrenceRule(t.RecurrenceRule).
+		SetNillableRecurrenceTimezone(t.RecurrenceTimezone).
+		AddTagIDs(tagIDs(t.Tags)...)
 
 	if t.DueDate != nil {
 		builder.SetDueDate(*t.DueDate)
@@ -39,100 +57,632 @@ func (r *TodoRepository) Create(ctx context.Context, t *model.Todo) (*model.Todo
 	if err != nil {
 		return nil, fmt.Errorf("failed to create todo: %w", err)
 	}
+	if err := loadTags(ctx, created); err != nil {
+		return nil, fmt.Errorf("failed to create todo: %w", err)
+	}
 	return toModelTodo(created), nil
 }
 
 func (r *TodoRepository) FindByID(ctx context.Context, id string) (*model.Todo, error) {
-	t, err := r.client.Todo.Get(ctx, id)
+	t, err := r.conn(ctx).Todo.Query().
+		Where(todo.ID(id), todo.DeletedAtIsNil()).
+		WithTags(tagsByName).
+		Only(ctx)
+	if err != nil {
+		if generated.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to find todo by id: %w", err)
+	}
+	result := toModelTodo(t)
+	if err := r.loadCounts(ctx, result); err != nil {
+		return nil, fmt.Errorf("failed to find todo by id: %w", err)
+	}
+	return result, nil
+}
+
+func (r *TodoRepository) FindByIDWithTrashed(ctx context.Context, id string) (*model.Todo, error) {
+	t, err := r.conn(ctx).Todo.Query().
+		Where(todo.ID(id)).
+		WithTags(tagsByName).
+		Only(ctx)
 	if err != nil {
 		if generated.IsNotFound(err) {
 			return nil, nil
 		}
 		return nil, fmt.Errorf("failed to find todo by id: %w", err)
 	}
-	return toModelTodo(t), nil
+	result := toModelTodo(t)
+	if err := r.loadCounts(ctx, result); err != nil {
+		return nil, fmt.Errorf("failed to find todo by id: %w", err)
+	}
+	return result, nil
 }
 
-func (r *TodoRepository) FindByUserID(ctx context.Context, userID string) ([]*model.Todo, error) {
-	todos, err := r.client.Todo.Query().
-		Where(todo.UserIDEQ(userID)).
-		Order(generated.Desc(todo.FieldCreatedAt)).
+func (r *TodoRepository) FindByIDs(ctx context.Context, ids []string) ([]*model.Todo, error) {
+	todos, err := r.conn(ctx).Todo.Query().
+		Where(todo.IDIn(ids...), todo.DeletedAtIsNil()).
+		WithTags(tagsByName).
 		All(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to find todos by user id: %w", err)
+		return nil, fmt.Errorf("failed to find todos by ids: %w", err)
 	}
 
 	result := make([]*model.Todo, len(todos))
 	for i, t := range todos {
 		result[i] = toModelTodo(t)
 	}
+	if err := r.loadCounts(ctx, result...); err != nil {
+		return nil, fmt.Errorf("failed to find todos by ids: %w", err)
+	}
 	return result, nil
 }
 
-func (r *TodoRepository) FindPublicByTenantID(ctx context.Context, tenantID string) ([]*model.Todo, error) {
-	todos, err := r.client.Todo.Query().
-		Where(
-			todo.TenantIDEQ(tenantID),
-			todo.IsPublicEQ(true),
-		).
-		Order(generated.Desc(todo.FieldCreatedAt)).
-		All(ctx)
+func (r *TodoRepository) FindByUserID(ctx context.Context, userID string, query repository.TodoQuery) ([]*model.Todo, error) {
+	todos, err := r.list(ctx, query, todo.UserIDEQ(userID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to find todos by user id: %w", err)
+	}
+	return todos, nil
+}
+
+func (r *TodoRepository) FindPublicByTenantID(ctx context.Context, tenantID string, query repository.TodoQuery) ([]*model.Todo, error) {
+	todos, err := r.list(ctx, query, todo.TenantIDEQ(tenantID), todo.IsPublicEQ(true))
 	if err != nil {
 		return nil, fmt.Errorf("failed to find public todos by tenant id: %w", err)
 	}
+	return todos, nil
+}
+
+func (r *TodoRepository) FindByAssigneeID(ctx context.Context, userID string, query repository.TodoQuery) ([]*model.Todo, error) {
+	todos, err := r.list(ctx, query, todo.AssigneeIDEQ(userID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to find todos by assignee id: %w", err)
+	}
+	return todos, nil
+}
+
+func (r *TodoRepository) FindByProjectID(ctx context.Context, projectID string, query repository.TodoQuery) ([]*model.Todo, error) {
+	todos, err := r.list(ctx, query, todo.ProjectIDEQ(projectID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to find todos by project id: %w", err)
+	}
+	return todos, nil
+}
+
+func (r *TodoRepository) FindSubtasks(ctx context.Context, parentIDs []string) ([]*model.Todo, error) {
+	todos, err := r.conn(ctx).Todo.Query().
+		Where(todo.ParentIDIn(parentIDs...), todo.DeletedAtIsNil()).
+		Order(todo.ByCreatedAt(), todo.ByID()).
+		WithTags(tagsByName).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find subtasks: %w", err)
+	}
+
+	result := make([]*model.Todo, len(todos))
+	for i, t := range todos {
+		result[i] = toModelTodo(t)
+	}
+	if err := r.loadCounts(ctx, result...); err != nil {
+		return nil, fmt.Errorf("failed to find subtasks: %w", err)
+	}
+	return result, nil
+}
+
+// list runs a filtered, sorted keyset page query on top of the scope predicates.
+func (r *TodoRepository) list(ctx context.Context, query repository.TodoQuery, scope ...predicate.Todo) ([]*model.Todo, error) {
+	q := r.conn(ctx).Todo.Query().
+		Where(scope...).
+		Where(todoFilterPredicates(query.Filter)...).
+		Order(todoOrder(query.Sort)...).
+		WithTags(tagsByName)
+	if query.After != nil {
+		q = q.Where(afterCursor(query.Sort, query.After))
+	}
+	if query.Limit > 0 {
+		q = q.Limit(query.Limit)
+	}
+
+	todos, err := q.All(ctx)
+	if err != nil {
+		return nil, err
+	}
 
 	result := make([]*model.Todo, len(todos))
 	for i, t := range todos {
 		result[i] = toModelTodo(t)
 	}
+	if err := r.loadCounts(ctx, result...); err != nil {
+		return nil, err
+	}
 	return result, nil
 }
 
-func (r *TodoRepository) Update(ctx context.Context, t *model.Todo) (*model.Todo, error) {
-	builder := r.client.Todo.UpdateOneID(t.ID).
+func (r *TodoRepository) Update(ctx context.Context, t *model.Todo, expectedVersion *int) (*model.Todo, error) {
+	builder := r.conn(ctx).Todo.UpdateOneID(t.ID).
 		SetTitle(t.Title).
 		SetDescription(t.Description).
 		SetCompleted(t.Completed).
-		SetIsPublic(t.IsPublic)
+		SetIsPublic(t.IsPublic).
+		SetAutoComplete(t.AutoComplete).
+		SetPriority(todo.Priority(t.Priority)).
+		ClearTags().
+		AddTagIDs(tagIDs(t.Tags)...).
+		AddVersion(1).
+		Where(todo.DeletedAtIsNil())
 
+	// The version check sits in the UPDATE's WHERE clause so no concurrent write can slip in between
+	if expectedVersion != nil {
+		builder.Where(todo.VersionEQ(*expectedVersion))
+	}
+
+	if t.AssigneeID != nil {
+		builder.SetAssigneeID(*t.AssigneeID)
+	} else {
+		builder.ClearAssigneeID()
+	}
+	if t.ProjectID != nil {
+		builder.SetProjectID(*t.ProjectID)
+	} else {
+		builder.ClearProjectID()
+	}
+	if t.ParentID != nil {
+		builder.SetParentID(*t.ParentID)
+	} else {
+		builder.ClearParentID()
+	}
 	if t.DueDate != nil {
 		builder.SetDueDate(*t.DueDate)
 	} else {
 		builder.ClearDueDate()
 	}
+	if t.RecurrenceRule != nil {
+		builder.SetRecurrenceRule(*t.RecurrenceRule)
+	} else {
+		builder.ClearRecurrenceRule()
+	}
+	if t.RecurrenceTimezone != nil {
+		builder.SetRecurrenceTimezone(*t.RecurrenceTimezone)
+	} else {
+		builder.ClearRecurrenceTimezone()
+	}
 	if t.CompletedAt != nil {
 		builder.SetCompletedAt(*t.CompletedAt)
 	} else {
 		builder.ClearCompletedAt()
 	}
+	if t.SnoozedUntil != nil {
+		builder.SetSnoozedUntil(*t.SnoozedUntil)
+	} else {
+		builder.ClearSnoozedUntil()
+	}
 
 	updated, err := builder.Save(ctx)
 	if err != nil {
+		if expectedVersion != nil && generated.IsNotFound(err) {
+			return nil, repository.ErrVersionMismatch
+		}
+		return nil, fmt.Errorf("failed to update todo: %w", err)
+	}
+	if err := loadTags(ctx, updated); err != nil {
 		return nil, fmt.Errorf("failed to update todo: %w", err)
 	}
-	return toModelTodo(updated), nil
+	result := toModelTodo(updated)
+	if err := r.loadCounts(ctx, result); err != nil {
+		return nil, fmt.Errorf("failed to update todo: %w", err)
+	}
+	return result, nil
+}
+
+func (r *TodoRepository) Trash(ctx context.Context, id string, deletedAt time.Time, expectedVersion *int) error {
+	builder := r.conn(ctx).Todo.UpdateOneID(id).
+		SetDeletedAt(deletedAt).
+		AddVersion(1).
+		Where(todo.DeletedAtIsNil())
+	if expectedVersion != nil {
+		builder.Where(todo.VersionEQ(*expectedVersion))
+	}
+
+	if err := builder.Exec(ctx); err != nil {
+		if generated.IsNotFound(err) {
+			if expectedVersion != nil {
+				return repository.ErrVersionMismatch
+			}
+			return repository.ErrTodoNotFound
+		}
+		return fmt.Errorf("failed to trash todo: %w", err)
+	}
+	return nil
+}
+
+func (r *TodoRepository) Restore(ctx context.Context, id string) (*model.Todo, error) {
+	restored, err := r.conn(ctx).Todo.UpdateOneID(id).
+		ClearDeletedAt().
+		AddVersion(1).
+		Where(todo.DeletedAtNotNil()).
+		Save(ctx)
+	if err != nil {
+		if generated.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to restore todo: %w", err)
+	}
+	if err := loadTags(ctx, restored); err != nil {
+		return nil, fmt.Errorf("failed to restore todo: %w", err)
+	}
+	result := toModelTodo(restored)
+	if err := r.loadCounts(ctx, result); err != nil {
+		return nil, fmt.Errorf("failed to restore todo: %w", err)
+	}
+	return result, nil
 }
 
-func (r *TodoRepository) Delete(ctx context.Context, id string) error {
-	err := r.client.Todo.DeleteOneID(id).Exec(ctx)
+func (r *TodoRepository) Snooze(ctx context.Context, id string, until *time.Time) (*model.Todo, error) {
+	builder := r.conn(ctx).Todo.UpdateOneID(id).
+		AddVersion(1).
+		Where(todo.DeletedAtIsNil())
+	if until != nil {
+		builder.SetSnoozedUntil(*until)
+	} else {
+		builder.ClearSnoozedUntil()
+	}
+
+	snoozed, err := builder.Save(ctx)
+	if err != nil {
+		if generated.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to snooze todo: %w", err)
+	}
+	if err := loadTags(ctx, snoozed); err != nil {
+		return nil, fmt.Errorf("failed to snooze todo: %w", err)
+	}
+	result := toModelTodo(snoozed)
+	if err := r.loadCounts(ctx, result); err != nil {
+		return nil, fmt.Errorf("failed to snooze todo: %w", err)
+	}
+	return result, nil
+}
+
+func (r *TodoRepository) SetAssignee(ctx context.Context, id string, assigneeID *string) (*model.Todo, error) {
+	builder := r.conn(ctx).Todo.UpdateOneID(id).
+		AddVersion(1).
+		Where(todo.DeletedAtIsNil())
+	if assigneeID != nil {
+		builder.SetAssigneeID(*assigneeID)
+	} else {
+		builder.ClearAssigneeID()
+	}
+
+	assigned, err := builder.Save(ctx)
+	if err != nil {
+		if generated.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to assign todo: %w", err)
+	}
+	if err := loadTags(ctx, assigned); err != nil {
+		return nil, fmt.Errorf("failed to assign todo: %w", err)
+	}
+	result := toModelTodo(assigned)
+	if err := r.loadCounts(ctx, result); err != nil {
+		return nil, fmt.Errorf("failed to assign todo: %w", err)
+	}
+	return result, nil
+}
+
+func (r *TodoRepository) TrashProjectTodos(ctx context.Context, projectID, userID string, deletedAt time.Time) (int, error) {
+	n, err := r.conn(ctx).Todo.Update().
+		Where(todo.ProjectIDEQ(projectID), todo.UserIDEQ(userID), todo.DeletedAtIsNil()).
+		SetDeletedAt(deletedAt).
+		AddVersion(1).
+		Save(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to trash project todos: %w", err)
+	}
+	return n, nil
+}
+
+func (r *TodoRepository) PurgeTrash(ctx context.Context, tenantID string, cutoff time.Time) (int, error) {
+	n, err := r.conn(ctx).Todo.Delete().
+		Where(todo.TenantIDEQ(tenantID), todo.DeletedAtLT(cutoff)).
+		Exec(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge trashed todos: %w", err)
+	}
+	return n, nil
+}
+
+func (r *TodoRepository) Delete(ctx context.Context, id string, expectedVersion *int) error {
+	if expectedVersion == nil {
+		err := r.conn(ctx).Todo.DeleteOneID(id).Exec(ctx)
+		if generated.IsNotFound(err) {
+			return repository.ErrTodoNotFound
+		}
+		if err != nil {
+			return fmt.Errorf("failed to delete todo: %w", err)
+		}
+		return nil
+	}
+
+	n, err := r.conn(ctx).Todo.Delete().
+		Where(todo.ID(id), todo.VersionEQ(*expectedVersion)).
+		Exec(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to delete todo: %w", err)
 	}
+	if n == 0 {
+		return repository.ErrVersionMismatch
+	}
 	return nil
 }
 
+func todoFilterPredicates(f repository.TodoFilter) []predicate.Todo {
+	var ps []predicate.Todo
+	if f.Trashed {
+		ps = append(ps, todo.DeletedAtNotNil())
+	} else {
+		ps = append(ps, todo.DeletedAtIsNil())
+		if !f.IncludeSnoozed {
+			ps = append(ps, todo.Or(todo.SnoozedUntilIsNil(), todo.SnoozedUntilLTE(f.Now)))
+		}
+	}
+	if f.VisibleTo != "" {
+		ps = append(ps, todo.Or(todo.UserIDEQ(f.VisibleTo), todo.AssigneeIDEQ(f.VisibleTo), todo.IsPublicEQ(true)))
+	}
+	if f.Completed != nil {
+		ps = append(ps, todo.CompletedEQ(*f.Completed))
+	}
+	if f.IsPublic != nil {
+		ps = append(ps, todo.IsPublicEQ(*f.IsPublic))
+	}
+	if f.DueBefore != nil {
+		ps = append(ps, todo.DueDateLT(*f.DueBefore))
+	}
+	if f.DueAfter != nil {
+		ps = append(ps, todo.DueDateGT(*f.DueAfter))
+	}
+	if f.DueFrom != nil {
+		ps = append(ps, todo.DueDateGTE(*f.DueFrom))
+	}
+	if f.Overdue != nil {
+		if *f.Overdue {
+			ps = append(ps, todo.DueDateLT(f.Now), todo.CompletedEQ(false))
+		} else {
+			// Spelled out rather than negated so todos without a due date are kept
+			ps = append(ps, todo.Or(todo.DueDateIsNil(), todo.DueDateGTE(f.Now), todo.CompletedEQ(true)))
+		}
+	}
+	if f.HasDueDate != nil {
+		if *f.HasDueDate {
+			ps = append(ps, todo.DueDateNotNil())
+		} else {
+			ps = append(ps, todo.DueDateIsNil())
+		}
+	}
+	if f.CreatedBefore != nil {
+		ps = append(ps, todo.CreatedAtLT(*f.CreatedBefore))
+	}
+	if f.CreatedAfter != nil {
+		ps = append(ps, todo.CreatedAtGT(*f.CreatedAfter))
+	}
+	for _, text := range f.Text {
+		ps = append(ps, todoContains(text))
+	}
+	for _, text := range f.ExcludeText {
+		ps = append(ps, todo.Not(todoContains(text)))
+	}
+	if f.ProjectID != nil {
+		if *f.ProjectID == "" {
+			ps = append(ps, todo.ProjectIDIsNil())
+		} else {
+			ps = append(ps, todo.ProjectIDEQ(*f.ProjectID))
+		}
+	}
+	for _, id := range f.TagIDs {
+		ps = append(ps, todo.HasTagsWith(tag.IDEQ(id)))
+	}
+	for _, name := range f.Tags {
+		ps = append(ps, todo.HasTagsWith(tag.NameEqualFold(name)))
+	}
+	for _, name := range f.ExcludeTags {
+		ps = append(ps, todo.Not(todo.HasTagsWith(tag.NameEqualFold(name))))
+	}
+	return ps
+}
+
+// todoContains matches text anywhere in the title or description, ignoring case.
+// Both columns carry trigram indexes, so the ILIKE stays index-backed.
+func todoContains(text string) predicate.Todo {
+	return todo.Or(todo.TitleContainsFold(text), todo.DescriptionContainsFold(text))
+}
+
+// todoSortColumn maps a sort field to its column, defaulting to created_at.
+func todoSortColumn(field repository.TodoSortField) string {
+	switch field {
+	case repository.TodoSortDueDate:
+		return todo.FieldDueDate
+	case repository.TodoSortUpdatedAt:
+		return todo.FieldUpdatedAt
+	case repository.TodoSortTitle:
+		return todo.FieldTitle
+	case repository.TodoSortPosition:
+		return todo.FieldPosition
+	case repository.TodoSortPriority:
+		return todo.FieldPriority
+	default:
+		return todo.FieldCreatedAt
+	}
+}
+
+func todoOrder(sort repository.TodoSort) []todo.OrderOption {
+	dir := sql.OrderAsc()
+	if sort.Desc {
+		dir = sql.OrderDesc()
+	}
+	column := todoSortColumn(sort.Field)
+	switch column {
+	case todo.FieldDueDate:
+		return []todo.OrderOption{
+			todo.ByDueDate(dir, sql.OrderNullsLast()),
+			todo.ByID(dir),
+		}
+	case todo.FieldPriority:
+		return []todo.OrderOption{
+			todo.ByPriority(dir),
+			todo.ByDueDate(sql.OrderNullsLast()),
+			todo.ByID(),
+		}
+	}
+	return []todo.OrderOption{
+		sql.OrderByField(column, dir).ToFunc(),
+		todo.ByID(dir),
+	}
+}
+
+// afterCursor restricts a query in sort order to rows strictly after the cursor.
+func afterCursor(sort repository.TodoSort, c *repository.TodoCursor) predicate.Todo {
+	column := todoSortColumn(sort.Field)
+	var value any
+	switch column {
+	case todo.FieldDueDate:
+		if c.DueDate == nil {
+			// Todos without a due date sort last, so only those remain
+			return todo.And(todo.DueDateIsNil(), idAfter(sort, c.ID))
+		}
+		value = *c.DueDate
+	case todo.FieldUpdatedAt:
+		value = c.UpdatedAt
+	case todo.FieldTitle:
+		value = c.Title
+	case todo.FieldPosition:
+		value = c.Position
+	case todo.FieldPriority:
+		// Within a priority the rows run by due date, so the rest of the cursor is compared that way
+		return todo.Or(
+			beyond(sort, column, string(c.Priority)),
+			todo.And(todo.PriorityEQ(todo.Priority(c.Priority)), afterCursor(repository.TodoSort{Field: repository.TodoSortDueDate}, c)),
+		)
+	default:
+		value = c.CreatedAt
+	}
+
+	after := todo.Or(
+		beyond(sort, column, value),
+		todo.And(sql.FieldEQ(column, value), idAfter(sort, c.ID)),
+	)
+	if column == todo.FieldDueDate {
+		return todo.Or(after, todo.DueDateIsNil())
+	}
+	return after
+}
+
+func beyond(sort repository.TodoSort, column string, value any) predicate.Todo {
+	if sort.Desc {
+		return sql.FieldLT(column, value)
+	}
+	return sql.FieldGT(column, value)
+}
+
+func idAfter(sort repository.TodoSort, id string) predicate.Todo {
+	return beyond(sort, todo.FieldID, id)
+}
+
 func toModelTodo(t *generated.Todo) *model.Todo {
 	return &model.Todo{
-		ID:          t.ID,
-		TenantID:    t.TenantID,
-		UserID:      t.UserID,
-		Title:       t.Title,
-		Description: t.Description,
-		Completed:   t.Completed,
-		IsPublic:    t.IsPublic,
-		DueDate:     t.DueDate,
-		CompletedAt: t.CompletedAt,
-		CreatedAt:   t.CreatedAt,
-		UpdatedAt:   t.UpdatedAt,
+		ID:                 t.ID,
+		TenantID:           t.TenantID,
+		UserID:             t.UserID,
+		AssigneeID:         t.AssigneeID,
+		ProjectID:          t.ProjectID,
+		ParentID:           t.ParentID,
+		Title:              t.Title,
+		Description:        t.Description,
+		Completed:          t.Completed,
+		IsPublic:           t.IsPublic,
+		AutoComplete:       t.AutoComplete,
+		Priority:           model.TodoPriority(t.Priority),
+		DueDate:            t.DueDate,
+		RecurrenceRule:     t.RecurrenceRule,
+		RecurrenceTimezone: t.RecurrenceTimezone,
+		CompletedAt:        t.CompletedAt,
+		SnoozedUntil:       t.SnoozedUntil,
+		Version:            t.Version,
+		DeletedAt:          t.DeletedAt,
+		Position:           t.Position,
+		Tags:               toModelTags(t.Edges.Tags),
+		CreatedAt:          t.CreatedAt,
+		UpdatedAt:          t.UpdatedAt,
+	}
+}
+
+// loadCounts fills in the subtask and dependency counts of todos.
+func (r *TodoRepository) loadCounts(ctx context.Context, todos ...*model.Todo) error {
+	if err := r.countSubtasks(ctx, todos...); err != nil {
+		return err
+	}
+	return r.countDependencies(ctx, todos...)
+}
+
+// countSubtasks fills in the subtask counts of todos with a single grouped query.
+func (r *TodoRepository) countSubtasks(ctx context.Context, todos ...*model.Todo) error {
+	if len(todos) == 0 {
+		return nil
+	}
+	ids := make([]string, len(todos))
+	for i, t := range todos {
+		ids[i] = t.ID
+	}
+
+	var counts []struct {
+		ParentID  string `json:"parent_id"`
+		Completed bool   `json:"completed"`
+		Count     int    `json:"count"`
+	}
+	err := r.conn(ctx).Todo.Query().
+		Where(todo.ParentIDIn(ids...), todo.DeletedAtIsNil()).
+		GroupBy(todo.FieldParentID, todo.FieldCompleted).
+		Aggregate(generated.Count()).
+		Scan(ctx, &counts)
+	if err != nil {
+		return err
+	}
+
+	byID := make(map[string]*model.Todo, len(todos))
+	for _, t := range todos {
+		byID[t.ID] = t
+	}
+	for _, c := range counts {
+		t := byID[c.ParentID]
+		t.SubtaskCount += c.Count
+		if c.Completed {
+			t.CompletedSubtaskCount += c.Count
+		}
+	}
+	return nil
+}
+
+// tagsByName orders eager-loaded tags. Ent loads the tags of all todos in a result
+// with one extra query, so listings stay free of per-todo lookups.
+func tagsByName(q *generated.TagQuery) {
+	q.Order(tag.ByName())
+}
+
+// loadTags fills in the tags of a todo returned by a write, which Ent does not load.
+func loadTags(ctx context.Context, t *generated.Todo) error {
+	tags, err := t.QueryTags().Order(tag.ByName()).All(ctx)
+	if err != nil {
+		return err
+	}
+	t.Edges.Tags = tags
+	return nil
+}
+
+func tagIDs(tags []*model.Tag) []string {
+	ids := make([]string, len(tags))
+	for i, t := range tags {
+		ids[i] = t.ID
 	}
+	return ids
 }
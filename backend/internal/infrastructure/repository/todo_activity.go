@@ -0,0 +1,69 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/domain/repository"
+	"good-todo-go/internal/ent/generated"
+	"good-todo-go/internal/ent/generated/todoactivity"
+)
+
+type TodoActivityRepository struct {
+	client *generated.Client
+}
+
+func NewTodoActivityRepository(client *generated.Client) repository.ITodoActivityRepository {
+	return &TodoActivityRepository{client: client}
+}
+
+func (r *TodoActivityRepository) conn(ctx context.Context) *generated.Client {
+	return clientFromContext(ctx, r.client)
+}
+
+func (r *TodoActivityRepository) Create(ctx context.Context, m *model.TodoActivity) (*model.TodoActivity, error) {
+	created, err := r.conn(ctx).TodoActivity.Create().
+		SetID(m.ID).
+		SetTenantID(m.TenantID).
+		SetTodoID(m.TodoID).
+		SetActorID(m.ActorID).
+		SetAction(todoactivity.Action(m.Action)).
+		SetNillableSnoozedUntil(m.SnoozedUntil).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create todo activity: %w", err)
+	}
+	return toModelTodoActivity(created), nil
+}
+
+func (r *TodoActivityRepository) FindByTodoID(ctx context.Context, tenantID, todoID string) ([]*model.TodoActivity, error) {
+	activities, err := r.conn(ctx).TodoActivity.Query().
+		Where(
+			todoactivity.TenantIDEQ(tenantID),
+			todoactivity.TodoIDEQ(todoID),
+		).
+		Order(todoactivity.ByCreatedAt(), todoactivity.ByID()).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list todo activity: %w", err)
+	}
+
+	result := make([]*model.TodoActivity, len(activities))
+	for i, m := range activities {
+		result[i] = toModelTodoActivity(m)
+	}
+	return result, nil
+}
+
+func toModelTodoActivity(m *generated.TodoActivity) *model.TodoActivity {
+	return &model.TodoActivity{
+		ID:           m.ID,
+		TenantID:     m.TenantID,
+		TodoID:       m.TodoID,
+		ActorID:      m.ActorID,
+		Action:       model.TodoActivityAction(m.Action),
+		SnoozedUntil: m.SnoozedUntil,
+		CreatedAt:    m.CreatedAt,
+	}
+}
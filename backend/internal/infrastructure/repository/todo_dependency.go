@@ -0,0 +1,149 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/domain/repository"
+	"good-todo-go/internal/ent/generated/todo"
+
+	"github.com/lib/pq"
+)
+
+// todoWaitsOnQuery reports whether $1 is blocked by $2, directly or through a chain of
+// blockers. UNION drops rows already seen, so the walk ends even on existing cycles.
+const todoWaitsOnQuery = `
+WITH RECURSIVE blockers(id) AS (
+    SELECT blocker_id FROM todo_dependencies WHERE todo_id = $1
+    UNION
+    SELECT d.blocker_id FROM todo_dependencies d JOIN blockers b ON d.todo_id = b.id
+)
+SELECT EXISTS (SELECT 1 FROM blockers WHERE id = $2)`
+
+// todoDependencyCountsQuery counts, for each of the todos in $1, the open todos blocking
+// it and the open todos it blocks.
+const todoDependencyCountsQuery = `
+SELECT d.todo_id, true, count(*)
+FROM todo_dependencies d JOIN todos b ON b.id = d.blocker_id
+WHERE d.todo_id = ANY($1) AND b.deleted_at IS NULL AND NOT b.completed
+GROUP BY d.todo_id
+UNION ALL
+SELECT d.blocker_id, false, count(*)
+FROM todo_dependencies d JOIN todos t ON t.id = d.todo_id
+WHERE d.blocker_id = ANY($1) AND t.deleted_at IS NULL AND NOT t.completed
+GROUP BY d.blocker_id`
+
+func (r *TodoRepository) FindBlockers(ctx context.Context, todoID string) ([]*model.Todo, error) {
+	blockers, err := r.conn(ctx).Todo.Query().
+		Where(todo.HasBlockingWith(todo.ID(todoID)), todo.DeletedAtIsNil()).
+		Order(todo.ByCreatedAt(), todo.ByID()).
+		WithTags(tagsByName).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find blockers: %w", err)
+	}
+
+	result := make([]*model.Todo, len(blockers))
+	for i, t := range blockers {
+		result[i] = toModelTodo(t)
+	}
+	if err := r.loadCounts(ctx, result...); err != nil {
+		return nil, fmt.Errorf("failed to find blockers: %w", err)
+	}
+	return result, nil
+}
+
+func (r *TodoRepository) AddBlocker(ctx context.Context, tenantID, todoID, blockerID string) error {
+	conn := r.conn(ctx)
+
+	// Two additions checked side by side could each pass and close a cycle together
+	if _, err := conn.ExecContext(ctx, `SELECT pg_advisory_xact_lock(hashtext('todo_dependencies:' || $1))`, tenantID); err != nil {
+		return fmt.Errorf("failed to add blocker: %w", err)
+	}
+
+	if todoID == blockerID {
+		return repository.ErrDependencyCycle
+	}
+	cycle, err := r.waitsOn(ctx, blockerID, todoID)
+	if err != nil {
+		return fmt.Errorf("failed to add blocker: %w", err)
+	}
+	if cycle {
+		return repository.ErrDependencyCycle
+	}
+
+	_, err = conn.ExecContext(ctx,
+		`INSERT INTO todo_dependencies (todo_id, blocker_id) VALUES ($1, $2) ON CONFLICT DO NOTHING`,
+		todoID, blockerID)
+	if err != nil {
+		return fmt.Errorf("failed to add blocker: %w", err)
+	}
+	return nil
+}
+
+// waitsOn reports whether todoID is blocked by blockerID, directly or through other todos.
+func (r *TodoRepository) waitsOn(ctx context.Context, todoID, blockerID string) (bool, error) {
+	rows, err := r.conn(ctx).QueryContext(ctx, todoWaitsOnQuery, todoID, blockerID)
+	if err != nil {
+		return false, err
+	}
+	defer rows.Close()
+
+	var waits bool
+	if rows.Next() {
+		if err := rows.Scan(&waits); err != nil {
+			return false, err
+		}
+	}
+	return waits, rows.Err()
+}
+
+func (r *TodoRepository) RemoveBlocker(ctx context.Context, todoID, blockerID string) (bool, error) {
+	res, err := r.conn(ctx).ExecContext(ctx,
+		`DELETE FROM todo_dependencies WHERE todo_id = $1 AND blocker_id = $2`,
+		todoID, blockerID)
+	if err != nil {
+		return false, fmt.Errorf("failed to remove blocker: %w", err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to remove blocker: %w", err)
+	}
+	return n > 0, nil
+}
+
+// countDependencies fills in the blocked and blocking counts of todos with a single query.
+func (r *TodoRepository) countDependencies(ctx context.Context, todos ...*model.Todo) error {
+	if len(todos) == 0 {
+		return nil
+	}
+	ids := make([]string, len(todos))
+	byID := make(map[string]*model.Todo, len(todos))
+	for i, t := range todos {
+		ids[i] = t.ID
+		byID[t.ID] = t
+	}
+
+	rows, err := r.conn(ctx).QueryContext(ctx, todoDependencyCountsQuery, pq.Array(ids))
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var (
+			id      string
+			blocked bool
+			count   int
+		)
+		if err := rows.Scan(&id, &blocked, &count); err != nil {
+			return err
+		}
+		if blocked {
+			byID[id].BlockedCount = count
+		} else {
+			byID[id].BlockingCount = count
+		}
+	}
+	return rows.Err()
+}
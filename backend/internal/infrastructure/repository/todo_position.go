@@ -0,0 +1,111 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/ent/generated"
+	"good-todo-go/internal/ent/generated/todo"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/lib/pq"
+)
+
+// todoSetPositionsQuery pairs the ids in $1 with the positions in $2 and writes them in one statement.
+const todoSetPositionsQuery = `
+UPDATE todos SET position = p.position
+FROM unnest($1::text[], $2::text[]) AS p(id, position)
+WHERE todos.id = p.id`
+
+func (r *TodoRepository) LockPositions(ctx context.Context, tenantID string) error {
+	if _, err := r.conn(ctx).ExecContext(ctx, `SELECT pg_advisory_xact_lock(hashtext('todo_positions:' || $1))`, tenantID); err != nil {
+		return fmt.Errorf("failed to lock todo positions: %w", err)
+	}
+	return nil
+}
+
+func (r *TodoRepository) AdjacentPosition(ctx context.Context, tenantID, position string, after bool, excludeID string) (string, error) {
+	q := r.conn(ctx).Todo.Query().
+		Where(todo.TenantIDEQ(tenantID), todo.IDNEQ(excludeID))
+	if after {
+		q = q.Where(todo.PositionGT(position)).Order(todo.ByPosition())
+	} else {
+		q = q.Where(todo.PositionLT(position)).Order(todo.ByPosition(sql.OrderDesc()))
+	}
+
+	positions, err := q.Limit(1).Select(todo.FieldPosition).Strings(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to find adjacent position: %w", err)
+	}
+	if len(positions) == 0 {
+		return "", nil
+	}
+	return positions[0], nil
+}
+
+func (r *TodoRepository) Move(ctx context.Context, t *model.Todo) (*model.Todo, error) {
+	builder := r.conn(ctx).Todo.UpdateOneID(t.ID).
+		SetPosition(t.Position).
+		AddVersion(1).
+		Where(todo.DeletedAtIsNil())
+	if t.ProjectID != nil {
+		builder.SetProjectID(*t.ProjectID)
+	} else {
+		builder.ClearProjectID()
+	}
+
+	moved, err := builder.Save(ctx)
+	if err != nil {
+		if generated.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to move todo: %w", err)
+	}
+	if err := loadTags(ctx, moved); err != nil {
+		return nil, fmt.Errorf("failed to move todo: %w", err)
+	}
+	result := toModelTodo(moved)
+	if err := r.loadCounts(ctx, result); err != nil {
+		return nil, fmt.Errorf("failed to move todo: %w", err)
+	}
+	return result, nil
+}
+
+func (r *TodoRepository) LongestPosition(ctx context.Context, tenantID string) (int, error) {
+	rows, err := r.conn(ctx).QueryContext(ctx,
+		`SELECT coalesce(max(length(position)), 0) FROM todos WHERE tenant_id = $1`, tenantID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to find longest position: %w", err)
+	}
+	defer rows.Close()
+
+	var longest int
+	if rows.Next() {
+		if err := rows.Scan(&longest); err != nil {
+			return 0, fmt.Errorf("failed to find longest position: %w", err)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return 0, fmt.Errorf("failed to find longest position: %w", err)
+	}
+	return longest, nil
+}
+
+func (r *TodoRepository) FindIDsByPosition(ctx context.Context, tenantID string) ([]string, error) {
+	ids, err := r.conn(ctx).Todo.Query().
+		Where(todo.TenantIDEQ(tenantID)).
+		Order(todo.ByPosition(), todo.ByID()).
+		IDs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find todos by position: %w", err)
+	}
+	return ids, nil
+}
+
+func (r *TodoRepository) SetPositions(ctx context.Context, ids, positions []string) error {
+	if _, err := r.conn(ctx).ExecContext(ctx, todoSetPositionsQuery, pq.Array(ids), pq.Array(positions)); err != nil {
+		return fmt.Errorf("failed to set positions: %w", err)
+	}
+	return nil
+}
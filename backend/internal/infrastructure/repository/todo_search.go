@@ -0,0 +1,134 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/ent/generated/todo"
+)
+
+// todoSearchQuery ranks full-text matches on the generated search_vector column first and
+// falls back to trigram matching so short or partial words still find todos.
+//
+//	$1 search text, $2 tenant, $3 user, $4 ILIKE pattern, $5 limit, $6 title and $7 description headline options
+const todoSearchQuery = `
+WITH q AS (SELECT websearch_to_tsquery('simple', $1) AS query)
+SELECT t.id, t.tenant_id, t.user_id, t.assignee_id, t.project_id, t.parent_id, t.title, t.description, t.completed,
+       t.is_public, t.auto_complete, t.priority, t.due_date, t.recurrence_rule, t.recurrence_timezone, t.completed_at,
+       t.snoozed_until, t.version, t.position, t.created_at, t.updated_at,
+       ts_rank(t.search_vector, q.query) AS rank,
+       ts_headline('simple', t.title, q.query, $6) AS title_highlight,
+       ts_headline('simple', t.description, q.query, $7) AS description_highlight
+FROM todos t, q
+WHERE t.tenant_id = $2
+  AND t.deleted_at IS NULL
+  AND (t.user_id = $3 OR t.assignee_id = $3 OR t.is_public)
+  AND (t.search_vector @@ q.query
+       OR t.title ILIKE $4 ESCAPE '\'
+       OR t.description ILIKE $4 ESCAPE '\'
+       OR $1 <% t.title)
+ORDER BY rank DESC, word_similarity($1, t.title) DESC, t.created_at DESC, t.id DESC
+LIMIT $5`
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+func (r *TodoRepository) Search(ctx context.Context, tenantID, userID, text string, limit int) ([]*model.TodoSearchHit, error) {
+	titleOptions := fmt.Sprintf("StartSel=%s, StopSel=%s, HighlightAll=true", model.HighlightStart, model.HighlightEnd)
+	descriptionOptions := fmt.Sprintf("StartSel=%s, StopSel=%s, MaxFragments=2, MaxWords=20, MinWords=5", model.HighlightStart, model.HighlightEnd)
+
+	rows, err := r.conn(ctx).QueryContext(ctx, todoSearchQuery,
+		text, tenantID, userID, "%"+likeEscaper.Replace(text)+"%", limit, titleOptions, descriptionOptions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search todos: %w", err)
+	}
+	defer rows.Close()
+
+	var hits []*model.TodoSearchHit
+	for rows.Next() {
+		var (
+			t                                  model.Todo
+			assigneeID, projectID, parentID    sql.NullString
+			recurrenceRule, recurrenceTimezone sql.NullString
+			dueDate, completedAt, snoozedUntil sql.NullTime
+			hit                                model.TodoSearchHit
+		)
+		if err := rows.Scan(
+			&t.ID, &t.TenantID, &t.UserID, &assigneeID, &projectID, &parentID, &t.Title, &t.Description, &t.Completed,
+			&t.IsPublic, &t.AutoComplete, &t.Priority, &dueDate, &recurrenceRule, &recurrenceTimezone, &completedAt,
+			&snoozedUntil, &t.Version, &t.Position, &t.CreatedAt, &t.UpdatedAt,
+			&hit.Rank, &hit.TitleHighlight, &hit.DescriptionHighlight,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan todo search hit: %w", err)
+		}
+		if assigneeID.Valid {
+			t.AssigneeID = &assigneeID.String
+		}
+		if projectID.Valid {
+			t.ProjectID = &projectID.String
+		}
+		if parentID.Valid {
+			t.ParentID = &parentID.String
+		}
+		if dueDate.Valid {
+			t.DueDate = &dueDate.Time
+		}
+		if recurrenceRule.Valid {
+			t.RecurrenceRule = &recurrenceRule.String
+		}
+		if recurrenceTimezone.Valid {
+			t.RecurrenceTimezone = &recurrenceTimezone.String
+		}
+		if completedAt.Valid {
+			t.CompletedAt = &completedAt.Time
+		}
+		if snoozedUntil.Valid {
+			t.SnoozedUntil = &snoozedUntil.Time
+		}
+		hit.Todo = &t
+		hits = append(hits, &hit)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to search todos: %w", err)
+	}
+	if err := r.loadHitTags(ctx, hits); err != nil {
+		return nil, fmt.Errorf("failed to search todos: %w", err)
+	}
+	todos := make([]*model.Todo, len(hits))
+	for i, hit := range hits {
+		todos[i] = hit.Todo
+	}
+	if err := r.loadCounts(ctx, todos...); err != nil {
+		return nil, fmt.Errorf("failed to search todos: %w", err)
+	}
+	return hits, nil
+}
+
+// loadHitTags fills in the tags of all hits with a single query.
+func (r *TodoRepository) loadHitTags(ctx context.Context, hits []*model.TodoSearchHit) error {
+	if len(hits) == 0 {
+		return nil
+	}
+	ids := make([]string, len(hits))
+	for i, hit := range hits {
+		ids[i] = hit.Todo.ID
+	}
+
+	todos, err := r.conn(ctx).Todo.Query().
+		Where(todo.IDIn(ids...)).
+		WithTags(tagsByName).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	tags := make(map[string][]*model.Tag, len(todos))
+	for _, t := range todos {
+		tags[t.ID] = toModelTags(t.Edges.Tags)
+	}
+	for _, hit := range hits {
+		hit.Todo.Tags = tags[hit.Todo.ID]
+	}
+	return nil
+}
@@ -0,0 +1,44 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"good-todo-go/internal/domain/repository"
+	"good-todo-go/internal/ent/generated"
+	"good-todo-go/internal/infrastructure/database"
+)
+
+type txClientKey struct{}
+
+type UnitOfWork struct {
+	db *sql.DB
+}
+
+func NewUnitOfWork(db *sql.DB) repository.IUnitOfWork {
+	return &UnitOfWork{db: db}
+}
+
+func (u *UnitOfWork) RunInTenantTx(ctx context.Context, tenantID string, fn func(ctx context.Context) error) error {
+	tc, err := database.NewTenantContext(ctx, u.db, tenantID)
+	if err != nil {
+		return err
+	}
+	defer tc.Close()
+
+	if err := fn(context.WithValue(ctx, txClientKey{}, tc.Client)); err != nil {
+		return err
+	}
+
+	return tc.Commit()
+}
+
+// clientFromContext returns the transactional client stored by RunInTenantTx,
+// or fallback when ctx is not part of a unit of work. Repositories reach the database
+// through it so their writes join any surrounding unit of work.
+func clientFromContext(ctx context.Context, fallback *generated.Client) *generated.Client {
+	if client, ok := ctx.Value(txClientKey{}).(*generated.Client); ok {
+		return client
+	}
+	return fallback
+}
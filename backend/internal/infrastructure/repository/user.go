@@ -18,16 +18,21 @@ func NewUserRepository(client *generated.Client) repository.IUserRepository {
 	return &UserRepository{client: client}
 }
 
+func (r *UserRepository) conn(ctx context.Context) *generated.Client {
+	return clientFromContext(ctx, r.client)
+}
+
 func (r *UserRepository) Create(ctx context.Context, u *model.User) (*model.User, error) {
-	builder := r.client.User.Create().
+	builder := r.conn(ctx).User.Create().
 		SetID(u.ID).
-		SetTenantID(u.TenantID).
 		SetEmail(u.Email).
 		SetPasswordHash(u.PasswordHash).
 		SetName(u.Name).
-		SetRole(user.Role(u.Role)).
 		SetEmailVerified(u.EmailVerified)
 
+	if u.Timezone != "" {
+		builder.SetTimezone(u.Timezone)
+	}
 	if u.VerificationToken != nil {
 		builder.SetVerificationToken(*u.VerificationToken)
 	}
@@ -37,13 +42,16 @@ func (r *UserRepository) Create(ctx context.Context, u *model.User) (*model.User
 
 	created, err := builder.Save(ctx)
 	if err != nil {
+		if constraint, ok := uniqueViolation(err); ok && constraint == constraintUserEmail {
+			return nil, repository.ErrUserAlreadyExists
+		}
 		return nil, fmt.Errorf("failed to create user: %w", err)
 	}
 	return toModelUser(created), nil
 }
 
 func (r *UserRepository) FindByID(ctx context.Context, id string) (*model.User, error) {
-	u, err := r.client.User.Get(ctx, id)
+	u, err := r.conn(ctx).User.Get(ctx, id)
 	if err != nil {
 		if generated.IsNotFound(err) {
 			return nil, nil
@@ -53,12 +61,9 @@ func (r *UserRepository) FindByID(ctx context.Context, id string) (*model.User,
 	return toModelUser(u), nil
 }
 
-func (r *UserRepository) FindByEmail(ctx context.Context, tenantID, email string) (*model.User, error) {
-	u, err := r.client.User.Query().
-		Where(
-			user.TenantIDEQ(tenantID),
-			user.EmailEQ(email),
-		).
+func (r *UserRepository) FindByEmail(ctx context.Context, email string) (*model.User, error) {
+	u, err := r.conn(ctx).User.Query().
+		Where(user.EmailEQ(email)).
 		Only(ctx)
 	if err != nil {
 		if generated.IsNotFound(err) {
@@ -70,7 +75,7 @@ func (r *UserRepository) FindByEmail(ctx context.Context, tenantID, email string
 }
 
 func (r *UserRepository) FindByVerificationToken(ctx context.Context, token string) (*model.User, error) {
-	u, err := r.client.User.Query().
+	u, err := r.conn(ctx).User.Query().
 		Where(user.VerificationTokenEQ(token)).
 		Only(ctx)
 	if err != nil {
@@ -83,10 +88,10 @@ func (r *UserRepository) FindByVerificationToken(ctx context.Context, token stri
 }
 
 func (r *UserRepository) Update(ctx context.Context, u *model.User) (*model.User, error) {
-	builder := r.client.User.UpdateOneID(u.ID).
+	builder := r.conn(ctx).User.UpdateOneID(u.ID).
 		SetEmail(u.Email).
 		SetName(u.Name).
-		SetRole(user.Role(u.Role)).
+		SetTimezone(u.Timezone).
 		SetEmailVerified(u.EmailVerified)
 
 	if u.VerificationToken != nil {
@@ -102,6 +107,9 @@ func (r *UserRepository) Update(ctx context.Context, u *model.User) (*model.User
 
 	updated, err := builder.Save(ctx)
 	if err != nil {
+		if constraint, ok := uniqueViolation(err); ok && constraint == constraintUserEmail {
+			return nil, repository.ErrUserAlreadyExists
+		}
 		return nil, fmt.Errorf("failed to update user: %w", err)
 	}
 	return toModelUser(updated), nil
@@ -110,11 +118,10 @@ func (r *UserRepository) Update(ctx context.Context, u *model.User) (*model.User
 func toModelUser(u *generated.User) *model.User {
 	return &model.User{
 		ID:                         u.ID,
-		TenantID:                   u.TenantID,
 		Email:                      u.Email,
 		PasswordHash:               u.PasswordHash,
 		Name:                       u.Name,
-		Role:                       model.UserRole(u.Role),
+		Timezone:                   u.Timezone,
 		EmailVerified:              u.EmailVerified,
 		VerificationToken:          u.VerificationToken,
 		VerificationTokenExpiresAt: u.VerificationTokenExpiresAt,
@@ -85,9 +85,24 @@ func (td *TestDatabase) CleanupTables(ctx context.Context) error {
 	if _, err := td.AdminDB.ExecContext(ctx, "DELETE FROM todos"); err != nil {
 		return fmt.Errorf("failed to clean todos: %w", err)
 	}
+	if _, err := td.AdminDB.ExecContext(ctx, "DELETE FROM tags"); err != nil {
+		return fmt.Errorf("failed to clean tags: %w", err)
+	}
+	if _, err := td.AdminDB.ExecContext(ctx, "DELETE FROM projects"); err != nil {
+		return fmt.Errorf("failed to clean projects: %w", err)
+	}
+	if _, err := td.AdminDB.ExecContext(ctx, "DELETE FROM memberships"); err != nil {
+		return fmt.Errorf("failed to clean memberships: %w", err)
+	}
 	if _, err := td.AdminDB.ExecContext(ctx, "DELETE FROM users"); err != nil {
 		return fmt.Errorf("failed to clean users: %w", err)
 	}
+	if _, err := td.AdminDB.ExecContext(ctx, "DELETE FROM tenant_domains"); err != nil {
+		return fmt.Errorf("failed to clean tenant domains: %w", err)
+	}
+	if _, err := td.AdminDB.ExecContext(ctx, "DELETE FROM tenant_slug_histories"); err != nil {
+		return fmt.Errorf("failed to clean tenant slug histories: %w", err)
+	}
 	if _, err := td.AdminDB.ExecContext(ctx, "DELETE FROM tenants"); err != nil {
 		return fmt.Errorf("failed to clean tenants: %w", err)
 	}
@@ -3,10 +3,12 @@ package common
 import (
 	"context"
 	"testing"
+	"time"
 
 	"good-todo-go/internal/domain/model"
 	"good-todo-go/internal/ent/generated"
-	"good-todo-go/internal/ent/generated/user"
+	"good-todo-go/internal/ent/generated/membership"
+	enttodo "good-todo-go/internal/ent/generated/todo"
 
 	"github.com/google/uuid"
 )
@@ -26,15 +28,24 @@ type TestUser struct {
 	PasswordHash string
 	Name         string
 	Role         string
+	// Timezone defaults to UTC
+	Timezone string
 }
 
 // TestTodo represents test todo data
 type TestTodo struct {
-	ID       string
-	TenantID string
-	UserID   string
-	Title    string
-	IsPublic bool
+	ID          string
+	TenantID    string
+	UserID      string
+	Title       string
+	Description string
+	IsPublic    bool
+	// Position defaults to the same key for every todo; set it when the manual order matters
+	Position string
+	// Priority defaults to none
+	Priority  model.TodoPriority
+	DueDate   *time.Time
+	Completed bool
 }
 
 // CreateTestTenant creates a test tenant using admin client
@@ -63,45 +74,72 @@ func CreateTestTenant(t *testing.T, client *generated.Client, tenant *TestTenant
 	}
 }
 
-// CreateTestUser creates a test user using admin client
+// CreateTestUser creates a test user with a membership in TenantID using admin client
 func CreateTestUser(t *testing.T, client *generated.Client, u *TestUser) *model.User {
 	t.Helper()
 
 	if u.ID == "" {
 		u.ID = uuid.New().String()
 	}
-
-	role := user.RoleMember
-	if u.Role == "admin" {
-		role = user.RoleAdmin
+	if u.Timezone == "" {
+		u.Timezone = "UTC"
 	}
 
 	created, err := client.User.Create().
 		SetID(u.ID).
-		SetTenantID(u.TenantID).
 		SetEmail(u.Email).
 		SetPasswordHash(u.PasswordHash).
 		SetName(u.Name).
-		SetRole(role).
 		SetEmailVerified(true).
+		SetTimezone(u.Timezone).
 		Save(context.Background())
 	if err != nil {
 		t.Fatalf("Failed to create test user: %v", err)
 	}
 
+	CreateTestMembership(t, client, u.TenantID, created.ID, u.Role)
+
 	return &model.User{
 		ID:            created.ID,
-		TenantID:      created.TenantID,
 		Email:         created.Email,
 		PasswordHash:  created.PasswordHash,
 		Name:          created.Name,
-		Role:          model.UserRole(created.Role),
+		Timezone:      created.Timezone,
 		EmailVerified: created.EmailVerified,
 		CreatedAt:     created.CreatedAt,
 		UpdatedAt:     created.UpdatedAt,
 	}
 }
 
+// CreateTestMembership adds an existing user to a tenant using admin client
+func CreateTestMembership(t *testing.T, client *generated.Client, tenantID, userID, role string) *model.Membership {
+	t.Helper()
+
+	r := membership.RoleMember
+	if role == "admin" {
+		r = membership.RoleAdmin
+	}
+
+	created, err := client.Membership.Create().
+		SetID(uuid.New().String()).
+		SetTenantID(tenantID).
+		SetUserID(userID).
+		SetRole(r).
+		Save(context.Background())
+	if err != nil {
+		t.Fatalf("Failed to create test membership: %v", err)
+	}
+
+	return &model.Membership{
+		ID:        created.ID,
+		TenantID:  created.TenantID,
+		UserID:    created.UserID,
+		Role:      model.UserRole(created.Role),
+		CreatedAt: created.CreatedAt,
+		UpdatedAt: created.UpdatedAt,
+	}
+}
+
 // CreateTestTodo creates a test todo using admin client
 func CreateTestTodo(t *testing.T, client *generated.Client, todo *TestTodo) *model.Todo {
 	t.Helper()
@@ -109,25 +147,41 @@ func CreateTestTodo(t *testing.T, client *generated.Client, todo *TestTodo) *mod
 	if todo.ID == "" {
 		todo.ID = uuid.New().String()
 	}
+	if todo.Position == "" {
+		todo.Position = "a0"
+	}
+	if todo.Priority == "" {
+		todo.Priority = model.TodoPriorityNone
+	}
 
 	created, err := client.Todo.Create().
 		SetID(todo.ID).
 		SetTenantID(todo.TenantID).
 		SetUserID(todo.UserID).
 		SetTitle(todo.Title).
+		SetDescription(todo.Description).
 		SetIsPublic(todo.IsPublic).
+		SetPosition(todo.Position).
+		SetPriority(enttodo.Priority(todo.Priority)).
+		SetNillableDueDate(todo.DueDate).
+		SetCompleted(todo.Completed).
 		Save(context.Background())
 	if err != nil {
 		t.Fatalf("Failed to create test todo: %v", err)
 	}
 
 	return &model.Todo{
-		ID:        created.ID,
-		TenantID:  created.TenantID,
-		UserID:    created.UserID,
-		Title:     created.Title,
-		IsPublic:  created.IsPublic,
-		CreatedAt: created.CreatedAt,
-		UpdatedAt: created.UpdatedAt,
+		ID:          created.ID,
+		TenantID:    created.TenantID,
+		UserID:      created.UserID,
+		Title:       created.Title,
+		Description: created.Description,
+		IsPublic:    created.IsPublic,
+		Completed:   created.Completed,
+		Priority:    model.TodoPriority(created.Priority),
+		DueDate:     created.DueDate,
+		Position:    created.Position,
+		CreatedAt:   created.CreatedAt,
+		UpdatedAt:   created.UpdatedAt,
 	}
 }
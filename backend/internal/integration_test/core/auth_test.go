@@ -0,0 +1,145 @@
+package core
+
+import (
+	"context"
+	"testing"
+
+	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/ent/generated/membership"
+	"good-todo-go/internal/ent/generated/tenant"
+	"good-todo-go/internal/ent/generated/user"
+	"good-todo-go/internal/infrastructure/environment"
+	infrarepo "good-todo-go/internal/infrastructure/repository"
+	"good-todo-go/internal/integration_test/common"
+	"good-todo-go/internal/pkg"
+	mocku "good-todo-go/internal/pkg/mock"
+	"good-todo-go/internal/usecase"
+	"good-todo-go/internal/usecase/input"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthIntegration_Register(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	db := common.SetupTestDatabase(t)
+	defer db.Close()
+
+	ctx := context.Background()
+
+	// Cleanup before test
+	err := db.CleanupTables(ctx)
+	require.NoError(t, err)
+
+	newInteractor := func(uuids ...string) usecase.IAuthInteractor {
+		return usecase.NewAuthInteractor(
+			infrarepo.NewUnitOfWork(db.AppDB),
+			infrarepo.NewTenantRepository(db.AppClient),
+			infrarepo.NewUserRepository(db.AppClient),
+			infrarepo.NewMembershipRepository(db.AppClient),
+			infrarepo.NewAuthRepository(environment.NewEnvironment()),
+			pkg.NewJWTService("test-secret"),
+			pkg.NewPasswordService(),
+			mocku.NewMockUUIDGenerator(uuids...),
+		)
+	}
+
+	t.Run("Register creates tenant and user", func(t *testing.T) {
+		result, err := newInteractor("11111111-0000-0000-0000-000000000001", "user-1", "token-1", "membership-1").
+			Register(ctx, &input.RegisterInput{
+				Email:    "alice@test.com",
+				Password: "password123",
+				Name:     "Alice",
+			})
+		require.NoError(t, err)
+
+		created, err := db.AdminClient.Tenant.Get(ctx, result.TenantID)
+		require.NoError(t, err)
+		assert.Equal(t, "alice-11111111", created.Slug)
+
+		m, err := db.AdminClient.Membership.Query().
+			Where(membership.UserIDEQ(result.UserID), membership.TenantIDEQ(result.TenantID)).
+			Only(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, membership.RoleAdmin, m.Role)
+	})
+
+	t.Run("Register with an existing email fails even with its password", func(t *testing.T) {
+		_, err := newInteractor("55555555-0000-0000-0000-000000000005", "user-5", "token-5", "membership-5").
+			Register(ctx, &input.RegisterInput{
+				Email:    "alice@test.com",
+				Password: "password123",
+				Name:     "Alice Second",
+			})
+		assert.Equal(t, usecase.ErrUserAlreadyExists, err)
+	})
+
+	t.Run("CreateTenant adds a tenant to an existing identity", func(t *testing.T) {
+		aliceActor := input.Actor{UserID: "user-1", TenantID: "11111111-0000-0000-0000-000000000001"}
+
+		created, err := newInteractor("44444444-0000-0000-0000-000000000004", "membership-4").
+			CreateTenant(ctx, aliceActor, &input.CreateTenantInput{Name: "Alice Consulting"})
+		require.NoError(t, err)
+		assert.Equal(t, "admin", created.Role)
+
+		count, err := db.AdminClient.Membership.Query().
+			Where(membership.UserIDEQ("user-1")).
+			Count(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 2, count)
+
+		// The same identity can switch into the new tenant
+		switched, err := newInteractor().SwitchTenant(ctx, aliceActor, &input.SwitchTenantInput{TenantID: created.TenantID})
+		require.NoError(t, err)
+		assert.Equal(t, created.TenantID, switched.TenantID)
+		assert.Equal(t, "admin", switched.Role)
+	})
+
+	t.Run("A tenant cannot write users that are not its members", func(t *testing.T) {
+		userRepo := infrarepo.NewUserRepository(db.AppClient)
+		err := infrarepo.NewUnitOfWork(db.AppDB).RunInTenantTx(ctx, "11111111-0000-0000-0000-000000000001", func(ctx context.Context) error {
+			_, err := userRepo.Create(ctx, &model.User{ID: "user-orphan", Email: "orphan@test.com", PasswordHash: "hash", Name: "Orphan"})
+			return err
+		})
+		require.Error(t, err)
+
+		exists, err := db.AdminClient.User.Query().Where(user.IDEQ("user-orphan")).Exist(ctx)
+		require.NoError(t, err)
+		assert.False(t, exists)
+	})
+
+	t.Run("Failed user insert rolls back tenant", func(t *testing.T) {
+		// Reusing user-1 makes the user insert fail after the tenant insert succeeded
+		_, err := newInteractor("22222222-0000-0000-0000-000000000002", "user-1", "token-2").
+			Register(ctx, &input.RegisterInput{
+				Email:    "bob@test.com",
+				Password: "password123",
+				Name:     "Bob",
+			})
+		require.Error(t, err)
+
+		exists, err := db.AdminClient.Tenant.Query().
+			Where(tenant.IDEQ("22222222-0000-0000-0000-000000000002")).
+			Exist(ctx)
+		require.NoError(t, err)
+		assert.False(t, exists, "tenant must not be left behind")
+	})
+
+	t.Run("Duplicate slug is reported as conflict", func(t *testing.T) {
+		// Same email local part and tenant ID prefix produce the same slug
+		_, err := newInteractor("11111111-0000-0000-0000-000000000003", "user-3", "token-3").
+			Register(ctx, &input.RegisterInput{
+				Email:    "alice@other.com",
+				Password: "password123",
+				Name:     "Alice Again",
+			})
+		assert.Equal(t, usecase.ErrTenantSlugTaken, err)
+	})
+
+	// Cleanup after test
+	err = db.CleanupTables(ctx)
+	require.NoError(t, err)
+}
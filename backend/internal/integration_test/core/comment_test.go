@@ -0,0 +1,142 @@
+package core
+
+import (
+	"context"
+	"testing"
+
+	"good-todo-go/internal/domain/model"
+	infrarepo "good-todo-go/internal/infrastructure/repository"
+	"good-todo-go/internal/integration_test/common"
+	"good-todo-go/internal/pkg"
+	"good-todo-go/internal/usecase"
+	"good-todo-go/internal/usecase/input"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCommentIntegration(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	db := common.SetupTestDatabase(t)
+	defer db.Close()
+
+	ctx := context.Background()
+
+	err := db.CleanupTables(ctx)
+	require.NoError(t, err)
+
+	tenant := common.CreateTestTenant(t, db.AdminClient, &common.TestTenant{Name: "Test Tenant", Slug: "test-tenant"})
+	otherTenant := common.CreateTestTenant(t, db.AdminClient, &common.TestTenant{Name: "Other Tenant", Slug: "other-tenant"})
+	owner := common.CreateTestUser(t, db.AdminClient, &common.TestUser{
+		TenantID: tenant.ID, Email: "owner@test.com", PasswordHash: "hash", Name: "Owner", Role: "member",
+	})
+	colleague := common.CreateTestUser(t, db.AdminClient, &common.TestUser{
+		TenantID: tenant.ID, Email: "colleague@test.com", PasswordHash: "hash", Name: "Colleague", Role: "member",
+	})
+	common.CreateTestUser(t, db.AdminClient, &common.TestUser{
+		TenantID: otherTenant.ID, Email: "outsider@test.com", PasswordHash: "hash", Name: "Outsider", Role: "member",
+	})
+	common.CreateTestTodo(t, db.AdminClient, &common.TestTodo{ID: "public", TenantID: tenant.ID, UserID: owner.ID, Title: "Plan the offsite", IsPublic: true})
+	common.CreateTestTodo(t, db.AdminClient, &common.TestTodo{ID: "private", TenantID: tenant.ID, UserID: owner.ID, Title: "Write my review"})
+
+	err = db.SetTenantContext(ctx, tenant.ID)
+	require.NoError(t, err)
+
+	mail := &recordingMail{}
+	commentRepo := infrarepo.NewCommentRepository(db.AppClient)
+	commentInteractor := usecase.NewCommentInteractor(
+		commentRepo,
+		infrarepo.NewTodoRepository(db.AppClient),
+		infrarepo.NewUserRepository(db.AppClient),
+		infrarepo.NewMembershipRepository(db.AppClient),
+		mail,
+		usecase.NewPermissionEvaluator(usecase.DefaultPermissionRules()),
+		pkg.NewUUIDGenerator(),
+		pkg.NewClock(),
+	)
+	ownerActor := input.Actor{UserID: owner.ID, TenantID: tenant.ID, Role: model.UserRoleMember}
+	colleagueActor := input.Actor{UserID: colleague.ID, TenantID: tenant.ID, Role: model.UserRoleMember}
+
+	first, err := commentInteractor.Create(ctx, colleagueActor, &input.CreateCommentInput{
+		TodoID: "public", Body: "Friday works for me, @owner@test.com. What about @outsider@test.com?",
+	})
+	require.NoError(t, err)
+
+	t.Run("mentioned members of the tenant are emailed", func(t *testing.T) {
+		require.Len(t, mail.mentions, 1)
+		assert.Equal(t, "owner@test.com", mail.mentions[0].Email)
+		assert.Equal(t, "Colleague", mail.mentions[0].MentionedBy)
+		assert.Equal(t, "Plan the offsite", mail.mentions[0].TodoTitle)
+	})
+
+	t.Run("comments page oldest first", func(t *testing.T) {
+		for _, body := range []string{"Booked the room", "Sent the invite"} {
+			_, err := commentInteractor.Create(ctx, ownerActor, &input.CreateCommentInput{TodoID: "public", Body: body})
+			require.NoError(t, err)
+		}
+
+		page, err := commentInteractor.List(ctx, colleagueActor, &input.ListCommentsInput{TodoID: "public", Limit: 2})
+		require.NoError(t, err)
+		require.Len(t, page.Comments, 2)
+		assert.Equal(t, first.ID, page.Comments[0].ID)
+		assert.Equal(t, "Booked the room", page.Comments[1].Body)
+		require.NotNil(t, page.NextCursor)
+
+		page, err = commentInteractor.List(ctx, colleagueActor, &input.ListCommentsInput{TodoID: "public", Limit: 2, Cursor: *page.NextCursor})
+		require.NoError(t, err)
+		require.Len(t, page.Comments, 1)
+		assert.Equal(t, "Sent the invite", page.Comments[0].Body)
+		assert.Nil(t, page.NextCursor)
+	})
+
+	t.Run("only the author edits a comment", func(t *testing.T) {
+		_, err := commentInteractor.Update(ctx, ownerActor, &input.UpdateCommentInput{TodoID: "public", CommentID: first.ID, Body: "Changed"})
+		assert.Equal(t, usecase.ErrNotCommentAuthor, err)
+
+		edited, err := commentInteractor.Update(ctx, colleagueActor, &input.UpdateCommentInput{TodoID: "public", CommentID: first.ID, Body: "Friday or Monday"})
+		require.NoError(t, err)
+		assert.Equal(t, "Friday or Monday", edited.Body)
+		assert.NotNil(t, edited.EditedAt)
+	})
+
+	t.Run("comments on a private todo stay with its owner", func(t *testing.T) {
+		mail.mentions = nil
+		_, err := commentInteractor.Create(ctx, ownerActor, &input.CreateCommentInput{TodoID: "private", Body: "Ask @colleague@test.com for feedback"})
+		require.NoError(t, err)
+		assert.Empty(t, mail.mentions)
+
+		_, err = commentInteractor.List(ctx, colleagueActor, &input.ListCommentsInput{TodoID: "private"})
+		assert.Equal(t, usecase.ErrTodoNotFound, err)
+
+		page, err := commentInteractor.List(ctx, ownerActor, &input.ListCommentsInput{TodoID: "private"})
+		require.NoError(t, err)
+		assert.Len(t, page.Comments, 1)
+	})
+
+	t.Run("row level security hides comments from other tenants", func(t *testing.T) {
+		err := db.SetTenantContext(ctx, otherTenant.ID)
+		require.NoError(t, err)
+
+		found, err := commentRepo.FindByID(ctx, tenant.ID, first.ID)
+		require.NoError(t, err)
+		assert.Nil(t, found)
+
+		err = db.SetTenantContext(ctx, tenant.ID)
+		require.NoError(t, err)
+	})
+
+	t.Run("the author deletes a comment", func(t *testing.T) {
+		err := commentInteractor.Delete(ctx, colleagueActor, "public", first.ID)
+		require.NoError(t, err)
+
+		page, err := commentInteractor.List(ctx, colleagueActor, &input.ListCommentsInput{TodoID: "public"})
+		require.NoError(t, err)
+		assert.Len(t, page.Comments, 2)
+	})
+
+	err = db.CleanupTables(ctx)
+	require.NoError(t, err)
+}
@@ -0,0 +1,98 @@
+package core
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"good-todo-go/internal/domain/model"
+	infrarepo "good-todo-go/internal/infrastructure/repository"
+	"good-todo-go/internal/integration_test/common"
+	"good-todo-go/internal/usecase"
+	"good-todo-go/internal/usecase/input"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDigestIntegration_SendDueDigests(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	db := common.SetupTestDatabase(t)
+	defer db.Close()
+
+	ctx := context.Background()
+
+	err := db.CleanupTables(ctx)
+	require.NoError(t, err)
+
+	tenant := common.CreateTestTenant(t, db.AdminClient, &common.TestTenant{Name: "Acme", Slug: "acme"})
+	other := common.CreateTestTenant(t, db.AdminClient, &common.TestTenant{Name: "Other", Slug: "other"})
+	user := common.CreateTestUser(t, db.AdminClient, &common.TestUser{
+		TenantID: tenant.ID, Email: "user@test.com", PasswordHash: "hash", Name: "User", Role: "member",
+	})
+	common.CreateTestMembership(t, db.AdminClient, other.ID, user.ID, "member")
+
+	now := time.Now()
+	overdue := now.Add(-time.Hour)
+	nextWeek := now.AddDate(0, 0, 3)
+	common.CreateTestTodo(t, db.AdminClient, &common.TestTodo{TenantID: tenant.ID, UserID: user.ID, Title: "File taxes", DueDate: &overdue})
+	common.CreateTestTodo(t, db.AdminClient, &common.TestTodo{TenantID: tenant.ID, UserID: user.ID, Title: "Book flights", DueDate: &nextWeek})
+	common.CreateTestTodo(t, db.AdminClient, &common.TestTodo{TenantID: tenant.ID, UserID: user.ID, Title: "Done", DueDate: &overdue, Completed: true})
+	// Todos in the other tenant stay out of this tenant's digest
+	common.CreateTestTodo(t, db.AdminClient, &common.TestTodo{TenantID: other.ID, UserID: user.ID, Title: "Other work", DueDate: &overdue})
+
+	err = db.SetTenantContext(ctx, tenant.ID)
+	require.NoError(t, err)
+
+	userInteractor := usecase.NewUserInteractor(
+		infrarepo.NewUserRepository(db.AppClient),
+		infrarepo.NewMembershipRepository(db.AppClient),
+		usecase.NewPermissionEvaluator(usecase.DefaultPermissionRules()),
+	)
+	actor := input.Actor{UserID: user.ID, TenantID: tenant.ID, Role: model.UserRoleMember}
+
+	// A digest time of midnight has always passed
+	preference, err := userInteractor.UpdateDigest(ctx, actor, &input.UpdateDigestInput{Enabled: true, Time: "00:00"})
+	require.NoError(t, err)
+	assert.Nil(t, preference.LastSentOn)
+
+	mail := &recordingMail{}
+	digestInteractor := usecase.NewDigestInteractor(
+		infrarepo.NewUnitOfWork(db.AppDB),
+		infrarepo.NewTenantRepository(db.AppClient),
+		infrarepo.NewMembershipRepository(db.AppClient),
+		infrarepo.NewTodoRepository(db.AppClient),
+		mail,
+	)
+
+	t.Run("sends the tenant's open todos once a day", func(t *testing.T) {
+		sent, err := digestInteractor.SendDueDigests(ctx, now)
+		require.NoError(t, err)
+		assert.Equal(t, 1, sent)
+		require.Len(t, mail.digests, 1)
+
+		digest := mail.digests[0]
+		assert.Equal(t, "user@test.com", digest.Email)
+		assert.Equal(t, "Acme", digest.TenantName)
+		require.Len(t, digest.Overdue.Todos, 1)
+		assert.Equal(t, "File taxes", digest.Overdue.Todos[0].Title)
+		require.Len(t, digest.DueThisWeek.Todos, 1)
+		assert.Equal(t, "Book flights", digest.DueThisWeek.Todos[0].Title)
+
+		// The recorded date stops a restart from sending again
+		sent, err = digestInteractor.SendDueDigests(ctx, now)
+		require.NoError(t, err)
+		assert.Zero(t, sent)
+
+		preference, err := userInteractor.GetDigest(ctx, actor)
+		require.NoError(t, err)
+		require.NotNil(t, preference.LastSentOn)
+		assert.Equal(t, now.UTC().Format(time.DateOnly), preference.LastSentOn.Format(time.DateOnly))
+	})
+
+	err = db.CleanupTables(ctx)
+	require.NoError(t, err)
+}
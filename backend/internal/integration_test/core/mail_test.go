@@ -0,0 +1,45 @@
+package core
+
+import (
+	"context"
+	"sync"
+
+	"good-todo-go/internal/domain/model"
+)
+
+// recordingMail remembers the emails it is asked to send instead of sending them.
+type recordingMail struct {
+	mu          sync.Mutex
+	reminders   []*model.DueReminder
+	assignments []*model.TodoAssignment
+	mentions    []*model.CommentMention
+	digests     []*model.Digest
+}
+
+func (m *recordingMail) SendReminder(ctx context.Context, reminder *model.DueReminder) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.reminders = append(m.reminders, reminder)
+	return nil
+}
+
+func (m *recordingMail) SendAssignment(ctx context.Context, assignment *model.TodoAssignment) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.assignments = append(m.assignments, assignment)
+	return nil
+}
+
+func (m *recordingMail) SendMention(ctx context.Context, mention *model.CommentMention) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.mentions = append(m.mentions, mention)
+	return nil
+}
+
+func (m *recordingMail) SendDigest(ctx context.Context, digest *model.Digest) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.digests = append(m.digests, digest)
+	return nil
+}
@@ -0,0 +1,150 @@
+package core
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/domain/repository"
+	infrarepo "good-todo-go/internal/infrastructure/repository"
+	"good-todo-go/internal/integration_test/common"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProjectRepository(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	db := common.SetupTestDatabase(t)
+	defer db.Close()
+
+	ctx := context.Background()
+
+	err := db.CleanupTables(ctx)
+	require.NoError(t, err)
+
+	tenant := common.CreateTestTenant(t, db.AdminClient, &common.TestTenant{
+		Name: "Test Tenant",
+		Slug: "test-tenant",
+	})
+	owner := common.CreateTestUser(t, db.AdminClient, &common.TestUser{
+		TenantID:     tenant.ID,
+		Email:        "owner@test.com",
+		PasswordHash: "hash",
+		Name:         "Owner",
+		Role:         "member",
+	})
+	other := common.CreateTestUser(t, db.AdminClient, &common.TestUser{
+		TenantID:     tenant.ID,
+		Email:        "other@test.com",
+		PasswordHash: "hash",
+		Name:         "Other",
+		Role:         "member",
+	})
+	mine := common.CreateTestTodo(t, db.AdminClient, &common.TestTodo{TenantID: tenant.ID, UserID: owner.ID, Title: "Mine"})
+	theirsPublic := common.CreateTestTodo(t, db.AdminClient, &common.TestTodo{TenantID: tenant.ID, UserID: other.ID, Title: "Theirs, public", IsPublic: true})
+	theirsPrivate := common.CreateTestTodo(t, db.AdminClient, &common.TestTodo{TenantID: tenant.ID, UserID: other.ID, Title: "Theirs, private"})
+	inbox := common.CreateTestTodo(t, db.AdminClient, &common.TestTodo{TenantID: tenant.ID, UserID: owner.ID, Title: "Inbox"})
+
+	err = db.SetTenantContext(ctx, tenant.ID)
+	require.NoError(t, err)
+
+	projectRepo := infrarepo.NewProjectRepository(db.AppClient)
+	todoRepo := infrarepo.NewTodoRepository(db.AppClient)
+
+	launch, err := projectRepo.Create(ctx, &model.Project{ID: "project-launch", TenantID: tenant.ID, UserID: owner.ID, Name: "Launch", Color: "#6b7280", IsPublic: true})
+	require.NoError(t, err)
+	_, err = projectRepo.Create(ctx, &model.Project{ID: "project-private", TenantID: tenant.ID, UserID: other.ID, Name: "Private", Color: "#6b7280"})
+	require.NoError(t, err)
+	_, err = projectRepo.Create(ctx, &model.Project{ID: "project-old", TenantID: tenant.ID, UserID: owner.ID, Name: "Archive", Color: "#6b7280", Archived: true})
+	require.NoError(t, err)
+
+	for _, todo := range []*model.Todo{mine, theirsPublic, theirsPrivate} {
+		todo.ProjectID = &launch.ID
+		_, err := todoRepo.Update(ctx, todo, nil)
+		require.NoError(t, err)
+	}
+
+	t.Run("own and public projects are visible", func(t *testing.T) {
+		projects, err := projectRepo.FindVisible(ctx, tenant.ID, owner.ID, false)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Launch"}, projectNames(projects))
+
+		projects, err = projectRepo.FindVisible(ctx, tenant.ID, owner.ID, true)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Archive", "Launch"}, projectNames(projects))
+
+		projects, err = projectRepo.FindVisible(ctx, tenant.ID, other.ID, false)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Launch", "Private"}, projectNames(projects))
+	})
+
+	t.Run("project todos are the user's own and public ones", func(t *testing.T) {
+		todos, err := todoRepo.FindByProjectID(ctx, launch.ID, repository.TodoQuery{Filter: repository.TodoFilter{VisibleTo: owner.ID}})
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{mine.ID, theirsPublic.ID}, todoIDs(todos))
+	})
+
+	t.Run("hidden todos do not count towards the limit", func(t *testing.T) {
+		// The newest todo in the project is someone else's private one
+		todos, err := todoRepo.FindByProjectID(ctx, launch.ID, repository.TodoQuery{
+			Filter: repository.TodoFilter{VisibleTo: owner.ID},
+			Sort:   repository.TodoSort{Field: repository.TodoSortCreatedAt, Desc: true},
+			Limit:  2,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{theirsPublic.ID, mine.ID}, todoIDs(todos))
+	})
+
+	t.Run("filter by project and inbox", func(t *testing.T) {
+		inProject, err := todoRepo.FindByUserID(ctx, owner.ID, repository.TodoQuery{
+			Filter: repository.TodoFilter{ProjectID: &launch.ID},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{mine.ID}, todoIDs(inProject))
+
+		inInbox, err := todoRepo.FindByUserID(ctx, owner.ID, repository.TodoQuery{
+			Filter: repository.TodoFilter{ProjectID: new(string)},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{inbox.ID}, todoIDs(inInbox))
+	})
+
+	t.Run("trashing project todos keeps other members' todos", func(t *testing.T) {
+		trashed, err := todoRepo.TrashProjectTodos(ctx, launch.ID, owner.ID, time.Now())
+		require.NoError(t, err)
+		assert.Equal(t, 1, trashed)
+
+		found, err := todoRepo.FindByID(ctx, mine.ID)
+		require.NoError(t, err)
+		assert.Nil(t, found)
+	})
+
+	t.Run("deleting a project moves its todos to the inbox", func(t *testing.T) {
+		require.NoError(t, projectRepo.Delete(ctx, launch.ID))
+
+		found, err := todoRepo.FindByID(ctx, theirsPublic.ID)
+		require.NoError(t, err)
+		require.NotNil(t, found)
+		assert.Nil(t, found.ProjectID)
+
+		project, err := projectRepo.FindByID(ctx, tenant.ID, launch.ID)
+		require.NoError(t, err)
+		assert.Nil(t, project)
+	})
+
+	err = db.CleanupTables(ctx)
+	require.NoError(t, err)
+}
+
+func projectNames(projects []*model.Project) []string {
+	names := make([]string, len(projects))
+	for i, project := range projects {
+		names[i] = project.Name
+	}
+	return names
+}
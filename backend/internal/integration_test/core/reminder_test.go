@@ -0,0 +1,132 @@
+package core
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"good-todo-go/internal/domain/model"
+	infrarepo "good-todo-go/internal/infrastructure/repository"
+	"good-todo-go/internal/integration_test/common"
+	"good-todo-go/internal/pkg"
+	"good-todo-go/internal/usecase"
+	"good-todo-go/internal/usecase/input"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReminderIntegration_Delivery(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	db := common.SetupTestDatabase(t)
+	defer db.Close()
+
+	ctx := context.Background()
+
+	err := db.CleanupTables(ctx)
+	require.NoError(t, err)
+
+	tenant := common.CreateTestTenant(t, db.AdminClient, &common.TestTenant{Name: "Test Tenant", Slug: "test-tenant"})
+	user := common.CreateTestUser(t, db.AdminClient, &common.TestUser{
+		TenantID: tenant.ID, Email: "user@test.com", PasswordHash: "hash", Name: "User", Role: "member",
+	})
+
+	now := time.Now()
+	due := now.Add(time.Hour)
+	todo := common.CreateTestTodo(t, db.AdminClient, &common.TestTodo{TenantID: tenant.ID, UserID: user.ID, Title: "Pay rent", DueDate: &due})
+	done := common.CreateTestTodo(t, db.AdminClient, &common.TestTodo{TenantID: tenant.ID, UserID: user.ID, Title: "Done already", DueDate: &due, Completed: true})
+	snoozed := common.CreateTestTodo(t, db.AdminClient, &common.TestTodo{TenantID: tenant.ID, UserID: user.ID, Title: "Call back", DueDate: &due})
+	wakes := now.Add(3 * time.Hour)
+	err = db.AdminClient.Todo.UpdateOneID(snoozed.ID).SetSnoozedUntil(wakes).Exec(ctx)
+	require.NoError(t, err)
+
+	err = db.SetTenantContext(ctx, tenant.ID)
+	require.NoError(t, err)
+
+	unitOfWork := infrarepo.NewUnitOfWork(db.AppDB)
+	reminderRepo := infrarepo.NewReminderRepository(db.AppClient)
+	reminderInteractor := usecase.NewReminderInteractor(
+		reminderRepo,
+		infrarepo.NewTodoRepository(db.AppClient),
+		usecase.NewPermissionEvaluator(usecase.DefaultPermissionRules()),
+		pkg.NewUUIDGenerator(),
+		pkg.NewClock(),
+	)
+	actor := input.Actor{UserID: user.ID, TenantID: tenant.ID, Role: model.UserRoleMember}
+
+	// Due now: two hours before a todo due in one
+	twoHours := 120
+	dueNow, err := reminderInteractor.Create(ctx, actor, &input.CreateReminderInput{TodoID: todo.ID, OffsetMinutes: &twoHours})
+	require.NoError(t, err)
+	later := now.Add(30 * time.Minute)
+	_, err = reminderInteractor.Create(ctx, actor, &input.CreateReminderInput{TodoID: todo.ID, RemindAt: &later})
+	require.NoError(t, err)
+	_, err = reminderInteractor.Create(ctx, actor, &input.CreateReminderInput{TodoID: done.ID, OffsetMinutes: &twoHours})
+	require.NoError(t, err)
+	afterWaking, err := reminderInteractor.Create(ctx, actor, &input.CreateReminderInput{TodoID: snoozed.ID, OffsetMinutes: &twoHours})
+	require.NoError(t, err)
+
+	t.Run("a claimed reminder is skipped by other transactions", func(t *testing.T) {
+		err := unitOfWork.RunInTenantTx(ctx, tenant.ID, func(txCtx context.Context) error {
+			// The snoozed todo's reminder is due too, but not before the todo wakes
+			claimed, err := reminderRepo.ClaimDue(txCtx, tenant.ID, now, 10)
+			require.NoError(t, err)
+			require.Len(t, claimed, 1)
+			assert.Equal(t, dueNow.ID, claimed[0].Reminder.ID)
+			assert.Equal(t, "Pay rent", claimed[0].TodoTitle)
+			assert.Equal(t, "user@test.com", claimed[0].Email)
+
+			// A second scheduler runs in its own transaction while the first holds the row
+			return unitOfWork.RunInTenantTx(ctx, tenant.ID, func(otherCtx context.Context) error {
+				others, err := reminderRepo.ClaimDue(otherCtx, tenant.ID, now, 10)
+				require.NoError(t, err)
+				assert.Empty(t, others)
+				return nil
+			})
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("reminders of a snoozed todo wait until it wakes", func(t *testing.T) {
+		err := unitOfWork.RunInTenantTx(ctx, tenant.ID, func(txCtx context.Context) error {
+			claimed, err := reminderRepo.ClaimDue(txCtx, tenant.ID, wakes, 10)
+			require.NoError(t, err)
+			var ids []string
+			for _, due := range claimed {
+				ids = append(ids, due.Reminder.ID)
+			}
+			assert.Contains(t, ids, afterWaking.ID)
+			return nil
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("delivers due reminders once", func(t *testing.T) {
+		mail := &recordingMail{}
+		deliveryInteractor := usecase.NewReminderDeliveryInteractor(unitOfWork, infrarepo.NewTenantRepository(db.AppClient), reminderRepo, mail)
+
+		delivered, err := deliveryInteractor.DeliverDueReminders(ctx, now)
+		require.NoError(t, err)
+		assert.Equal(t, 1, delivered)
+		require.Len(t, mail.reminders, 1)
+		assert.Equal(t, dueNow.ID, mail.reminders[0].Reminder.ID)
+
+		delivered, err = deliveryInteractor.DeliverDueReminders(ctx, now)
+		require.NoError(t, err)
+		assert.Zero(t, delivered)
+
+		reminders, err := reminderInteractor.List(ctx, actor, todo.ID)
+		require.NoError(t, err)
+		require.Len(t, reminders, 2)
+		assert.Equal(t, "delivered", reminders[0].Status)
+		assert.Equal(t, 1, reminders[0].Attempts)
+		assert.NotNil(t, reminders[0].DeliveredAt)
+		assert.Equal(t, "pending", reminders[1].Status)
+	})
+
+	err = db.CleanupTables(ctx)
+	require.NoError(t, err)
+}
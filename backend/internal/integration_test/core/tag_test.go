@@ -0,0 +1,117 @@
+package core
+
+import (
+	"context"
+	"testing"
+
+	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/domain/repository"
+	infrarepo "good-todo-go/internal/infrastructure/repository"
+	"good-todo-go/internal/integration_test/common"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTagRepository(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	db := common.SetupTestDatabase(t)
+	defer db.Close()
+
+	ctx := context.Background()
+
+	err := db.CleanupTables(ctx)
+	require.NoError(t, err)
+
+	tenant := common.CreateTestTenant(t, db.AdminClient, &common.TestTenant{
+		Name: "Test Tenant",
+		Slug: "test-tenant",
+	})
+	user := common.CreateTestUser(t, db.AdminClient, &common.TestUser{
+		TenantID:     tenant.ID,
+		Email:        "user@test.com",
+		PasswordHash: "hash",
+		Name:         "Test User",
+		Role:         "member",
+	})
+	tagged := common.CreateTestTodo(t, db.AdminClient, &common.TestTodo{TenantID: tenant.ID, UserID: user.ID, Title: "Tagged"})
+	untagged := common.CreateTestTodo(t, db.AdminClient, &common.TestTodo{TenantID: tenant.ID, UserID: user.ID, Title: "Untagged"})
+
+	err = db.SetTenantContext(ctx, tenant.ID)
+	require.NoError(t, err)
+
+	tagRepo := infrarepo.NewTagRepository(db.AppClient)
+	todoRepo := infrarepo.NewTodoRepository(db.AppClient)
+
+	work, err := tagRepo.Create(ctx, &model.Tag{ID: "tag-work", TenantID: tenant.ID, Name: "Work", Color: "#3b82f6"})
+	require.NoError(t, err)
+	home, err := tagRepo.Create(ctx, &model.Tag{ID: "tag-home", TenantID: tenant.ID, Name: "Home", Color: "#22c55e"})
+	require.NoError(t, err)
+
+	t.Run("names are unique ignoring case", func(t *testing.T) {
+		_, err := tagRepo.Create(ctx, &model.Tag{ID: "tag-dup", TenantID: tenant.ID, Name: "WORK", Color: "#000000"})
+		assert.Equal(t, repository.ErrTagNameConflict, err)
+
+		home.Name = "work"
+		_, err = tagRepo.Update(ctx, home)
+		assert.Equal(t, repository.ErrTagNameConflict, err)
+		home.Name = "Home"
+	})
+
+	t.Run("saving a todo replaces its tags", func(t *testing.T) {
+		tagged.Tags = []*model.Tag{work, home}
+		updated, err := todoRepo.Update(ctx, tagged, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Home", "Work"}, tagNames(updated.Tags))
+
+		updated.Tags = []*model.Tag{work}
+		updated, err = todoRepo.Update(ctx, updated, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Work"}, tagNames(updated.Tags))
+
+		found, err := todoRepo.FindByID(ctx, tagged.ID)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Work"}, tagNames(found.Tags))
+	})
+
+	t.Run("filter by tag", func(t *testing.T) {
+		byID, err := todoRepo.FindByUserID(ctx, user.ID, repository.TodoQuery{
+			Filter: repository.TodoFilter{TagIDs: []string{work.ID}},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{tagged.ID}, todoIDs(byID))
+
+		byName, err := todoRepo.FindByUserID(ctx, user.ID, repository.TodoQuery{
+			Filter: repository.TodoFilter{ExcludeTags: []string{"work"}},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{untagged.ID}, todoIDs(byName))
+	})
+
+	t.Run("deleting a tag untags its todos", func(t *testing.T) {
+		require.NoError(t, tagRepo.Delete(ctx, work.ID))
+
+		found, err := todoRepo.FindByID(ctx, tagged.ID)
+		require.NoError(t, err)
+		require.NotNil(t, found)
+		assert.Empty(t, found.Tags)
+
+		tags, err := tagRepo.ListByTenant(ctx, tenant.ID)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Home"}, tagNames(tags))
+	})
+
+	err = db.CleanupTables(ctx)
+	require.NoError(t, err)
+}
+
+func tagNames(tags []*model.Tag) []string {
+	names := make([]string, len(tags))
+	for i, tag := range tags {
+		names[i] = tag.Name
+	}
+	return names
+}
@@ -0,0 +1,140 @@
+package core
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/domain/repository"
+	infrarepo "good-todo-go/internal/infrastructure/repository"
+	"good-todo-go/internal/integration_test/common"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTenantRepository_ChangeSlug(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	db := common.SetupTestDatabase(t)
+	defer db.Close()
+
+	ctx := context.Background()
+
+	// Cleanup before test
+	err := db.CleanupTables(ctx)
+	require.NoError(t, err)
+
+	repo := infrarepo.NewTenantRepository(db.AppClient)
+
+	_, err = repo.Create(ctx, &model.Tenant{ID: "tenant-a", Name: "Acme", Slug: "acme"})
+	require.NoError(t, err)
+	_, err = repo.Create(ctx, &model.Tenant{ID: "tenant-b", Name: "Globex", Slug: "globex"})
+	require.NoError(t, err)
+
+	t.Run("Previous slug resolves to canonical slug during grace period", func(t *testing.T) {
+		renamed, err := repo.ChangeSlug(ctx, "tenant-a", "acme-corp", time.Now().Add(time.Hour))
+		require.NoError(t, err)
+		assert.Equal(t, "acme-corp", renamed.Slug)
+
+		found, err := repo.FindBySlug(ctx, "acme")
+		require.NoError(t, err)
+		require.NotNil(t, found)
+		assert.Equal(t, "tenant-a", found.ID)
+		assert.Equal(t, "acme-corp", found.Slug)
+	})
+
+	t.Run("Slug in another tenant's grace period is taken", func(t *testing.T) {
+		_, err := repo.ChangeSlug(ctx, "tenant-b", "acme", time.Now().Add(time.Hour))
+		assert.Equal(t, repository.ErrTenantSlugConflict, err)
+	})
+
+	t.Run("Tenant can take back its own previous slug", func(t *testing.T) {
+		renamed, err := repo.ChangeSlug(ctx, "tenant-a", "acme", time.Now().Add(time.Hour))
+		require.NoError(t, err)
+		assert.Equal(t, "acme", renamed.Slug)
+
+		found, err := repo.FindBySlug(ctx, "acme-corp")
+		require.NoError(t, err)
+		require.NotNil(t, found)
+		assert.Equal(t, "acme", found.Slug)
+	})
+
+	t.Run("Expired slug no longer resolves and can be reused", func(t *testing.T) {
+		_, err := repo.ChangeSlug(ctx, "tenant-a", "acme-new", time.Now().Add(-time.Minute))
+		require.NoError(t, err)
+
+		found, err := repo.FindBySlug(ctx, "acme")
+		require.NoError(t, err)
+		assert.Nil(t, found)
+
+		renamed, err := repo.ChangeSlug(ctx, "tenant-b", "acme", time.Now().Add(time.Hour))
+		require.NoError(t, err)
+		assert.Equal(t, "acme", renamed.Slug)
+	})
+
+	// Cleanup after test
+	err = db.CleanupTables(ctx)
+	require.NoError(t, err)
+}
+
+func TestTenantRepository_FindByDomain(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	db := common.SetupTestDatabase(t)
+	defer db.Close()
+
+	ctx := context.Background()
+
+	// Cleanup before test
+	err := db.CleanupTables(ctx)
+	require.NoError(t, err)
+
+	tenantRepo := infrarepo.NewTenantRepository(db.AppClient)
+	domainRepo := infrarepo.NewTenantDomainRepository(db.AppClient)
+
+	_, err = tenantRepo.Create(ctx, &model.Tenant{ID: "tenant-a", Name: "Acme", Slug: "acme"})
+	require.NoError(t, err)
+	_, err = domainRepo.Create(ctx, &model.TenantDomain{
+		ID:                "domain-1",
+		TenantID:          "tenant-a",
+		Domain:            "todo.acme.com",
+		VerificationToken: "token-1",
+	})
+	require.NoError(t, err)
+
+	t.Run("Unverified domain does not resolve", func(t *testing.T) {
+		found, err := tenantRepo.FindByDomain(ctx, "todo.acme.com")
+		require.NoError(t, err)
+		assert.Nil(t, found)
+	})
+
+	t.Run("Verified domain resolves to tenant", func(t *testing.T) {
+		_, err := domainRepo.MarkVerified(ctx, "domain-1", time.Now())
+		require.NoError(t, err)
+
+		found, err := tenantRepo.FindByDomain(ctx, "todo.acme.com")
+		require.NoError(t, err)
+		require.NotNil(t, found)
+		assert.Equal(t, "tenant-a", found.ID)
+	})
+
+	t.Run("Domain cannot be registered twice", func(t *testing.T) {
+		_, err := domainRepo.Create(ctx, &model.TenantDomain{
+			ID:                "domain-2",
+			TenantID:          "tenant-a",
+			Domain:            "todo.acme.com",
+			VerificationToken: "token-2",
+		})
+		assert.Equal(t, repository.ErrTenantDomainConflict, err)
+	})
+
+	// Cleanup after test
+	err = db.CleanupTables(ctx)
+	require.NoError(t, err)
+}
@@ -0,0 +1,125 @@
+package core
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"good-todo-go/internal/domain/model"
+	infrarepo "good-todo-go/internal/infrastructure/repository"
+	"good-todo-go/internal/integration_test/common"
+	"good-todo-go/internal/pkg"
+	mocku "good-todo-go/internal/pkg/mock"
+	"good-todo-go/internal/usecase"
+	"good-todo-go/internal/usecase/input"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTodoIntegration_Assign(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	db := common.SetupTestDatabase(t)
+	defer db.Close()
+
+	ctx := context.Background()
+
+	err := db.CleanupTables(ctx)
+	require.NoError(t, err)
+
+	tenant := common.CreateTestTenant(t, db.AdminClient, &common.TestTenant{Name: "Test Tenant", Slug: "test-tenant"})
+	otherTenant := common.CreateTestTenant(t, db.AdminClient, &common.TestTenant{Name: "Other Tenant", Slug: "other-tenant"})
+	owner := common.CreateTestUser(t, db.AdminClient, &common.TestUser{
+		TenantID: tenant.ID, Email: "owner@test.com", PasswordHash: "hash", Name: "Owner", Role: "member",
+	})
+	colleague := common.CreateTestUser(t, db.AdminClient, &common.TestUser{
+		TenantID: tenant.ID, Email: "colleague@test.com", PasswordHash: "hash", Name: "Colleague", Role: "member", Timezone: "Asia/Tokyo",
+	})
+	outsider := common.CreateTestUser(t, db.AdminClient, &common.TestUser{
+		TenantID: otherTenant.ID, Email: "outsider@test.com", PasswordHash: "hash", Name: "Outsider", Role: "member",
+	})
+	common.CreateTestTodo(t, db.AdminClient, &common.TestTodo{ID: "report", TenantID: tenant.ID, UserID: owner.ID, Title: "File the report"})
+
+	err = db.SetTenantContext(ctx, tenant.ID)
+	require.NoError(t, err)
+
+	mail := &recordingMail{}
+	todoInteractor := usecase.NewTodoInteractor(
+		infrarepo.NewUnitOfWork(db.AppDB),
+		infrarepo.NewTodoRepository(db.AppClient),
+		infrarepo.NewTodoActivityRepository(db.AppClient),
+		infrarepo.NewReminderRepository(db.AppClient),
+		infrarepo.NewTagRepository(db.AppClient),
+		infrarepo.NewProjectRepository(db.AppClient),
+		infrarepo.NewUserRepository(db.AppClient),
+		infrarepo.NewMembershipRepository(db.AppClient),
+		mail,
+		usecase.NewPermissionEvaluator(usecase.DefaultPermissionRules()),
+		pkg.NewUUIDGenerator(),
+		mocku.NewMockClock(time.Now()),
+		usecase.DefaultMaxSubtaskDepth,
+	)
+	ownerActor := input.Actor{UserID: owner.ID, TenantID: tenant.ID, Role: model.UserRoleMember}
+	colleagueActor := input.Actor{UserID: colleague.ID, TenantID: tenant.ID, Role: model.UserRoleMember}
+
+	assigned, err := todoInteractor.Assign(ctx, ownerActor, "report", colleague.ID)
+	require.NoError(t, err)
+	require.NotNil(t, assigned.AssigneeID)
+	assert.Equal(t, colleague.ID, *assigned.AssigneeID)
+
+	t.Run("the assignee is emailed", func(t *testing.T) {
+		require.Len(t, mail.assignments, 1)
+		assert.Equal(t, "colleague@test.com", mail.assignments[0].Email)
+		assert.Equal(t, "Owner", mail.assignments[0].AssignedBy)
+		assert.Equal(t, "File the report", mail.assignments[0].TodoTitle)
+		assert.Equal(t, "Asia/Tokyo", mail.assignments[0].Timezone)
+	})
+
+	t.Run("the todo is in the assignee's assigned-to-me list", func(t *testing.T) {
+		result, err := todoInteractor.ListAssigned(ctx, colleagueActor, &input.ListTodosInput{})
+		require.NoError(t, err)
+		require.Len(t, result.Todos, 1)
+		assert.Equal(t, "report", result.Todos[0].ID)
+
+		result, err = todoInteractor.ListAssigned(ctx, ownerActor, &input.ListTodosInput{})
+		require.NoError(t, err)
+		assert.Empty(t, result.Todos)
+	})
+
+	t.Run("the assignee can find and complete the private todo but not delete it", func(t *testing.T) {
+		hits, err := todoInteractor.Search(ctx, colleagueActor, &input.SearchTodosInput{Query: "report"})
+		require.NoError(t, err)
+		require.Len(t, hits, 1)
+
+		updated, err := todoInteractor.Update(ctx, colleagueActor, &input.UpdateTodoInput{ID: "report", Title: "File the report", Completed: true})
+		require.NoError(t, err)
+		assert.True(t, updated.Completed)
+		assert.Equal(t, owner.ID, updated.UserID)
+		require.NotNil(t, updated.AssigneeID)
+
+		err = todoInteractor.Delete(ctx, colleagueActor, &input.DeleteTodoInput{ID: "report"})
+		assert.Equal(t, usecase.ErrNotTodoOwner, err)
+	})
+
+	t.Run("members of other tenants cannot be assigned", func(t *testing.T) {
+		_, err := todoInteractor.Assign(ctx, ownerActor, "report", outsider.ID)
+		assert.Equal(t, usecase.ErrAssigneeNotFound, err)
+
+		// The composite foreign key holds even when the usecase is bypassed
+		err = db.AdminClient.Todo.UpdateOneID("report").SetAssigneeID(outsider.ID).Exec(ctx)
+		assert.Error(t, err)
+	})
+
+	t.Run("unassigning takes it off the list", func(t *testing.T) {
+		unassigned, err := todoInteractor.Unassign(ctx, ownerActor, "report")
+		require.NoError(t, err)
+		assert.Nil(t, unassigned.AssigneeID)
+
+		result, err := todoInteractor.ListAssigned(ctx, colleagueActor, &input.ListTodosInput{})
+		require.NoError(t, err)
+		assert.Empty(t, result.Todos)
+	})
+}
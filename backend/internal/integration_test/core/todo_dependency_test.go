@@ -0,0 +1,106 @@
+package core
+
+import (
+	"context"
+	"testing"
+
+	"good-todo-go/internal/domain/repository"
+	infrarepo "good-todo-go/internal/infrastructure/repository"
+	"good-todo-go/internal/integration_test/common"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTodoRepository_Dependencies(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	db := common.SetupTestDatabase(t)
+	defer db.Close()
+
+	ctx := context.Background()
+
+	err := db.CleanupTables(ctx)
+	require.NoError(t, err)
+
+	tenant := common.CreateTestTenant(t, db.AdminClient, &common.TestTenant{
+		Name: "Test Tenant",
+		Slug: "test-tenant",
+	})
+	user := common.CreateTestUser(t, db.AdminClient, &common.TestUser{
+		TenantID:     tenant.ID,
+		Email:        "user@test.com",
+		PasswordHash: "hash",
+		Name:         "User",
+		Role:         "member",
+	})
+	design := common.CreateTestTodo(t, db.AdminClient, &common.TestTodo{TenantID: tenant.ID, UserID: user.ID, Title: "Design"})
+	build := common.CreateTestTodo(t, db.AdminClient, &common.TestTodo{TenantID: tenant.ID, UserID: user.ID, Title: "Build"})
+	ship := common.CreateTestTodo(t, db.AdminClient, &common.TestTodo{TenantID: tenant.ID, UserID: user.ID, Title: "Ship"})
+
+	err = db.SetTenantContext(ctx, tenant.ID)
+	require.NoError(t, err)
+
+	todoRepo := infrarepo.NewTodoRepository(db.AppClient)
+
+	// Ship waits on build, which waits on design
+	require.NoError(t, todoRepo.AddBlocker(ctx, tenant.ID, build.ID, design.ID))
+	require.NoError(t, todoRepo.AddBlocker(ctx, tenant.ID, ship.ID, build.ID))
+
+	t.Run("adding a dependency twice is a no-op", func(t *testing.T) {
+		require.NoError(t, todoRepo.AddBlocker(ctx, tenant.ID, ship.ID, build.ID))
+
+		blockers, err := todoRepo.FindBlockers(ctx, ship.ID)
+		require.NoError(t, err)
+		assert.Equal(t, []string{build.ID}, todoIDs(blockers))
+	})
+
+	t.Run("counts open blockers and dependents", func(t *testing.T) {
+		found, err := todoRepo.FindByID(ctx, build.ID)
+		require.NoError(t, err)
+		require.NotNil(t, found)
+		assert.Equal(t, 1, found.BlockedCount)
+		assert.Equal(t, 1, found.BlockingCount)
+	})
+
+	t.Run("refuses cycles through other todos", func(t *testing.T) {
+		err := todoRepo.AddBlocker(ctx, tenant.ID, design.ID, ship.ID)
+		assert.Equal(t, repository.ErrDependencyCycle, err)
+
+		err = todoRepo.AddBlocker(ctx, tenant.ID, design.ID, design.ID)
+		assert.Equal(t, repository.ErrDependencyCycle, err)
+	})
+
+	t.Run("completed blockers no longer count", func(t *testing.T) {
+		design.Completed = true
+		_, err := todoRepo.Update(ctx, design, nil)
+		require.NoError(t, err)
+
+		found, err := todoRepo.FindByID(ctx, build.ID)
+		require.NoError(t, err)
+		assert.Equal(t, 0, found.BlockedCount)
+	})
+
+	t.Run("removes a dependency", func(t *testing.T) {
+		removed, err := todoRepo.RemoveBlocker(ctx, ship.ID, build.ID)
+		require.NoError(t, err)
+		assert.True(t, removed)
+
+		removed, err = todoRepo.RemoveBlocker(ctx, ship.ID, build.ID)
+		require.NoError(t, err)
+		assert.False(t, removed)
+	})
+
+	t.Run("deleting a todo drops its dependencies", func(t *testing.T) {
+		require.NoError(t, todoRepo.Delete(ctx, design.ID, nil))
+
+		blockers, err := todoRepo.FindBlockers(ctx, build.ID)
+		require.NoError(t, err)
+		assert.Empty(t, blockers)
+	})
+
+	err = db.CleanupTables(ctx)
+	require.NoError(t, err)
+}
@@ -0,0 +1,98 @@
+package core
+
+import (
+	"context"
+	"testing"
+
+	infrarepo "good-todo-go/internal/infrastructure/repository"
+	"good-todo-go/internal/integration_test/common"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTodoRepository_Positions(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	db := common.SetupTestDatabase(t)
+	defer db.Close()
+
+	ctx := context.Background()
+
+	err := db.CleanupTables(ctx)
+	require.NoError(t, err)
+
+	tenant := common.CreateTestTenant(t, db.AdminClient, &common.TestTenant{
+		Name: "Test Tenant",
+		Slug: "test-tenant",
+	})
+	user := common.CreateTestUser(t, db.AdminClient, &common.TestUser{
+		TenantID:     tenant.ID,
+		Email:        "user@test.com",
+		PasswordHash: "hash",
+		Name:         "User",
+		Role:         "member",
+	})
+	first := common.CreateTestTodo(t, db.AdminClient, &common.TestTodo{TenantID: tenant.ID, UserID: user.ID, Title: "First", Position: "a0"})
+	second := common.CreateTestTodo(t, db.AdminClient, &common.TestTodo{TenantID: tenant.ID, UserID: user.ID, Title: "Second", Position: "a1"})
+	third := common.CreateTestTodo(t, db.AdminClient, &common.TestTodo{TenantID: tenant.ID, UserID: user.ID, Title: "Third", Position: "a1V"})
+
+	err = db.SetTenantContext(ctx, tenant.ID)
+	require.NoError(t, err)
+
+	todoRepo := infrarepo.NewTodoRepository(db.AppClient)
+
+	t.Run("finds neighbours", func(t *testing.T) {
+		next, err := todoRepo.AdjacentPosition(ctx, tenant.ID, "a0", true, "")
+		require.NoError(t, err)
+		assert.Equal(t, "a1", next)
+
+		prev, err := todoRepo.AdjacentPosition(ctx, tenant.ID, "a1V", false, second.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "a0", prev)
+
+		top, err := todoRepo.AdjacentPosition(ctx, tenant.ID, "", true, "")
+		require.NoError(t, err)
+		assert.Equal(t, "a0", top)
+
+		none, err := todoRepo.AdjacentPosition(ctx, tenant.ID, "a1V", true, "")
+		require.NoError(t, err)
+		assert.Empty(t, none)
+	})
+
+	t.Run("moves one todo", func(t *testing.T) {
+		third.Position = "Zz"
+		moved, err := todoRepo.Move(ctx, third)
+		require.NoError(t, err)
+		require.NotNil(t, moved)
+		assert.Equal(t, "Zz", moved.Position)
+		assert.Equal(t, 2, moved.Version)
+
+		ids, err := todoRepo.FindIDsByPosition(ctx, tenant.ID)
+		require.NoError(t, err)
+		assert.Equal(t, []string{third.ID, first.ID, second.ID}, ids)
+	})
+
+	t.Run("rewrites positions", func(t *testing.T) {
+		longest, err := todoRepo.LongestPosition(ctx, tenant.ID)
+		require.NoError(t, err)
+		assert.Equal(t, 2, longest)
+
+		err = todoRepo.SetPositions(ctx, []string{second.ID, third.ID, first.ID}, []string{"a0", "a1", "a2"})
+		require.NoError(t, err)
+
+		ids, err := todoRepo.FindIDsByPosition(ctx, tenant.ID)
+		require.NoError(t, err)
+		assert.Equal(t, []string{second.ID, third.ID, first.ID}, ids)
+
+		// Rewriting keys is not a change to the todo
+		found, err := todoRepo.FindByID(ctx, second.ID)
+		require.NoError(t, err)
+		assert.Equal(t, 1, found.Version)
+	})
+
+	err = db.CleanupTables(ctx)
+	require.NoError(t, err)
+}
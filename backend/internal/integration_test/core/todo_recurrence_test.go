@@ -0,0 +1,123 @@
+package core
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"good-todo-go/internal/domain/model"
+	infrarepo "good-todo-go/internal/infrastructure/repository"
+	"good-todo-go/internal/integration_test/common"
+	"good-todo-go/internal/pkg"
+	"good-todo-go/internal/usecase"
+	"good-todo-go/internal/usecase/input"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTodoIntegration_Recurrence(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	db := common.SetupTestDatabase(t)
+	defer db.Close()
+
+	ctx := context.Background()
+
+	err := db.CleanupTables(ctx)
+	require.NoError(t, err)
+
+	tenant := common.CreateTestTenant(t, db.AdminClient, &common.TestTenant{Name: "Test Tenant", Slug: "test-tenant"})
+	user := common.CreateTestUser(t, db.AdminClient, &common.TestUser{
+		TenantID: tenant.ID, Email: "user@test.com", PasswordHash: "hash", Name: "User", Role: "member", Timezone: "America/New_York",
+	})
+
+	err = db.SetTenantContext(ctx, tenant.ID)
+	require.NoError(t, err)
+
+	reminderRepo := infrarepo.NewReminderRepository(db.AppClient)
+	todoInteractor := usecase.NewTodoInteractor(
+		infrarepo.NewUnitOfWork(db.AppDB),
+		infrarepo.NewTodoRepository(db.AppClient),
+		infrarepo.NewTodoActivityRepository(db.AppClient),
+		reminderRepo,
+		infrarepo.NewTagRepository(db.AppClient),
+		infrarepo.NewProjectRepository(db.AppClient),
+		infrarepo.NewUserRepository(db.AppClient),
+		infrarepo.NewMembershipRepository(db.AppClient),
+		&recordingMail{},
+		usecase.NewPermissionEvaluator(usecase.DefaultPermissionRules()),
+		pkg.NewUUIDGenerator(),
+		pkg.NewClock(),
+		usecase.DefaultMaxSubtaskDepth,
+	)
+	actor := input.Actor{UserID: user.ID, TenantID: tenant.ID, Role: model.UserRoleMember}
+
+	// 09:00 in New York on the Friday before clocks spring forward
+	due := time.Date(2024, 3, 8, 14, 0, 0, 0, time.UTC)
+	created, err := todoInteractor.Create(ctx, actor, &input.CreateTodoInput{
+		Title: "Take out the bins", DueDate: &due, RecurrenceRule: "FREQ=WEEKLY;COUNT=2",
+	})
+	require.NoError(t, err)
+	require.NotNil(t, created.RecurrenceTimezone)
+	assert.Equal(t, "America/New_York", *created.RecurrenceTimezone)
+
+	t.Run("previews the next occurrences at the same wall-clock time", func(t *testing.T) {
+		occurrences, err := todoInteractor.ListOccurrences(ctx, actor, created.ID, 5)
+
+		require.NoError(t, err)
+		require.Len(t, occurrences, 1)
+		assert.True(t, time.Date(2024, 3, 15, 13, 0, 0, 0, time.UTC).Equal(occurrences[0]))
+	})
+
+	t.Run("completing creates the next occurrence until COUNT runs out", func(t *testing.T) {
+		offset := 60
+		_, err := reminderRepo.Create(ctx, &model.Reminder{
+			ID: "reminder-1", TenantID: tenant.ID, TodoID: created.ID, OffsetMinutes: &offset, Status: model.ReminderStatusPending,
+		})
+		require.NoError(t, err)
+
+		completed, err := todoInteractor.Update(ctx, actor, &input.UpdateTodoInput{
+			ID: created.ID, Title: created.Title, Completed: true, DueDate: created.DueDate,
+		})
+		require.NoError(t, err)
+		assert.Nil(t, completed.RecurrenceRule)
+
+		open := false
+		list, err := todoInteractor.List(ctx, actor, &input.ListTodosInput{Filter: input.TodoFilter{Completed: &open}})
+		require.NoError(t, err)
+		require.Len(t, list.Todos, 1)
+		next := list.Todos[0]
+		assert.Equal(t, "Take out the bins", next.Title)
+		assert.True(t, time.Date(2024, 3, 15, 13, 0, 0, 0, time.UTC).Equal(*next.DueDate))
+		require.NotNil(t, next.RecurrenceRule)
+		assert.Equal(t, "FREQ=WEEKLY;COUNT=1", *next.RecurrenceRule)
+		assert.Less(t, next.Position, completed.Position)
+
+		reminders, err := reminderRepo.FindByTodoID(ctx, tenant.ID, next.ID)
+		require.NoError(t, err)
+		require.Len(t, reminders, 1)
+		assert.Equal(t, &offset, reminders[0].OffsetMinutes)
+
+		last, err := todoInteractor.Update(ctx, actor, &input.UpdateTodoInput{
+			ID: next.ID, Title: next.Title, Completed: true, DueDate: next.DueDate,
+		})
+		require.NoError(t, err)
+		assert.True(t, last.Completed)
+
+		list, err = todoInteractor.List(ctx, actor, &input.ListTodosInput{Filter: input.TodoFilter{Completed: &open}})
+		require.NoError(t, err)
+		assert.Empty(t, list.Todos)
+
+		// Both completed occurrences stay as history
+		done := true
+		list, err = todoInteractor.List(ctx, actor, &input.ListTodosInput{Filter: input.TodoFilter{Completed: &done}})
+		require.NoError(t, err)
+		assert.Len(t, list.Todos, 2)
+	})
+
+	err = db.CleanupTables(ctx)
+	require.NoError(t, err)
+}
@@ -0,0 +1,109 @@
+package core
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"good-todo-go/internal/domain/model"
+	infrarepo "good-todo-go/internal/infrastructure/repository"
+	"good-todo-go/internal/integration_test/common"
+	"good-todo-go/internal/pkg"
+	"good-todo-go/internal/usecase"
+	"good-todo-go/internal/usecase/input"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTodoIntegration_Search(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	db := common.SetupTestDatabase(t)
+	defer db.Close()
+
+	ctx := context.Background()
+
+	err := db.CleanupTables(ctx)
+	require.NoError(t, err)
+
+	tenantA := common.CreateTestTenant(t, db.AdminClient, &common.TestTenant{Name: "Tenant A", Slug: "tenant-a"})
+	tenantB := common.CreateTestTenant(t, db.AdminClient, &common.TestTenant{Name: "Tenant B", Slug: "tenant-b"})
+
+	alice := common.CreateTestUser(t, db.AdminClient, &common.TestUser{
+		TenantID: tenantA.ID, Email: "alice@test.com", PasswordHash: "hash", Name: "Alice", Role: "member",
+	})
+	bob := common.CreateTestUser(t, db.AdminClient, &common.TestUser{
+		TenantID: tenantA.ID, Email: "bob@test.com", PasswordHash: "hash", Name: "Bob", Role: "member",
+	})
+	carol := common.CreateTestUser(t, db.AdminClient, &common.TestUser{
+		TenantID: tenantB.ID, Email: "carol@test.com", PasswordHash: "hash", Name: "Carol", Role: "member",
+	})
+
+	common.CreateTestTodo(t, db.AdminClient, &common.TestTodo{ID: "todo-own", TenantID: tenantA.ID, UserID: alice.ID, Title: "Quarterly report", Description: "Draft the budget section"})
+	common.CreateTestTodo(t, db.AdminClient, &common.TestTodo{ID: "todo-public", TenantID: tenantA.ID, UserID: bob.ID, Title: "Budget review", IsPublic: true})
+	common.CreateTestTodo(t, db.AdminClient, &common.TestTodo{ID: "todo-private", TenantID: tenantA.ID, UserID: bob.ID, Title: "Budget secrets"})
+	common.CreateTestTodo(t, db.AdminClient, &common.TestTodo{ID: "todo-other-tenant", TenantID: tenantB.ID, UserID: carol.ID, Title: "Budget elsewhere", IsPublic: true})
+	due := time.Date(2024, 3, 8, 9, 0, 0, 0, time.UTC)
+	common.CreateTestTodo(t, db.AdminClient, &common.TestTodo{ID: "todo-recurring", TenantID: tenantA.ID, UserID: alice.ID, Title: "Water the ferns", Position: "a3", Priority: model.TodoPriorityHigh, DueDate: &due})
+	err = db.AdminClient.Todo.UpdateOneID("todo-recurring").SetRecurrenceRule("FREQ=WEEKLY").SetRecurrenceTimezone("Europe/Berlin").Exec(ctx)
+	require.NoError(t, err)
+
+	todoInteractor := usecase.NewTodoInteractor(
+		infrarepo.NewUnitOfWork(db.AppDB),
+		infrarepo.NewTodoRepository(db.AppClient),
+		infrarepo.NewTodoActivityRepository(db.AppClient),
+		infrarepo.NewReminderRepository(db.AppClient),
+		infrarepo.NewTagRepository(db.AppClient),
+		infrarepo.NewProjectRepository(db.AppClient),
+		infrarepo.NewUserRepository(db.AppClient),
+		infrarepo.NewMembershipRepository(db.AppClient),
+		&recordingMail{},
+		usecase.NewPermissionEvaluator(usecase.DefaultPermissionRules()),
+		pkg.NewUUIDGenerator(),
+		pkg.NewClock(),
+		usecase.DefaultMaxSubtaskDepth,
+	)
+	actor := input.Actor{UserID: alice.ID, TenantID: tenantA.ID, Role: model.UserRoleMember}
+
+	t.Run("Full-text matches cover own and public todos in tenant", func(t *testing.T) {
+		results, err := todoInteractor.Search(ctx, actor, &input.SearchTodosInput{Query: "budget"})
+		require.NoError(t, err)
+
+		ids := make([]string, len(results))
+		for i, r := range results {
+			ids[i] = r.Todo.ID
+		}
+		// Title matches are weighted above description matches
+		assert.Equal(t, []string{"todo-public", "todo-own"}, ids)
+		assert.Contains(t, results[0].TitleHighlight, model.HighlightStart+"Budget"+model.HighlightEnd)
+	})
+
+	t.Run("Partial words fall back to trigram matching", func(t *testing.T) {
+		results, err := todoInteractor.Search(ctx, actor, &input.SearchTodosInput{Query: "quart"})
+		require.NoError(t, err)
+
+		require.Len(t, results, 1)
+		assert.Equal(t, "todo-own", results[0].Todo.ID)
+		assert.Contains(t, results[0].TitleHighlight, model.HighlightStart+"Quart"+model.HighlightEnd)
+	})
+
+	t.Run("Hits carry the same fields as listings", func(t *testing.T) {
+		results, err := todoInteractor.Search(ctx, actor, &input.SearchTodosInput{Query: "ferns"})
+		require.NoError(t, err)
+
+		require.Len(t, results, 1)
+		hit := results[0].Todo
+		assert.Equal(t, "high", hit.Priority)
+		assert.Equal(t, "a3", hit.Position)
+		require.NotNil(t, hit.RecurrenceRule)
+		assert.Equal(t, "FREQ=WEEKLY", *hit.RecurrenceRule)
+		require.NotNil(t, hit.RecurrenceTimezone)
+		assert.Equal(t, "Europe/Berlin", *hit.RecurrenceTimezone)
+	})
+
+	err = db.CleanupTables(ctx)
+	require.NoError(t, err)
+}
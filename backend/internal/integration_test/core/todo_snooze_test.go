@@ -0,0 +1,122 @@
+package core
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"good-todo-go/internal/domain/model"
+	infrarepo "good-todo-go/internal/infrastructure/repository"
+	"good-todo-go/internal/integration_test/common"
+	"good-todo-go/internal/pkg"
+	mocku "good-todo-go/internal/pkg/mock"
+	"good-todo-go/internal/usecase"
+	"good-todo-go/internal/usecase/input"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTodoIntegration_Snooze(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	db := common.SetupTestDatabase(t)
+	defer db.Close()
+
+	ctx := context.Background()
+
+	err := db.CleanupTables(ctx)
+	require.NoError(t, err)
+
+	tenant := common.CreateTestTenant(t, db.AdminClient, &common.TestTenant{Name: "Test Tenant", Slug: "test-tenant"})
+	user := common.CreateTestUser(t, db.AdminClient, &common.TestUser{
+		TenantID: tenant.ID, Email: "user@test.com", PasswordHash: "hash", Name: "User", Role: "member",
+	})
+	common.CreateTestTodo(t, db.AdminClient, &common.TestTodo{ID: "snoozed", TenantID: tenant.ID, UserID: user.ID, Title: "Renew passport"})
+	common.CreateTestTodo(t, db.AdminClient, &common.TestTodo{ID: "awake", TenantID: tenant.ID, UserID: user.ID, Title: "Water plants"})
+
+	err = db.SetTenantContext(ctx, tenant.ID)
+	require.NoError(t, err)
+
+	clock := mocku.NewMockClock(time.Date(2024, 3, 11, 10, 0, 0, 0, time.UTC))
+	todoInteractor := usecase.NewTodoInteractor(
+		infrarepo.NewUnitOfWork(db.AppDB),
+		infrarepo.NewTodoRepository(db.AppClient),
+		infrarepo.NewTodoActivityRepository(db.AppClient),
+		infrarepo.NewReminderRepository(db.AppClient),
+		infrarepo.NewTagRepository(db.AppClient),
+		infrarepo.NewProjectRepository(db.AppClient),
+		infrarepo.NewUserRepository(db.AppClient),
+		infrarepo.NewMembershipRepository(db.AppClient),
+		&recordingMail{},
+		usecase.NewPermissionEvaluator(usecase.DefaultPermissionRules()),
+		pkg.NewUUIDGenerator(),
+		clock,
+		usecase.DefaultMaxSubtaskDepth,
+	)
+	actor := input.Actor{UserID: user.ID, TenantID: tenant.ID, Role: model.UserRoleMember}
+
+	list := func(t *testing.T, includeSnoozed bool) []string {
+		result, err := todoInteractor.List(ctx, actor, &input.ListTodosInput{Filter: input.TodoFilter{IncludeSnoozed: includeSnoozed, SortBy: "title", SortOrder: "asc"}})
+		require.NoError(t, err)
+		var ids []string
+		for _, todo := range result.Todos {
+			ids = append(ids, todo.ID)
+		}
+		return ids
+	}
+
+	snoozed, err := todoInteractor.Snooze(ctx, actor, &input.SnoozeTodoInput{ID: "snoozed", Preset: usecase.SnoozeTomorrow})
+	require.NoError(t, err)
+	require.NotNil(t, snoozed.SnoozedUntil)
+	assert.True(t, time.Date(2024, 3, 12, 8, 0, 0, 0, time.UTC).Equal(*snoozed.SnoozedUntil))
+
+	t.Run("listings hide snoozed todos by default", func(t *testing.T) {
+		assert.Equal(t, []string{"awake"}, list(t, false))
+		assert.Equal(t, []string{"snoozed", "awake"}, list(t, true))
+
+		view, err := todoInteractor.ListView(ctx, actor, &input.ListTodoViewInput{View: usecase.TodoViewSomeday})
+		require.NoError(t, err)
+		require.Len(t, view.Todos, 1)
+		assert.Equal(t, "awake", view.Todos[0].ID)
+	})
+
+	t.Run("search still finds snoozed todos", func(t *testing.T) {
+		results, err := todoInteractor.Search(ctx, actor, &input.SearchTodosInput{Query: "passport"})
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		assert.NotNil(t, results[0].Todo.SnoozedUntil)
+	})
+
+	t.Run("snoozed todos come back once the time passes", func(t *testing.T) {
+		clock.At = *snoozed.SnoozedUntil
+		defer func() { clock.At = time.Date(2024, 3, 11, 10, 0, 0, 0, time.UTC) }()
+
+		assert.Equal(t, []string{"snoozed", "awake"}, list(t, false))
+	})
+
+	t.Run("unsnooze brings the todo back right away", func(t *testing.T) {
+		result, err := todoInteractor.Unsnooze(ctx, actor, "snoozed")
+		require.NoError(t, err)
+		assert.Nil(t, result.SnoozedUntil)
+
+		assert.Equal(t, []string{"snoozed", "awake"}, list(t, false))
+	})
+
+	t.Run("the activity records the snooze and unsnooze", func(t *testing.T) {
+		activities, err := todoInteractor.ListActivity(ctx, actor, "snoozed")
+		require.NoError(t, err)
+		require.Len(t, activities, 2)
+		assert.Equal(t, "snoozed", activities[0].Action)
+		assert.Equal(t, user.ID, activities[0].ActorID)
+		require.NotNil(t, activities[0].SnoozedUntil)
+		assert.True(t, snoozed.SnoozedUntil.Equal(*activities[0].SnoozedUntil))
+		assert.Equal(t, "unsnoozed", activities[1].Action)
+		assert.Nil(t, activities[1].SnoozedUntil)
+	})
+
+	err = db.CleanupTables(ctx)
+	require.NoError(t, err)
+}
@@ -0,0 +1,87 @@
+package core
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"good-todo-go/internal/domain/model"
+	infrarepo "good-todo-go/internal/infrastructure/repository"
+	"good-todo-go/internal/integration_test/common"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTodoRepository_Subtasks(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	db := common.SetupTestDatabase(t)
+	defer db.Close()
+
+	ctx := context.Background()
+
+	err := db.CleanupTables(ctx)
+	require.NoError(t, err)
+
+	tenant := common.CreateTestTenant(t, db.AdminClient, &common.TestTenant{
+		Name: "Test Tenant",
+		Slug: "test-tenant",
+	})
+	user := common.CreateTestUser(t, db.AdminClient, &common.TestUser{
+		TenantID:     tenant.ID,
+		Email:        "user@test.com",
+		PasswordHash: "hash",
+		Name:         "User",
+		Role:         "member",
+	})
+	parent := common.CreateTestTodo(t, db.AdminClient, &common.TestTodo{TenantID: tenant.ID, UserID: user.ID, Title: "Parent"})
+
+	err = db.SetTenantContext(ctx, tenant.ID)
+	require.NoError(t, err)
+
+	todoRepo := infrarepo.NewTodoRepository(db.AppClient)
+
+	completedAt := time.Now()
+	var subtasks []*model.Todo
+	for _, todo := range []*model.Todo{
+		{ID: "subtask-1", TenantID: tenant.ID, UserID: user.ID, Title: "First", ParentID: &parent.ID, Completed: true, CompletedAt: &completedAt},
+		{ID: "subtask-2", TenantID: tenant.ID, UserID: user.ID, Title: "Second", ParentID: &parent.ID},
+		{ID: "subtask-3", TenantID: tenant.ID, UserID: user.ID, Title: "Third", ParentID: &parent.ID},
+	} {
+		created, err := todoRepo.Create(ctx, todo)
+		require.NoError(t, err)
+		subtasks = append(subtasks, created)
+	}
+
+	t.Run("counts live subtasks", func(t *testing.T) {
+		require.NoError(t, todoRepo.Trash(ctx, subtasks[2].ID, time.Now(), nil))
+
+		found, err := todoRepo.FindByID(ctx, parent.ID)
+		require.NoError(t, err)
+		require.NotNil(t, found)
+		assert.Equal(t, 2, found.SubtaskCount)
+		assert.Equal(t, 1, found.CompletedSubtaskCount)
+	})
+
+	t.Run("finds subtasks oldest first", func(t *testing.T) {
+		found, err := todoRepo.FindSubtasks(ctx, []string{parent.ID})
+		require.NoError(t, err)
+		assert.Equal(t, []string{subtasks[0].ID, subtasks[1].ID}, todoIDs(found))
+		assert.Equal(t, parent.ID, *found[0].ParentID)
+	})
+
+	t.Run("deleting the parent makes subtasks top-level", func(t *testing.T) {
+		require.NoError(t, todoRepo.Delete(ctx, parent.ID, nil))
+
+		found, err := todoRepo.FindByID(ctx, subtasks[1].ID)
+		require.NoError(t, err)
+		require.NotNil(t, found)
+		assert.Nil(t, found.ParentID)
+	})
+
+	err = db.CleanupTables(ctx)
+	require.NoError(t, err)
+}
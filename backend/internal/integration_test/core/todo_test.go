@@ -2,9 +2,12 @@ package core
 
 import (
 	"context"
+	"fmt"
 	"testing"
+	"time"
 
 	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/domain/repository"
 	infrarepo "good-todo-go/internal/infrastructure/repository"
 	"good-todo-go/internal/integration_test/common"
 	"good-todo-go/internal/pkg"
@@ -50,7 +53,9 @@ func TestTodoIntegration_CRUD(t *testing.T) {
 	// Create repository and interactor
 	todoRepo := infrarepo.NewTodoRepository(db.AppClient)
 	uuidGen := pkg.NewUUIDGenerator()
-	todoInteractor := usecase.NewTodoInteractor(todoRepo, uuidGen)
+	todoInteractor := usecase.NewTodoInteractor(infrarepo.NewUnitOfWork(db.AppDB), todoRepo, infrarepo.NewTodoActivityRepository(db.AppClient), infrarepo.NewReminderRepository(db.AppClient), infrarepo.NewTagRepository(db.AppClient), infrarepo.NewProjectRepository(db.AppClient), infrarepo.NewUserRepository(db.AppClient), infrarepo.NewMembershipRepository(db.AppClient), &recordingMail{}, usecase.NewPermissionEvaluator(usecase.DefaultPermissionRules()), uuidGen, pkg.NewClock(), usecase.DefaultMaxSubtaskDepth)
+
+	actor := input.Actor{UserID: user.ID, TenantID: tenant.ID, Role: model.UserRoleMember}
 
 	var createdTodoID string
 
@@ -61,7 +66,7 @@ func TestTodoIntegration_CRUD(t *testing.T) {
 			IsPublic:    false,
 		}
 
-		result, err := todoInteractor.Create(ctx, user.ID, tenant.ID, inp)
+		result, err := todoInteractor.Create(ctx, actor, inp)
 		require.NoError(t, err)
 
 		assert.NotEmpty(t, result.ID)
@@ -74,11 +79,12 @@ func TestTodoIntegration_CRUD(t *testing.T) {
 	})
 
 	t.Run("List todos", func(t *testing.T) {
-		result, err := todoInteractor.List(ctx, user.ID)
+		result, err := todoInteractor.List(ctx, actor, &input.ListTodosInput{})
 		require.NoError(t, err)
 
-		assert.Len(t, result, 1)
-		assert.Equal(t, createdTodoID, result[0].ID)
+		assert.Len(t, result.Todos, 1)
+		assert.Equal(t, createdTodoID, result.Todos[0].ID)
+		assert.Nil(t, result.NextCursor)
 	})
 
 	t.Run("Update todo", func(t *testing.T) {
@@ -90,7 +96,7 @@ func TestTodoIntegration_CRUD(t *testing.T) {
 			IsPublic:    true,
 		}
 
-		result, err := todoInteractor.Update(ctx, user.ID, inp)
+		result, err := todoInteractor.Update(ctx, actor, inp)
 		require.NoError(t, err)
 
 		assert.Equal(t, inp.Title, result.Title)
@@ -100,23 +106,35 @@ func TestTodoIntegration_CRUD(t *testing.T) {
 		assert.NotNil(t, result.CompletedAt)
 	})
 
+	t.Run("Patch todo", func(t *testing.T) {
+		completed := false
+		result, err := todoInteractor.Patch(ctx, actor, &input.PatchTodoInput{ID: createdTodoID, Completed: &completed})
+		require.NoError(t, err)
+
+		assert.False(t, result.Completed)
+		assert.Nil(t, result.CompletedAt)
+		assert.Equal(t, "Updated Todo", result.Title)
+		assert.Equal(t, "Updated description", result.Description)
+		assert.True(t, result.IsPublic)
+	})
+
 	t.Run("List public todos", func(t *testing.T) {
-		result, err := todoInteractor.ListPublic(ctx, tenant.ID)
+		result, err := todoInteractor.ListPublic(ctx, actor, &input.ListTodosInput{})
 		require.NoError(t, err)
 
-		assert.Len(t, result, 1)
-		assert.Equal(t, createdTodoID, result[0].ID)
-		assert.True(t, result[0].IsPublic)
+		assert.Len(t, result.Todos, 1)
+		assert.Equal(t, createdTodoID, result.Todos[0].ID)
+		assert.True(t, result.Todos[0].IsPublic)
 	})
 
 	t.Run("Delete todo", func(t *testing.T) {
-		err := todoInteractor.Delete(ctx, user.ID, createdTodoID)
+		err := todoInteractor.Delete(ctx, actor, &input.DeleteTodoInput{ID: createdTodoID})
 		require.NoError(t, err)
 
 		// Verify deletion
-		result, err := todoInteractor.List(ctx, user.ID)
+		result, err := todoInteractor.List(ctx, actor, &input.ListTodosInput{})
 		require.NoError(t, err)
-		assert.Empty(t, result)
+		assert.Empty(t, result.Todos)
 	})
 
 	// Cleanup after test
@@ -175,7 +193,10 @@ func TestTodoIntegration_Authorization(t *testing.T) {
 
 	todoRepo := infrarepo.NewTodoRepository(db.AppClient)
 	uuidGen := pkg.NewUUIDGenerator()
-	todoInteractor := usecase.NewTodoInteractor(todoRepo, uuidGen)
+	todoInteractor := usecase.NewTodoInteractor(infrarepo.NewUnitOfWork(db.AppDB), todoRepo, infrarepo.NewTodoActivityRepository(db.AppClient), infrarepo.NewReminderRepository(db.AppClient), infrarepo.NewTagRepository(db.AppClient), infrarepo.NewProjectRepository(db.AppClient), infrarepo.NewUserRepository(db.AppClient), infrarepo.NewMembershipRepository(db.AppClient), &recordingMail{}, usecase.NewPermissionEvaluator(usecase.DefaultPermissionRules()), uuidGen, pkg.NewClock(), usecase.DefaultMaxSubtaskDepth)
+
+	actor1 := input.Actor{UserID: user1.ID, TenantID: tenant.ID, Role: model.UserRoleMember}
+	actor2 := input.Actor{UserID: user2.ID, TenantID: tenant.ID, Role: model.UserRoleMember}
 
 	t.Run("User cannot update other user's todo", func(t *testing.T) {
 		inp := &input.UpdateTodoInput{
@@ -184,25 +205,25 @@ func TestTodoIntegration_Authorization(t *testing.T) {
 			Completed: false,
 		}
 
-		_, err := todoInteractor.Update(ctx, user2.ID, inp)
+		_, err := todoInteractor.Update(ctx, actor2, inp)
 		assert.Equal(t, usecase.ErrNotTodoOwner, err)
 	})
 
 	t.Run("User cannot delete other user's todo", func(t *testing.T) {
-		err := todoInteractor.Delete(ctx, user2.ID, todo.ID)
+		err := todoInteractor.Delete(ctx, actor2, &input.DeleteTodoInput{ID: todo.ID})
 		assert.Equal(t, usecase.ErrNotTodoOwner, err)
 	})
 
 	t.Run("User can see their own todos but not others' private todos", func(t *testing.T) {
 		// User1 should see their todo
-		user1Todos, err := todoInteractor.List(ctx, user1.ID)
+		user1Todos, err := todoInteractor.List(ctx, actor1, &input.ListTodosInput{})
 		require.NoError(t, err)
-		assert.Len(t, user1Todos, 1)
+		assert.Len(t, user1Todos.Todos, 1)
 
 		// User2 should not see user1's private todo
-		user2Todos, err := todoInteractor.List(ctx, user2.ID)
+		user2Todos, err := todoInteractor.List(ctx, actor2, &input.ListTodosInput{})
 		require.NoError(t, err)
-		assert.Empty(t, user2Todos)
+		assert.Empty(t, user2Todos.Todos)
 	})
 
 	t.Run("User can see public todos from same tenant", func(t *testing.T) {
@@ -215,16 +236,405 @@ func TestTodoIntegration_Authorization(t *testing.T) {
 			IsPublic: true,
 		}
 		todoRepo := infrarepo.NewTodoRepository(db.AdminClient)
-		_, err := todoRepo.Update(ctx, updatedTodo)
+		_, err := todoRepo.Update(ctx, updatedTodo, nil)
 		require.NoError(t, err)
 
 		// Both users should see the public todo
-		publicTodos, err := todoInteractor.ListPublic(ctx, tenant.ID)
+		publicTodos, err := todoInteractor.ListPublic(ctx, actor2, &input.ListTodosInput{})
 		require.NoError(t, err)
-		assert.Len(t, publicTodos, 1)
+		assert.Len(t, publicTodos.Todos, 1)
 	})
 
 	// Cleanup after test
 	err = db.CleanupTables(ctx)
 	require.NoError(t, err)
 }
+
+func TestTodoIntegration_Pagination(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	db := common.SetupTestDatabase(t)
+	defer db.Close()
+
+	ctx := context.Background()
+
+	err := db.CleanupTables(ctx)
+	require.NoError(t, err)
+
+	tenant := common.CreateTestTenant(t, db.AdminClient, &common.TestTenant{
+		Name: "Test Tenant",
+		Slug: "test-tenant",
+	})
+
+	user := common.CreateTestUser(t, db.AdminClient, &common.TestUser{
+		TenantID:     tenant.ID,
+		Email:        "user@test.com",
+		PasswordHash: "hash",
+		Name:         "Test User",
+		Role:         "member",
+	})
+
+	// Todos sharing a created_at are ordered by id, so the tie must not drop or repeat rows
+	createdAt := time.Now().Add(-time.Hour).Truncate(time.Microsecond)
+	for i := 0; i < 5; i++ {
+		_, err := db.AdminClient.Todo.Create().
+			SetID(fmt.Sprintf("todo-%d", i)).
+			SetTenantID(tenant.ID).
+			SetUserID(user.ID).
+			SetTitle(fmt.Sprintf("Todo %d", i)).
+			SetPosition(fmt.Sprintf("a%d", i)).
+			SetCreatedAt(createdAt.Add(time.Duration(i/2) * time.Second)).
+			Save(ctx)
+		require.NoError(t, err)
+	}
+
+	err = db.SetTenantContext(ctx, tenant.ID)
+	require.NoError(t, err)
+
+	todoInteractor := usecase.NewTodoInteractor(
+		infrarepo.NewUnitOfWork(db.AppDB),
+		infrarepo.NewTodoRepository(db.AppClient),
+		infrarepo.NewTodoActivityRepository(db.AppClient),
+		infrarepo.NewReminderRepository(db.AppClient),
+		infrarepo.NewTagRepository(db.AppClient),
+		infrarepo.NewProjectRepository(db.AppClient),
+		infrarepo.NewUserRepository(db.AppClient),
+		infrarepo.NewMembershipRepository(db.AppClient),
+		&recordingMail{},
+		usecase.NewPermissionEvaluator(usecase.DefaultPermissionRules()),
+		pkg.NewUUIDGenerator(),
+		pkg.NewClock(),
+		usecase.DefaultMaxSubtaskDepth,
+	)
+	actor := input.Actor{UserID: user.ID, TenantID: tenant.ID, Role: model.UserRoleMember}
+
+	var seen []string
+	cursor := ""
+	for pages := 0; pages < 5; pages++ {
+		result, err := todoInteractor.List(ctx, actor, &input.ListTodosInput{Limit: 2, Cursor: cursor})
+		require.NoError(t, err)
+		for _, todo := range result.Todos {
+			seen = append(seen, todo.ID)
+		}
+		if result.NextCursor == nil {
+			break
+		}
+		cursor = *result.NextCursor
+	}
+
+	assert.Equal(t, []string{"todo-4", "todo-3", "todo-2", "todo-1", "todo-0"}, seen)
+
+	err = db.CleanupTables(ctx)
+	require.NoError(t, err)
+}
+
+func TestTodoRepository_FilterAndSort(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	db := common.SetupTestDatabase(t)
+	defer db.Close()
+
+	ctx := context.Background()
+
+	err := db.CleanupTables(ctx)
+	require.NoError(t, err)
+
+	tenant := common.CreateTestTenant(t, db.AdminClient, &common.TestTenant{
+		Name: "Test Tenant",
+		Slug: "test-tenant",
+	})
+
+	user := common.CreateTestUser(t, db.AdminClient, &common.TestUser{
+		TenantID:     tenant.ID,
+		Email:        "user@test.com",
+		PasswordHash: "hash",
+		Name:         "Test User",
+		Role:         "member",
+	})
+
+	now := time.Date(2024, 6, 15, 12, 0, 0, 0, time.UTC)
+	seed := []struct {
+		id        string
+		title     string
+		completed bool
+		due       *time.Time
+		createdAt time.Time
+		position  string
+	}{
+		{"todo-a", "Alpha", false, ptrTime(now.Add(-48 * time.Hour)), now.Add(-5 * time.Hour), "a2"},
+		{"todo-b", "Bravo", true, ptrTime(now.Add(-24 * time.Hour)), now.Add(-4 * time.Hour), "a0"},
+		{"todo-c", "Charlie", false, ptrTime(now.Add(24 * time.Hour)), now.Add(-3 * time.Hour), "a1V"},
+		{"todo-d", "Delta", false, nil, now.Add(-2 * time.Hour), "a1"},
+		{"todo-e", "Echo", true, nil, now.Add(-1 * time.Hour), "Zz"},
+	}
+	for _, s := range seed {
+		builder := db.AdminClient.Todo.Create().
+			SetID(s.id).
+			SetTenantID(tenant.ID).
+			SetUserID(user.ID).
+			SetTitle(s.title).
+			SetCompleted(s.completed).
+			SetCreatedAt(s.createdAt).
+			SetUpdatedAt(s.createdAt).
+			SetPosition(s.position)
+		if s.due != nil {
+			builder.SetDueDate(*s.due)
+		}
+		_, err := builder.Save(ctx)
+		require.NoError(t, err)
+	}
+
+	err = db.SetTenantContext(ctx, tenant.ID)
+	require.NoError(t, err)
+
+	todoRepo := infrarepo.NewTodoRepository(db.AppClient)
+	yes := true
+	no := false
+	newestFirst := repository.TodoSort{Field: repository.TodoSortCreatedAt, Desc: true}
+
+	tests := []struct {
+		name   string
+		filter repository.TodoFilter
+		sort   repository.TodoSort
+		want   []string
+	}{
+		{"no filter", repository.TodoFilter{}, newestFirst, []string{"todo-e", "todo-d", "todo-c", "todo-b", "todo-a"}},
+		{"completed", repository.TodoFilter{Completed: &yes}, newestFirst, []string{"todo-e", "todo-b"}},
+		{"not completed", repository.TodoFilter{Completed: &no}, newestFirst, []string{"todo-d", "todo-c", "todo-a"}},
+		{"due before", repository.TodoFilter{DueBefore: &now}, newestFirst, []string{"todo-b", "todo-a"}},
+		{"due after", repository.TodoFilter{DueAfter: &now}, newestFirst, []string{"todo-c"}},
+		{"overdue", repository.TodoFilter{Overdue: &yes, Now: now}, newestFirst, []string{"todo-a"}},
+		{"not overdue", repository.TodoFilter{Overdue: &no, Now: now}, newestFirst, []string{"todo-e", "todo-d", "todo-c", "todo-b"}},
+		{"has due date", repository.TodoFilter{HasDueDate: &yes}, newestFirst, []string{"todo-c", "todo-b", "todo-a"}},
+		{"no due date", repository.TodoFilter{HasDueDate: &no}, newestFirst, []string{"todo-e", "todo-d"}},
+		{"created after", repository.TodoFilter{CreatedAfter: ptrTime(now.Add(-150 * time.Minute))}, newestFirst, []string{"todo-e", "todo-d"}},
+		{"created before", repository.TodoFilter{CreatedBefore: ptrTime(now.Add(-150 * time.Minute))}, newestFirst, []string{"todo-c", "todo-b", "todo-a"}},
+		{"text", repository.TodoFilter{Text: []string{"ALPH"}}, newestFirst, []string{"todo-a"}},
+		{"excluded text", repository.TodoFilter{ExcludeText: []string{"a"}}, newestFirst, []string{"todo-e"}},
+		{"text with like wildcards", repository.TodoFilter{Text: []string{"%"}}, newestFirst, []string{}},
+		{"due date ascending", repository.TodoFilter{}, repository.TodoSort{Field: repository.TodoSortDueDate}, []string{"todo-a", "todo-b", "todo-c", "todo-d", "todo-e"}},
+		{"due date descending keeps missing last", repository.TodoFilter{}, repository.TodoSort{Field: repository.TodoSortDueDate, Desc: true}, []string{"todo-c", "todo-b", "todo-a", "todo-e", "todo-d"}},
+		{"title descending", repository.TodoFilter{}, repository.TodoSort{Field: repository.TodoSortTitle, Desc: true}, []string{"todo-e", "todo-d", "todo-c", "todo-b", "todo-a"}},
+		{"manual order", repository.TodoFilter{}, repository.TodoSort{Field: repository.TodoSortPosition}, []string{"todo-e", "todo-b", "todo-d", "todo-c", "todo-a"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			todos, err := todoRepo.FindByUserID(ctx, user.ID, repository.TodoQuery{Filter: tt.filter, Sort: tt.sort})
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, todoIDs(todos))
+
+			// Walking the same order one row at a time must visit the same todos
+			var walked []*model.Todo
+			var after *repository.TodoCursor
+			for range tt.want {
+				page, err := todoRepo.FindByUserID(ctx, user.ID, repository.TodoQuery{Filter: tt.filter, Sort: tt.sort, Limit: 1, After: after})
+				require.NoError(t, err)
+				require.Len(t, page, 1)
+				walked = append(walked, page[0])
+				after = &repository.TodoCursor{
+					CreatedAt: page[0].CreatedAt,
+					UpdatedAt: page[0].UpdatedAt,
+					DueDate:   page[0].DueDate,
+					Title:     page[0].Title,
+					Position:  page[0].Position,
+					ID:        page[0].ID,
+				}
+			}
+			assert.Equal(t, tt.want, todoIDs(walked))
+		})
+	}
+
+	err = db.CleanupTables(ctx)
+	require.NoError(t, err)
+}
+
+func TestTodoRepository_Version(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	db := common.SetupTestDatabase(t)
+	defer db.Close()
+
+	ctx := context.Background()
+
+	err := db.CleanupTables(ctx)
+	require.NoError(t, err)
+
+	tenant := common.CreateTestTenant(t, db.AdminClient, &common.TestTenant{
+		Name: "Test Tenant",
+		Slug: "test-tenant",
+	})
+	user := common.CreateTestUser(t, db.AdminClient, &common.TestUser{
+		TenantID:     tenant.ID,
+		Email:        "user@test.com",
+		PasswordHash: "hash",
+		Name:         "Test User",
+		Role:         "member",
+	})
+	created := common.CreateTestTodo(t, db.AdminClient, &common.TestTodo{
+		TenantID: tenant.ID,
+		UserID:   user.ID,
+		Title:    "Versioned",
+	})
+
+	err = db.SetTenantContext(ctx, tenant.ID)
+	require.NoError(t, err)
+
+	todoRepo := infrarepo.NewTodoRepository(db.AppClient)
+	version := func(v int) *int { return &v }
+
+	t.Run("new todos start at version 1", func(t *testing.T) {
+		found, err := todoRepo.FindByID(ctx, created.ID)
+		require.NoError(t, err)
+		assert.Equal(t, 1, found.Version)
+	})
+
+	t.Run("every update bumps the version", func(t *testing.T) {
+		created.Title = "Unconditional"
+		updated, err := todoRepo.Update(ctx, created, nil)
+		require.NoError(t, err)
+		assert.Equal(t, 2, updated.Version)
+
+		updated.Title = "Conditional"
+		updated, err = todoRepo.Update(ctx, updated, version(2))
+		require.NoError(t, err)
+		assert.Equal(t, 3, updated.Version)
+	})
+
+	t.Run("stale update and delete are rejected", func(t *testing.T) {
+		created.Title = "Stale"
+		_, err := todoRepo.Update(ctx, created, version(2))
+		assert.Equal(t, repository.ErrVersionMismatch, err)
+
+		err = todoRepo.Delete(ctx, created.ID, version(2))
+		assert.Equal(t, repository.ErrVersionMismatch, err)
+
+		found, err := todoRepo.FindByID(ctx, created.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "Conditional", found.Title)
+		assert.Equal(t, 3, found.Version)
+	})
+
+	t.Run("current delete succeeds", func(t *testing.T) {
+		err := todoRepo.Delete(ctx, created.ID, version(3))
+		require.NoError(t, err)
+	})
+
+	err = db.CleanupTables(ctx)
+	require.NoError(t, err)
+}
+
+func TestTodoRepository_Trash(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	db := common.SetupTestDatabase(t)
+	defer db.Close()
+
+	ctx := context.Background()
+
+	err := db.CleanupTables(ctx)
+	require.NoError(t, err)
+
+	tenant := common.CreateTestTenant(t, db.AdminClient, &common.TestTenant{
+		Name: "Test Tenant",
+		Slug: "test-tenant",
+	})
+	user := common.CreateTestUser(t, db.AdminClient, &common.TestUser{
+		TenantID:     tenant.ID,
+		Email:        "user@test.com",
+		PasswordHash: "hash",
+		Name:         "Test User",
+		Role:         "member",
+	})
+	kept := common.CreateTestTodo(t, db.AdminClient, &common.TestTodo{TenantID: tenant.ID, UserID: user.ID, Title: "Kept"})
+	recent := common.CreateTestTodo(t, db.AdminClient, &common.TestTodo{TenantID: tenant.ID, UserID: user.ID, Title: "Recently trashed"})
+	old := common.CreateTestTodo(t, db.AdminClient, &common.TestTodo{TenantID: tenant.ID, UserID: user.ID, Title: "Trashed long ago"})
+
+	err = db.SetTenantContext(ctx, tenant.ID)
+	require.NoError(t, err)
+
+	todoRepo := infrarepo.NewTodoRepository(db.AppClient)
+	now := time.Now()
+
+	require.NoError(t, todoRepo.Trash(ctx, recent.ID, now.Add(-time.Hour), nil))
+	require.NoError(t, todoRepo.Trash(ctx, old.ID, now.AddDate(0, 0, -40), nil))
+
+	t.Run("trashed todos leave the default queries", func(t *testing.T) {
+		found, err := todoRepo.FindByID(ctx, recent.ID)
+		require.NoError(t, err)
+		assert.Nil(t, found)
+
+		live, err := todoRepo.FindByUserID(ctx, user.ID, repository.TodoQuery{})
+		require.NoError(t, err)
+		assert.Equal(t, []string{kept.ID}, todoIDs(live))
+
+		hits, err := todoRepo.Search(ctx, tenant.ID, user.ID, "trashed", 10)
+		require.NoError(t, err)
+		assert.Empty(t, hits)
+	})
+
+	t.Run("trash listing", func(t *testing.T) {
+		trashed, err := todoRepo.FindByUserID(ctx, user.ID, repository.TodoQuery{
+			Filter: repository.TodoFilter{Trashed: true},
+			Sort:   repository.TodoSort{Field: repository.TodoSortCreatedAt},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{recent.ID, old.ID}, todoIDs(trashed))
+	})
+
+	t.Run("find by ids skips trashed and unknown todos", func(t *testing.T) {
+		found, err := todoRepo.FindByIDs(ctx, []string{kept.ID, recent.ID, "missing"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{kept.ID}, todoIDs(found))
+	})
+
+	t.Run("trashing twice is a version mismatch", func(t *testing.T) {
+		err := todoRepo.Trash(ctx, recent.ID, now, nil)
+		assert.Equal(t, repository.ErrVersionMismatch, err)
+	})
+
+	t.Run("purge removes only expired trash", func(t *testing.T) {
+		purged, err := todoRepo.PurgeTrash(ctx, tenant.ID, now.AddDate(0, 0, -30))
+		require.NoError(t, err)
+		assert.Equal(t, 1, purged)
+
+		gone, err := todoRepo.FindByIDWithTrashed(ctx, old.ID)
+		require.NoError(t, err)
+		assert.Nil(t, gone)
+	})
+
+	t.Run("restore", func(t *testing.T) {
+		restored, err := todoRepo.Restore(ctx, recent.ID)
+		require.NoError(t, err)
+		require.NotNil(t, restored)
+		assert.Nil(t, restored.DeletedAt)
+		assert.Equal(t, 3, restored.Version)
+
+		again, err := todoRepo.Restore(ctx, recent.ID)
+		require.NoError(t, err)
+		assert.Nil(t, again)
+	})
+
+	err = db.CleanupTables(ctx)
+	require.NoError(t, err)
+}
+
+func ptrTime(t time.Time) *time.Time {
+	return &t
+}
+
+func todoIDs(todos []*model.Todo) []string {
+	ids := make([]string, len(todos))
+	for i, todo := range todos {
+		ids[i] = todo.ID
+	}
+	return ids
+}
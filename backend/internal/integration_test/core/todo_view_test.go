@@ -0,0 +1,115 @@
+package core
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"good-todo-go/internal/domain/model"
+	infrarepo "good-todo-go/internal/infrastructure/repository"
+	"good-todo-go/internal/integration_test/common"
+	"good-todo-go/internal/pkg"
+	mocku "good-todo-go/internal/pkg/mock"
+	"good-todo-go/internal/usecase"
+	"good-todo-go/internal/usecase/input"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTodoIntegration_Views(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	db := common.SetupTestDatabase(t)
+	defer db.Close()
+
+	ctx := context.Background()
+
+	err := db.CleanupTables(ctx)
+	require.NoError(t, err)
+
+	tenant := common.CreateTestTenant(t, db.AdminClient, &common.TestTenant{Name: "Test Tenant", Slug: "test-tenant"})
+	user := common.CreateTestUser(t, db.AdminClient, &common.TestUser{
+		TenantID: tenant.ID, Email: "user@test.com", PasswordHash: "hash", Name: "User", Role: "member", Timezone: "Asia/Tokyo",
+	})
+
+	// 08:30 on March 11th in Tokyo
+	now := time.Date(2024, 3, 10, 23, 30, 0, 0, time.UTC)
+	at := func(d time.Duration) *time.Time {
+		due := now.Add(d)
+		return &due
+	}
+	todo := func(id string, priority model.TodoPriority, due *time.Time) {
+		common.CreateTestTodo(t, db.AdminClient, &common.TestTodo{ID: id, TenantID: tenant.ID, UserID: user.ID, Title: id, Priority: priority, DueDate: due})
+	}
+	todo("today-low", model.TodoPriorityLow, at(2*time.Hour))
+	todo("today-urgent-late", model.TodoPriorityUrgent, at(10*time.Hour))
+	todo("today-urgent-early", model.TodoPriorityUrgent, at(time.Hour))
+	// Still March 11th in UTC but already March 12th in Tokyo
+	todo("tomorrow", model.TodoPriorityNone, at(16*time.Hour))
+	todo("next-month", model.TodoPriorityHigh, at(30*24*time.Hour))
+	todo("overdue", model.TodoPriorityMedium, at(-time.Hour))
+	todo("someday-high", model.TodoPriorityHigh, nil)
+	todo("someday-none", model.TodoPriorityNone, nil)
+	common.CreateTestTodo(t, db.AdminClient, &common.TestTodo{ID: "done", TenantID: tenant.ID, UserID: user.ID, Title: "done", DueDate: at(time.Hour), Completed: true})
+
+	err = db.SetTenantContext(ctx, tenant.ID)
+	require.NoError(t, err)
+
+	todoInteractor := usecase.NewTodoInteractor(
+		infrarepo.NewUnitOfWork(db.AppDB),
+		infrarepo.NewTodoRepository(db.AppClient),
+		infrarepo.NewTodoActivityRepository(db.AppClient),
+		infrarepo.NewReminderRepository(db.AppClient),
+		infrarepo.NewTagRepository(db.AppClient),
+		infrarepo.NewProjectRepository(db.AppClient),
+		infrarepo.NewUserRepository(db.AppClient),
+		infrarepo.NewMembershipRepository(db.AppClient),
+		&recordingMail{},
+		usecase.NewPermissionEvaluator(usecase.DefaultPermissionRules()),
+		pkg.NewUUIDGenerator(),
+		mocku.NewMockClock(now),
+		usecase.DefaultMaxSubtaskDepth,
+	)
+	actor := input.Actor{UserID: user.ID, TenantID: tenant.ID, Role: model.UserRoleMember}
+
+	// view pages through a view two todos at a time and returns the ids in order
+	view := func(t *testing.T, name string) []string {
+		var ids []string
+		cursor := ""
+		for pages := 0; pages < 5; pages++ {
+			result, err := todoInteractor.ListView(ctx, actor, &input.ListTodoViewInput{View: name, Limit: 2, Cursor: cursor})
+			require.NoError(t, err)
+			for _, todo := range result.Todos {
+				ids = append(ids, todo.ID)
+			}
+			if result.NextCursor == nil {
+				return ids
+			}
+			cursor = *result.NextCursor
+		}
+		t.Fatal("view did not end")
+		return nil
+	}
+
+	t.Run("today sorts by priority, then due date", func(t *testing.T) {
+		assert.Equal(t, []string{"today-urgent-early", "today-urgent-late", "today-low"}, view(t, "today"))
+	})
+
+	t.Run("upcoming starts at midnight in the user's timezone", func(t *testing.T) {
+		assert.Equal(t, []string{"tomorrow"}, view(t, "upcoming"))
+	})
+
+	t.Run("overdue", func(t *testing.T) {
+		assert.Equal(t, []string{"overdue"}, view(t, "overdue"))
+	})
+
+	t.Run("someday", func(t *testing.T) {
+		assert.Equal(t, []string{"someday-high", "someday-none"}, view(t, "someday"))
+	})
+
+	err = db.CleanupTables(ctx)
+	require.NoError(t, err)
+}
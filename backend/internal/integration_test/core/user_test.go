@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	"good-todo-go/internal/domain/model"
 	infrarepo "good-todo-go/internal/infrastructure/repository"
 	"good-todo-go/internal/integration_test/common"
 	"good-todo-go/internal/usecase"
@@ -47,10 +48,10 @@ func TestUserIntegration_GetMe(t *testing.T) {
 
 	// Create repository and interactor
 	userRepo := infrarepo.NewUserRepository(db.AppClient)
-	userInteractor := usecase.NewUserInteractor(userRepo)
+	userInteractor := usecase.NewUserInteractor(userRepo, infrarepo.NewMembershipRepository(db.AppClient), usecase.NewPermissionEvaluator(usecase.DefaultPermissionRules()))
 
 	t.Run("Get existing user", func(t *testing.T) {
-		result, err := userInteractor.GetMe(ctx, user.ID)
+		result, err := userInteractor.GetMe(ctx, input.Actor{UserID: user.ID, TenantID: tenant.ID, Role: model.UserRoleAdmin})
 		require.NoError(t, err)
 
 		assert.Equal(t, user.ID, result.ID)
@@ -61,7 +62,7 @@ func TestUserIntegration_GetMe(t *testing.T) {
 	})
 
 	t.Run("Get non-existent user", func(t *testing.T) {
-		_, err := userInteractor.GetMe(ctx, "non-existent-id")
+		_, err := userInteractor.GetMe(ctx, input.Actor{UserID: "non-existent-id", TenantID: tenant.ID})
 		assert.Equal(t, usecase.ErrUserNotFound, err)
 	})
 
@@ -104,14 +105,14 @@ func TestUserIntegration_UpdateMe(t *testing.T) {
 
 	// Create repository and interactor
 	userRepo := infrarepo.NewUserRepository(db.AppClient)
-	userInteractor := usecase.NewUserInteractor(userRepo)
+	userInteractor := usecase.NewUserInteractor(userRepo, infrarepo.NewMembershipRepository(db.AppClient), usecase.NewPermissionEvaluator(usecase.DefaultPermissionRules()))
 
 	t.Run("Update user name", func(t *testing.T) {
 		inp := &input.UpdateUserInput{
 			Name: "Updated Name",
 		}
 
-		result, err := userInteractor.UpdateMe(ctx, user.ID, inp)
+		result, err := userInteractor.UpdateMe(ctx, input.Actor{UserID: user.ID, TenantID: tenant.ID, Role: model.UserRoleMember}, inp)
 		require.NoError(t, err)
 
 		assert.Equal(t, "Updated Name", result.Name)
@@ -123,7 +124,7 @@ func TestUserIntegration_UpdateMe(t *testing.T) {
 			Name: "New Name",
 		}
 
-		_, err := userInteractor.UpdateMe(ctx, "non-existent-id", inp)
+		_, err := userInteractor.UpdateMe(ctx, input.Actor{UserID: "non-existent-id", TenantID: tenant.ID}, inp)
 		assert.Equal(t, usecase.ErrUserNotFound, err)
 	})
 
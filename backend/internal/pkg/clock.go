@@ -0,0 +1,18 @@
+package pkg
+
+import "time"
+
+// IClock tells the current time, so code that depends on it can be tested at a fixed instant.
+type IClock interface {
+	Now() time.Time
+}
+
+type Clock struct{}
+
+func NewClock() IClock {
+	return &Clock{}
+}
+
+func (c *Clock) Now() time.Time {
+	return time.Now()
+}
@@ -0,0 +1,227 @@
+// Package fracindex generates fractional index keys: strings that sort in the order of
+// the items they label, with a new key available between any two, so moving an item
+// only rewrites that item's key.
+//
+// A key is an integer part followed by an optional fraction, both in base 62. The
+// integer part starts with a head letter giving its length ('a' is one digit, 'b' two,
+// ...; 'Z', 'Y', ... mirror that for negative integers), which keeps keys short when
+// items are repeatedly added at either end. Keys compare byte-wise, so a database
+// column holding them needs a binary collation such as "C".
+package fracindex
+
+import (
+	"errors"
+	"strings"
+)
+
+const digits = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+
+// smallestInteger is the lowest integer part; it has no predecessor, so keys never
+// consist of it alone.
+const smallestInteger = "A" + "00000000000000000000000000"
+
+var (
+	ErrInvalidKey = errors.New("invalid fractional index key")
+	ErrKeyOrder   = errors.New("fractional index keys out of order")
+	// ErrKeySpace means the integer parts ran out at one end; rewriting the keys fixes it.
+	ErrKeySpace = errors.New("fractional index key space exhausted")
+)
+
+// Between returns a key sorting strictly between a and b. An empty a means no lower
+// bound and an empty b no upper bound, so Between("", "") is the first key of a list.
+func Between(a, b string) (string, error) {
+	if (a != "" && !Valid(a)) || (b != "" && !Valid(b)) {
+		return "", ErrInvalidKey
+	}
+	if a != "" && b != "" && a >= b {
+		return "", ErrKeyOrder
+	}
+
+	if a == "" {
+		if b == "" {
+			return "a0", nil
+		}
+		ib := integerPart(b)
+		if ib == smallestInteger {
+			return ib + midpoint("", b[len(ib):]), nil
+		}
+		if ib < b {
+			return ib, nil
+		}
+		if prev := decrement(ib); prev != "" {
+			return prev, nil
+		}
+		return "", ErrKeySpace
+	}
+
+	ia := integerPart(a)
+	fa := a[len(ia):]
+	if b == "" {
+		if next := increment(ia); next != "" {
+			return next, nil
+		}
+		return ia + midpoint(fa, ""), nil
+	}
+
+	ib := integerPart(b)
+	if ia == ib {
+		return ia + midpoint(fa, b[len(ib):]), nil
+	}
+	next := increment(ia)
+	if next == "" {
+		return "", ErrKeySpace
+	}
+	if next < b {
+		return next, nil
+	}
+	return ia + midpoint(fa, ""), nil
+}
+
+// Sequence returns n ascending keys as short as they can be, for relabelling a whole list.
+func Sequence(n int) []string {
+	keys := make([]string, 0, n)
+	key := "a0"
+	for len(keys) < n && key != "" {
+		keys = append(keys, key)
+		key = increment(key)
+	}
+	return keys
+}
+
+// Valid reports whether key is a well-formed key.
+func Valid(key string) bool {
+	if key == "" || key == smallestInteger {
+		return false
+	}
+	n := integerLength(key[0])
+	if n == 0 || len(key) < n {
+		return false
+	}
+	for i := 1; i < len(key); i++ {
+		if strings.IndexByte(digits, key[i]) < 0 {
+			return false
+		}
+	}
+	// A trailing zero would make the key equal to a shorter one
+	return len(key) == n || key[len(key)-1] != '0'
+}
+
+// integerLength is the length of the integer part introduced by head, or 0 if head is
+// not a head letter.
+func integerLength(head byte) int {
+	switch {
+	case head >= 'a' && head <= 'z':
+		return int(head-'a') + 2
+	case head >= 'A' && head <= 'Z':
+		return int('Z'-head) + 2
+	default:
+		return 0
+	}
+}
+
+func integerPart(key string) string {
+	return key[:integerLength(key[0])]
+}
+
+// midpoint returns a fraction strictly between fractions a and b, where an empty b
+// stands for 1. Neither may end in a zero.
+func midpoint(a, b string) string {
+	if b != "" {
+		// Keep the shared prefix, treating a as padded with zeros
+		n := 0
+		for n < len(b) && digitAt(a, n) == b[n] {
+			n++
+		}
+		if n > 0 {
+			return b[:n] + midpoint(a[min(n, len(a)):], b[n:])
+		}
+	}
+
+	da := 0
+	if a != "" {
+		da = strings.IndexByte(digits, a[0])
+	}
+	db := len(digits)
+	if b != "" {
+		db = strings.IndexByte(digits, b[0])
+	}
+	if db-da > 1 {
+		return string(digits[(da+db+1)/2])
+	}
+	// The first digits are adjacent, so go one digit deeper
+	if len(b) > 1 {
+		return b[:1]
+	}
+	rest := ""
+	if a != "" {
+		rest = a[1:]
+	}
+	return string(digits[da]) + midpoint(rest, "")
+}
+
+func digitAt(s string, i int) byte {
+	if i < len(s) {
+		return s[i]
+	}
+	return '0'
+}
+
+// increment returns the integer part following x, or "" once the largest is reached.
+func increment(x string) string {
+	head, digs := x[0], []byte(x[1:])
+	carry := true
+	for i := len(digs) - 1; carry && i >= 0; i-- {
+		if d := strings.IndexByte(digits, digs[i]) + 1; d == len(digits) {
+			digs[i] = '0'
+		} else {
+			digs[i] = digits[d]
+			carry = false
+		}
+	}
+	if !carry {
+		return string(head) + string(digs)
+	}
+	switch head {
+	case 'Z':
+		return "a0"
+	case 'z':
+		return ""
+	}
+	head++
+	if head > 'a' {
+		digs = append(digs, '0')
+	} else {
+		digs = digs[:len(digs)-1]
+	}
+	return string(head) + string(digs)
+}
+
+// decrement returns the integer part before x, or "" once the smallest is reached.
+func decrement(x string) string {
+	head, digs := x[0], []byte(x[1:])
+	borrow := true
+	for i := len(digs) - 1; borrow && i >= 0; i-- {
+		if d := strings.IndexByte(digits, digs[i]) - 1; d < 0 {
+			digs[i] = digits[len(digits)-1]
+		} else {
+			digs[i] = digits[d]
+			borrow = false
+		}
+	}
+	if !borrow {
+		return string(head) + string(digs)
+	}
+	switch head {
+	case 'a':
+		return "Z" + digits[len(digits)-1:]
+	case 'A':
+		return ""
+	}
+	head--
+	if head < 'Z' {
+		digs = append(digs, digits[len(digits)-1])
+	} else {
+		digs = digs[:len(digs)-1]
+	}
+	return string(head) + string(digs)
+}
@@ -0,0 +1,94 @@
+package fracindex
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBetween(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b string
+		want string
+	}{
+		{name: "first key", a: "", b: "", want: "a0"},
+		{name: "after", a: "a0", b: "", want: "a1"},
+		{name: "before", a: "", b: "a0", want: "Zz"},
+		{name: "after the last digit", a: "az", b: "", want: "b00"},
+		{name: "before the first two-digit integer", a: "", b: "b00", want: "az"},
+		{name: "between adjacent integers", a: "a0", b: "a1", want: "a0V"},
+		{name: "between integers with room", a: "a0", b: "a5", want: "a1"},
+		{name: "between fractions", a: "a0V", b: "a1", want: "a0l"},
+		{name: "between close fractions", a: "a0V", b: "a0W", want: "a0VV"},
+		{name: "before a fraction of the same integer", a: "", b: "a0V", want: "a0"},
+		{name: "after a fraction", a: "a0V", b: "", want: "a1"},
+		{name: "backfilled keys", a: "g0000001", b: "g0000002", want: "g0000001V"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Between(tt.a, tt.b)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.True(t, Valid(got))
+		})
+	}
+
+	t.Run("rejects keys out of order", func(t *testing.T) {
+		_, err := Between("a1", "a0")
+		assert.Equal(t, ErrKeyOrder, err)
+
+		_, err = Between("a1", "a1")
+		assert.Equal(t, ErrKeyOrder, err)
+	})
+
+	t.Run("rejects invalid keys", func(t *testing.T) {
+		for _, key := range []string{"a", "a00", "a0!", "00", smallestInteger} {
+			_, err := Between(key, "")
+			assert.Equal(t, ErrInvalidKey, err, key)
+		}
+	})
+}
+
+func TestBetween_RepeatedInserts(t *testing.T) {
+	t.Run("prepending keeps keys short", func(t *testing.T) {
+		key := "a0"
+		for i := 0; i < 1000; i++ {
+			prev, err := Between("", key)
+			require.NoError(t, err)
+			require.Less(t, prev, key)
+			key = prev
+		}
+		assert.LessOrEqual(t, len(key), 3)
+	})
+
+	t.Run("inserting at one spot stays ordered", func(t *testing.T) {
+		lo, hi := "a0", "a1"
+		for i := 0; i < 100; i++ {
+			mid, err := Between(lo, hi)
+			require.NoError(t, err)
+			require.Less(t, lo, mid)
+			require.Less(t, mid, hi)
+			if i%2 == 0 {
+				lo = mid
+			} else {
+				hi = mid
+			}
+		}
+	})
+}
+
+func TestSequence(t *testing.T) {
+	keys := Sequence(3906)
+
+	require.Len(t, keys, 3906)
+	assert.Equal(t, "a0", keys[0])
+	assert.Equal(t, "bzz", keys[len(keys)-1])
+	assert.True(t, sort.StringsAreSorted(keys))
+	for _, key := range keys {
+		assert.True(t, Valid(key), key)
+		assert.LessOrEqual(t, len(key), 3)
+	}
+}
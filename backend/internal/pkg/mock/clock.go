@@ -0,0 +1,16 @@
+package mock
+
+import "time"
+
+// MockClock always tells the time it holds; tests move it by setting At.
+type MockClock struct {
+	At time.Time
+}
+
+func NewMockClock(at time.Time) *MockClock {
+	return &MockClock{At: at}
+}
+
+func (c *MockClock) Now() time.Time {
+	return c.At
+}
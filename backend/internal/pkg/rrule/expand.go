@@ -0,0 +1,237 @@
+package rrule
+
+import (
+	"slices"
+	"time"
+)
+
+// maxEmptyPeriods bounds the search for rules that can never match again, such as
+// FREQ=YEARLY;BYMONTH=2;BYMONTHDAY=30.
+const maxEmptyPeriods = 10000
+
+// Occurrences returns up to n occurrences of the series starting at dtstart, which is
+// always the first and counts towards COUNT. Later occurrences keep the wall-clock time
+// of dtstart in its location, and dates that do not exist, such as the 31st of a short
+// month, are skipped.
+func (r *Rule) Occurrences(dtstart time.Time, n int) []time.Time {
+	if n <= 0 {
+		return nil
+	}
+	if r.Count > 0 && n > r.Count {
+		n = r.Count
+	}
+	loc := dtstart.Location()
+	hour, min, sec := dtstart.Clock()
+	var until time.Time
+	if r.Until != nil {
+		until = r.Until.in(loc)
+	}
+
+	out := []time.Time{dtstart}
+	empty := 0
+	for k := 0; len(out) < n && empty < maxEmptyPeriods; k++ {
+		days, ok := r.period(dtstart, k)
+		if !ok {
+			break
+		}
+		found := false
+		for _, day := range days {
+			y, m, d := day.Date()
+			t := resolve(y, m, d, hour, min, sec, dtstart.Nanosecond(), loc)
+			if !t.After(dtstart) {
+				continue
+			}
+			if r.Until != nil && t.After(until) {
+				return out
+			}
+			out = append(out, t)
+			found = true
+			if len(out) == n {
+				return out
+			}
+		}
+		if found {
+			empty = 0
+		} else {
+			empty++
+		}
+	}
+	return out
+}
+
+// period returns the dates of the kth period after the one holding start, in order and
+// with BYSETPOS applied. Dates are midnight UTC so day arithmetic ignores daylight
+// saving. It reports false once the period lies beyond year 9999.
+func (r *Rule) period(start time.Time, k int) ([]time.Time, bool) {
+	y, m, d := start.Date()
+	step := k * r.Interval
+
+	var days []time.Time
+	switch r.Freq {
+	case Daily:
+		day := date(y, m, d+step)
+		if day.Year() > 9999 {
+			return nil, false
+		}
+		if r.hasMonth(day.Month()) && r.dayMatches(day, day, day) {
+			days = append(days, day)
+		}
+	case Weekly:
+		offset := (int(start.Weekday()) - int(r.WeekStart) + 7) % 7
+		first := date(y, m, d-offset+7*step)
+		if first.Year() > 9999 {
+			return nil, false
+		}
+		for i := 0; i < 7; i++ {
+			day := first.AddDate(0, 0, i)
+			matches := day.Weekday() == start.Weekday()
+			if len(r.ByDay) > 0 {
+				matches = r.dayMatches(day, day, day)
+			}
+			if matches && r.hasMonth(day.Month()) {
+				days = append(days, day)
+			}
+		}
+	case Monthly:
+		first := date(y, m+time.Month(step), 1)
+		if first.Year() > 9999 {
+			return nil, false
+		}
+		if r.hasMonth(first.Month()) {
+			days = r.monthDays(first, d)
+		}
+	case Yearly:
+		year := y + step
+		if year > 9999 {
+			return nil, false
+		}
+		switch {
+		case len(r.ByMonthDay) == 0 && len(r.ByDay) == 0:
+			months := []time.Month{m}
+			if len(r.ByMonth) > 0 {
+				months = slices.Sorted(slices.Values(r.ByMonth))
+			}
+			for _, month := range months {
+				days = append(days, r.monthDays(date(year, month, 1), d)...)
+			}
+		case len(r.ByMonth) == 0 && len(r.ByMonthDay) == 0:
+			// Numbered weekdays count within the whole year
+			first, last := date(year, time.January, 1), date(year, time.December, 31)
+			for day := first; !day.After(last); day = day.AddDate(0, 0, 1) {
+				if r.dayMatches(day, first, last) {
+					days = append(days, day)
+				}
+			}
+		default:
+			for month := time.January; month <= time.December; month++ {
+				if r.hasMonth(month) {
+					days = append(days, r.monthDays(date(year, month, 1), d)...)
+				}
+			}
+		}
+	}
+	return r.setPos(days), true
+}
+
+// monthDays returns the days of the month starting at first that match BYMONTHDAY and
+// BYDAY, or the day of month of the series start if neither is given.
+func (r *Rule) monthDays(first time.Time, startDay int) []time.Time {
+	last := first.AddDate(0, 1, -1)
+	if len(r.ByMonthDay) == 0 && len(r.ByDay) == 0 {
+		if startDay > last.Day() {
+			return nil
+		}
+		return []time.Time{first.AddDate(0, 0, startDay-1)}
+	}
+	var days []time.Time
+	for day := first; !day.After(last); day = day.AddDate(0, 0, 1) {
+		if r.dayMatches(day, first, last) {
+			days = append(days, day)
+		}
+	}
+	return days
+}
+
+// dayMatches checks day against BYMONTHDAY and BYDAY. first and last bound the month or
+// year that numbered weekdays count within.
+func (r *Rule) dayMatches(day, first, last time.Time) bool {
+	if len(r.ByMonthDay) > 0 {
+		length := day.AddDate(0, 1, -day.Day()).Day()
+		matches := false
+		for _, md := range r.ByMonthDay {
+			if md == day.Day() || (md < 0 && length+1+md == day.Day()) {
+				matches = true
+				break
+			}
+		}
+		if !matches {
+			return false
+		}
+	}
+	if len(r.ByDay) == 0 {
+		return true
+	}
+	nth := daysBetween(first, day)/7 + 1
+	nthLast := -(daysBetween(day, last)/7 + 1)
+	for _, wd := range r.ByDay {
+		if wd.Day == day.Weekday() && (wd.N == 0 || wd.N == nth || wd.N == nthLast) {
+			return true
+		}
+	}
+	return false
+}
+
+// setPos keeps the BYSETPOS positions of the ordered days of a period.
+func (r *Rule) setPos(days []time.Time) []time.Time {
+	if len(r.BySetPos) == 0 {
+		return days
+	}
+	var kept []time.Time
+	for _, pos := range r.BySetPos {
+		i := pos - 1
+		if pos < 0 {
+			i = len(days) + pos
+		}
+		if i >= 0 && i < len(days) && !slices.Contains(kept, days[i]) {
+			kept = append(kept, days[i])
+		}
+	}
+	slices.SortFunc(kept, time.Time.Compare)
+	return kept
+}
+
+// resolve returns the given wall-clock time in loc. A time repeated when clocks fall
+// back resolves to its first instant; a time skipped when clocks spring forward moves
+// forward by the size of the gap, so 02:30 becomes 03:30.
+func resolve(y int, m time.Month, d, hour, min, sec, nsec int, loc *time.Location) time.Time {
+	wall := time.Date(y, m, d, hour, min, sec, nsec, time.UTC)
+	// Offsets two days either side bracket any transition near the wall time
+	_, before := wall.Add(-48 * time.Hour).In(loc).Zone()
+	_, after := wall.Add(48 * time.Hour).In(loc).Zone()
+
+	var resolved time.Time
+	for _, offset := range []int{before, after} {
+		t := wall.Add(-time.Duration(offset) * time.Second).In(loc)
+		if sameWallClock(t, wall) && (resolved.IsZero() || t.Before(resolved)) {
+			resolved = t
+		}
+	}
+	if resolved.IsZero() {
+		return wall.Add(-time.Duration(before) * time.Second).In(loc)
+	}
+	return resolved
+}
+
+func sameWallClock(t, wall time.Time) bool {
+	y1, m1, d1 := t.Date()
+	y2, m2, d2 := wall.Date()
+	return y1 == y2 && m1 == m2 && d1 == d2 && t.Hour() == wall.Hour() && t.Minute() == wall.Minute() && t.Second() == wall.Second()
+}
+
+func date(y int, m time.Month, d int) time.Time {
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+func daysBetween(a, b time.Time) int {
+	return int(b.Sub(a) / (24 * time.Hour))
+}
@@ -0,0 +1,314 @@
+// Package rrule parses and expands the RFC 5545 recurrence rules of recurring todos,
+// for example
+//
+//	FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,WE
+//	FREQ=MONTHLY;BYDAY=-1FR;COUNT=12
+//	FREQ=YEARLY;BYMONTH=3;BYMONTHDAY=31;UNTIL=20301231
+//
+// FREQ is DAILY, WEEKLY, MONTHLY or YEARLY; the rule parts INTERVAL, COUNT, UNTIL,
+// BYMONTH, BYMONTHDAY, BYDAY, BYSETPOS and WKST are supported. Todos recur at most
+// daily, so the sub-daily frequencies and the BYHOUR, BYMINUTE, BYSECOND, BYWEEKNO and
+// BYYEARDAY parts are rejected.
+//
+// Occurrences are expanded in the wall-clock time of the series start, so a todo due
+// at 09:00 stays at 09:00 across daylight saving changes.
+package rrule
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type Frequency string
+
+const (
+	Daily   Frequency = "DAILY"
+	Weekly  Frequency = "WEEKLY"
+	Monthly Frequency = "MONTHLY"
+	Yearly  Frequency = "YEARLY"
+)
+
+// ErrInvalidRule wraps every parse error, which also names the offending part.
+var ErrInvalidRule = errors.New("invalid recurrence rule")
+
+// WeekdayNum is a BYDAY entry: a weekday, and with a non-zero N the Nth such weekday of
+// the month or year, counting from the end when N is negative.
+type WeekdayNum struct {
+	Day time.Weekday
+	N   int
+}
+
+// Rule is a parsed RRULE. Zero Count and Until leave the series unbounded.
+type Rule struct {
+	Freq       Frequency
+	Interval   int
+	Count      int
+	Until      *Until
+	ByMonth    []time.Month
+	ByMonthDay []int
+	ByDay      []WeekdayNum
+	BySetPos   []int
+	WeekStart  time.Weekday
+}
+
+// Until is the inclusive end of a series. A UTC until is an instant; otherwise it is a
+// wall-clock time in the series' timezone, and a date alone lasts until the end of that day.
+type Until struct {
+	Year                 int
+	Month                time.Month
+	Day                  int
+	Hour, Minute, Second int
+	DateOnly             bool
+	UTC                  bool
+}
+
+var weekdays = map[string]time.Weekday{
+	"SU": time.Sunday, "MO": time.Monday, "TU": time.Tuesday, "WE": time.Wednesday,
+	"TH": time.Thursday, "FR": time.Friday, "SA": time.Saturday,
+}
+
+var weekdayNames = [...]string{"SU", "MO", "TU", "WE", "TH", "FR", "SA"}
+
+// Parse reads a rule such as "FREQ=WEEKLY;BYDAY=MO,WE". An "RRULE:" prefix is allowed
+// and names are case-insensitive.
+func Parse(s string) (*Rule, error) {
+	s = strings.TrimSpace(s)
+	if len(s) >= 6 && strings.EqualFold(s[:6], "RRULE:") {
+		s = s[6:]
+	}
+	if s == "" {
+		return nil, fmt.Errorf("%w: empty", ErrInvalidRule)
+	}
+
+	r := &Rule{Interval: 1, WeekStart: time.Monday}
+	seen := map[string]bool{}
+	for _, part := range strings.Split(s, ";") {
+		name, value, ok := strings.Cut(part, "=")
+		name = strings.ToUpper(strings.TrimSpace(name))
+		value = strings.ToUpper(strings.TrimSpace(value))
+		if !ok || name == "" || value == "" {
+			return nil, fmt.Errorf("%w: malformed part %q", ErrInvalidRule, part)
+		}
+		if seen[name] {
+			return nil, fmt.Errorf("%w: %s given twice", ErrInvalidRule, name)
+		}
+		seen[name] = true
+
+		var err error
+		switch name {
+		case "FREQ":
+			switch f := Frequency(value); f {
+			case Daily, Weekly, Monthly, Yearly:
+				r.Freq = f
+			default:
+				err = fmt.Errorf("unsupported FREQ %s", value)
+			}
+		case "INTERVAL":
+			r.Interval, err = parseInt(value, 1, 1000)
+		case "COUNT":
+			r.Count, err = parseInt(value, 1, 10000)
+		case "UNTIL":
+			r.Until, err = parseUntil(value)
+		case "BYMONTH":
+			err = parseList(value, func(v string) error {
+				m, err := parseInt(v, 1, 12)
+				r.ByMonth = append(r.ByMonth, time.Month(m))
+				return err
+			})
+		case "BYMONTHDAY":
+			err = parseList(value, func(v string) error {
+				d, err := parseSignedInt(v, 31)
+				r.ByMonthDay = append(r.ByMonthDay, d)
+				return err
+			})
+		case "BYDAY":
+			err = parseList(value, func(v string) error {
+				wd, err := parseWeekdayNum(v)
+				r.ByDay = append(r.ByDay, wd)
+				return err
+			})
+		case "BYSETPOS":
+			err = parseList(value, func(v string) error {
+				p, err := parseSignedInt(v, 366)
+				r.BySetPos = append(r.BySetPos, p)
+				return err
+			})
+		case "WKST":
+			day, ok := weekdays[value]
+			if !ok {
+				err = fmt.Errorf("unknown weekday %s", value)
+			}
+			r.WeekStart = day
+		case "BYSECOND", "BYMINUTE", "BYHOUR", "BYWEEKNO", "BYYEARDAY":
+			err = errors.New("not supported")
+		default:
+			err = errors.New("unknown rule part")
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", ErrInvalidRule, name, err)
+		}
+	}
+
+	if r.Freq == "" {
+		return nil, fmt.Errorf("%w: FREQ is required", ErrInvalidRule)
+	}
+	if r.Count > 0 && r.Until != nil {
+		return nil, fmt.Errorf("%w: COUNT and UNTIL cannot both be given", ErrInvalidRule)
+	}
+	if len(r.BySetPos) > 0 && len(r.ByMonth)+len(r.ByMonthDay)+len(r.ByDay) == 0 {
+		return nil, fmt.Errorf("%w: BYSETPOS needs another BYxxx part", ErrInvalidRule)
+	}
+	for _, wd := range r.ByDay {
+		// Numbered weekdays only make sense within a month or a year
+		if wd.N != 0 && (r.Freq == Daily || r.Freq == Weekly || (r.Freq == Yearly && len(r.ByMonthDay) > 0)) {
+			return nil, fmt.Errorf("%w: BYDAY: numbered weekdays need FREQ=MONTHLY or YEARLY", ErrInvalidRule)
+		}
+		if wd.N != 0 && r.Freq == Monthly && (wd.N > 5 || wd.N < -5) {
+			return nil, fmt.Errorf("%w: BYDAY: a month has at most 5 of each weekday", ErrInvalidRule)
+		}
+	}
+	if r.Freq == Weekly && len(r.ByMonthDay) > 0 {
+		return nil, fmt.Errorf("%w: BYMONTHDAY cannot be used with FREQ=WEEKLY", ErrInvalidRule)
+	}
+	return r, nil
+}
+
+// String formats the rule canonically, so equal rules read the same.
+func (r *Rule) String() string {
+	parts := []string{"FREQ=" + string(r.Freq)}
+	if r.Interval > 1 {
+		parts = append(parts, "INTERVAL="+strconv.Itoa(r.Interval))
+	}
+	if r.Count > 0 {
+		parts = append(parts, "COUNT="+strconv.Itoa(r.Count))
+	}
+	if r.Until != nil {
+		parts = append(parts, "UNTIL="+r.Until.String())
+	}
+	if len(r.ByMonth) > 0 {
+		parts = append(parts, "BYMONTH="+joinInts(r.ByMonth))
+	}
+	if len(r.ByMonthDay) > 0 {
+		parts = append(parts, "BYMONTHDAY="+joinInts(r.ByMonthDay))
+	}
+	if len(r.ByDay) > 0 {
+		days := make([]string, len(r.ByDay))
+		for i, wd := range r.ByDay {
+			days[i] = weekdayNames[wd.Day]
+			if wd.N != 0 {
+				days[i] = strconv.Itoa(wd.N) + days[i]
+			}
+		}
+		parts = append(parts, "BYDAY="+strings.Join(days, ","))
+	}
+	if len(r.BySetPos) > 0 {
+		parts = append(parts, "BYSETPOS="+joinInts(r.BySetPos))
+	}
+	if r.WeekStart != time.Monday {
+		parts = append(parts, "WKST="+weekdayNames[r.WeekStart])
+	}
+	return strings.Join(parts, ";")
+}
+
+func (u *Until) String() string {
+	if u.DateOnly {
+		return fmt.Sprintf("%04d%02d%02d", u.Year, u.Month, u.Day)
+	}
+	s := fmt.Sprintf("%04d%02d%02dT%02d%02d%02d", u.Year, u.Month, u.Day, u.Hour, u.Minute, u.Second)
+	if u.UTC {
+		s += "Z"
+	}
+	return s
+}
+
+// in returns the last instant of the series in loc.
+func (u *Until) in(loc *time.Location) time.Time {
+	if u.DateOnly {
+		return resolve(u.Year, u.Month, u.Day+1, 0, 0, 0, 0, loc).Add(-time.Nanosecond)
+	}
+	if u.UTC {
+		return time.Date(u.Year, u.Month, u.Day, u.Hour, u.Minute, u.Second, 0, time.UTC)
+	}
+	return resolve(u.Year, u.Month, u.Day, u.Hour, u.Minute, u.Second, 0, loc)
+}
+
+func parseUntil(v string) (*Until, error) {
+	u := &Until{}
+	layout := "20060102T150405"
+	switch {
+	case len(v) == 8:
+		layout = "20060102"
+		u.DateOnly = true
+	case strings.HasSuffix(v, "Z"):
+		v = strings.TrimSuffix(v, "Z")
+		u.UTC = true
+	}
+	t, err := time.Parse(layout, v)
+	if err != nil {
+		return nil, errors.New("expected YYYYMMDD or YYYYMMDDTHHMMSS[Z]")
+	}
+	u.Year, u.Month, u.Day = t.Date()
+	u.Hour, u.Minute, u.Second = t.Clock()
+	return u, nil
+}
+
+func parseWeekdayNum(v string) (WeekdayNum, error) {
+	if len(v) < 2 {
+		return WeekdayNum{}, fmt.Errorf("unknown weekday %s", v)
+	}
+	day, ok := weekdays[v[len(v)-2:]]
+	if !ok {
+		return WeekdayNum{}, fmt.Errorf("unknown weekday %s", v)
+	}
+	wd := WeekdayNum{Day: day}
+	if n := v[:len(v)-2]; n != "" {
+		var err error
+		if wd.N, err = parseSignedInt(n, 53); err != nil {
+			return WeekdayNum{}, err
+		}
+	}
+	return wd, nil
+}
+
+func parseList(value string, each func(string) error) error {
+	for _, v := range strings.Split(value, ",") {
+		if err := each(strings.TrimSpace(v)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func parseInt(v string, lo, hi int) (int, error) {
+	n, err := strconv.Atoi(v)
+	if err != nil || n < lo || n > hi {
+		return 0, fmt.Errorf("%s is not a number from %d to %d", v, lo, hi)
+	}
+	return n, nil
+}
+
+// parseSignedInt accepts 1..limit and -limit..-1.
+func parseSignedInt(v string, limit int) (int, error) {
+	n, err := strconv.Atoi(strings.TrimPrefix(v, "+"))
+	if err != nil || n == 0 || n < -limit || n > limit {
+		return 0, fmt.Errorf("%s is not a non-zero number from -%d to %d", v, limit, limit)
+	}
+	return n, nil
+}
+
+func joinInts[T ~int](values []T) string {
+	s := make([]string, len(values))
+	for i, v := range values {
+		s[i] = strconv.Itoa(int(v))
+	}
+	return strings.Join(s, ",")
+}
+
+// hasMonth reports whether BYMONTH, if given, allows m.
+func (r *Rule) hasMonth(m time.Month) bool {
+	return len(r.ByMonth) == 0 || slices.Contains(r.ByMonth, m)
+}
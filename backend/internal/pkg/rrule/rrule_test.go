@@ -0,0 +1,214 @@
+package rrule
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name string
+		rule string
+		want string
+	}{
+		{name: "daily", rule: "FREQ=DAILY", want: "FREQ=DAILY"},
+		{name: "prefix and lower case", rule: "rrule:freq=weekly;byday=mo,we", want: "FREQ=WEEKLY;BYDAY=MO,WE"},
+		{name: "canonical order", rule: "BYDAY=-1FR;COUNT=12;FREQ=MONTHLY", want: "FREQ=MONTHLY;COUNT=12;BYDAY=-1FR"},
+		{name: "interval of one is implied", rule: "FREQ=DAILY;INTERVAL=1", want: "FREQ=DAILY"},
+		{name: "until date", rule: "FREQ=YEARLY;BYMONTH=3;BYMONTHDAY=31;UNTIL=20301231", want: "FREQ=YEARLY;UNTIL=20301231;BYMONTH=3;BYMONTHDAY=31"},
+		{name: "until in UTC", rule: "FREQ=DAILY;UNTIL=20240301T120000Z", want: "FREQ=DAILY;UNTIL=20240301T120000Z"},
+		{name: "set position and week start", rule: "FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1;WKST=SU", want: "FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1;WKST=SU"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := Parse(tt.rule)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, r.String())
+		})
+	}
+
+	t.Run("rejects invalid rules", func(t *testing.T) {
+		for _, rule := range []string{
+			"",
+			"INTERVAL=2",
+			"FREQ=HOURLY",
+			"FREQ=DAILY;FREQ=WEEKLY",
+			"FREQ=DAILY;INTERVAL=0",
+			"FREQ=DAILY;COUNT=2;UNTIL=20240101",
+			"FREQ=DAILY;UNTIL=2024-01-01",
+			"FREQ=MONTHLY;BYMONTHDAY=0",
+			"FREQ=MONTHLY;BYMONTHDAY=32",
+			"FREQ=MONTHLY;BYDAY=XX",
+			"FREQ=MONTHLY;BYDAY=6MO",
+			"FREQ=WEEKLY;BYDAY=1MO",
+			"FREQ=WEEKLY;BYMONTHDAY=1",
+			"FREQ=DAILY;BYSETPOS=1",
+			"FREQ=YEARLY;BYWEEKNO=20",
+			"FREQ=DAILY;BYHOUR=9",
+			"FREQ=DAILY;COLOR=RED",
+			"FREQ=DAILY;",
+		} {
+			_, err := Parse(rule)
+			assert.True(t, errors.Is(err, ErrInvalidRule), rule)
+		}
+	})
+}
+
+func TestOccurrences(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+
+	day := func(y int, m time.Month, d int) time.Time {
+		return time.Date(y, m, d, 9, 0, 0, 0, time.UTC)
+	}
+	tests := []struct {
+		name  string
+		rule  string
+		start time.Time
+		n     int
+		want  []time.Time
+	}{
+		{
+			name:  "every other day",
+			rule:  "FREQ=DAILY;INTERVAL=2",
+			start: day(2024, 2, 27), n: 3,
+			want: []time.Time{day(2024, 2, 27), day(2024, 2, 29), day(2024, 3, 2)},
+		},
+		{
+			name:  "weekdays of the week",
+			rule:  "FREQ=WEEKLY;BYDAY=MO,WE,FR",
+			start: day(2024, 3, 6), n: 4,
+			want: []time.Time{day(2024, 3, 6), day(2024, 3, 8), day(2024, 3, 11), day(2024, 3, 13)},
+		},
+		{
+			name:  "start off the rule still counts first",
+			rule:  "FREQ=WEEKLY;BYDAY=MO",
+			start: day(2024, 3, 6), n: 2,
+			want: []time.Time{day(2024, 3, 6), day(2024, 3, 11)},
+		},
+		{
+			name:  "the 31st skips short months",
+			rule:  "FREQ=MONTHLY",
+			start: day(2024, 1, 31), n: 3,
+			want: []time.Time{day(2024, 1, 31), day(2024, 3, 31), day(2024, 5, 31)},
+		},
+		{
+			name:  "last day of the month",
+			rule:  "FREQ=MONTHLY;BYMONTHDAY=-1",
+			start: day(2024, 1, 31), n: 3,
+			want: []time.Time{day(2024, 1, 31), day(2024, 2, 29), day(2024, 3, 31)},
+		},
+		{
+			name:  "last Friday of the month",
+			rule:  "FREQ=MONTHLY;BYDAY=-1FR",
+			start: day(2024, 1, 26), n: 3,
+			want: []time.Time{day(2024, 1, 26), day(2024, 2, 23), day(2024, 3, 29)},
+		},
+		{
+			name:  "last weekday of the month",
+			rule:  "FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1",
+			start: day(2024, 1, 31), n: 3,
+			want: []time.Time{day(2024, 1, 31), day(2024, 2, 29), day(2024, 3, 29)},
+		},
+		{
+			name:  "leap day",
+			rule:  "FREQ=YEARLY",
+			start: day(2024, 2, 29), n: 2,
+			want: []time.Time{day(2024, 2, 29), day(2028, 2, 29)},
+		},
+		{
+			name:  "Thanksgiving",
+			rule:  "FREQ=YEARLY;BYMONTH=11;BYDAY=4TH",
+			start: day(2023, 11, 23), n: 3,
+			want: []time.Time{day(2023, 11, 23), day(2024, 11, 28), day(2025, 11, 27)},
+		},
+		{
+			name:  "first Monday of the year",
+			rule:  "FREQ=YEARLY;BYDAY=1MO",
+			start: day(2024, 1, 1), n: 2,
+			want: []time.Time{day(2024, 1, 1), day(2025, 1, 6)},
+		},
+		{
+			name:  "count includes the start",
+			rule:  "FREQ=DAILY;COUNT=2",
+			start: day(2024, 3, 1), n: 5,
+			want: []time.Time{day(2024, 3, 1), day(2024, 3, 2)},
+		},
+		{
+			name:  "until date includes that whole day",
+			rule:  "FREQ=DAILY;UNTIL=20240303",
+			start: day(2024, 3, 1), n: 5,
+			want: []time.Time{day(2024, 3, 1), day(2024, 3, 2), day(2024, 3, 3)},
+		},
+		{
+			name:  "until in UTC is an instant",
+			rule:  "FREQ=DAILY;UNTIL=20240303T085959Z",
+			start: day(2024, 3, 1), n: 5,
+			want: []time.Time{day(2024, 3, 1), day(2024, 3, 2)},
+		},
+		{
+			name:  "impossible dates end the series",
+			rule:  "FREQ=YEARLY;BYMONTH=2;BYMONTHDAY=30",
+			start: day(2024, 1, 1), n: 3,
+			want: []time.Time{day(2024, 1, 1)},
+		},
+		{
+			name:  "keeps the wall clock across daylight saving",
+			rule:  "FREQ=DAILY",
+			start: time.Date(2024, 3, 9, 9, 0, 0, 0, newYork), n: 3,
+			want: []time.Time{
+				time.Date(2024, 3, 9, 14, 0, 0, 0, time.UTC),
+				time.Date(2024, 3, 10, 13, 0, 0, 0, time.UTC),
+				time.Date(2024, 3, 11, 13, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:  "a skipped time moves past the gap",
+			rule:  "FREQ=DAILY",
+			start: time.Date(2024, 3, 9, 2, 30, 0, 0, newYork), n: 3,
+			want: []time.Time{
+				time.Date(2024, 3, 9, 7, 30, 0, 0, time.UTC),
+				// 02:30 does not exist on March 10th, so the todo is due at 03:30
+				time.Date(2024, 3, 10, 7, 30, 0, 0, time.UTC),
+				time.Date(2024, 3, 11, 6, 30, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:  "a repeated time takes its first instant",
+			rule:  "FREQ=DAILY",
+			start: time.Date(2024, 11, 2, 1, 30, 0, 0, newYork), n: 3,
+			want: []time.Time{
+				time.Date(2024, 11, 2, 5, 30, 0, 0, time.UTC),
+				time.Date(2024, 11, 3, 5, 30, 0, 0, time.UTC),
+				time.Date(2024, 11, 4, 6, 30, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:  "until is read in the start's timezone",
+			rule:  "FREQ=DAILY;UNTIL=20240310T090000",
+			start: time.Date(2024, 3, 9, 9, 0, 0, 0, newYork), n: 5,
+			want: []time.Time{
+				time.Date(2024, 3, 9, 14, 0, 0, 0, time.UTC),
+				time.Date(2024, 3, 10, 13, 0, 0, 0, time.UTC),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := Parse(tt.rule)
+			require.NoError(t, err)
+
+			got := r.Occurrences(tt.start, tt.n)
+
+			require.Len(t, got, len(tt.want))
+			for i := range tt.want {
+				assert.True(t, tt.want[i].Equal(got[i]), "occurrence %d: want %s, got %s", i, tt.want[i], got[i])
+				assert.Equal(t, tt.start.Location(), got[i].Location())
+			}
+		})
+	}
+}
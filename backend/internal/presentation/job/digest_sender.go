@@ -0,0 +1,50 @@
+package job
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"good-todo-go/internal/usecase"
+)
+
+// DigestSender periodically emails members whose daily digest time has passed. A digest
+// goes out within one interval of the member's chosen time.
+type DigestSender struct {
+	digestUsecase usecase.IDigestInteractor
+	interval      time.Duration
+}
+
+func NewDigestSender(digestUsecase usecase.IDigestInteractor, interval time.Duration) *DigestSender {
+	return &DigestSender{
+		digestUsecase: digestUsecase,
+		interval:      interval,
+	}
+}
+
+// Run sends once right away and then every interval until ctx is cancelled.
+func (j *DigestSender) Run(ctx context.Context) {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		j.send(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (j *DigestSender) send(ctx context.Context) {
+	sent, err := j.digestUsecase.SendDueDigests(ctx, time.Now())
+	if err != nil {
+		// Members that did get their digest are still counted, so log both
+		log.Printf("digest sending failed: %v", err)
+	}
+	if sent > 0 {
+		log.Printf("sent %d daily digests", sent)
+	}
+}
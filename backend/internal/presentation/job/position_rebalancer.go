@@ -0,0 +1,50 @@
+package job
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"good-todo-go/internal/usecase"
+)
+
+// PositionRebalancer periodically gives fresh, short keys to the manual todo order of
+// tenants whose keys have grown too long.
+type PositionRebalancer struct {
+	rebalanceUsecase usecase.ITodoRebalanceInteractor
+	interval         time.Duration
+}
+
+func NewPositionRebalancer(rebalanceUsecase usecase.ITodoRebalanceInteractor, interval time.Duration) *PositionRebalancer {
+	return &PositionRebalancer{
+		rebalanceUsecase: rebalanceUsecase,
+		interval:         interval,
+	}
+}
+
+// Run rebalances once right away and then every interval until ctx is cancelled.
+func (j *PositionRebalancer) Run(ctx context.Context) {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		j.rebalance(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (j *PositionRebalancer) rebalance(ctx context.Context) {
+	rewritten, err := j.rebalanceUsecase.RebalancePositions(ctx)
+	if err != nil {
+		// Tenants that were rebalanced are still counted, so log both
+		log.Printf("position rebalance failed: %v", err)
+	}
+	if rewritten > 0 {
+		log.Printf("rebalanced the positions of %d todos", rewritten)
+	}
+}
@@ -0,0 +1,50 @@
+package job
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"good-todo-go/internal/usecase"
+)
+
+// ReminderScheduler periodically emails the reminders that have come due. Several API
+// instances can run it at once; each reminder is claimed by only one of them.
+type ReminderScheduler struct {
+	deliveryUsecase usecase.IReminderDeliveryInteractor
+	interval        time.Duration
+}
+
+func NewReminderScheduler(deliveryUsecase usecase.IReminderDeliveryInteractor, interval time.Duration) *ReminderScheduler {
+	return &ReminderScheduler{
+		deliveryUsecase: deliveryUsecase,
+		interval:        interval,
+	}
+}
+
+// Run delivers once right away and then every interval until ctx is cancelled.
+func (j *ReminderScheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		j.deliver(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (j *ReminderScheduler) deliver(ctx context.Context) {
+	delivered, err := j.deliveryUsecase.DeliverDueReminders(ctx, time.Now())
+	if err != nil {
+		// Tenants that did deliver are still counted, so log both
+		log.Printf("reminder delivery failed: %v", err)
+	}
+	if delivered > 0 {
+		log.Printf("delivered %d reminders", delivered)
+	}
+}
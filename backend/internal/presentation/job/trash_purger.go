@@ -0,0 +1,50 @@
+package job
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"good-todo-go/internal/usecase"
+)
+
+// TrashPurger periodically removes todos that have been in the trash longer than
+// their tenant's retention period.
+type TrashPurger struct {
+	purgeUsecase usecase.ITodoPurgeInteractor
+	interval     time.Duration
+}
+
+func NewTrashPurger(purgeUsecase usecase.ITodoPurgeInteractor, interval time.Duration) *TrashPurger {
+	return &TrashPurger{
+		purgeUsecase: purgeUsecase,
+		interval:     interval,
+	}
+}
+
+// Run purges once right away and then every interval until ctx is cancelled.
+func (j *TrashPurger) Run(ctx context.Context) {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		j.purge(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (j *TrashPurger) purge(ctx context.Context) {
+	purged, err := j.purgeUsecase.PurgeExpiredTrash(ctx, time.Now())
+	if err != nil {
+		// Tenants that did purge are still counted, so log both
+		log.Printf("trash purge failed: %v", err)
+	}
+	if purged > 0 {
+		log.Printf("purged %d todos from the trash", purged)
+	}
+}
@@ -17,18 +17,254 @@ const (
 	BearerAuthScopes = "bearerAuth.Scopes"
 )
 
+// Defines values for BatchTodoAction.
+const (
+	Complete   BatchTodoAction = "complete"
+	Delete     BatchTodoAction = "delete"
+	MoveToList BatchTodoAction = "move_to_list"
+	SetDueDate BatchTodoAction = "set_due_date"
+	SetPublic  BatchTodoAction = "set_public"
+	Uncomplete BatchTodoAction = "uncomplete"
+)
+
+// Defines values for BatchTodoMode.
+const (
+	Atomic  BatchTodoMode = "atomic"
+	Partial BatchTodoMode = "partial"
+)
+
+// Defines values for MembershipResponseRole.
+const (
+	MembershipResponseRoleAdmin  MembershipResponseRole = "admin"
+	MembershipResponseRoleMember MembershipResponseRole = "member"
+)
+
+// Defines values for ReminderStatus.
+const (
+	Delivered ReminderStatus = "delivered"
+	Failed    ReminderStatus = "failed"
+	Pending   ReminderStatus = "pending"
+)
+
+// Defines values for SnoozePreset.
+const (
+	LaterToday SnoozePreset = "later_today"
+	NextWeek   SnoozePreset = "next_week"
+	Tomorrow   SnoozePreset = "tomorrow"
+)
+
+// Defines values for SortOrder.
+const (
+	Asc  SortOrder = "asc"
+	Desc SortOrder = "desc"
+)
+
+// Defines values for TodoActivityAction.
+const (
+	Snoozed   TodoActivityAction = "snoozed"
+	Unsnoozed TodoActivityAction = "unsnoozed"
+)
+
+// Defines values for TodoPriority.
+const (
+	High   TodoPriority = "high"
+	Low    TodoPriority = "low"
+	Medium TodoPriority = "medium"
+	None   TodoPriority = "none"
+	Urgent TodoPriority = "urgent"
+)
+
+// Defines values for TodoSortField.
+const (
+	CreatedAt TodoSortField = "created_at"
+	DueDate   TodoSortField = "due_date"
+	Position  TodoSortField = "position"
+	Priority  TodoSortField = "priority"
+	Title     TodoSortField = "title"
+	UpdatedAt TodoSortField = "updated_at"
+)
+
+// Defines values for TodoView.
+const (
+	Overdue  TodoView = "overdue"
+	Someday  TodoView = "someday"
+	Today    TodoView = "today"
+	Upcoming TodoView = "upcoming"
+)
+
 // Defines values for UserResponseRole.
 const (
-	Admin  UserResponseRole = "admin"
-	Member UserResponseRole = "member"
+	UserResponseRoleAdmin  UserResponseRole = "admin"
+	UserResponseRoleMember UserResponseRole = "member"
 )
 
+// AddBlockerRequest defines model for AddBlockerRequest.
+type AddBlockerRequest struct {
+	// BlockerId Todo that must be completed first
+	BlockerId string `json:"blocker_id"`
+}
+
+// AddTenantDomainRequest defines model for AddTenantDomainRequest.
+type AddTenantDomainRequest struct {
+	Domain string `json:"domain"`
+}
+
+// AssignTodoRequest defines model for AssignTodoRequest.
+type AssignTodoRequest struct {
+	// UserId The tenant member to assign the todo to
+	UserId string `json:"user_id"`
+}
+
+// BatchTodoAction defines model for BatchTodoAction.
+type BatchTodoAction string
+
+// BatchTodoItemResult defines model for BatchTodoItemResult.
+type BatchTodoItemResult struct {
+	// Error Why the item failed
+	Error *string `json:"error,omitempty"`
+	Id    string  `json:"id"`
+
+	// Operation Index of the operation in the request
+	Operation int `json:"operation"`
+
+	// Status HTTP status code of the item
+	Status int           `json:"status"`
+	Todo   *TodoResponse `json:"todo,omitempty"`
+}
+
+// BatchTodoMode defines model for BatchTodoMode.
+type BatchTodoMode string
+
+// BatchTodoOperation defines model for BatchTodoOperation.
+type BatchTodoOperation struct {
+	// DueDate New due date for set_due_date; omit or null to clear it
+	DueDate *time.Time `json:"due_date"`
+	Ids     []string   `json:"ids"`
+
+	// IsPublic Required for set_public
+	IsPublic *bool           `json:"is_public,omitempty"`
+	Op       BatchTodoAction `json:"op"`
+
+	// ProjectId Target project for move_to_list; omit or null for the inbox
+	ProjectId *string `json:"project_id"`
+}
+
+// BatchTodoRequest defines model for BatchTodoRequest.
+type BatchTodoRequest struct {
+	Mode       *BatchTodoMode       `json:"mode,omitempty"`
+	Operations []BatchTodoOperation `json:"operations"`
+}
+
+// BatchTodoResponse defines model for BatchTodoResponse.
+type BatchTodoResponse struct {
+	// Committed False when an atomic batch was rolled back
+	Committed bool                  `json:"committed"`
+	Results   []BatchTodoItemResult `json:"results"`
+}
+
+// CommentListResponse defines model for CommentListResponse.
+type CommentListResponse struct {
+	Comments []CommentResponse `json:"comments"`
+
+	// NextCursor Cursor for the next page, null on the last page
+	NextCursor *string `json:"next_cursor"`
+}
+
+// CommentResponse defines model for CommentResponse.
+type CommentResponse struct {
+	AuthorId string `json:"author_id"`
+
+	// Body Markdown text of the comment
+	Body      string    `json:"body"`
+	CreatedAt time.Time `json:"created_at"`
+
+	// EditedAt When the body was last changed; null for comments never edited
+	EditedAt  *time.Time `json:"edited_at"`
+	Id        string     `json:"id"`
+	TodoId    string     `json:"todo_id"`
+	UpdatedAt time.Time  `json:"updated_at"`
+}
+
+// CreateCommentRequest defines model for CreateCommentRequest.
+type CreateCommentRequest struct {
+	// Body Markdown text; mention members as @ followed by their email address
+	Body string `json:"body"`
+}
+
+// CreateProjectRequest defines model for CreateProjectRequest.
+type CreateProjectRequest struct {
+	// Color Hex color such as #3b82f6; defaults to gray
+	Color       *string `json:"color,omitempty"`
+	Description *string `json:"description,omitempty"`
+	IsPublic    *bool   `json:"is_public,omitempty"`
+	Name        string  `json:"name"`
+}
+
+// CreateReminderRequest defines model for CreateReminderRequest.
+type CreateReminderRequest struct {
+	// OffsetMinutes Minutes before the todo's due date to send the reminder; give this or remind_at
+	OffsetMinutes *int `json:"offset_minutes,omitempty"`
+
+	// RemindAt When to send the reminder; give this or offset_minutes
+	RemindAt *time.Time `json:"remind_at,omitempty"`
+}
+
+// CreateTagRequest defines model for CreateTagRequest.
+type CreateTagRequest struct {
+	// Color Hex color such as #3b82f6; defaults to gray
+	Color *string `json:"color,omitempty"`
+	Name  string  `json:"name"`
+}
+
+// CreateTenantRequest defines model for CreateTenantRequest.
+type CreateTenantRequest struct {
+	Name string `json:"name"`
+
+	// TenantSlug Optional vanity slug. Derived from the email when omitted.
+	TenantSlug *string `json:"tenant_slug,omitempty"`
+}
+
 // CreateTodoRequest defines model for CreateTodoRequest.
 type CreateTodoRequest struct {
-	Description *string    `json:"description,omitempty"`
-	DueDate     *time.Time `json:"due_date"`
-	IsPublic    *bool      `json:"is_public,omitempty"`
-	Title       string     `json:"title"`
+	// AutoComplete Complete the todo once all of its subtasks are done
+	AutoComplete *bool      `json:"auto_complete,omitempty"`
+	Description  *string    `json:"description,omitempty"`
+	DueDate      *time.Time `json:"due_date"`
+	IsPublic     *bool      `json:"is_public,omitempty"`
+
+	// ParentId Todo to create this one as a subtask of; omit or null for a top-level todo
+	ParentId *string `json:"parent_id"`
+
+	// Priority How urgent the todo is; omit for none
+	Priority *TodoPriority `json:"priority,omitempty"`
+
+	// ProjectId Project to file the todo in; omit or null for the inbox
+	ProjectId *string `json:"project_id"`
+
+	// RecurrenceRule RFC 5545 RRULE, such as FREQ=WEEKLY;BYDAY=MO, repeating the todo from its due date, which it requires
+	RecurrenceRule *string `json:"recurrence_rule"`
+
+	// RecurrenceTimezone IANA time zone whose wall clock the occurrences keep; defaults to your timezone
+	RecurrenceTimezone *string `json:"recurrence_timezone"`
+
+	// TagIds Tags of the tenant to put on the todo
+	TagIds *[]string `json:"tag_ids,omitempty"`
+	Title  string    `json:"title"`
+}
+
+// DigestPreferenceResponse defines model for DigestPreferenceResponse.
+type DigestPreferenceResponse struct {
+	// Enabled Whether the daily digest of due and overdue todos is emailed
+	Enabled bool `json:"enabled"`
+
+	// LastSentOn Local date of the last digest sent; null before the first
+	LastSentOn *openapi_types.Date `json:"last_sent_on"`
+
+	// Time 24-hour HH:MM time the digest is sent at
+	Time string `json:"time"`
+
+	// Timezone IANA time zone of the digest time; null follows your own timezone
+	Timezone *string `json:"timezone"`
 }
 
 // ErrorResponse defines model for ErrorResponse.
@@ -44,9 +280,11 @@ type HealthResponse struct {
 
 // LoginRequest defines model for LoginRequest.
 type LoginRequest struct {
-	Email      openapi_types.Email `json:"email"`
-	Password   string              `json:"password"`
-	TenantSlug string              `json:"tenant_slug"`
+	Email    openapi_types.Email `json:"email"`
+	Password string              `json:"password"`
+
+	// TenantSlug Required unless the request host identifies the tenant.
+	TenantSlug *string `json:"tenant_slug,omitempty"`
 }
 
 // LoginResponse defines model for LoginResponse.
@@ -56,6 +294,87 @@ type LoginResponse struct {
 	User         UserResponse `json:"user"`
 }
 
+// MembershipListResponse defines model for MembershipListResponse.
+type MembershipListResponse struct {
+	Memberships []MembershipResponse `json:"memberships"`
+}
+
+// MembershipResponse defines model for MembershipResponse.
+type MembershipResponse struct {
+	// Current Whether this is the tenant of the token used for the request.
+	Current    bool                   `json:"current"`
+	JoinedAt   time.Time              `json:"joined_at"`
+	Role       MembershipResponseRole `json:"role"`
+	TenantId   string                 `json:"tenant_id"`
+	TenantName string                 `json:"tenant_name"`
+	TenantSlug string                 `json:"tenant_slug"`
+}
+
+// MembershipResponseRole defines model for MembershipResponse.Role.
+type MembershipResponseRole string
+
+// MoveTodoRequest defines model for MoveTodoRequest.
+type MoveTodoRequest struct {
+	// AfterId Places the todo right after this todo
+	AfterId *string `json:"after_id,omitempty"`
+
+	// BeforeId Places the todo right before this todo
+	BeforeId *string `json:"before_id,omitempty"`
+
+	// ProjectId Also files the todo in this project, or in the inbox when empty; without a neighbour the todo goes to the top
+	ProjectId *string `json:"project_id,omitempty"`
+}
+
+// PatchTodoRequest defines model for PatchTodoRequest.
+type PatchTodoRequest struct {
+	// AutoComplete Complete the todo once all of its subtasks are done
+	AutoComplete *bool      `json:"auto_complete,omitempty"`
+	Completed    *bool      `json:"completed,omitempty"`
+	Description  *string    `json:"description,omitempty"`
+	DueDate      *time.Time `json:"due_date,omitempty"`
+	IsPublic     *bool      `json:"is_public,omitempty"`
+
+	// ParentId Makes the todo a subtask of this todo; null makes it a top-level todo
+	ParentId *string `json:"parent_id,omitempty"`
+
+	// Priority How urgent the todo is; null resets it to none
+	Priority *TodoPriority `json:"priority,omitempty"`
+
+	// ProjectId Moves the todo to this project; null moves it to the inbox
+	ProjectId *string `json:"project_id,omitempty"`
+
+	// RecurrenceRule RFC 5545 RRULE repeating the todo from its due date; null stops it repeating
+	RecurrenceRule *string `json:"recurrence_rule,omitempty"`
+
+	// RecurrenceTimezone IANA time zone whose wall clock the occurrences keep; null uses your timezone
+	RecurrenceTimezone *string `json:"recurrence_timezone,omitempty"`
+
+	// TagIds Replaces the todo's tags; null removes them all
+	TagIds *[]string `json:"tag_ids,omitempty"`
+	Title  *string   `json:"title,omitempty"`
+}
+
+// ProjectListResponse defines model for ProjectListResponse.
+type ProjectListResponse struct {
+	Projects []ProjectResponse `json:"projects"`
+}
+
+// ProjectResponse defines model for ProjectResponse.
+type ProjectResponse struct {
+	// Archived Archived projects are hidden from the project list and take no new todos
+	Archived    bool      `json:"archived"`
+	Color       string    `json:"color"`
+	CreatedAt   time.Time `json:"created_at"`
+	Description string    `json:"description"`
+	Id          string    `json:"id"`
+
+	// IsPublic Public projects are visible to everyone in the tenant, who can file their own todos in them
+	IsPublic  bool      `json:"is_public"`
+	Name      string    `json:"name"`
+	UpdatedAt time.Time `json:"updated_at"`
+	UserId    string    `json:"user_id"`
+}
+
 // RefreshTokenRequest defines model for RefreshTokenRequest.
 type RefreshTokenRequest struct {
 	RefreshToken string `json:"refresh_token"`
@@ -72,6 +391,9 @@ type RegisterRequest struct {
 	Email    openapi_types.Email `json:"email"`
 	Name     string              `json:"name"`
 	Password string              `json:"password"`
+
+	// TenantSlug Optional vanity slug. Derived from the email when omitted.
+	TenantSlug *string `json:"tenant_slug,omitempty"`
 }
 
 // RegisterResponse defines model for RegisterResponse.
@@ -82,37 +404,297 @@ type RegisterResponse struct {
 	UserId   string `json:"user_id"`
 }
 
+// ReminderListResponse defines model for ReminderListResponse.
+type ReminderListResponse struct {
+	Reminders []ReminderResponse `json:"reminders"`
+}
+
+// ReminderResponse defines model for ReminderResponse.
+type ReminderResponse struct {
+	// Attempts How many times sending the reminder has been tried
+	Attempts    int        `json:"attempts"`
+	CreatedAt   time.Time  `json:"created_at"`
+	DeliveredAt *time.Time `json:"delivered_at"`
+	Id          string     `json:"id"`
+
+	// LastError Why the last send failed; null once the reminder is delivered
+	LastError *string `json:"last_error"`
+
+	// OffsetMinutes Minutes before the todo's due date the reminder is sent; null for reminders at a fixed time
+	OffsetMinutes *int `json:"offset_minutes"`
+
+	// RemindAt When the reminder is sent; null for reminders relative to the due date
+	RemindAt *time.Time `json:"remind_at"`
+
+	// Status Pending reminders are retried until delivered or failed for good
+	Status ReminderStatus `json:"status"`
+	TodoId string         `json:"todo_id"`
+}
+
+// ReminderStatus Delivery state of a reminder
+type ReminderStatus string
+
+// SnoozePreset Later today is three hours from now; tomorrow and next week are 08:00 in your timezone, next week on the coming Monday
+type SnoozePreset string
+
+// SnoozeTodoRequest defines model for SnoozeTodoRequest.
+type SnoozeTodoRequest struct {
+	// Preset Named time to snooze until; give either this or until
+	Preset *SnoozePreset `json:"preset,omitempty"`
+
+	// Until When the todo comes back; give either this or preset
+	Until *time.Time `json:"until,omitempty"`
+}
+
+// SortOrder defines model for SortOrder.
+type SortOrder string
+
+// SwitchTenantRequest defines model for SwitchTenantRequest.
+type SwitchTenantRequest struct {
+	TenantId string `json:"tenant_id"`
+}
+
+// TagListResponse defines model for TagListResponse.
+type TagListResponse struct {
+	Tags []TagResponse `json:"tags"`
+}
+
+// TagResponse defines model for TagResponse.
+type TagResponse struct {
+	Color     string    `json:"color"`
+	CreatedAt time.Time `json:"created_at"`
+	Id        string    `json:"id"`
+	Name      string    `json:"name"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// TenantDomainListResponse defines model for TenantDomainListResponse.
+type TenantDomainListResponse struct {
+	Domains []TenantDomainResponse `json:"domains"`
+}
+
+// TenantDomainResponse defines model for TenantDomainResponse.
+type TenantDomainResponse struct {
+	CreatedAt time.Time `json:"created_at"`
+	Domain    string    `json:"domain"`
+	Id        string    `json:"id"`
+
+	// VerificationRecord Name of the DNS TXT record that must contain the verification token.
+	VerificationRecord string     `json:"verification_record"`
+	VerificationToken  string     `json:"verification_token"`
+	Verified           bool       `json:"verified"`
+	VerifiedAt         *time.Time `json:"verified_at"`
+}
+
+// TenantResponse defines model for TenantResponse.
+type TenantResponse struct {
+	CreatedAt time.Time `json:"created_at"`
+	Id        string    `json:"id"`
+	Name      string    `json:"name"`
+	Slug      string    `json:"slug"`
+
+	// TrashRetentionDays Days a trashed todo is kept before it is purged
+	TrashRetentionDays int       `json:"trash_retention_days"`
+	UpdatedAt          time.Time `json:"updated_at"`
+}
+
+// TodoActivityAction What happened to the todo
+type TodoActivityAction string
+
+// TodoActivityListResponse defines model for TodoActivityListResponse.
+type TodoActivityListResponse struct {
+	Activities []TodoActivityResponse `json:"activities"`
+}
+
+// TodoActivityResponse defines model for TodoActivityResponse.
+type TodoActivityResponse struct {
+	// Action What happened to the todo
+	Action    TodoActivityAction `json:"action"`
+	ActorId   string             `json:"actor_id"`
+	CreatedAt time.Time          `json:"created_at"`
+	Id        string             `json:"id"`
+
+	// SnoozedUntil When the snoozed todo wakes; null for other actions
+	SnoozedUntil *time.Time `json:"snoozed_until"`
+	TodoId       string     `json:"todo_id"`
+}
+
 // TodoListResponse defines model for TodoListResponse.
 type TodoListResponse struct {
-	Todos []TodoResponse `json:"todos"`
+	// NextCursor Cursor for the next page, null on the last page
+	NextCursor *string        `json:"next_cursor"`
+	Todos      []TodoResponse `json:"todos"`
+}
+
+// TodoOccurrencesResponse defines model for TodoOccurrencesResponse.
+type TodoOccurrencesResponse struct {
+	// Occurrences Due dates of the next occurrences after the current one, in the recurrence timezone; empty for todos that do not repeat
+	Occurrences []time.Time `json:"occurrences"`
 }
 
+// TodoPriority How urgent a todo is, from none up to urgent
+type TodoPriority string
+
 // TodoResponse defines model for TodoResponse.
 type TodoResponse struct {
-	Completed   bool       `json:"completed"`
-	CompletedAt *time.Time `json:"completed_at"`
-	CreatedAt   time.Time  `json:"created_at"`
+	// AssigneeId The tenant member the todo is assigned to; null for unassigned todos
+	AssigneeId *string `json:"assignee_id"`
+
+	// AutoComplete Whether the todo completes itself once all of its subtasks are done
+	AutoComplete bool `json:"auto_complete"`
+
+	// BlockedCount How many open todos block this one; it cannot be completed without force while above zero
+	BlockedCount int `json:"blocked_count"`
+
+	// BlockingCount How many open todos wait on this one
+	BlockingCount int        `json:"blocking_count"`
+	Completed     bool       `json:"completed"`
+	CompletedAt   *time.Time `json:"completed_at"`
+
+	// CompletedSubtaskCount How many of the todo's subtasks are done
+	CompletedSubtaskCount int       `json:"completed_subtask_count"`
+	CreatedAt             time.Time `json:"created_at"`
+
+	// DeletedAt When the todo was moved to the trash; null for live todos
+	DeletedAt   *time.Time `json:"deleted_at"`
 	Description string     `json:"description"`
 	DueDate     *time.Time `json:"due_date"`
 	Id          string     `json:"id"`
 	IsPublic    bool       `json:"is_public"`
-	Title       string     `json:"title"`
-	UpdatedAt   time.Time  `json:"updated_at"`
-	UserId      string     `json:"user_id"`
+
+	// ParentId The todo this is a subtask of; null for top-level todos
+	ParentId *string `json:"parent_id"`
+
+	// Position The todo's key in the manual order; keys sort byte-wise
+	Position string       `json:"position"`
+	Priority TodoPriority `json:"priority"`
+
+	// ProjectId The todo's project; null for todos in the inbox
+	ProjectId *string `json:"project_id"`
+
+	// RecurrenceRule RFC 5545 RRULE the todo repeats by; completing it creates the next occurrence. Null for todos that do not repeat
+	RecurrenceRule *string `json:"recurrence_rule"`
+
+	// RecurrenceTimezone IANA time zone whose wall clock the occurrences keep; null for todos that do not repeat
+	RecurrenceTimezone *string `json:"recurrence_timezone"`
+
+	// SnoozedUntil When the todo comes back into listings; null when it is not snoozed
+	SnoozedUntil *time.Time `json:"snoozed_until"`
+
+	// SubtaskCount How many direct subtasks the todo has, not counting trashed ones
+	SubtaskCount int `json:"subtask_count"`
+
+	// Tags Sorted by name
+	Tags      []TagResponse `json:"tags"`
+	Title     string        `json:"title"`
+	UpdatedAt time.Time     `json:"updated_at"`
+	UserId    string        `json:"user_id"`
+
+	// Version Increments on every change; also sent as the ETag header
+	Version int `json:"version"`
+}
+
+// TodoSearchResponse defines model for TodoSearchResponse.
+type TodoSearchResponse struct {
+	Results []TodoSearchResult `json:"results"`
+}
+
+// TodoSearchResult defines model for TodoSearchResult.
+type TodoSearchResult struct {
+	// DescriptionHighlight Description snippet with matches wrapped in <mark> tags; other text is HTML-escaped
+	DescriptionHighlight string  `json:"description_highlight"`
+	Rank                 float32 `json:"rank"`
+
+	// TitleHighlight Title with matches wrapped in <mark> tags; other text is HTML-escaped
+	TitleHighlight string       `json:"title_highlight"`
+	Todo           TodoResponse `json:"todo"`
+}
+
+// TodoSortField Field to sort by; position is the manual order and sorts ascending unless an order is given, and priority sorts by earliest due date within a priority
+type TodoSortField string
+
+// TodoView Smart view of your open todos; days start at midnight in your timezone
+type TodoView string
+
+// UpdateCommentRequest defines model for UpdateCommentRequest.
+type UpdateCommentRequest struct {
+	// Body Markdown text replacing the comment's body
+	Body string `json:"body"`
+}
+
+// UpdateDigestPreferenceRequest defines model for UpdateDigestPreferenceRequest.
+type UpdateDigestPreferenceRequest struct {
+	// Enabled Whether to email the daily digest
+	Enabled bool `json:"enabled"`
+
+	// Time 24-hour HH:MM time to send the digest at; omit for 08:00
+	Time *string `json:"time,omitempty"`
+
+	// Timezone IANA time zone of the digest time; omit to follow your own timezone
+	Timezone *string `json:"timezone,omitempty"`
+}
+
+// UpdateProjectRequest defines model for UpdateProjectRequest.
+type UpdateProjectRequest struct {
+	Archived    *bool   `json:"archived,omitempty"`
+	Color       *string `json:"color,omitempty"`
+	Description *string `json:"description,omitempty"`
+	IsPublic    *bool   `json:"is_public,omitempty"`
+	Name        *string `json:"name,omitempty"`
+}
+
+// UpdateTagRequest defines model for UpdateTagRequest.
+type UpdateTagRequest struct {
+	Color *string `json:"color,omitempty"`
+	Name  *string `json:"name,omitempty"`
+}
+
+// UpdateTenantRequest defines model for UpdateTenantRequest.
+type UpdateTenantRequest struct {
+	Name *string `json:"name,omitempty"`
+
+	// Slug New tenant slug. The previous slug keeps resolving for a grace period.
+	Slug *string `json:"slug,omitempty"`
+
+	// TrashRetentionDays Days a trashed todo is kept before it is purged
+	TrashRetentionDays *int `json:"trash_retention_days,omitempty"`
 }
 
 // UpdateTodoRequest defines model for UpdateTodoRequest.
 type UpdateTodoRequest struct {
-	Completed   bool       `json:"completed"`
-	Description *string    `json:"description,omitempty"`
-	DueDate     *time.Time `json:"due_date"`
-	IsPublic    *bool      `json:"is_public,omitempty"`
-	Title       string     `json:"title"`
+	// AutoComplete Complete the todo once all of its subtasks are done; omit to keep the setting
+	AutoComplete *bool      `json:"auto_complete,omitempty"`
+	Completed    bool       `json:"completed"`
+	Description  *string    `json:"description,omitempty"`
+	DueDate      *time.Time `json:"due_date"`
+	IsPublic     *bool      `json:"is_public,omitempty"`
+
+	// ParentId Makes the todo a subtask of this todo, or a top-level todo when empty; omit to keep it where it is
+	ParentId *string `json:"parent_id,omitempty"`
+
+	// Priority How urgent the todo is; omit to keep it
+	Priority *TodoPriority `json:"priority,omitempty"`
+
+	// ProjectId Moves the todo to this project, or to the inbox when empty; omit to keep it where it is
+	ProjectId *string `json:"project_id,omitempty"`
+
+	// RecurrenceRule RFC 5545 RRULE repeating the todo from its due date, or empty to stop it repeating; omit to keep it. Removing the due date also stops the todo repeating
+	RecurrenceRule *string `json:"recurrence_rule,omitempty"`
+
+	// RecurrenceTimezone IANA time zone whose wall clock the occurrences keep, or empty for your timezone; omit to keep it
+	RecurrenceTimezone *string `json:"recurrence_timezone,omitempty"`
+
+	// TagIds Replaces the todo's tags; omit to keep them
+	TagIds *[]string `json:"tag_ids,omitempty"`
+	Title  string    `json:"title"`
 }
 
 // UpdateUserRequest defines model for UpdateUserRequest.
 type UpdateUserRequest struct {
 	Name string `json:"name"`
+
+	// Timezone IANA time zone name, such as Europe/Berlin; omit to keep the current one
+	Timezone *string `json:"timezone,omitempty"`
 }
 
 // UserResponse defines model for UserResponse.
@@ -124,7 +706,10 @@ type UserResponse struct {
 	Name          string           `json:"name"`
 	Role          UserResponseRole `json:"role"`
 	TenantId      string           `json:"tenant_id"`
-	UpdatedAt     time.Time        `json:"updated_at"`
+
+	// Timezone IANA time zone name that decides where the user's days start
+	Timezone  string    `json:"timezone"`
+	UpdatedAt time.Time `json:"updated_at"`
 }
 
 // UserResponseRole defines model for UserResponse.Role.
@@ -141,86 +726,542 @@ type VerifyEmailResponse struct {
 	Success bool   `json:"success"`
 }
 
-// LoginJSONRequestBody defines body for Login for application/json ContentType.
-type LoginJSONRequestBody = LoginRequest
+// ListProjectsParams defines parameters for ListProjects.
+type ListProjectsParams struct {
+	// IncludeArchived Also list archived projects
+	IncludeArchived *bool `form:"include_archived,omitempty" json:"include_archived,omitempty"`
+}
 
-// RefreshTokenJSONRequestBody defines body for RefreshToken for application/json ContentType.
-type RefreshTokenJSONRequestBody = RefreshTokenRequest
+// DeleteProjectParams defines parameters for DeleteProject.
+type DeleteProjectParams struct {
+	// DeleteTodos Move your todos in the project to the trash instead of the inbox
+	DeleteTodos *bool `form:"delete_todos,omitempty" json:"delete_todos,omitempty"`
+}
 
-// RegisterJSONRequestBody defines body for Register for application/json ContentType.
-type RegisterJSONRequestBody = RegisterRequest
+// ListProjectTodosParams defines parameters for ListProjectTodos.
+type ListProjectTodosParams struct {
+	// Limit Maximum number of todos to return
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
 
-// VerifyEmailJSONRequestBody defines body for VerifyEmail for application/json ContentType.
-type VerifyEmailJSONRequestBody = VerifyEmailRequest
+	// Cursor Opaque cursor from a previous page's next_cursor
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
 
-// UpdateMeJSONRequestBody defines body for UpdateMe for application/json ContentType.
-type UpdateMeJSONRequestBody = UpdateUserRequest
+	// Query Filter expression such as `is:open due:<7d -is:public "quarterly report"`
+	Query *string `form:"query,omitempty" json:"query,omitempty"`
 
-// CreateTodoJSONRequestBody defines body for CreateTodo for application/json ContentType.
-type CreateTodoJSONRequestBody = CreateTodoRequest
+	// Completed Only todos with this completion state
+	Completed *bool `form:"completed,omitempty" json:"completed,omitempty"`
 
-// UpdateTodoJSONRequestBody defines body for UpdateTodo for application/json ContentType.
-type UpdateTodoJSONRequestBody = UpdateTodoRequest
+	// DueBefore Only todos due before this time
+	DueBefore *time.Time `form:"due_before,omitempty" json:"due_before,omitempty"`
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// Login
-	// (POST /auth/login)
-	Login(ctx echo.Context) error
-	// Refresh access token
-	// (POST /auth/refresh)
-	RefreshToken(ctx echo.Context) error
-	// Register a new user and tenant
-	// (POST /auth/register)
-	Register(ctx echo.Context) error
-	// Verify email address
-	// (POST /auth/verify-email)
-	VerifyEmail(ctx echo.Context) error
-	// Health check
-	// (GET /health)
-	GetHealth(ctx echo.Context) error
-	// Get current user
-	// (GET /me)
-	GetMe(ctx echo.Context) error
-	// Update current user
-	// (PUT /me)
-	UpdateMe(ctx echo.Context) error
-	// List user's todos
-	// (GET /todos)
-	ListTodos(ctx echo.Context) error
-	// Create a new todo
-	// (POST /todos)
-	CreateTodo(ctx echo.Context) error
-	// List public todos in tenant
-	// (GET /todos-public)
-	ListPublicTodos(ctx echo.Context) error
-	// Delete a todo
-	// (DELETE /todos/{id})
-	DeleteTodo(ctx echo.Context, id string) error
-	// Update a todo
-	// (PUT /todos/{id})
-	UpdateTodo(ctx echo.Context, id string) error
-}
+	// DueAfter Only todos due after this time
+	DueAfter *time.Time `form:"due_after,omitempty" json:"due_after,omitempty"`
 
-// ServerInterfaceWrapper converts echo contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler ServerInterface
-}
+	// Overdue Only incomplete todos past their due date, or only todos that are not
+	Overdue *bool `form:"overdue,omitempty" json:"overdue,omitempty"`
 
-// Login converts echo context to params.
-func (w *ServerInterfaceWrapper) Login(ctx echo.Context) error {
-	var err error
+	// HasDueDate Only todos with or without a due date
+	HasDueDate *bool `form:"has_due_date,omitempty" json:"has_due_date,omitempty"`
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.Login(ctx)
-	return err
-}
+	// CreatedAfter Only todos created after this time
+	CreatedAfter *time.Time `form:"created_after,omitempty" json:"created_after,omitempty"`
 
-// RefreshToken converts echo context to params.
-func (w *ServerInterfaceWrapper) RefreshToken(ctx echo.Context) error {
-	var err error
+	// TagId Only todos carrying this tag; repeat to require several
+	TagId *[]string `form:"tag_id,omitempty" json:"tag_id,omitempty"`
 
-	// Invoke the callback with all the unmarshaled arguments
+	// IncludeSnoozed Also list todos snoozed until later
+	IncludeSnoozed *bool `form:"include_snoozed,omitempty" json:"include_snoozed,omitempty"`
+
+	// Sort Field to sort by
+	Sort *TodoSortField `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Order Sort direction
+	Order *SortOrder `form:"order,omitempty" json:"order,omitempty"`
+}
+
+// ListTodosParams defines parameters for ListTodos.
+type ListTodosParams struct {
+	// Limit Maximum number of todos to return
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor Opaque cursor from a previous page's next_cursor
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Query Filter expression such as `is:open due:<7d -is:public "quarterly report"`
+	Query *string `form:"query,omitempty" json:"query,omitempty"`
+
+	// Completed Only todos with this completion state
+	Completed *bool `form:"completed,omitempty" json:"completed,omitempty"`
+
+	// DueBefore Only todos due before this time
+	DueBefore *time.Time `form:"due_before,omitempty" json:"due_before,omitempty"`
+
+	// DueAfter Only todos due after this time
+	DueAfter *time.Time `form:"due_after,omitempty" json:"due_after,omitempty"`
+
+	// Overdue Only incomplete todos past their due date, or only todos that are not
+	Overdue *bool `form:"overdue,omitempty" json:"overdue,omitempty"`
+
+	// HasDueDate Only todos with or without a due date
+	HasDueDate *bool `form:"has_due_date,omitempty" json:"has_due_date,omitempty"`
+
+	// CreatedAfter Only todos created after this time
+	CreatedAfter *time.Time `form:"created_after,omitempty" json:"created_after,omitempty"`
+
+	// TagId Only todos carrying this tag; repeat to require several
+	TagId *[]string `form:"tag_id,omitempty" json:"tag_id,omitempty"`
+
+	// ProjectId Only todos of this project, or `inbox` for todos without one
+	ProjectId *string `form:"project_id,omitempty" json:"project_id,omitempty"`
+
+	// IncludeSnoozed Also list todos snoozed until later
+	IncludeSnoozed *bool `form:"include_snoozed,omitempty" json:"include_snoozed,omitempty"`
+
+	// Sort Field to sort by
+	Sort *TodoSortField `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Order Sort direction
+	Order *SortOrder `form:"order,omitempty" json:"order,omitempty"`
+}
+
+// ListPublicTodosParams defines parameters for ListPublicTodos.
+type ListPublicTodosParams struct {
+	// Limit Maximum number of todos to return
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor Opaque cursor from a previous page's next_cursor
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Query Filter expression such as `is:open due:<7d -is:public "quarterly report"`
+	Query *string `form:"query,omitempty" json:"query,omitempty"`
+
+	// Completed Only todos with this completion state
+	Completed *bool `form:"completed,omitempty" json:"completed,omitempty"`
+
+	// DueBefore Only todos due before this time
+	DueBefore *time.Time `form:"due_before,omitempty" json:"due_before,omitempty"`
+
+	// DueAfter Only todos due after this time
+	DueAfter *time.Time `form:"due_after,omitempty" json:"due_after,omitempty"`
+
+	// Overdue Only incomplete todos past their due date, or only todos that are not
+	Overdue *bool `form:"overdue,omitempty" json:"overdue,omitempty"`
+
+	// HasDueDate Only todos with or without a due date
+	HasDueDate *bool `form:"has_due_date,omitempty" json:"has_due_date,omitempty"`
+
+	// CreatedAfter Only todos created after this time
+	CreatedAfter *time.Time `form:"created_after,omitempty" json:"created_after,omitempty"`
+
+	// TagId Only todos carrying this tag; repeat to require several
+	TagId *[]string `form:"tag_id,omitempty" json:"tag_id,omitempty"`
+
+	// ProjectId Only todos of this project, or `inbox` for todos without one
+	ProjectId *string `form:"project_id,omitempty" json:"project_id,omitempty"`
+
+	// IncludeSnoozed Also list todos snoozed until later
+	IncludeSnoozed *bool `form:"include_snoozed,omitempty" json:"include_snoozed,omitempty"`
+
+	// Sort Field to sort by
+	Sort *TodoSortField `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Order Sort direction
+	Order *SortOrder `form:"order,omitempty" json:"order,omitempty"`
+}
+
+// ListAssignedTodosParams defines parameters for ListAssignedTodos.
+type ListAssignedTodosParams struct {
+	// Limit Maximum number of todos to return
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor Opaque cursor from a previous page's next_cursor
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// IncludeSnoozed Also list todos snoozed until later
+	IncludeSnoozed *bool `form:"include_snoozed,omitempty" json:"include_snoozed,omitempty"`
+}
+
+// ListTrashedTodosParams defines parameters for ListTrashedTodos.
+type ListTrashedTodosParams struct {
+	// Limit Maximum number of todos to return
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor Opaque cursor from a previous page's next_cursor
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Query Filter expression such as `is:open due:<7d -is:public "quarterly report"`
+	Query *string `form:"query,omitempty" json:"query,omitempty"`
+
+	// Completed Only todos with this completion state
+	Completed *bool `form:"completed,omitempty" json:"completed,omitempty"`
+
+	// DueBefore Only todos due before this time
+	DueBefore *time.Time `form:"due_before,omitempty" json:"due_before,omitempty"`
+
+	// DueAfter Only todos due after this time
+	DueAfter *time.Time `form:"due_after,omitempty" json:"due_after,omitempty"`
+
+	// Overdue Only incomplete todos past their due date, or only todos that are not
+	Overdue *bool `form:"overdue,omitempty" json:"overdue,omitempty"`
+
+	// HasDueDate Only todos with or without a due date
+	HasDueDate *bool `form:"has_due_date,omitempty" json:"has_due_date,omitempty"`
+
+	// CreatedAfter Only todos created after this time
+	CreatedAfter *time.Time `form:"created_after,omitempty" json:"created_after,omitempty"`
+
+	// TagId Only todos carrying this tag; repeat to require several
+	TagId *[]string `form:"tag_id,omitempty" json:"tag_id,omitempty"`
+
+	// ProjectId Only todos of this project, or `inbox` for todos without one
+	ProjectId *string `form:"project_id,omitempty" json:"project_id,omitempty"`
+
+	// Sort Field to sort by
+	Sort *TodoSortField `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Order Sort direction
+	Order *SortOrder `form:"order,omitempty" json:"order,omitempty"`
+}
+
+// SearchTodosParams defines parameters for SearchTodos.
+type SearchTodosParams struct {
+	// Q Search text
+	Q string `form:"q" json:"q"`
+
+	// Limit Maximum number of results to return
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// ListTodoViewParams defines parameters for ListTodoView.
+type ListTodoViewParams struct {
+	// Limit Maximum number of todos to return
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor Opaque cursor from a previous page's next_cursor
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// ListCommentsParams defines parameters for ListComments.
+type ListCommentsParams struct {
+	// Limit Maximum number of comments to return
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor Opaque cursor from a previous page's next_cursor
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// ListTodoOccurrencesParams defines parameters for ListTodoOccurrences.
+type ListTodoOccurrencesParams struct {
+	// Count How many occurrences to preview, up to 50
+	Count *int `form:"count,omitempty" json:"count,omitempty"`
+}
+
+// DeleteTodoParams defines parameters for DeleteTodo.
+type DeleteTodoParams struct {
+	// Cascade Also delete the todo's subtasks; without it a todo with subtasks is not deleted
+	Cascade *bool `form:"cascade,omitempty" json:"cascade,omitempty"`
+
+	// Permanent Remove the todo for good instead of moving it to the trash; also empties a trashed todo
+	Permanent *bool `form:"permanent,omitempty" json:"permanent,omitempty"`
+
+	// IfMatch ETag from an earlier response; the request fails with 412 if the todo has changed since
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// PatchTodoParams defines parameters for PatchTodo.
+type PatchTodoParams struct {
+	// Force Complete the todo even while open todos block it
+	Force *bool `form:"force,omitempty" json:"force,omitempty"`
+
+	// IfMatch ETag from an earlier response; the request fails with 412 if the todo has changed since
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// UpdateTodoParams defines parameters for UpdateTodo.
+type UpdateTodoParams struct {
+	// Force Complete the todo even while open todos block it
+	Force *bool `form:"force,omitempty" json:"force,omitempty"`
+
+	// IfMatch ETag from an earlier response; the request fails with 412 if the todo has changed since
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// LoginJSONRequestBody defines body for Login for application/json ContentType.
+type LoginJSONRequestBody = LoginRequest
+
+// RefreshTokenJSONRequestBody defines body for RefreshToken for application/json ContentType.
+type RefreshTokenJSONRequestBody = RefreshTokenRequest
+
+// RegisterJSONRequestBody defines body for Register for application/json ContentType.
+type RegisterJSONRequestBody = RegisterRequest
+
+// SwitchTenantJSONRequestBody defines body for SwitchTenant for application/json ContentType.
+type SwitchTenantJSONRequestBody = SwitchTenantRequest
+
+// VerifyEmailJSONRequestBody defines body for VerifyEmail for application/json ContentType.
+type VerifyEmailJSONRequestBody = VerifyEmailRequest
+
+// CreateProjectJSONRequestBody defines body for CreateProject for application/json ContentType.
+type CreateProjectJSONRequestBody = CreateProjectRequest
+
+// UpdateProjectJSONRequestBody defines body for UpdateProject for application/json ContentType.
+type UpdateProjectJSONRequestBody = UpdateProjectRequest
+
+// CreateTagJSONRequestBody defines body for CreateTag for application/json ContentType.
+type CreateTagJSONRequestBody = CreateTagRequest
+
+// UpdateTagJSONRequestBody defines body for UpdateTag for application/json ContentType.
+type UpdateTagJSONRequestBody = UpdateTagRequest
+
+// UpdateMeJSONRequestBody defines body for UpdateMe for application/json ContentType.
+type UpdateMeJSONRequestBody = UpdateUserRequest
+
+// UpdateMyDigestJSONRequestBody defines body for UpdateMyDigest for application/json ContentType.
+type UpdateMyDigestJSONRequestBody = UpdateDigestPreferenceRequest
+
+// CreateMyTenantJSONRequestBody defines body for CreateMyTenant for application/json ContentType.
+type CreateMyTenantJSONRequestBody = CreateTenantRequest
+
+// UpdateTenantJSONRequestBody defines body for UpdateTenant for application/json ContentType.
+type UpdateTenantJSONRequestBody = UpdateTenantRequest
+
+// AddTenantDomainJSONRequestBody defines body for AddTenantDomain for application/json ContentType.
+type AddTenantDomainJSONRequestBody = AddTenantDomainRequest
+
+// BatchTodosJSONRequestBody defines body for BatchTodos for application/json ContentType.
+type BatchTodosJSONRequestBody = BatchTodoRequest
+
+// CreateTodoJSONRequestBody defines body for CreateTodo for application/json ContentType.
+type CreateTodoJSONRequestBody = CreateTodoRequest
+
+// PatchTodoApplicationMergePatchPlusJSONRequestBody defines body for PatchTodo for application/merge-patch+json ContentType.
+type PatchTodoApplicationMergePatchPlusJSONRequestBody = PatchTodoRequest
+
+// PatchTodoJSONRequestBody defines body for PatchTodo for application/json ContentType.
+type PatchTodoJSONRequestBody = PatchTodoRequest
+
+// UpdateTodoJSONRequestBody defines body for UpdateTodo for application/json ContentType.
+type UpdateTodoJSONRequestBody = UpdateTodoRequest
+
+// AssignTodoJSONRequestBody defines body for AssignTodo for application/json ContentType.
+type AssignTodoJSONRequestBody = AssignTodoRequest
+
+// AddBlockerJSONRequestBody defines body for AddBlocker for application/json ContentType.
+type AddBlockerJSONRequestBody = AddBlockerRequest
+
+// CreateCommentJSONRequestBody defines body for CreateComment for application/json ContentType.
+type CreateCommentJSONRequestBody = CreateCommentRequest
+
+// UpdateCommentJSONRequestBody defines body for UpdateComment for application/json ContentType.
+type UpdateCommentJSONRequestBody = UpdateCommentRequest
+
+// MoveTodoJSONRequestBody defines body for MoveTodo for application/json ContentType.
+type MoveTodoJSONRequestBody = MoveTodoRequest
+
+// CreateReminderJSONRequestBody defines body for CreateReminder for application/json ContentType.
+type CreateReminderJSONRequestBody = CreateReminderRequest
+
+// SnoozeTodoJSONRequestBody defines body for SnoozeTodo for application/json ContentType.
+type SnoozeTodoJSONRequestBody = SnoozeTodoRequest
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Login
+	// (POST /auth/login)
+	Login(ctx echo.Context) error
+	// Refresh access token
+	// (POST /auth/refresh)
+	RefreshToken(ctx echo.Context) error
+	// Register a new user and tenant
+	// (POST /auth/register)
+	Register(ctx echo.Context) error
+	// Issue tokens for another tenant membership
+	// (POST /auth/switch-tenant)
+	SwitchTenant(ctx echo.Context) error
+	// Verify email address
+	// (POST /auth/verify-email)
+	VerifyEmail(ctx echo.Context) error
+	// Health check
+	// (GET /health)
+	GetHealth(ctx echo.Context) error
+	// Get current user
+	// (GET /me)
+	GetMe(ctx echo.Context) error
+	// Update current user
+	// (PUT /me)
+	UpdateMe(ctx echo.Context) error
+	// Get your daily digest preference in the current tenant
+	// (GET /me/digest)
+	GetMyDigest(ctx echo.Context) error
+	// Set your daily digest preference in the current tenant
+	// (PUT /me/digest)
+	UpdateMyDigest(ctx echo.Context) error
+	// List current user's tenant memberships
+	// (GET /me/tenants)
+	ListMyTenants(ctx echo.Context) error
+	// Create a tenant with the current user as its admin
+	// (POST /me/tenants)
+	CreateMyTenant(ctx echo.Context) error
+	// List the projects you can see
+	// (GET /projects)
+	ListProjects(ctx echo.Context, params ListProjectsParams) error
+	// Create a project
+	// (POST /projects)
+	CreateProject(ctx echo.Context) error
+	// Delete a project
+	// (DELETE /projects/{id})
+	DeleteProject(ctx echo.Context, id string, params DeleteProjectParams) error
+	// Get a project
+	// (GET /projects/{id})
+	GetProject(ctx echo.Context, id string) error
+	// Update a project
+	// (PATCH /projects/{id})
+	UpdateProject(ctx echo.Context, id string) error
+	// List a project's todos
+	// (GET /projects/{id}/todos)
+	ListProjectTodos(ctx echo.Context, id string, params ListProjectTodosParams) error
+	// List the tenant's tags
+	// (GET /tags)
+	ListTags(ctx echo.Context) error
+	// Create a tag
+	// (POST /tags)
+	CreateTag(ctx echo.Context) error
+	// Delete a tag
+	// (DELETE /tags/{id})
+	DeleteTag(ctx echo.Context, id string) error
+	// Update a tag
+	// (PATCH /tags/{id})
+	UpdateTag(ctx echo.Context, id string) error
+	// Get current tenant
+	// (GET /tenant)
+	GetTenant(ctx echo.Context) error
+	// Update current tenant
+	// (PUT /tenant)
+	UpdateTenant(ctx echo.Context) error
+	// List custom domains of current tenant
+	// (GET /tenant/domains)
+	ListTenantDomains(ctx echo.Context) error
+	// Register a custom domain for current tenant
+	// (POST /tenant/domains)
+	AddTenantDomain(ctx echo.Context) error
+	// Remove a custom domain
+	// (DELETE /tenant/domains/{id})
+	RemoveTenantDomain(ctx echo.Context, id string) error
+	// Verify a custom domain
+	// (POST /tenant/domains/{id}/verify)
+	VerifyTenantDomain(ctx echo.Context, id string) error
+	// List user's todos
+	// (GET /todos)
+	ListTodos(ctx echo.Context, params ListTodosParams) error
+	// Create a new todo
+	// (POST /todos)
+	CreateTodo(ctx echo.Context) error
+	// List public todos in tenant
+	// (GET /todos-public)
+	ListPublicTodos(ctx echo.Context, params ListPublicTodosParams) error
+	// List the todos assigned to you
+	// (GET /todos/assigned-to-me)
+	ListAssignedTodos(ctx echo.Context, params ListAssignedTodosParams) error
+	// Run operations on many todos
+	// (POST /todos/batch)
+	BatchTodos(ctx echo.Context) error
+	// Search own and public todos
+	// (GET /todos/search)
+	SearchTodos(ctx echo.Context, params SearchTodosParams) error
+	// List the actor's todos in the trash
+	// (GET /todos/trash)
+	ListTrashedTodos(ctx echo.Context, params ListTrashedTodosParams) error
+	// List one of your smart views of open todos, most urgent first
+	// (GET /todos/views/{view})
+	ListTodoView(ctx echo.Context, view TodoView, params ListTodoViewParams) error
+	// Delete a todo
+	// (DELETE /todos/{id})
+	DeleteTodo(ctx echo.Context, id string, params DeleteTodoParams) error
+	// Partially update a todo
+	// (PATCH /todos/{id})
+	PatchTodo(ctx echo.Context, id string, params PatchTodoParams) error
+	// Update a todo
+	// (PUT /todos/{id})
+	UpdateTodo(ctx echo.Context, id string, params UpdateTodoParams) error
+	// List a todo's activity
+	// (GET /todos/{id}/activity)
+	ListTodoActivity(ctx echo.Context, id string) error
+	// Unassign a todo
+	// (DELETE /todos/{id}/assignee)
+	UnassignTodo(ctx echo.Context, id string) error
+	// Assign a todo to a tenant member
+	// (PUT /todos/{id}/assignee)
+	AssignTodo(ctx echo.Context, id string) error
+	// List the todos blocking a todo
+	// (GET /todos/{id}/blockers)
+	ListBlockers(ctx echo.Context, id string) error
+	// Block a todo on another todo
+	// (POST /todos/{id}/blockers)
+	AddBlocker(ctx echo.Context, id string) error
+	// Remove a blocker from a todo
+	// (DELETE /todos/{id}/blockers/{blocker_id})
+	RemoveBlocker(ctx echo.Context, id string, blocker_id string) error
+	// List a todo's comments, oldest first
+	// (GET /todos/{id}/comments)
+	ListComments(ctx echo.Context, id string, params ListCommentsParams) error
+	// Comment on a todo
+	// (POST /todos/{id}/comments)
+	CreateComment(ctx echo.Context, id string) error
+	// Delete a comment
+	// (DELETE /todos/{id}/comments/{comment_id})
+	DeleteComment(ctx echo.Context, id string, comment_id string) error
+	// Edit a comment
+	// (PATCH /todos/{id}/comments/{comment_id})
+	UpdateComment(ctx echo.Context, id string, comment_id string) error
+	// Move a todo in the manual order
+	// (POST /todos/{id}/move)
+	MoveTodo(ctx echo.Context, id string) error
+	// Preview the next occurrences of a recurring todo
+	// (GET /todos/{id}/occurrences)
+	ListTodoOccurrences(ctx echo.Context, id string, params ListTodoOccurrencesParams) error
+	// List a todo's reminders
+	// (GET /todos/{id}/reminders)
+	ListReminders(ctx echo.Context, id string) error
+	// Set an email reminder on a todo
+	// (POST /todos/{id}/reminders)
+	CreateReminder(ctx echo.Context, id string) error
+	// Remove a reminder from a todo
+	// (DELETE /todos/{id}/reminders/{reminder_id})
+	DeleteReminder(ctx echo.Context, id string, reminder_id string) error
+	// Restore a todo from the trash
+	// (POST /todos/{id}/restore)
+	RestoreTodo(ctx echo.Context, id string) error
+	// Bring a snoozed todo back
+	// (DELETE /todos/{id}/snooze)
+	UnsnoozeTodo(ctx echo.Context, id string) error
+	// Snooze a todo
+	// (POST /todos/{id}/snooze)
+	SnoozeTodo(ctx echo.Context, id string) error
+	// List a todo's subtasks
+	// (GET /todos/{id}/subtasks)
+	ListSubtasks(ctx echo.Context, id string) error
+}
+
+// ServerInterfaceWrapper converts echo contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler ServerInterface
+}
+
+// Login converts echo context to params.
+func (w *ServerInterfaceWrapper) Login(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.Login(ctx)
+	return err
+}
+
+// RefreshToken converts echo context to params.
+func (w *ServerInterfaceWrapper) RefreshToken(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.RefreshToken(ctx)
 	return err
 }
@@ -234,6 +1275,17 @@ func (w *ServerInterfaceWrapper) Register(ctx echo.Context) error {
 	return err
 }
 
+// SwitchTenant converts echo context to params.
+func (w *ServerInterfaceWrapper) SwitchTenant(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.SwitchTenant(ctx)
+	return err
+}
+
 // VerifyEmail converts echo context to params.
 func (w *ServerInterfaceWrapper) VerifyEmail(ctx echo.Context) error {
 	var err error
@@ -274,41 +1326,83 @@ func (w *ServerInterfaceWrapper) UpdateMe(ctx echo.Context) error {
 	return err
 }
 
-// ListTodos converts echo context to params.
-func (w *ServerInterfaceWrapper) ListTodos(ctx echo.Context) error {
+// GetMyDigest converts echo context to params.
+func (w *ServerInterfaceWrapper) GetMyDigest(ctx echo.Context) error {
 	var err error
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.ListTodos(ctx)
+	err = w.Handler.GetMyDigest(ctx)
 	return err
 }
 
-// CreateTodo converts echo context to params.
-func (w *ServerInterfaceWrapper) CreateTodo(ctx echo.Context) error {
+// UpdateMyDigest converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateMyDigest(ctx echo.Context) error {
 	var err error
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.CreateTodo(ctx)
+	err = w.Handler.UpdateMyDigest(ctx)
 	return err
 }
 
-// ListPublicTodos converts echo context to params.
-func (w *ServerInterfaceWrapper) ListPublicTodos(ctx echo.Context) error {
+// ListMyTenants converts echo context to params.
+func (w *ServerInterfaceWrapper) ListMyTenants(ctx echo.Context) error {
 	var err error
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.ListPublicTodos(ctx)
+	err = w.Handler.ListMyTenants(ctx)
 	return err
 }
 
-// DeleteTodo converts echo context to params.
-func (w *ServerInterfaceWrapper) DeleteTodo(ctx echo.Context) error {
+// CreateMyTenant converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateMyTenant(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateMyTenant(ctx)
+	return err
+}
+
+// ListProjects converts echo context to params.
+func (w *ServerInterfaceWrapper) ListProjects(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListProjectsParams
+	// ------------- Optional query parameter "include_archived" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include_archived", ctx.QueryParams(), &params.IncludeArchived)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter include_archived: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListProjects(ctx, params)
+	return err
+}
+
+// CreateProject converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateProject(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateProject(ctx)
+	return err
+}
+
+// DeleteProject converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteProject(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "id" -------------
 	var id string
@@ -320,13 +1414,1270 @@ func (w *ServerInterfaceWrapper) DeleteTodo(ctx echo.Context) error {
 
 	ctx.Set(BearerAuthScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteProjectParams
+
+	// ------------- Optional query parameter "delete_todos" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "delete_todos", ctx.QueryParams(), &params.DeleteTodos)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter delete_todos: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeleteTodo(ctx, id)
+	err = w.Handler.DeleteProject(ctx, id, params)
 	return err
 }
 
-// UpdateTodo converts echo context to params.
-func (w *ServerInterfaceWrapper) UpdateTodo(ctx echo.Context) error {
+// GetProject converts echo context to params.
+func (w *ServerInterfaceWrapper) GetProject(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetProject(ctx, id)
+	return err
+}
+
+// UpdateProject converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateProject(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpdateProject(ctx, id)
+	return err
+}
+
+// ListProjectTodos converts echo context to params.
+func (w *ServerInterfaceWrapper) ListProjectTodos(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListProjectTodosParams
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
+	// ------------- Optional query parameter "query" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "query", ctx.QueryParams(), &params.Query)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter query: %s", err))
+	}
+
+	// ------------- Optional query parameter "completed" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "completed", ctx.QueryParams(), &params.Completed)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter completed: %s", err))
+	}
+
+	// ------------- Optional query parameter "due_before" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "due_before", ctx.QueryParams(), &params.DueBefore)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter due_before: %s", err))
+	}
+
+	// ------------- Optional query parameter "due_after" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "due_after", ctx.QueryParams(), &params.DueAfter)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter due_after: %s", err))
+	}
+
+	// ------------- Optional query parameter "overdue" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "overdue", ctx.QueryParams(), &params.Overdue)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter overdue: %s", err))
+	}
+
+	// ------------- Optional query parameter "has_due_date" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "has_due_date", ctx.QueryParams(), &params.HasDueDate)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter has_due_date: %s", err))
+	}
+
+	// ------------- Optional query parameter "created_after" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "created_after", ctx.QueryParams(), &params.CreatedAfter)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter created_after: %s", err))
+	}
+
+	// ------------- Optional query parameter "tag_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tag_id", ctx.QueryParams(), &params.TagId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tag_id: %s", err))
+	}
+
+	// ------------- Optional query parameter "include_snoozed" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include_snoozed", ctx.QueryParams(), &params.IncludeSnoozed)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter include_snoozed: %s", err))
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", ctx.QueryParams(), &params.Sort)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sort: %s", err))
+	}
+
+	// ------------- Optional query parameter "order" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order", ctx.QueryParams(), &params.Order)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter order: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListProjectTodos(ctx, id, params)
+	return err
+}
+
+// ListTags converts echo context to params.
+func (w *ServerInterfaceWrapper) ListTags(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListTags(ctx)
+	return err
+}
+
+// CreateTag converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateTag(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateTag(ctx)
+	return err
+}
+
+// DeleteTag converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteTag(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteTag(ctx, id)
+	return err
+}
+
+// UpdateTag converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateTag(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpdateTag(ctx, id)
+	return err
+}
+
+// GetTenant converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTenant(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTenant(ctx)
+	return err
+}
+
+// UpdateTenant converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateTenant(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpdateTenant(ctx)
+	return err
+}
+
+// ListTenantDomains converts echo context to params.
+func (w *ServerInterfaceWrapper) ListTenantDomains(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListTenantDomains(ctx)
+	return err
+}
+
+// AddTenantDomain converts echo context to params.
+func (w *ServerInterfaceWrapper) AddTenantDomain(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.AddTenantDomain(ctx)
+	return err
+}
+
+// RemoveTenantDomain converts echo context to params.
+func (w *ServerInterfaceWrapper) RemoveTenantDomain(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.RemoveTenantDomain(ctx, id)
+	return err
+}
+
+// VerifyTenantDomain converts echo context to params.
+func (w *ServerInterfaceWrapper) VerifyTenantDomain(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.VerifyTenantDomain(ctx, id)
+	return err
+}
+
+// ListTodos converts echo context to params.
+func (w *ServerInterfaceWrapper) ListTodos(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListTodosParams
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
+	// ------------- Optional query parameter "query" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "query", ctx.QueryParams(), &params.Query)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter query: %s", err))
+	}
+
+	// ------------- Optional query parameter "completed" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "completed", ctx.QueryParams(), &params.Completed)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter completed: %s", err))
+	}
+
+	// ------------- Optional query parameter "due_before" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "due_before", ctx.QueryParams(), &params.DueBefore)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter due_before: %s", err))
+	}
+
+	// ------------- Optional query parameter "due_after" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "due_after", ctx.QueryParams(), &params.DueAfter)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter due_after: %s", err))
+	}
+
+	// ------------- Optional query parameter "overdue" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "overdue", ctx.QueryParams(), &params.Overdue)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter overdue: %s", err))
+	}
+
+	// ------------- Optional query parameter "has_due_date" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "has_due_date", ctx.QueryParams(), &params.HasDueDate)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter has_due_date: %s", err))
+	}
+
+	// ------------- Optional query parameter "created_after" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "created_after", ctx.QueryParams(), &params.CreatedAfter)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter created_after: %s", err))
+	}
+
+	// ------------- Optional query parameter "tag_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tag_id", ctx.QueryParams(), &params.TagId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tag_id: %s", err))
+	}
+
+	// ------------- Optional query parameter "project_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "project_id", ctx.QueryParams(), &params.ProjectId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter project_id: %s", err))
+	}
+
+	// ------------- Optional query parameter "include_snoozed" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include_snoozed", ctx.QueryParams(), &params.IncludeSnoozed)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter include_snoozed: %s", err))
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", ctx.QueryParams(), &params.Sort)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sort: %s", err))
+	}
+
+	// ------------- Optional query parameter "order" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order", ctx.QueryParams(), &params.Order)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter order: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListTodos(ctx, params)
+	return err
+}
+
+// CreateTodo converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateTodo(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateTodo(ctx)
+	return err
+}
+
+// ListPublicTodos converts echo context to params.
+func (w *ServerInterfaceWrapper) ListPublicTodos(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListPublicTodosParams
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
+	// ------------- Optional query parameter "query" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "query", ctx.QueryParams(), &params.Query)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter query: %s", err))
+	}
+
+	// ------------- Optional query parameter "completed" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "completed", ctx.QueryParams(), &params.Completed)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter completed: %s", err))
+	}
+
+	// ------------- Optional query parameter "due_before" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "due_before", ctx.QueryParams(), &params.DueBefore)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter due_before: %s", err))
+	}
+
+	// ------------- Optional query parameter "due_after" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "due_after", ctx.QueryParams(), &params.DueAfter)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter due_after: %s", err))
+	}
+
+	// ------------- Optional query parameter "overdue" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "overdue", ctx.QueryParams(), &params.Overdue)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter overdue: %s", err))
+	}
+
+	// ------------- Optional query parameter "has_due_date" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "has_due_date", ctx.QueryParams(), &params.HasDueDate)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter has_due_date: %s", err))
+	}
+
+	// ------------- Optional query parameter "created_after" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "created_after", ctx.QueryParams(), &params.CreatedAfter)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter created_after: %s", err))
+	}
+
+	// ------------- Optional query parameter "tag_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tag_id", ctx.QueryParams(), &params.TagId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tag_id: %s", err))
+	}
+
+	// ------------- Optional query parameter "project_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "project_id", ctx.QueryParams(), &params.ProjectId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter project_id: %s", err))
+	}
+
+	// ------------- Optional query parameter "include_snoozed" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include_snoozed", ctx.QueryParams(), &params.IncludeSnoozed)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter include_snoozed: %s", err))
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", ctx.QueryParams(), &params.Sort)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sort: %s", err))
+	}
+
+	// ------------- Optional query parameter "order" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order", ctx.QueryParams(), &params.Order)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter order: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListPublicTodos(ctx, params)
+	return err
+}
+
+// ListAssignedTodos converts echo context to params.
+func (w *ServerInterfaceWrapper) ListAssignedTodos(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListAssignedTodosParams
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
+	// ------------- Optional query parameter "include_snoozed" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include_snoozed", ctx.QueryParams(), &params.IncludeSnoozed)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter include_snoozed: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListAssignedTodos(ctx, params)
+	return err
+}
+
+// BatchTodos converts echo context to params.
+func (w *ServerInterfaceWrapper) BatchTodos(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.BatchTodos(ctx)
+	return err
+}
+
+// SearchTodos converts echo context to params.
+func (w *ServerInterfaceWrapper) SearchTodos(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SearchTodosParams
+	// ------------- Required query parameter "q" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "q", ctx.QueryParams(), &params.Q)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter q: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.SearchTodos(ctx, params)
+	return err
+}
+
+// ListTrashedTodos converts echo context to params.
+func (w *ServerInterfaceWrapper) ListTrashedTodos(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListTrashedTodosParams
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
+	// ------------- Optional query parameter "query" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "query", ctx.QueryParams(), &params.Query)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter query: %s", err))
+	}
+
+	// ------------- Optional query parameter "completed" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "completed", ctx.QueryParams(), &params.Completed)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter completed: %s", err))
+	}
+
+	// ------------- Optional query parameter "due_before" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "due_before", ctx.QueryParams(), &params.DueBefore)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter due_before: %s", err))
+	}
+
+	// ------------- Optional query parameter "due_after" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "due_after", ctx.QueryParams(), &params.DueAfter)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter due_after: %s", err))
+	}
+
+	// ------------- Optional query parameter "overdue" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "overdue", ctx.QueryParams(), &params.Overdue)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter overdue: %s", err))
+	}
+
+	// ------------- Optional query parameter "has_due_date" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "has_due_date", ctx.QueryParams(), &params.HasDueDate)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter has_due_date: %s", err))
+	}
+
+	// ------------- Optional query parameter "created_after" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "created_after", ctx.QueryParams(), &params.CreatedAfter)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter created_after: %s", err))
+	}
+
+	// ------------- Optional query parameter "tag_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tag_id", ctx.QueryParams(), &params.TagId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tag_id: %s", err))
+	}
+
+	// ------------- Optional query parameter "project_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "project_id", ctx.QueryParams(), &params.ProjectId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter project_id: %s", err))
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", ctx.QueryParams(), &params.Sort)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sort: %s", err))
+	}
+
+	// ------------- Optional query parameter "order" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order", ctx.QueryParams(), &params.Order)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter order: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListTrashedTodos(ctx, params)
+	return err
+}
+
+// ListTodoView converts echo context to params.
+func (w *ServerInterfaceWrapper) ListTodoView(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "view" -------------
+	var view TodoView
+
+	err = runtime.BindStyledParameterWithOptions("simple", "view", ctx.Param("view"), &view, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter view: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListTodoViewParams
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListTodoView(ctx, view, params)
+	return err
+}
+
+// DeleteTodo converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteTodo(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteTodoParams
+
+	// ------------- Optional query parameter "cascade" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cascade", ctx.QueryParams(), &params.Cascade)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cascade: %s", err))
+	}
+
+	// ------------- Optional query parameter "permanent" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "permanent", ctx.QueryParams(), &params.Permanent)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter permanent: %s", err))
+	}
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteTodo(ctx, id, params)
+	return err
+}
+
+// PatchTodo converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchTodo(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PatchTodoParams
+
+	// ------------- Optional query parameter "force" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "force", ctx.QueryParams(), &params.Force)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter force: %s", err))
+	}
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PatchTodo(ctx, id, params)
+	return err
+}
+
+// UpdateTodo converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateTodo(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdateTodoParams
+
+	// ------------- Optional query parameter "force" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "force", ctx.QueryParams(), &params.Force)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter force: %s", err))
+	}
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpdateTodo(ctx, id, params)
+	return err
+}
+
+// ListTodoActivity converts echo context to params.
+func (w *ServerInterfaceWrapper) ListTodoActivity(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListTodoActivity(ctx, id)
+	return err
+}
+
+// UnassignTodo converts echo context to params.
+func (w *ServerInterfaceWrapper) UnassignTodo(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UnassignTodo(ctx, id)
+	return err
+}
+
+// AssignTodo converts echo context to params.
+func (w *ServerInterfaceWrapper) AssignTodo(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.AssignTodo(ctx, id)
+	return err
+}
+
+// ListBlockers converts echo context to params.
+func (w *ServerInterfaceWrapper) ListBlockers(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListBlockers(ctx, id)
+	return err
+}
+
+// AddBlocker converts echo context to params.
+func (w *ServerInterfaceWrapper) AddBlocker(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.AddBlocker(ctx, id)
+	return err
+}
+
+// RemoveBlocker converts echo context to params.
+func (w *ServerInterfaceWrapper) RemoveBlocker(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+	// ------------- Path parameter "blocker_id" -------------
+	var blocker_id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "blocker_id", ctx.Param("blocker_id"), &blocker_id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter blocker_id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.RemoveBlocker(ctx, id, blocker_id)
+	return err
+}
+
+// ListComments converts echo context to params.
+func (w *ServerInterfaceWrapper) ListComments(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListCommentsParams
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListComments(ctx, id, params)
+	return err
+}
+
+// CreateComment converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateComment(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateComment(ctx, id)
+	return err
+}
+
+// DeleteComment converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteComment(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+	// ------------- Path parameter "comment_id" -------------
+	var comment_id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "comment_id", ctx.Param("comment_id"), &comment_id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter comment_id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteComment(ctx, id, comment_id)
+	return err
+}
+
+// UpdateComment converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateComment(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+	// ------------- Path parameter "comment_id" -------------
+	var comment_id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "comment_id", ctx.Param("comment_id"), &comment_id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter comment_id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpdateComment(ctx, id, comment_id)
+	return err
+}
+
+// MoveTodo converts echo context to params.
+func (w *ServerInterfaceWrapper) MoveTodo(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.MoveTodo(ctx, id)
+	return err
+}
+
+// ListTodoOccurrences converts echo context to params.
+func (w *ServerInterfaceWrapper) ListTodoOccurrences(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListTodoOccurrencesParams
+	// ------------- Optional query parameter "count" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "count", ctx.QueryParams(), &params.Count)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter count: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListTodoOccurrences(ctx, id, params)
+	return err
+}
+
+// ListReminders converts echo context to params.
+func (w *ServerInterfaceWrapper) ListReminders(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListReminders(ctx, id)
+	return err
+}
+
+// CreateReminder converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateReminder(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateReminder(ctx, id)
+	return err
+}
+
+// DeleteReminder converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteReminder(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+	// ------------- Path parameter "reminder_id" -------------
+	var reminder_id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "reminder_id", ctx.Param("reminder_id"), &reminder_id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter reminder_id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteReminder(ctx, id, reminder_id)
+	return err
+}
+
+// RestoreTodo converts echo context to params.
+func (w *ServerInterfaceWrapper) RestoreTodo(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.RestoreTodo(ctx, id)
+	return err
+}
+
+// UnsnoozeTodo converts echo context to params.
+func (w *ServerInterfaceWrapper) UnsnoozeTodo(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UnsnoozeTodo(ctx, id)
+	return err
+}
+
+// SnoozeTodo converts echo context to params.
+func (w *ServerInterfaceWrapper) SnoozeTodo(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.SnoozeTodo(ctx, id)
+	return err
+}
+
+// ListSubtasks converts echo context to params.
+func (w *ServerInterfaceWrapper) ListSubtasks(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "id" -------------
 	var id string
@@ -339,7 +2690,7 @@ func (w *ServerInterfaceWrapper) UpdateTodo(ctx echo.Context) error {
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.UpdateTodo(ctx, id)
+	err = w.Handler.ListSubtasks(ctx, id)
 	return err
 }
 
@@ -374,14 +2725,60 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.POST(baseURL+"/auth/login", wrapper.Login)
 	router.POST(baseURL+"/auth/refresh", wrapper.RefreshToken)
 	router.POST(baseURL+"/auth/register", wrapper.Register)
+	router.POST(baseURL+"/auth/switch-tenant", wrapper.SwitchTenant)
 	router.POST(baseURL+"/auth/verify-email", wrapper.VerifyEmail)
 	router.GET(baseURL+"/health", wrapper.GetHealth)
 	router.GET(baseURL+"/me", wrapper.GetMe)
 	router.PUT(baseURL+"/me", wrapper.UpdateMe)
+	router.GET(baseURL+"/me/digest", wrapper.GetMyDigest)
+	router.PUT(baseURL+"/me/digest", wrapper.UpdateMyDigest)
+	router.GET(baseURL+"/me/tenants", wrapper.ListMyTenants)
+	router.POST(baseURL+"/me/tenants", wrapper.CreateMyTenant)
+	router.GET(baseURL+"/projects", wrapper.ListProjects)
+	router.POST(baseURL+"/projects", wrapper.CreateProject)
+	router.DELETE(baseURL+"/projects/:id", wrapper.DeleteProject)
+	router.GET(baseURL+"/projects/:id", wrapper.GetProject)
+	router.PATCH(baseURL+"/projects/:id", wrapper.UpdateProject)
+	router.GET(baseURL+"/projects/:id/todos", wrapper.ListProjectTodos)
+	router.GET(baseURL+"/tags", wrapper.ListTags)
+	router.POST(baseURL+"/tags", wrapper.CreateTag)
+	router.DELETE(baseURL+"/tags/:id", wrapper.DeleteTag)
+	router.PATCH(baseURL+"/tags/:id", wrapper.UpdateTag)
+	router.GET(baseURL+"/tenant", wrapper.GetTenant)
+	router.PUT(baseURL+"/tenant", wrapper.UpdateTenant)
+	router.GET(baseURL+"/tenant/domains", wrapper.ListTenantDomains)
+	router.POST(baseURL+"/tenant/domains", wrapper.AddTenantDomain)
+	router.DELETE(baseURL+"/tenant/domains/:id", wrapper.RemoveTenantDomain)
+	router.POST(baseURL+"/tenant/domains/:id/verify", wrapper.VerifyTenantDomain)
 	router.GET(baseURL+"/todos", wrapper.ListTodos)
 	router.POST(baseURL+"/todos", wrapper.CreateTodo)
 	router.GET(baseURL+"/todos-public", wrapper.ListPublicTodos)
+	router.GET(baseURL+"/todos/assigned-to-me", wrapper.ListAssignedTodos)
+	router.POST(baseURL+"/todos/batch", wrapper.BatchTodos)
+	router.GET(baseURL+"/todos/search", wrapper.SearchTodos)
+	router.GET(baseURL+"/todos/trash", wrapper.ListTrashedTodos)
+	router.GET(baseURL+"/todos/views/:view", wrapper.ListTodoView)
 	router.DELETE(baseURL+"/todos/:id", wrapper.DeleteTodo)
+	router.PATCH(baseURL+"/todos/:id", wrapper.PatchTodo)
 	router.PUT(baseURL+"/todos/:id", wrapper.UpdateTodo)
+	router.GET(baseURL+"/todos/:id/activity", wrapper.ListTodoActivity)
+	router.DELETE(baseURL+"/todos/:id/assignee", wrapper.UnassignTodo)
+	router.PUT(baseURL+"/todos/:id/assignee", wrapper.AssignTodo)
+	router.GET(baseURL+"/todos/:id/blockers", wrapper.ListBlockers)
+	router.POST(baseURL+"/todos/:id/blockers", wrapper.AddBlocker)
+	router.DELETE(baseURL+"/todos/:id/blockers/:blocker_id", wrapper.RemoveBlocker)
+	router.GET(baseURL+"/todos/:id/comments", wrapper.ListComments)
+	router.POST(baseURL+"/todos/:id/comments", wrapper.CreateComment)
+	router.DELETE(baseURL+"/todos/:id/comments/:comment_id", wrapper.DeleteComment)
+	router.PATCH(baseURL+"/todos/:id/comments/:comment_id", wrapper.UpdateComment)
+	router.POST(baseURL+"/todos/:id/move", wrapper.MoveTodo)
+	router.GET(baseURL+"/todos/:id/occurrences", wrapper.ListTodoOccurrences)
+	router.GET(baseURL+"/todos/:id/reminders", wrapper.ListReminders)
+	router.POST(baseURL+"/todos/:id/reminders", wrapper.CreateReminder)
+	router.DELETE(baseURL+"/todos/:id/reminders/:reminder_id", wrapper.DeleteReminder)
+	router.POST(baseURL+"/todos/:id/restore", wrapper.RestoreTodo)
+	router.DELETE(baseURL+"/todos/:id/snooze", wrapper.UnsnoozeTodo)
+	router.POST(baseURL+"/todos/:id/snooze", wrapper.SnoozeTodo)
+	router.GET(baseURL+"/todos/:id/subtasks", wrapper.ListSubtasks)
 
 }
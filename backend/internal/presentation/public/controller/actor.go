@@ -0,0 +1,34 @@
+package controller
+
+import (
+	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/presentation/public/router/context_keys"
+	"good-todo-go/internal/usecase/input"
+
+	"github.com/labstack/echo/v4"
+)
+
+// actorFromContext builds the usecase actor from the claims set by the JWT middleware.
+func actorFromContext(c echo.Context) (input.Actor, bool) {
+	userID, ok := c.Get(context_keys.UserIDContextKey).(string)
+	if !ok || userID == "" {
+		return input.Actor{}, false
+	}
+	tenantID, ok := c.Get(context_keys.TenantIDContextKey).(string)
+	if !ok || tenantID == "" {
+		return input.Actor{}, false
+	}
+	role, _ := c.Get(context_keys.RoleContextKey).(string)
+
+	return input.Actor{
+		UserID:   userID,
+		TenantID: tenantID,
+		Role:     model.UserRole(role),
+	}, true
+}
+
+// hostTenantFromContext returns the tenant identified by the request host, if any.
+func hostTenantFromContext(c echo.Context) (string, bool) {
+	tenantID, ok := c.Get(context_keys.HostTenantIDContextKey).(string)
+	return tenantID, ok && tenantID != ""
+}
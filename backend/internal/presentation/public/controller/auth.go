@@ -31,14 +31,25 @@ func NewAuthController(
 }
 
 func (ctrl *AuthController) Register(c echo.Context, req api.RegisterRequest) error {
-	out, err := ctrl.authUsecase.Register(c.Request().Context(), &input.RegisterInput{
+	inp := &input.RegisterInput{
 		Email:    string(req.Email),
 		Password: req.Password,
 		Name:     req.Name,
-	})
+	}
+	if req.TenantSlug != nil {
+		inp.TenantSlug = *req.TenantSlug
+	}
+
+	out, err := ctrl.authUsecase.Register(c.Request().Context(), inp)
 	if err != nil {
+		if err == usecase.ErrInvalidTenantSlug || err == usecase.ErrReservedTenantSlug {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
 		if err == usecase.ErrUserAlreadyExists {
-			return echo.NewHTTPError(http.StatusBadRequest, "user already exists")
+			return echo.NewHTTPError(http.StatusConflict, "user already exists")
+		}
+		if err == usecase.ErrTenantSlugTaken {
+			return echo.NewHTTPError(http.StatusConflict, "tenant slug already taken")
 		}
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
@@ -47,13 +58,9 @@ func (ctrl *AuthController) Register(c echo.Context, req api.RegisterRequest) er
 }
 
 func (ctrl *AuthController) Login(c echo.Context, req api.LoginRequest) error {
-	// First, find tenant by slug
-	tenant, err := ctrl.tenantRepo.FindBySlug(c.Request().Context(), req.TenantSlug)
+	tenantID, err := ctrl.loginTenantID(c, req)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
-	}
-	if tenant == nil {
-		return echo.NewHTTPError(http.StatusUnauthorized, "invalid credentials")
+		return err
 	}
 
 	// Cast to access the method with tenant ID
@@ -62,7 +69,7 @@ func (ctrl *AuthController) Login(c echo.Context, req api.LoginRequest) error {
 		return echo.NewHTTPError(http.StatusInternalServerError, "internal error")
 	}
 
-	out, err := authInteractor.LoginWithTenant(c.Request().Context(), tenant.ID, &input.LoginInput{
+	out, err := authInteractor.LoginWithTenant(c.Request().Context(), tenantID, &input.LoginInput{
 		Email:    string(req.Email),
 		Password: req.Password,
 	})
@@ -79,6 +86,28 @@ func (ctrl *AuthController) Login(c echo.Context, req api.LoginRequest) error {
 	return ctrl.authPresenter.Login(c, out)
 }
 
+// loginTenantID determines the tenant to log in to from the request host, falling back
+// to the tenant slug in the request body. When both are present they must agree.
+func (ctrl *AuthController) loginTenantID(c echo.Context, req api.LoginRequest) (string, error) {
+	hostTenantID, hasHostTenant := hostTenantFromContext(c)
+
+	if req.TenantSlug == nil || *req.TenantSlug == "" {
+		if !hasHostTenant {
+			return "", echo.NewHTTPError(http.StatusBadRequest, "tenant_slug is required")
+		}
+		return hostTenantID, nil
+	}
+
+	tenant, err := ctrl.tenantRepo.FindBySlug(c.Request().Context(), *req.TenantSlug)
+	if err != nil {
+		return "", echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	if tenant == nil || (hasHostTenant && tenant.ID != hostTenantID) {
+		return "", echo.NewHTTPError(http.StatusUnauthorized, "invalid credentials")
+	}
+	return tenant.ID, nil
+}
+
 func (ctrl *AuthController) VerifyEmail(c echo.Context, req api.VerifyEmailRequest) error {
 	out, err := ctrl.authUsecase.VerifyEmail(c.Request().Context(), &input.VerifyEmailInput{
 		Token: req.Token,
@@ -109,3 +138,53 @@ func (ctrl *AuthController) RefreshToken(c echo.Context, req api.RefreshTokenReq
 
 	return ctrl.authPresenter.RefreshToken(c, out)
 }
+
+func (ctrl *AuthController) SwitchTenant(c echo.Context, req api.SwitchTenantRequest) error {
+	actor, ok := actorFromContext(c)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	out, err := ctrl.authUsecase.SwitchTenant(c.Request().Context(), actor, &input.SwitchTenantInput{
+		TenantID: req.TenantId,
+	})
+	if err != nil {
+		if err == usecase.ErrNotTenantMember {
+			return echo.NewHTTPError(http.StatusForbidden, "not a member of the tenant")
+		}
+		if err == usecase.ErrUserNotFound {
+			return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return ctrl.authPresenter.SwitchTenant(c, out)
+}
+
+func (ctrl *AuthController) CreateTenant(c echo.Context, req api.CreateTenantRequest) error {
+	actor, ok := actorFromContext(c)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	inp := &input.CreateTenantInput{Name: req.Name}
+	if req.TenantSlug != nil {
+		inp.TenantSlug = *req.TenantSlug
+	}
+
+	out, err := ctrl.authUsecase.CreateTenant(c.Request().Context(), actor, inp)
+	if err != nil {
+		if err == usecase.ErrInvalidTenantSlug || err == usecase.ErrReservedTenantSlug {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+		if err == usecase.ErrTenantSlugTaken {
+			return echo.NewHTTPError(http.StatusConflict, "tenant slug already taken")
+		}
+		if err == usecase.ErrUserNotFound {
+			return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return ctrl.authPresenter.CreateTenant(c, out)
+}
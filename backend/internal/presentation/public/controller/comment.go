@@ -0,0 +1,115 @@
+package controller
+
+import (
+	"net/http"
+
+	"good-todo-go/internal/presentation/public/api"
+	"good-todo-go/internal/presentation/public/presenter"
+	"good-todo-go/internal/usecase"
+	"good-todo-go/internal/usecase/input"
+
+	"github.com/labstack/echo/v4"
+)
+
+type CommentController struct {
+	commentUsecase   usecase.ICommentInteractor
+	commentPresenter presenter.ICommentPresenter
+}
+
+func NewCommentController(
+	commentUsecase usecase.ICommentInteractor,
+	commentPresenter presenter.ICommentPresenter,
+) *CommentController {
+	return &CommentController{
+		commentUsecase:   commentUsecase,
+		commentPresenter: commentPresenter,
+	}
+}
+
+func (ctrl *CommentController) ListComments(c echo.Context, todoID string, params api.ListCommentsParams) error {
+	actor, ok := actorFromContext(c)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	inp := &input.ListCommentsInput{TodoID: todoID}
+	if params.Limit != nil {
+		inp.Limit = *params.Limit
+	}
+	if params.Cursor != nil {
+		inp.Cursor = *params.Cursor
+	}
+
+	out, err := ctrl.commentUsecase.List(c.Request().Context(), actor, inp)
+	if err != nil {
+		return commentError(err)
+	}
+
+	return ctrl.commentPresenter.List(c, out)
+}
+
+func (ctrl *CommentController) CreateComment(c echo.Context, todoID string, req api.CreateCommentRequest) error {
+	actor, ok := actorFromContext(c)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	out, err := ctrl.commentUsecase.Create(c.Request().Context(), actor, &input.CreateCommentInput{
+		TodoID: todoID,
+		Body:   req.Body,
+	})
+	if err != nil {
+		return commentError(err)
+	}
+
+	return ctrl.commentPresenter.Create(c, out)
+}
+
+func (ctrl *CommentController) UpdateComment(c echo.Context, todoID, commentID string, req api.UpdateCommentRequest) error {
+	actor, ok := actorFromContext(c)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	out, err := ctrl.commentUsecase.Update(c.Request().Context(), actor, &input.UpdateCommentInput{
+		TodoID:    todoID,
+		CommentID: commentID,
+		Body:      req.Body,
+	})
+	if err != nil {
+		return commentError(err)
+	}
+
+	return ctrl.commentPresenter.Update(c, out)
+}
+
+func (ctrl *CommentController) DeleteComment(c echo.Context, todoID, commentID string) error {
+	actor, ok := actorFromContext(c)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	if err := ctrl.commentUsecase.Delete(c.Request().Context(), actor, todoID, commentID); err != nil {
+		return commentError(err)
+	}
+
+	return ctrl.commentPresenter.Delete(c)
+}
+
+func commentError(err error) error {
+	switch err {
+	case usecase.ErrTodoNotFound:
+		return echo.NewHTTPError(http.StatusNotFound, "todo not found")
+	case usecase.ErrCommentNotFound:
+		return echo.NewHTTPError(http.StatusNotFound, "comment not found")
+	case usecase.ErrNotCommentAuthor:
+		return echo.NewHTTPError(http.StatusForbidden, "not authorized")
+	case usecase.ErrInvalidComment:
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	case usecase.ErrInvalidCursor:
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid cursor")
+	case usecase.ErrInvalidPageLimit:
+		return echo.NewHTTPError(http.StatusBadRequest, "limit must be between 1 and 100")
+	}
+	return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+}
@@ -0,0 +1,132 @@
+package controller
+
+import (
+	"net/http"
+
+	"good-todo-go/internal/presentation/public/api"
+	"good-todo-go/internal/presentation/public/presenter"
+	"good-todo-go/internal/usecase"
+	"good-todo-go/internal/usecase/input"
+
+	"github.com/labstack/echo/v4"
+)
+
+type ProjectController struct {
+	projectUsecase   usecase.IProjectInteractor
+	projectPresenter presenter.IProjectPresenter
+}
+
+func NewProjectController(
+	projectUsecase usecase.IProjectInteractor,
+	projectPresenter presenter.IProjectPresenter,
+) *ProjectController {
+	return &ProjectController{
+		projectUsecase:   projectUsecase,
+		projectPresenter: projectPresenter,
+	}
+}
+
+func (ctrl *ProjectController) ListProjects(c echo.Context, params api.ListProjectsParams) error {
+	actor, ok := actorFromContext(c)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	inp := &input.ListProjectsInput{}
+	if params.IncludeArchived != nil {
+		inp.IncludeArchived = *params.IncludeArchived
+	}
+	out, err := ctrl.projectUsecase.List(c.Request().Context(), actor, inp)
+	if err != nil {
+		return projectError(err)
+	}
+
+	return ctrl.projectPresenter.List(c, out)
+}
+
+func (ctrl *ProjectController) GetProject(c echo.Context, id string) error {
+	actor, ok := actorFromContext(c)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	out, err := ctrl.projectUsecase.Get(c.Request().Context(), actor, id)
+	if err != nil {
+		return projectError(err)
+	}
+
+	return ctrl.projectPresenter.Get(c, out)
+}
+
+func (ctrl *ProjectController) CreateProject(c echo.Context, req api.CreateProjectRequest) error {
+	actor, ok := actorFromContext(c)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	inp := &input.CreateProjectInput{Name: req.Name}
+	if req.Description != nil {
+		inp.Description = *req.Description
+	}
+	if req.Color != nil {
+		inp.Color = *req.Color
+	}
+	if req.IsPublic != nil {
+		inp.IsPublic = *req.IsPublic
+	}
+	out, err := ctrl.projectUsecase.Create(c.Request().Context(), actor, inp)
+	if err != nil {
+		return projectError(err)
+	}
+
+	return ctrl.projectPresenter.Create(c, out)
+}
+
+func (ctrl *ProjectController) UpdateProject(c echo.Context, id string, req api.UpdateProjectRequest) error {
+	actor, ok := actorFromContext(c)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	out, err := ctrl.projectUsecase.Update(c.Request().Context(), actor, &input.UpdateProjectInput{
+		ID:          id,
+		Name:        req.Name,
+		Description: req.Description,
+		Color:       req.Color,
+		Archived:    req.Archived,
+		IsPublic:    req.IsPublic,
+	})
+	if err != nil {
+		return projectError(err)
+	}
+
+	return ctrl.projectPresenter.Update(c, out)
+}
+
+func (ctrl *ProjectController) DeleteProject(c echo.Context, id string, deleteTodos bool) error {
+	actor, ok := actorFromContext(c)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	err := ctrl.projectUsecase.Delete(c.Request().Context(), actor, &input.DeleteProjectInput{ID: id, DeleteTodos: deleteTodos})
+	if err != nil {
+		return projectError(err)
+	}
+
+	return ctrl.projectPresenter.Delete(c)
+}
+
+func projectError(err error) error {
+	switch err {
+	case usecase.ErrProjectNotFound:
+		return echo.NewHTTPError(http.StatusNotFound, "project not found")
+	case usecase.ErrNotProjectOwner, usecase.ErrUnauthorized:
+		return echo.NewHTTPError(http.StatusForbidden, "not authorized")
+	case usecase.ErrInvalidProjectName:
+		return echo.NewHTTPError(http.StatusBadRequest, "name must be between 1 and 100 characters")
+	case usecase.ErrInvalidProjectColor:
+		return echo.NewHTTPError(http.StatusBadRequest, "color must be a #rrggbb hex color")
+	}
+	return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+}
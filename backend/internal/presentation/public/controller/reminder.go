@@ -0,0 +1,86 @@
+package controller
+
+import (
+	"net/http"
+
+	"good-todo-go/internal/presentation/public/api"
+	"good-todo-go/internal/presentation/public/presenter"
+	"good-todo-go/internal/usecase"
+	"good-todo-go/internal/usecase/input"
+
+	"github.com/labstack/echo/v4"
+)
+
+type ReminderController struct {
+	reminderUsecase   usecase.IReminderInteractor
+	reminderPresenter presenter.IReminderPresenter
+}
+
+func NewReminderController(
+	reminderUsecase usecase.IReminderInteractor,
+	reminderPresenter presenter.IReminderPresenter,
+) *ReminderController {
+	return &ReminderController{
+		reminderUsecase:   reminderUsecase,
+		reminderPresenter: reminderPresenter,
+	}
+}
+
+func (ctrl *ReminderController) ListReminders(c echo.Context, todoID string) error {
+	actor, ok := actorFromContext(c)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	out, err := ctrl.reminderUsecase.List(c.Request().Context(), actor, todoID)
+	if err != nil {
+		return reminderError(err)
+	}
+
+	return ctrl.reminderPresenter.List(c, out)
+}
+
+func (ctrl *ReminderController) CreateReminder(c echo.Context, todoID string, req api.CreateReminderRequest) error {
+	actor, ok := actorFromContext(c)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	out, err := ctrl.reminderUsecase.Create(c.Request().Context(), actor, &input.CreateReminderInput{
+		TodoID:        todoID,
+		RemindAt:      req.RemindAt,
+		OffsetMinutes: req.OffsetMinutes,
+	})
+	if err != nil {
+		return reminderError(err)
+	}
+
+	return ctrl.reminderPresenter.Create(c, out)
+}
+
+func (ctrl *ReminderController) DeleteReminder(c echo.Context, todoID, reminderID string) error {
+	actor, ok := actorFromContext(c)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	if err := ctrl.reminderUsecase.Delete(c.Request().Context(), actor, todoID, reminderID); err != nil {
+		return reminderError(err)
+	}
+
+	return ctrl.reminderPresenter.Delete(c)
+}
+
+func reminderError(err error) error {
+	switch err {
+	case usecase.ErrTodoNotFound:
+		return echo.NewHTTPError(http.StatusNotFound, "todo not found")
+	case usecase.ErrReminderNotFound:
+		return echo.NewHTTPError(http.StatusNotFound, "reminder not found")
+	case usecase.ErrNotTodoOwner:
+		return echo.NewHTTPError(http.StatusForbidden, "not authorized")
+	case usecase.ErrInvalidReminder, usecase.ErrReminderInPast, usecase.ErrReminderNeedsDueDate:
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+}
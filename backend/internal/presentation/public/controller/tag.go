@@ -0,0 +1,106 @@
+package controller
+
+import (
+	"net/http"
+
+	"good-todo-go/internal/presentation/public/api"
+	"good-todo-go/internal/presentation/public/presenter"
+	"good-todo-go/internal/usecase"
+	"good-todo-go/internal/usecase/input"
+
+	"github.com/labstack/echo/v4"
+)
+
+type TagController struct {
+	tagUsecase   usecase.ITagInteractor
+	tagPresenter presenter.ITagPresenter
+}
+
+func NewTagController(
+	tagUsecase usecase.ITagInteractor,
+	tagPresenter presenter.ITagPresenter,
+) *TagController {
+	return &TagController{
+		tagUsecase:   tagUsecase,
+		tagPresenter: tagPresenter,
+	}
+}
+
+func (ctrl *TagController) ListTags(c echo.Context) error {
+	actor, ok := actorFromContext(c)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	out, err := ctrl.tagUsecase.List(c.Request().Context(), actor)
+	if err != nil {
+		return tagError(err)
+	}
+
+	return ctrl.tagPresenter.List(c, out)
+}
+
+func (ctrl *TagController) CreateTag(c echo.Context, req api.CreateTagRequest) error {
+	actor, ok := actorFromContext(c)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	inp := &input.CreateTagInput{Name: req.Name}
+	if req.Color != nil {
+		inp.Color = *req.Color
+	}
+	out, err := ctrl.tagUsecase.Create(c.Request().Context(), actor, inp)
+	if err != nil {
+		return tagError(err)
+	}
+
+	return ctrl.tagPresenter.Create(c, out)
+}
+
+func (ctrl *TagController) UpdateTag(c echo.Context, id string, req api.UpdateTagRequest) error {
+	actor, ok := actorFromContext(c)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	out, err := ctrl.tagUsecase.Update(c.Request().Context(), actor, &input.UpdateTagInput{
+		ID:    id,
+		Name:  req.Name,
+		Color: req.Color,
+	})
+	if err != nil {
+		return tagError(err)
+	}
+
+	return ctrl.tagPresenter.Update(c, out)
+}
+
+func (ctrl *TagController) DeleteTag(c echo.Context, id string) error {
+	actor, ok := actorFromContext(c)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	if err := ctrl.tagUsecase.Delete(c.Request().Context(), actor, id); err != nil {
+		return tagError(err)
+	}
+
+	return ctrl.tagPresenter.Delete(c)
+}
+
+func tagError(err error) error {
+	switch err {
+	case usecase.ErrTagNotFound:
+		return echo.NewHTTPError(http.StatusNotFound, "tag not found")
+	case usecase.ErrUnauthorized:
+		return echo.NewHTTPError(http.StatusForbidden, "not authorized")
+	case usecase.ErrInvalidTagName:
+		return echo.NewHTTPError(http.StatusBadRequest, "name must be between 1 and 50 characters")
+	case usecase.ErrInvalidTagColor:
+		return echo.NewHTTPError(http.StatusBadRequest, "color must be a #rrggbb hex color")
+	case usecase.ErrTagNameTaken:
+		return echo.NewHTTPError(http.StatusConflict, "tag name already taken")
+	}
+	return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+}
@@ -0,0 +1,152 @@
+package controller
+
+import (
+	"net/http"
+
+	"good-todo-go/internal/presentation/public/api"
+	"good-todo-go/internal/presentation/public/presenter"
+	"good-todo-go/internal/usecase"
+	"good-todo-go/internal/usecase/input"
+
+	"github.com/labstack/echo/v4"
+)
+
+type TenantController struct {
+	tenantUsecase   usecase.ITenantInteractor
+	tenantPresenter presenter.ITenantPresenter
+}
+
+func NewTenantController(
+	tenantUsecase usecase.ITenantInteractor,
+	tenantPresenter presenter.ITenantPresenter,
+) *TenantController {
+	return &TenantController{
+		tenantUsecase:   tenantUsecase,
+		tenantPresenter: tenantPresenter,
+	}
+}
+
+func (ctrl *TenantController) GetTenant(c echo.Context) error {
+	actor, ok := actorFromContext(c)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	out, err := ctrl.tenantUsecase.GetTenant(c.Request().Context(), actor)
+	if err != nil {
+		if err == usecase.ErrTenantNotFound {
+			return echo.NewHTTPError(http.StatusNotFound, "tenant not found")
+		}
+		if err == usecase.ErrUnauthorized {
+			return echo.NewHTTPError(http.StatusForbidden, "not authorized")
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return ctrl.tenantPresenter.GetTenant(c, out)
+}
+
+func (ctrl *TenantController) UpdateTenant(c echo.Context, req api.UpdateTenantRequest) error {
+	actor, ok := actorFromContext(c)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	out, err := ctrl.tenantUsecase.UpdateTenant(c.Request().Context(), actor, &input.UpdateTenantInput{
+		Name:               req.Name,
+		Slug:               req.Slug,
+		TrashRetentionDays: req.TrashRetentionDays,
+	})
+	if err != nil {
+		if err == usecase.ErrTenantNotFound {
+			return echo.NewHTTPError(http.StatusNotFound, "tenant not found")
+		}
+		if err == usecase.ErrUnauthorized {
+			return echo.NewHTTPError(http.StatusForbidden, "not authorized")
+		}
+		if err == usecase.ErrInvalidTenantSlug || err == usecase.ErrReservedTenantSlug || err == usecase.ErrInvalidTrashRetention {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+		if err == usecase.ErrTenantSlugTaken {
+			return echo.NewHTTPError(http.StatusConflict, "tenant slug already taken")
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return ctrl.tenantPresenter.UpdateTenant(c, out)
+}
+
+func (ctrl *TenantController) ListDomains(c echo.Context) error {
+	actor, ok := actorFromContext(c)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	out, err := ctrl.tenantUsecase.ListDomains(c.Request().Context(), actor)
+	if err != nil {
+		return tenantDomainError(err)
+	}
+
+	return ctrl.tenantPresenter.ListDomains(c, out)
+}
+
+func (ctrl *TenantController) AddDomain(c echo.Context, req api.AddTenantDomainRequest) error {
+	actor, ok := actorFromContext(c)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	out, err := ctrl.tenantUsecase.AddDomain(c.Request().Context(), actor, &input.AddTenantDomainInput{
+		Domain: req.Domain,
+	})
+	if err != nil {
+		return tenantDomainError(err)
+	}
+
+	return ctrl.tenantPresenter.AddDomain(c, out)
+}
+
+func (ctrl *TenantController) VerifyDomain(c echo.Context, id string) error {
+	actor, ok := actorFromContext(c)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	out, err := ctrl.tenantUsecase.VerifyDomain(c.Request().Context(), actor, id)
+	if err != nil {
+		return tenantDomainError(err)
+	}
+
+	return ctrl.tenantPresenter.VerifyDomain(c, out)
+}
+
+func (ctrl *TenantController) RemoveDomain(c echo.Context, id string) error {
+	actor, ok := actorFromContext(c)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	if err := ctrl.tenantUsecase.RemoveDomain(c.Request().Context(), actor, id); err != nil {
+		return tenantDomainError(err)
+	}
+
+	return ctrl.tenantPresenter.RemoveDomain(c)
+}
+
+func tenantDomainError(err error) error {
+	switch err {
+	case usecase.ErrTenantNotFound:
+		return echo.NewHTTPError(http.StatusNotFound, "tenant not found")
+	case usecase.ErrTenantDomainNotFound:
+		return echo.NewHTTPError(http.StatusNotFound, "domain not found")
+	case usecase.ErrUnauthorized:
+		return echo.NewHTTPError(http.StatusForbidden, "not authorized")
+	case usecase.ErrInvalidTenantDomain:
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	case usecase.ErrTenantDomainTaken:
+		return echo.NewHTTPError(http.StatusConflict, err.Error())
+	case usecase.ErrTenantDomainUnverified:
+		return echo.NewHTTPError(http.StatusUnprocessableEntity, err.Error())
+	}
+	return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+}
@@ -1,13 +1,19 @@
 package controller
 
 import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
+	"strconv"
+	"strings"
 
 	"good-todo-go/internal/presentation/public/api"
 	"good-todo-go/internal/presentation/public/presenter"
-	"good-todo-go/internal/presentation/public/router/context_keys"
 	"good-todo-go/internal/usecase"
 	"good-todo-go/internal/usecase/input"
+	"good-todo-go/internal/usecase/todoquery"
 
 	"github.com/labstack/echo/v4"
 )
@@ -27,41 +33,140 @@ func NewTodoController(
 	}
 }
 
-func (ctrl *TodoController) ListTodos(c echo.Context) error {
-	userID, ok := c.Get(context_keys.UserIDContextKey).(string)
-	if !ok || userID == "" {
+func (ctrl *TodoController) ListTodos(c echo.Context, params api.ListTodosParams) error {
+	actor, ok := actorFromContext(c)
+	if !ok {
 		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
 	}
 
-	todos, err := ctrl.todoUsecase.List(c.Request().Context(), userID)
+	todos, err := ctrl.todoUsecase.List(c.Request().Context(), actor, toListTodosInput(params))
 	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		return todoListError(err)
 	}
 
 	return ctrl.todoPresenter.List(c, todos)
 }
 
-func (ctrl *TodoController) ListPublicTodos(c echo.Context) error {
-	tenantID, ok := c.Get(context_keys.TenantIDContextKey).(string)
-	if !ok || tenantID == "" {
+func (ctrl *TodoController) ListPublicTodos(c echo.Context, params api.ListTodosParams) error {
+	actor, ok := actorFromContext(c)
+	if !ok {
 		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
 	}
 
-	todos, err := ctrl.todoUsecase.ListPublic(c.Request().Context(), tenantID)
+	todos, err := ctrl.todoUsecase.ListPublic(c.Request().Context(), actor, toListTodosInput(params))
 	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		return todoListError(err)
 	}
 
 	return ctrl.todoPresenter.List(c, todos)
 }
 
-func (ctrl *TodoController) CreateTodo(c echo.Context, req api.CreateTodoRequest) error {
-	userID, ok := c.Get(context_keys.UserIDContextKey).(string)
-	if !ok || userID == "" {
+func (ctrl *TodoController) ListTrashedTodos(c echo.Context, params api.ListTodosParams) error {
+	actor, ok := actorFromContext(c)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	todos, err := ctrl.todoUsecase.ListTrash(c.Request().Context(), actor, toListTodosInput(params))
+	if err != nil {
+		return todoListError(err)
+	}
+
+	return ctrl.todoPresenter.List(c, todos)
+}
+
+func (ctrl *TodoController) ListProjectTodos(c echo.Context, projectID string, params api.ListTodosParams) error {
+	actor, ok := actorFromContext(c)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	todos, err := ctrl.todoUsecase.ListByProject(c.Request().Context(), actor, projectID, toListTodosInput(params))
+	if err != nil {
+		if err == usecase.ErrProjectNotFound || err == usecase.ErrNotProjectOwner {
+			return projectError(err)
+		}
+		return todoListError(err)
+	}
+
+	return ctrl.todoPresenter.List(c, todos)
+}
+
+func (ctrl *TodoController) ListAssignedTodos(c echo.Context, params api.ListAssignedTodosParams) error {
+	actor, ok := actorFromContext(c)
+	if !ok {
 		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
 	}
-	tenantID, ok := c.Get(context_keys.TenantIDContextKey).(string)
-	if !ok || tenantID == "" {
+
+	inp := &input.ListTodosInput{}
+	if params.Limit != nil {
+		inp.Limit = *params.Limit
+	}
+	if params.Cursor != nil {
+		inp.Cursor = *params.Cursor
+	}
+	if params.IncludeSnoozed != nil {
+		inp.Filter.IncludeSnoozed = *params.IncludeSnoozed
+	}
+
+	todos, err := ctrl.todoUsecase.ListAssigned(c.Request().Context(), actor, inp)
+	if err != nil {
+		return todoListError(err)
+	}
+
+	return ctrl.todoPresenter.List(c, todos)
+}
+
+func (ctrl *TodoController) ListTodoView(c echo.Context, view api.TodoView, params api.ListTodoViewParams) error {
+	actor, ok := actorFromContext(c)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	inp := &input.ListTodoViewInput{View: string(view)}
+	if params.Limit != nil {
+		inp.Limit = *params.Limit
+	}
+	if params.Cursor != nil {
+		inp.Cursor = *params.Cursor
+	}
+
+	todos, err := ctrl.todoUsecase.ListView(c.Request().Context(), actor, inp)
+	if err != nil {
+		if err == usecase.ErrUnknownTodoView {
+			return echo.NewHTTPError(http.StatusNotFound, "unknown view")
+		}
+		return todoListError(err)
+	}
+
+	return ctrl.todoPresenter.List(c, todos)
+}
+
+func (ctrl *TodoController) SearchTodos(c echo.Context, params api.SearchTodosParams) error {
+	actor, ok := actorFromContext(c)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	inp := &input.SearchTodosInput{Query: params.Q}
+	if params.Limit != nil {
+		inp.Limit = *params.Limit
+	}
+
+	results, err := ctrl.todoUsecase.Search(c.Request().Context(), actor, inp)
+	if err != nil {
+		if err == usecase.ErrInvalidSearchQuery {
+			return echo.NewHTTPError(http.StatusBadRequest, "q must be between 1 and 200 characters")
+		}
+		return todoListError(err)
+	}
+
+	return ctrl.todoPresenter.Search(c, results)
+}
+
+func (ctrl *TodoController) CreateTodo(c echo.Context, req api.CreateTodoRequest) error {
+	actor, ok := actorFromContext(c)
+	if !ok {
 		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
 	}
 
@@ -74,25 +179,55 @@ func (ctrl *TodoController) CreateTodo(c echo.Context, req api.CreateTodoRequest
 		isPublic = *req.IsPublic
 	}
 
-	todo, err := ctrl.todoUsecase.Create(c.Request().Context(), userID, tenantID, &input.CreateTodoInput{
+	inp := &input.CreateTodoInput{
 		Title:       req.Title,
 		Description: description,
 		IsPublic:    isPublic,
 		DueDate:     req.DueDate,
-	})
+		ProjectID:   req.ProjectId,
+		ParentID:    req.ParentId,
+	}
+	if req.TagIds != nil {
+		inp.TagIDs = *req.TagIds
+	}
+	if req.AutoComplete != nil {
+		inp.AutoComplete = *req.AutoComplete
+	}
+	if req.Priority != nil {
+		inp.Priority = string(*req.Priority)
+	}
+	if req.RecurrenceRule != nil {
+		inp.RecurrenceRule = *req.RecurrenceRule
+	}
+	if req.RecurrenceTimezone != nil {
+		inp.RecurrenceTimezone = *req.RecurrenceTimezone
+	}
+
+	todo, err := ctrl.todoUsecase.Create(c.Request().Context(), actor, inp)
 	if err != nil {
+		if err == usecase.ErrUnauthorized || err == usecase.ErrNotTodoOwner {
+			return echo.NewHTTPError(http.StatusForbidden, "not authorized")
+		}
+		if refErr := todoReferenceError(err); refErr != nil {
+			return refErr
+		}
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
 
 	return ctrl.todoPresenter.Create(c, todo)
 }
 
-func (ctrl *TodoController) UpdateTodo(c echo.Context, id string, req api.UpdateTodoRequest) error {
-	userID, ok := c.Get(context_keys.UserIDContextKey).(string)
-	if !ok || userID == "" {
+func (ctrl *TodoController) UpdateTodo(c echo.Context, id string, req api.UpdateTodoRequest, ifMatch *string, force bool) error {
+	actor, ok := actorFromContext(c)
+	if !ok {
 		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
 	}
 
+	version, err := parseIfMatch(ifMatch)
+	if err != nil {
+		return err
+	}
+
 	description := ""
 	if req.Description != nil {
 		description = *req.Description
@@ -102,43 +237,570 @@ func (ctrl *TodoController) UpdateTodo(c echo.Context, id string, req api.Update
 		isPublic = *req.IsPublic
 	}
 
-	todo, err := ctrl.todoUsecase.Update(c.Request().Context(), userID, &input.UpdateTodoInput{
-		ID:          id,
-		Title:       req.Title,
-		Description: description,
-		Completed:   req.Completed,
-		IsPublic:    isPublic,
-		DueDate:     req.DueDate,
-	})
+	inp := &input.UpdateTodoInput{
+		ID:                 id,
+		Title:              req.Title,
+		Description:        description,
+		Completed:          req.Completed,
+		IsPublic:           isPublic,
+		DueDate:            req.DueDate,
+		ProjectID:          req.ProjectId,
+		ParentID:           req.ParentId,
+		IfMatch:            version,
+		AutoComplete:       req.AutoComplete,
+		Priority:           (*string)(req.Priority),
+		RecurrenceRule:     req.RecurrenceRule,
+		RecurrenceTimezone: req.RecurrenceTimezone,
+		Force:              force,
+	}
+	if req.TagIds != nil {
+		inp.TagIDs = *req.TagIds
+	}
+
+	todo, err := ctrl.todoUsecase.Update(c.Request().Context(), actor, inp)
+	if err != nil {
+		return ctrl.todoWriteError(c, err)
+	}
+
+	return ctrl.todoPresenter.Update(c, todo)
+}
+
+func (ctrl *TodoController) PatchTodo(c echo.Context, id string, patch map[string]json.RawMessage, ifMatch *string, force bool) error {
+	actor, ok := actorFromContext(c)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	version, err := parseIfMatch(ifMatch)
+	if err != nil {
+		return err
+	}
+	inp, err := toPatchTodoInput(id, patch)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	inp.IfMatch = version
+	inp.Force = force
+
+	todo, err := ctrl.todoUsecase.Patch(c.Request().Context(), actor, inp)
+	if err != nil {
+		return ctrl.todoWriteError(c, err)
+	}
+
+	return ctrl.todoPresenter.Update(c, todo)
+}
+
+func (ctrl *TodoController) DeleteTodo(c echo.Context, id string, permanent, cascade bool, ifMatch *string) error {
+	actor, ok := actorFromContext(c)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	version, err := parseIfMatch(ifMatch)
+	if err != nil {
+		return err
+	}
+
+	err = ctrl.todoUsecase.Delete(c.Request().Context(), actor, &input.DeleteTodoInput{ID: id, IfMatch: version, Permanent: permanent, Cascade: cascade})
+	if err != nil {
+		return ctrl.todoWriteError(c, err)
+	}
+
+	return ctrl.todoPresenter.Delete(c)
+}
+
+func (ctrl *TodoController) RestoreTodo(c echo.Context, id string) error {
+	actor, ok := actorFromContext(c)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	todo, err := ctrl.todoUsecase.Restore(c.Request().Context(), actor, id)
+	if err != nil {
+		if err == usecase.ErrTodoNotTrashed {
+			return echo.NewHTTPError(http.StatusConflict, "todo is not in the trash")
+		}
+		return ctrl.todoWriteError(c, err)
+	}
+
+	return ctrl.todoPresenter.Update(c, todo)
+}
+
+func (ctrl *TodoController) ListSubtasks(c echo.Context, id string) error {
+	actor, ok := actorFromContext(c)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	subtasks, err := ctrl.todoUsecase.ListSubtasks(c.Request().Context(), actor, id)
 	if err != nil {
 		if err == usecase.ErrTodoNotFound {
 			return echo.NewHTTPError(http.StatusNotFound, "todo not found")
 		}
-		if err == usecase.ErrNotTodoOwner {
-			return echo.NewHTTPError(http.StatusForbidden, "not authorized")
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return ctrl.todoPresenter.All(c, subtasks)
+}
+
+func (ctrl *TodoController) ListTodoOccurrences(c echo.Context, id string, params api.ListTodoOccurrencesParams) error {
+	actor, ok := actorFromContext(c)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	count := 0
+	if params.Count != nil {
+		count = *params.Count
+	}
+	occurrences, err := ctrl.todoUsecase.ListOccurrences(c.Request().Context(), actor, id, count)
+	if err != nil {
+		if err == usecase.ErrTodoNotFound {
+			return echo.NewHTTPError(http.StatusNotFound, "todo not found")
+		}
+		if err == usecase.ErrInvalidOccurrenceCount {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return ctrl.todoPresenter.Occurrences(c, occurrences)
+}
+
+func (ctrl *TodoController) ListBlockers(c echo.Context, id string) error {
+	actor, ok := actorFromContext(c)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	blockers, err := ctrl.todoUsecase.ListBlockers(c.Request().Context(), actor, id)
+	if err != nil {
+		if err == usecase.ErrTodoNotFound {
+			return echo.NewHTTPError(http.StatusNotFound, "todo not found")
 		}
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
 
+	return ctrl.todoPresenter.All(c, blockers)
+}
+
+func (ctrl *TodoController) AddBlocker(c echo.Context, id string, req api.AddBlockerRequest) error {
+	actor, ok := actorFromContext(c)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	todo, err := ctrl.todoUsecase.AddBlocker(c.Request().Context(), actor, id, req.BlockerId)
+	if err != nil {
+		return ctrl.todoDependencyError(c, err)
+	}
+
+	return ctrl.todoPresenter.Update(c, todo)
+}
+
+func (ctrl *TodoController) RemoveBlocker(c echo.Context, id, blockerID string) error {
+	actor, ok := actorFromContext(c)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	todo, err := ctrl.todoUsecase.RemoveBlocker(c.Request().Context(), actor, id, blockerID)
+	if err != nil {
+		return ctrl.todoDependencyError(c, err)
+	}
+
+	return ctrl.todoPresenter.Update(c, todo)
+}
+
+func (ctrl *TodoController) MoveTodo(c echo.Context, id string, req api.MoveTodoRequest) error {
+	actor, ok := actorFromContext(c)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	inp := &input.MoveTodoInput{ID: id, ProjectID: req.ProjectId}
+	if req.BeforeId != nil {
+		inp.BeforeID = *req.BeforeId
+	}
+	if req.AfterId != nil {
+		inp.AfterID = *req.AfterId
+	}
+
+	todo, err := ctrl.todoUsecase.Move(c.Request().Context(), actor, inp)
+	if err != nil {
+		switch err {
+		case usecase.ErrInvalidTodoMove:
+			return echo.NewHTTPError(http.StatusBadRequest, "give one of before_id or after_id naming another todo, or a project_id")
+		case usecase.ErrMoveTargetNotFound:
+			return echo.NewHTTPError(http.StatusBadRequest, "unknown todo to move next to")
+		}
+		return ctrl.todoWriteError(c, err)
+	}
+
+	return ctrl.todoPresenter.Update(c, todo)
+}
+
+func (ctrl *TodoController) AssignTodo(c echo.Context, id string, req api.AssignTodoRequest) error {
+	actor, ok := actorFromContext(c)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	if req.UserId == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "user_id is required")
+	}
+
+	todo, err := ctrl.todoUsecase.Assign(c.Request().Context(), actor, id, req.UserId)
+	if err != nil {
+		if err == usecase.ErrAssigneeNotFound {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+		return ctrl.todoWriteError(c, err)
+	}
+
+	return ctrl.todoPresenter.Update(c, todo)
+}
+
+func (ctrl *TodoController) UnassignTodo(c echo.Context, id string) error {
+	actor, ok := actorFromContext(c)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	todo, err := ctrl.todoUsecase.Unassign(c.Request().Context(), actor, id)
+	if err != nil {
+		return ctrl.todoWriteError(c, err)
+	}
+
+	return ctrl.todoPresenter.Update(c, todo)
+}
+
+func (ctrl *TodoController) SnoozeTodo(c echo.Context, id string, req api.SnoozeTodoRequest) error {
+	actor, ok := actorFromContext(c)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	inp := &input.SnoozeTodoInput{ID: id, Until: req.Until}
+	if req.Preset != nil {
+		inp.Preset = string(*req.Preset)
+	}
+
+	todo, err := ctrl.todoUsecase.Snooze(c.Request().Context(), actor, inp)
+	if err != nil {
+		switch err {
+		case usecase.ErrInvalidSnooze, usecase.ErrUnknownSnoozePreset, usecase.ErrSnoozeInPast:
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+		return ctrl.todoWriteError(c, err)
+	}
+
+	return ctrl.todoPresenter.Update(c, todo)
+}
+
+func (ctrl *TodoController) UnsnoozeTodo(c echo.Context, id string) error {
+	actor, ok := actorFromContext(c)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	todo, err := ctrl.todoUsecase.Unsnooze(c.Request().Context(), actor, id)
+	if err != nil {
+		return ctrl.todoWriteError(c, err)
+	}
+
 	return ctrl.todoPresenter.Update(c, todo)
 }
 
-func (ctrl *TodoController) DeleteTodo(c echo.Context, id string) error {
-	userID, ok := c.Get(context_keys.UserIDContextKey).(string)
-	if !ok || userID == "" {
+func (ctrl *TodoController) ListTodoActivity(c echo.Context, id string) error {
+	actor, ok := actorFromContext(c)
+	if !ok {
 		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
 	}
 
-	err := ctrl.todoUsecase.Delete(c.Request().Context(), userID, id)
+	activities, err := ctrl.todoUsecase.ListActivity(c.Request().Context(), actor, id)
 	if err != nil {
 		if err == usecase.ErrTodoNotFound {
 			return echo.NewHTTPError(http.StatusNotFound, "todo not found")
 		}
-		if err == usecase.ErrNotTodoOwner {
-			return echo.NewHTTPError(http.StatusForbidden, "not authorized")
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return ctrl.todoPresenter.Activity(c, activities)
+}
+
+func (ctrl *TodoController) BatchTodos(c echo.Context, req api.BatchTodoRequest) error {
+	actor, ok := actorFromContext(c)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	inp := &input.BatchTodoInput{Atomic: true}
+	if req.Mode != nil {
+		switch *req.Mode {
+		case api.Atomic:
+		case api.Partial:
+			inp.Atomic = false
+		default:
+			return echo.NewHTTPError(http.StatusBadRequest, "mode must be atomic or partial")
+		}
+	}
+	for _, op := range req.Operations {
+		inp.Operations = append(inp.Operations, input.BatchTodoOperation{
+			Action:    input.BatchTodoAction(op.Op),
+			IDs:       op.Ids,
+			IsPublic:  op.IsPublic,
+			DueDate:   op.DueDate,
+			ProjectID: op.ProjectId,
+		})
+	}
+
+	result, err := ctrl.todoUsecase.Batch(c.Request().Context(), actor, inp)
+	if err != nil {
+		var opErr *usecase.BatchOperationError
+		if errors.As(err, &opErr) {
+			return echo.NewHTTPError(http.StatusBadRequest, opErr.Error())
+		}
+		// An item the todo's own endpoint would reject as invalid fails the request, not just the item
+		var itemErr *usecase.BatchItemError
+		if errors.As(err, &itemErr) {
+			if refErr, ok := todoReferenceError(itemErr.Err).(*echo.HTTPError); ok {
+				return echo.NewHTTPError(refErr.Code, fmt.Sprintf("operation %d, todo %s: %v", itemErr.Index, itemErr.ID, refErr.Message))
+			}
+		}
+		if err == usecase.ErrEmptyTodoBatch {
+			return echo.NewHTTPError(http.StatusBadRequest, "operations must not be empty")
+		}
+		if err == usecase.ErrTodoBatchTooLarge {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("a batch may name at most %d todos", usecase.MaxTodoBatchSize))
 		}
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
 
-	return ctrl.todoPresenter.Delete(c)
+	return ctrl.todoPresenter.Batch(c, result)
+}
+
+// todoWriteError maps errors from changing a single todo. A version conflict is
+// answered with the current todo rather than an error body.
+func (ctrl *TodoController) todoWriteError(c echo.Context, err error) error {
+	var conflict *usecase.TodoVersionConflictError
+	if errors.As(err, &conflict) {
+		return ctrl.todoPresenter.Conflict(c, conflict.Current)
+	}
+	if err == usecase.ErrTodoNotFound {
+		return echo.NewHTTPError(http.StatusNotFound, "todo not found")
+	}
+	if err == usecase.ErrNotTodoOwner {
+		return echo.NewHTTPError(http.StatusForbidden, "not authorized")
+	}
+	if err == usecase.ErrTodoHasSubtasks {
+		return echo.NewHTTPError(http.StatusConflict, "todo has subtasks; delete with cascade=true")
+	}
+	if err == usecase.ErrTodoBlocked {
+		return echo.NewHTTPError(http.StatusConflict, "todo is blocked by open todos; complete with force=true")
+	}
+	if refErr := todoReferenceError(err); refErr != nil {
+		return refErr
+	}
+	return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+}
+
+// todoDependencyError maps errors from adding or removing a blocker.
+func (ctrl *TodoController) todoDependencyError(c echo.Context, err error) error {
+	switch err {
+	case usecase.ErrBlockerNotFound:
+		return echo.NewHTTPError(http.StatusBadRequest, "unknown blocking todo")
+	case usecase.ErrDependencyCycle:
+		return echo.NewHTTPError(http.StatusConflict, "the todo already blocks that todo, directly or through others")
+	case usecase.ErrDependencyNotFound:
+		return echo.NewHTTPError(http.StatusNotFound, "the todo is not blocked by that todo")
+	}
+	return ctrl.todoWriteError(c, err)
+}
+
+// todoReferenceError maps a tag, project or parent todo named by a todo write that cannot
+// be used, an unknown priority and a recurrence that cannot be used. It returns nil for
+// any other error.
+func todoReferenceError(err error) error {
+	if errors.Is(err, usecase.ErrInvalidRecurrence) {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	switch err {
+	case usecase.ErrTagNotFound:
+		return echo.NewHTTPError(http.StatusBadRequest, "unknown tag")
+	case usecase.ErrProjectNotFound:
+		return echo.NewHTTPError(http.StatusBadRequest, "unknown project")
+	case usecase.ErrProjectArchived:
+		return echo.NewHTTPError(http.StatusBadRequest, "project is archived")
+	case usecase.ErrParentTodoNotFound:
+		return echo.NewHTTPError(http.StatusBadRequest, "unknown parent todo")
+	case usecase.ErrTodoCycle:
+		return echo.NewHTTPError(http.StatusBadRequest, "a todo cannot be its own subtask")
+	case usecase.ErrSubtaskTooDeep:
+		return echo.NewHTTPError(http.StatusBadRequest, "subtasks are nested too deep")
+	case usecase.ErrInvalidPriority, usecase.ErrRecurrenceNeedsDueDate, usecase.ErrInvalidTimezone:
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	return nil
+}
+
+// parseIfMatch reads the todo version from an If-Match header holding one strong ETag.
+// A missing header or "*" puts no version condition on the write.
+func parseIfMatch(ifMatch *string) (*int, error) {
+	if ifMatch == nil {
+		return nil, nil
+	}
+	tag := strings.TrimSpace(*ifMatch)
+	if tag == "*" {
+		return nil, nil
+	}
+	unquoted, err := strconv.Unquote(tag)
+	if err != nil || !strings.HasPrefix(tag, `"`) {
+		return nil, echo.NewHTTPError(http.StatusBadRequest, "If-Match must be a single strong ETag such as \"3\"")
+	}
+	version, err := strconv.Atoi(unquoted)
+	if err != nil || version < 1 {
+		return nil, echo.NewHTTPError(http.StatusBadRequest, "If-Match does not name a todo version")
+	}
+	return &version, nil
+}
+
+func toListTodosInput(params api.ListTodosParams) *input.ListTodosInput {
+	inp := &input.ListTodosInput{
+		Filter: input.TodoFilter{
+			Completed:    params.Completed,
+			DueBefore:    params.DueBefore,
+			DueAfter:     params.DueAfter,
+			Overdue:      params.Overdue,
+			HasDueDate:   params.HasDueDate,
+			CreatedAfter: params.CreatedAfter,
+		},
+	}
+	if params.TagId != nil {
+		inp.Filter.TagIDs = *params.TagId
+	}
+	if params.ProjectId != nil {
+		inp.Filter.ProjectID = *params.ProjectId
+	}
+	if params.IncludeSnoozed != nil {
+		inp.Filter.IncludeSnoozed = *params.IncludeSnoozed
+	}
+	if params.Limit != nil {
+		inp.Limit = *params.Limit
+	}
+	if params.Cursor != nil {
+		inp.Cursor = *params.Cursor
+	}
+	if params.Query != nil {
+		inp.Filter.Query = *params.Query
+	}
+	if params.Sort != nil {
+		inp.Filter.SortBy = string(*params.Sort)
+	}
+	if params.Order != nil {
+		inp.Filter.SortOrder = string(*params.Order)
+	}
+	return inp
+}
+
+// toPatchTodoInput reads a JSON Merge Patch (RFC 7396). Null clears due_date and tag_ids,
+// moves the todo to the inbox for project_id, makes it a top-level todo for parent_id and
+// resets description to empty and priority to none. Null recurrence_rule stops the todo
+// repeating and null recurrence_timezone uses the owner's timezone; the other fields
+// cannot be null. Unknown members are ignored.
+func toPatchTodoInput(id string, patch map[string]json.RawMessage) (*input.PatchTodoInput, error) {
+	inp := &input.PatchTodoInput{ID: id}
+	for name, raw := range patch {
+		isNull := bytes.Equal(bytes.TrimSpace(raw), []byte("null"))
+		var err error
+		switch name {
+		case "title", "completed", "is_public", "auto_complete":
+			if isNull {
+				return nil, fmt.Errorf("%s cannot be null", name)
+			}
+		}
+		switch name {
+		case "title":
+			err = json.Unmarshal(raw, &inp.Title)
+		case "completed":
+			err = json.Unmarshal(raw, &inp.Completed)
+		case "is_public":
+			err = json.Unmarshal(raw, &inp.IsPublic)
+		case "auto_complete":
+			err = json.Unmarshal(raw, &inp.AutoComplete)
+		case "description":
+			if isNull {
+				inp.Description = new(string)
+				continue
+			}
+			err = json.Unmarshal(raw, &inp.Description)
+		case "due_date":
+			if isNull {
+				inp.ClearDueDate = true
+				continue
+			}
+			err = json.Unmarshal(raw, &inp.DueDate)
+		case "tag_ids":
+			if isNull {
+				inp.TagIDs = []string{}
+				continue
+			}
+			err = json.Unmarshal(raw, &inp.TagIDs)
+		case "project_id":
+			if isNull {
+				inp.ProjectID = new(string)
+				continue
+			}
+			err = json.Unmarshal(raw, &inp.ProjectID)
+		case "parent_id":
+			if isNull {
+				inp.ParentID = new(string)
+				continue
+			}
+			err = json.Unmarshal(raw, &inp.ParentID)
+		case "priority":
+			if isNull {
+				none := string(api.None)
+				inp.Priority = &none
+				continue
+			}
+			err = json.Unmarshal(raw, &inp.Priority)
+		case "recurrence_rule":
+			if isNull {
+				inp.RecurrenceRule = new(string)
+				continue
+			}
+			err = json.Unmarshal(raw, &inp.RecurrenceRule)
+		case "recurrence_timezone":
+			if isNull {
+				inp.RecurrenceTimezone = new(string)
+				continue
+			}
+			err = json.Unmarshal(raw, &inp.RecurrenceTimezone)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s", name)
+		}
+	}
+	return inp, nil
+}
+
+func todoListError(err error) error {
+	var syntaxErr *todoquery.SyntaxError
+	if errors.As(err, &syntaxErr) {
+		return echo.NewHTTPError(http.StatusBadRequest, syntaxErr.Error())
+	}
+	if err == usecase.ErrConflictingTodoFilter {
+		return echo.NewHTTPError(http.StatusBadRequest, "filter given both in query and as a parameter")
+	}
+	if err == usecase.ErrInvalidCursor {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid cursor")
+	}
+	if err == usecase.ErrInvalidPageLimit {
+		return echo.NewHTTPError(http.StatusBadRequest, "limit must be between 1 and 100")
+	}
+	if err == usecase.ErrInvalidTodoSort {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid sort")
+	}
+	return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 }
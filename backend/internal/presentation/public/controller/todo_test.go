@@ -0,0 +1,81 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"good-todo-go/internal/presentation/public/api"
+	"good-todo-go/internal/presentation/public/presenter"
+	"good-todo-go/internal/presentation/public/router/context_keys"
+	"good-todo-go/internal/usecase"
+	"good-todo-go/internal/usecase/input"
+	"good-todo-go/internal/usecase/output"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// batchTodoUsecase answers Batch with err; the controller calls nothing else here.
+type batchTodoUsecase struct {
+	usecase.ITodoInteractor
+	err error
+}
+
+func (u *batchTodoUsecase) Batch(ctx context.Context, actor input.Actor, inp *input.BatchTodoInput) (*output.BatchTodoOutput, error) {
+	return nil, u.err
+}
+
+func TestTodoController_BatchTodos(t *testing.T) {
+	tests := []struct {
+		name    string
+		err     error
+		status  int
+		message string
+	}{
+		{
+			name:    "an invalid item fails the batch with its index",
+			err:     &usecase.BatchItemError{Index: 1, ID: "todo-2", Err: usecase.ErrRecurrenceNeedsDueDate},
+			status:  http.StatusBadRequest,
+			message: "operation 1, todo todo-2: " + usecase.ErrRecurrenceNeedsDueDate.Error(),
+		},
+		{
+			name:    "an unknown project names the item too",
+			err:     &usecase.BatchItemError{Index: 0, ID: "todo-1", Err: usecase.ErrProjectNotFound},
+			status:  http.StatusBadRequest,
+			message: "operation 0, todo todo-1: unknown project",
+		},
+		{
+			name:   "a failing item is still a server error",
+			err:    &usecase.BatchItemError{Index: 0, ID: "todo-1", Err: errors.New("connection reset")},
+			status: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := NewTodoController(&batchTodoUsecase{err: tt.err}, presenter.NewTodoPresenter())
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/todos/batch", nil)
+			c := e.NewContext(req, httptest.NewRecorder())
+			c.Set(context_keys.UserIDContextKey, "user-123")
+			c.Set(context_keys.TenantIDContextKey, "tenant-123")
+
+			err := ctrl.BatchTodos(c, api.BatchTodoRequest{Operations: []api.BatchTodoOperation{
+				{Op: "complete", Ids: []string{"todo-1"}},
+				{Op: "complete", Ids: []string{"todo-2"}},
+			}})
+
+			var httpErr *echo.HTTPError
+			require.True(t, errors.As(err, &httpErr))
+			assert.Equal(t, tt.status, httpErr.Code)
+			if tt.message != "" {
+				assert.Equal(t, tt.message, httpErr.Message)
+			}
+		})
+	}
+}
@@ -5,7 +5,6 @@ import (
 
 	"good-todo-go/internal/presentation/public/api"
 	"good-todo-go/internal/presentation/public/presenter"
-	"good-todo-go/internal/presentation/public/router/context_keys"
 	"good-todo-go/internal/usecase"
 	"good-todo-go/internal/usecase/input"
 
@@ -28,16 +27,19 @@ func NewUserController(
 }
 
 func (ctrl *UserController) GetMe(c echo.Context) error {
-	userID, ok := c.Get(context_keys.UserIDContextKey).(string)
-	if !ok || userID == "" {
+	actor, ok := actorFromContext(c)
+	if !ok {
 		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
 	}
 
-	out, err := ctrl.userUsecase.GetMe(c.Request().Context(), userID)
+	out, err := ctrl.userUsecase.GetMe(c.Request().Context(), actor)
 	if err != nil {
 		if err == usecase.ErrUserNotFound {
 			return echo.NewHTTPError(http.StatusNotFound, "user not found")
 		}
+		if err == usecase.ErrUnauthorized {
+			return echo.NewHTTPError(http.StatusForbidden, "not authorized")
+		}
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
 
@@ -45,20 +47,85 @@ func (ctrl *UserController) GetMe(c echo.Context) error {
 }
 
 func (ctrl *UserController) UpdateMe(c echo.Context, req api.UpdateUserRequest) error {
-	userID, ok := c.Get(context_keys.UserIDContextKey).(string)
-	if !ok || userID == "" {
+	actor, ok := actorFromContext(c)
+	if !ok {
 		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
 	}
 
-	out, err := ctrl.userUsecase.UpdateMe(c.Request().Context(), userID, &input.UpdateUserInput{
-		Name: req.Name,
+	out, err := ctrl.userUsecase.UpdateMe(c.Request().Context(), actor, &input.UpdateUserInput{
+		Name:     req.Name,
+		Timezone: req.Timezone,
 	})
 	if err != nil {
+		if err == usecase.ErrInvalidTimezone {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
 		if err == usecase.ErrUserNotFound {
 			return echo.NewHTTPError(http.StatusNotFound, "user not found")
 		}
+		if err == usecase.ErrUnauthorized {
+			return echo.NewHTTPError(http.StatusForbidden, "not authorized")
+		}
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
 
 	return ctrl.userPresenter.UpdateMe(c, out)
 }
+
+func (ctrl *UserController) ListMyTenants(c echo.Context) error {
+	actor, ok := actorFromContext(c)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	out, err := ctrl.userUsecase.ListMyTenants(c.Request().Context(), actor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return ctrl.userPresenter.ListMyTenants(c, out)
+}
+
+func (ctrl *UserController) GetMyDigest(c echo.Context) error {
+	actor, ok := actorFromContext(c)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	out, err := ctrl.userUsecase.GetDigest(c.Request().Context(), actor)
+	if err != nil {
+		return digestError(err)
+	}
+
+	return ctrl.userPresenter.Digest(c, out)
+}
+
+func (ctrl *UserController) UpdateMyDigest(c echo.Context, req api.UpdateDigestPreferenceRequest) error {
+	actor, ok := actorFromContext(c)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	inp := &input.UpdateDigestInput{Enabled: req.Enabled, Timezone: req.Timezone}
+	if req.Time != nil {
+		inp.Time = *req.Time
+	}
+	out, err := ctrl.userUsecase.UpdateDigest(c.Request().Context(), actor, inp)
+	if err != nil {
+		return digestError(err)
+	}
+
+	return ctrl.userPresenter.Digest(c, out)
+}
+
+func digestError(err error) error {
+	switch err {
+	case usecase.ErrInvalidDigestTime, usecase.ErrInvalidTimezone:
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	case usecase.ErrUserNotFound:
+		return echo.NewHTTPError(http.StatusNotFound, "user not found")
+	case usecase.ErrUnauthorized:
+		return echo.NewHTTPError(http.StatusForbidden, "not authorized")
+	}
+	return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+}
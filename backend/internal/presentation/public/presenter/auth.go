@@ -14,6 +14,8 @@ type IAuthPresenter interface {
 	Login(c echo.Context, out *output.LoginOutput) error
 	VerifyEmail(c echo.Context, out *output.VerifyEmailOutput) error
 	RefreshToken(c echo.Context, out *output.RefreshTokenOutput) error
+	SwitchTenant(c echo.Context, out *output.LoginOutput) error
+	CreateTenant(c echo.Context, out *output.MembershipOutput) error
 }
 
 type AuthPresenter struct{}
@@ -32,7 +34,19 @@ func (p *AuthPresenter) Register(c echo.Context, out *output.RegisterOutput) err
 }
 
 func (p *AuthPresenter) Login(c echo.Context, out *output.LoginOutput) error {
-	return c.JSON(http.StatusOK, api.LoginResponse{
+	return c.JSON(http.StatusOK, toLoginResponse(out))
+}
+
+func (p *AuthPresenter) SwitchTenant(c echo.Context, out *output.LoginOutput) error {
+	return c.JSON(http.StatusOK, toLoginResponse(out))
+}
+
+func (p *AuthPresenter) CreateTenant(c echo.Context, out *output.MembershipOutput) error {
+	return c.JSON(http.StatusCreated, toMembershipResponse(out))
+}
+
+func toLoginResponse(out *output.LoginOutput) api.LoginResponse {
+	return api.LoginResponse{
 		AccessToken:  out.AccessToken,
 		RefreshToken: out.RefreshToken,
 		User: api.UserResponse{
@@ -43,7 +57,7 @@ func (p *AuthPresenter) Login(c echo.Context, out *output.LoginOutput) error {
 			Role:          api.UserResponseRole(out.Role),
 			EmailVerified: true,
 		},
-	})
+	}
 }
 
 func (p *AuthPresenter) VerifyEmail(c echo.Context, out *output.VerifyEmailOutput) error {
@@ -0,0 +1,55 @@
+package presenter
+
+import (
+	"net/http"
+
+	"good-todo-go/internal/presentation/public/api"
+	"good-todo-go/internal/usecase/output"
+
+	"github.com/labstack/echo/v4"
+)
+
+type ICommentPresenter interface {
+	List(c echo.Context, comments *output.CommentListOutput) error
+	Create(c echo.Context, comment *output.CommentOutput) error
+	Update(c echo.Context, comment *output.CommentOutput) error
+	Delete(c echo.Context) error
+}
+
+type CommentPresenter struct{}
+
+func NewCommentPresenter() ICommentPresenter {
+	return &CommentPresenter{}
+}
+
+func (p *CommentPresenter) List(c echo.Context, comments *output.CommentListOutput) error {
+	resp := make([]api.CommentResponse, len(comments.Comments))
+	for i, comment := range comments.Comments {
+		resp[i] = toCommentResponse(comment)
+	}
+	return c.JSON(http.StatusOK, api.CommentListResponse{Comments: resp, NextCursor: comments.NextCursor})
+}
+
+func (p *CommentPresenter) Create(c echo.Context, comment *output.CommentOutput) error {
+	return c.JSON(http.StatusCreated, toCommentResponse(comment))
+}
+
+func (p *CommentPresenter) Update(c echo.Context, comment *output.CommentOutput) error {
+	return c.JSON(http.StatusOK, toCommentResponse(comment))
+}
+
+func (p *CommentPresenter) Delete(c echo.Context) error {
+	return c.NoContent(http.StatusNoContent)
+}
+
+func toCommentResponse(out *output.CommentOutput) api.CommentResponse {
+	return api.CommentResponse{
+		Id:        out.ID,
+		TodoId:    out.TodoID,
+		AuthorId:  out.AuthorID,
+		Body:      out.Body,
+		EditedAt:  out.EditedAt,
+		CreatedAt: out.CreatedAt,
+		UpdatedAt: out.UpdatedAt,
+	}
+}
@@ -0,0 +1,62 @@
+package presenter
+
+import (
+	"net/http"
+
+	"good-todo-go/internal/presentation/public/api"
+	"good-todo-go/internal/usecase/output"
+
+	"github.com/labstack/echo/v4"
+)
+
+type IProjectPresenter interface {
+	List(c echo.Context, projects []*output.ProjectOutput) error
+	Get(c echo.Context, project *output.ProjectOutput) error
+	Create(c echo.Context, project *output.ProjectOutput) error
+	Update(c echo.Context, project *output.ProjectOutput) error
+	Delete(c echo.Context) error
+}
+
+type ProjectPresenter struct{}
+
+func NewProjectPresenter() IProjectPresenter {
+	return &ProjectPresenter{}
+}
+
+func (p *ProjectPresenter) List(c echo.Context, projects []*output.ProjectOutput) error {
+	resp := make([]api.ProjectResponse, len(projects))
+	for i, project := range projects {
+		resp[i] = toProjectResponse(project)
+	}
+	return c.JSON(http.StatusOK, api.ProjectListResponse{Projects: resp})
+}
+
+func (p *ProjectPresenter) Get(c echo.Context, project *output.ProjectOutput) error {
+	return c.JSON(http.StatusOK, toProjectResponse(project))
+}
+
+func (p *ProjectPresenter) Create(c echo.Context, project *output.ProjectOutput) error {
+	return c.JSON(http.StatusCreated, toProjectResponse(project))
+}
+
+func (p *ProjectPresenter) Update(c echo.Context, project *output.ProjectOutput) error {
+	return c.JSON(http.StatusOK, toProjectResponse(project))
+}
+
+func (p *ProjectPresenter) Delete(c echo.Context) error {
+	return c.NoContent(http.StatusNoContent)
+}
+
+func toProjectResponse(out *output.ProjectOutput) api.ProjectResponse {
+	return api.ProjectResponse{
+		Id:          out.ID,
+		UserId:      out.UserID,
+		Name:        out.Name,
+		Description: out.Description,
+		Color:       out.Color,
+		Archived:    out.Archived,
+		IsPublic:    out.IsPublic,
+		CreatedAt:   out.CreatedAt,
+		UpdatedAt:   out.UpdatedAt,
+	}
+}
@@ -0,0 +1,52 @@
+package presenter
+
+import (
+	"net/http"
+
+	"good-todo-go/internal/presentation/public/api"
+	"good-todo-go/internal/usecase/output"
+
+	"github.com/labstack/echo/v4"
+)
+
+type IReminderPresenter interface {
+	List(c echo.Context, reminders []*output.ReminderOutput) error
+	Create(c echo.Context, reminder *output.ReminderOutput) error
+	Delete(c echo.Context) error
+}
+
+type ReminderPresenter struct{}
+
+func NewReminderPresenter() IReminderPresenter {
+	return &ReminderPresenter{}
+}
+
+func (p *ReminderPresenter) List(c echo.Context, reminders []*output.ReminderOutput) error {
+	resp := make([]api.ReminderResponse, len(reminders))
+	for i, reminder := range reminders {
+		resp[i] = toReminderResponse(reminder)
+	}
+	return c.JSON(http.StatusOK, api.ReminderListResponse{Reminders: resp})
+}
+
+func (p *ReminderPresenter) Create(c echo.Context, reminder *output.ReminderOutput) error {
+	return c.JSON(http.StatusCreated, toReminderResponse(reminder))
+}
+
+func (p *ReminderPresenter) Delete(c echo.Context) error {
+	return c.NoContent(http.StatusNoContent)
+}
+
+func toReminderResponse(out *output.ReminderOutput) api.ReminderResponse {
+	return api.ReminderResponse{
+		Id:            out.ID,
+		TodoId:        out.TodoID,
+		RemindAt:      out.RemindAt,
+		OffsetMinutes: out.OffsetMinutes,
+		Status:        api.ReminderStatus(out.Status),
+		Attempts:      out.Attempts,
+		LastError:     out.LastError,
+		DeliveredAt:   out.DeliveredAt,
+		CreatedAt:     out.CreatedAt,
+	}
+}
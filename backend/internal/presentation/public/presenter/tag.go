@@ -0,0 +1,57 @@
+package presenter
+
+import (
+	"net/http"
+
+	"good-todo-go/internal/presentation/public/api"
+	"good-todo-go/internal/usecase/output"
+
+	"github.com/labstack/echo/v4"
+)
+
+type ITagPresenter interface {
+	List(c echo.Context, tags []*output.TagOutput) error
+	Create(c echo.Context, tag *output.TagOutput) error
+	Update(c echo.Context, tag *output.TagOutput) error
+	Delete(c echo.Context) error
+}
+
+type TagPresenter struct{}
+
+func NewTagPresenter() ITagPresenter {
+	return &TagPresenter{}
+}
+
+func (p *TagPresenter) List(c echo.Context, tags []*output.TagOutput) error {
+	return c.JSON(http.StatusOK, api.TagListResponse{Tags: toTagResponses(tags)})
+}
+
+func (p *TagPresenter) Create(c echo.Context, tag *output.TagOutput) error {
+	return c.JSON(http.StatusCreated, toTagResponse(tag))
+}
+
+func (p *TagPresenter) Update(c echo.Context, tag *output.TagOutput) error {
+	return c.JSON(http.StatusOK, toTagResponse(tag))
+}
+
+func (p *TagPresenter) Delete(c echo.Context) error {
+	return c.NoContent(http.StatusNoContent)
+}
+
+func toTagResponse(out *output.TagOutput) api.TagResponse {
+	return api.TagResponse{
+		Id:        out.ID,
+		Name:      out.Name,
+		Color:     out.Color,
+		CreatedAt: out.CreatedAt,
+		UpdatedAt: out.UpdatedAt,
+	}
+}
+
+func toTagResponses(tags []*output.TagOutput) []api.TagResponse {
+	resp := make([]api.TagResponse, len(tags))
+	for i, tag := range tags {
+		resp[i] = toTagResponse(tag)
+	}
+	return resp
+}
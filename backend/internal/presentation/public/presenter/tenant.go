@@ -0,0 +1,76 @@
+package presenter
+
+import (
+	"net/http"
+
+	"good-todo-go/internal/presentation/public/api"
+	"good-todo-go/internal/usecase/output"
+
+	"github.com/labstack/echo/v4"
+)
+
+type ITenantPresenter interface {
+	GetTenant(c echo.Context, out *output.TenantOutput) error
+	UpdateTenant(c echo.Context, out *output.TenantOutput) error
+	ListDomains(c echo.Context, out []*output.TenantDomainOutput) error
+	AddDomain(c echo.Context, out *output.TenantDomainOutput) error
+	VerifyDomain(c echo.Context, out *output.TenantDomainOutput) error
+	RemoveDomain(c echo.Context) error
+}
+
+type TenantPresenter struct{}
+
+func NewTenantPresenter() ITenantPresenter {
+	return &TenantPresenter{}
+}
+
+func (p *TenantPresenter) GetTenant(c echo.Context, out *output.TenantOutput) error {
+	return c.JSON(http.StatusOK, toTenantResponse(out))
+}
+
+func (p *TenantPresenter) UpdateTenant(c echo.Context, out *output.TenantOutput) error {
+	return c.JSON(http.StatusOK, toTenantResponse(out))
+}
+
+func (p *TenantPresenter) ListDomains(c echo.Context, out []*output.TenantDomainOutput) error {
+	domains := make([]api.TenantDomainResponse, len(out))
+	for i, d := range out {
+		domains[i] = toTenantDomainResponse(d)
+	}
+	return c.JSON(http.StatusOK, api.TenantDomainListResponse{Domains: domains})
+}
+
+func (p *TenantPresenter) AddDomain(c echo.Context, out *output.TenantDomainOutput) error {
+	return c.JSON(http.StatusCreated, toTenantDomainResponse(out))
+}
+
+func (p *TenantPresenter) VerifyDomain(c echo.Context, out *output.TenantDomainOutput) error {
+	return c.JSON(http.StatusOK, toTenantDomainResponse(out))
+}
+
+func (p *TenantPresenter) RemoveDomain(c echo.Context) error {
+	return c.NoContent(http.StatusNoContent)
+}
+
+func toTenantResponse(out *output.TenantOutput) api.TenantResponse {
+	return api.TenantResponse{
+		Id:                 out.ID,
+		Name:               out.Name,
+		Slug:               out.Slug,
+		TrashRetentionDays: out.TrashRetentionDays,
+		CreatedAt:          out.CreatedAt,
+		UpdatedAt:          out.UpdatedAt,
+	}
+}
+
+func toTenantDomainResponse(out *output.TenantDomainOutput) api.TenantDomainResponse {
+	return api.TenantDomainResponse{
+		Id:                 out.ID,
+		Domain:             out.Domain,
+		VerificationRecord: out.VerificationRecord,
+		VerificationToken:  out.VerificationToken,
+		Verified:           out.VerifiedAt != nil,
+		VerifiedAt:         out.VerifiedAt,
+		CreatedAt:          out.CreatedAt,
+	}
+}
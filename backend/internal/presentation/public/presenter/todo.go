@@ -1,8 +1,13 @@
 package presenter
 
 import (
+	"html"
 	"net/http"
+	"strconv"
+	"strings"
+	"time"
 
+	"good-todo-go/internal/domain/model"
 	"good-todo-go/internal/presentation/public/api"
 	"good-todo-go/internal/usecase/output"
 
@@ -10,10 +15,20 @@ import (
 )
 
 type ITodoPresenter interface {
-	List(c echo.Context, todos []*output.TodoOutput) error
+	List(c echo.Context, todos *output.TodoListOutput) error
+	// All answers with todos that are never paged, such as a todo's subtasks or blockers,
+	// as a single page.
+	All(c echo.Context, todos []*output.TodoOutput) error
+	Search(c echo.Context, results []*output.TodoSearchResultOutput) error
 	Create(c echo.Context, todo *output.TodoOutput) error
 	Update(c echo.Context, todo *output.TodoOutput) error
 	Delete(c echo.Context) error
+	// Conflict answers a failed If-Match with the todo as it is now.
+	Conflict(c echo.Context, current *output.TodoOutput) error
+	// Batch answers 200 when the batch was committed and 422 when it was rolled back.
+	Batch(c echo.Context, result *output.BatchTodoOutput) error
+	Occurrences(c echo.Context, occurrences []time.Time) error
+	Activity(c echo.Context, activities []*output.TodoActivityOutput) error
 }
 
 type TodoPresenter struct{}
@@ -22,19 +37,38 @@ func NewTodoPresenter() ITodoPresenter {
 	return &TodoPresenter{}
 }
 
-func (p *TodoPresenter) List(c echo.Context, todos []*output.TodoOutput) error {
-	result := make([]api.TodoResponse, len(todos))
-	for i, todo := range todos {
+func (p *TodoPresenter) List(c echo.Context, todos *output.TodoListOutput) error {
+	result := make([]api.TodoResponse, len(todos.Todos))
+	for i, todo := range todos.Todos {
 		result[i] = toTodoResponse(todo)
 	}
-	return c.JSON(http.StatusOK, api.TodoListResponse{Todos: result})
+	return c.JSON(http.StatusOK, api.TodoListResponse{Todos: result, NextCursor: todos.NextCursor})
+}
+
+func (p *TodoPresenter) All(c echo.Context, todos []*output.TodoOutput) error {
+	return p.List(c, &output.TodoListOutput{Todos: todos})
+}
+
+func (p *TodoPresenter) Search(c echo.Context, results []*output.TodoSearchResultOutput) error {
+	resp := make([]api.TodoSearchResult, len(results))
+	for i, r := range results {
+		resp[i] = api.TodoSearchResult{
+			Todo:                 toTodoResponse(r.Todo),
+			Rank:                 float32(r.Rank),
+			TitleHighlight:       toHTMLHighlight(r.TitleHighlight),
+			DescriptionHighlight: toHTMLHighlight(r.DescriptionHighlight),
+		}
+	}
+	return c.JSON(http.StatusOK, api.TodoSearchResponse{Results: resp})
 }
 
 func (p *TodoPresenter) Create(c echo.Context, todo *output.TodoOutput) error {
+	setTodoETag(c, todo)
 	return c.JSON(http.StatusCreated, toTodoResponse(todo))
 }
 
 func (p *TodoPresenter) Update(c echo.Context, todo *output.TodoOutput) error {
+	setTodoETag(c, todo)
 	return c.JSON(http.StatusOK, toTodoResponse(todo))
 }
 
@@ -42,16 +76,113 @@ func (p *TodoPresenter) Delete(c echo.Context) error {
 	return c.NoContent(http.StatusNoContent)
 }
 
+func (p *TodoPresenter) Occurrences(c echo.Context, occurrences []time.Time) error {
+	return c.JSON(http.StatusOK, api.TodoOccurrencesResponse{Occurrences: occurrences})
+}
+
+func (p *TodoPresenter) Activity(c echo.Context, activities []*output.TodoActivityOutput) error {
+	resp := make([]api.TodoActivityResponse, len(activities))
+	for i, activity := range activities {
+		resp[i] = api.TodoActivityResponse{
+			Id:           activity.ID,
+			TodoId:       activity.TodoID,
+			ActorId:      activity.ActorID,
+			Action:       api.TodoActivityAction(activity.Action),
+			SnoozedUntil: activity.SnoozedUntil,
+			CreatedAt:    activity.CreatedAt,
+		}
+	}
+	return c.JSON(http.StatusOK, api.TodoActivityListResponse{Activities: resp})
+}
+
+func (p *TodoPresenter) Conflict(c echo.Context, current *output.TodoOutput) error {
+	setTodoETag(c, current)
+	return c.JSON(http.StatusPreconditionFailed, toTodoResponse(current))
+}
+
+func (p *TodoPresenter) Batch(c echo.Context, result *output.BatchTodoOutput) error {
+	resp := api.BatchTodoResponse{
+		Committed: result.Committed,
+		Results:   make([]api.BatchTodoItemResult, len(result.Items)),
+	}
+	for i, item := range result.Items {
+		r := api.BatchTodoItemResult{Operation: item.Operation, Id: item.ID}
+		r.Status, r.Error = batchItemStatus(item.Status)
+		if item.Todo != nil {
+			todo := toTodoResponse(item.Todo)
+			r.Todo = &todo
+		}
+		resp.Results[i] = r
+	}
+
+	status := http.StatusOK
+	if !result.Committed {
+		status = http.StatusUnprocessableEntity
+	}
+	return c.JSON(status, resp)
+}
+
+// batchItemStatus gives each item the status code and message the single-todo endpoints would have.
+func batchItemStatus(status output.BatchTodoItemStatus) (int, *string) {
+	var message string
+	switch status {
+	case output.BatchTodoItemUpdated:
+		return http.StatusOK, nil
+	case output.BatchTodoItemDeleted:
+		return http.StatusNoContent, nil
+	case output.BatchTodoItemNotFound:
+		message = "todo not found"
+		return http.StatusNotFound, &message
+	case output.BatchTodoItemForbidden:
+		message = "not authorized"
+		return http.StatusForbidden, &message
+	case output.BatchTodoItemHasSubtasks:
+		message = "todo has subtasks"
+		return http.StatusConflict, &message
+	case output.BatchTodoItemBlocked:
+		message = "todo is blocked by open todos"
+		return http.StatusConflict, &message
+	default:
+		message = "rolled back with the rest of the batch"
+		return http.StatusFailedDependency, &message
+	}
+}
+
+// TodoETag is the strong entity tag for a todo version, e.g. "3" including the quotes.
+func TodoETag(version int) string {
+	return strconv.Quote(strconv.Itoa(version))
+}
+
+func setTodoETag(c echo.Context, todo *output.TodoOutput) {
+	c.Response().Header().Set("ETag", TodoETag(todo.Version))
+}
+
 func toTodoResponse(out *output.TodoOutput) api.TodoResponse {
 	resp := api.TodoResponse{
-		Id:          out.ID,
-		UserId:      out.UserID,
-		Title:       out.Title,
-		Description: out.Description,
-		Completed:   out.Completed,
-		IsPublic:    out.IsPublic,
-		CreatedAt:   out.CreatedAt,
-		UpdatedAt:   out.UpdatedAt,
+		Id:                    out.ID,
+		UserId:                out.UserID,
+		AssigneeId:            out.AssigneeID,
+		ProjectId:             out.ProjectID,
+		ParentId:              out.ParentID,
+		Position:              out.Position,
+		Priority:              api.TodoPriority(out.Priority),
+		RecurrenceRule:        out.RecurrenceRule,
+		RecurrenceTimezone:    out.RecurrenceTimezone,
+		Title:                 out.Title,
+		Description:           out.Description,
+		Completed:             out.Completed,
+		IsPublic:              out.IsPublic,
+		AutoComplete:          out.AutoComplete,
+		Version:               out.Version,
+		SubtaskCount:          out.SubtaskCount,
+		CompletedSubtaskCount: out.CompletedSubtaskCount,
+		BlockedCount:          out.BlockedCount,
+		BlockingCount:         out.BlockingCount,
+		Tags:                  toTagResponses(out.Tags),
+		SnoozedUntil:          out.SnoozedUntil,
+		DeletedAt:             out.DeletedAt,
+		CreatedAt:             out.CreatedAt,
+		UpdatedAt:             out.UpdatedAt,
 	}
 	if out.DueDate != nil {
 		resp.DueDate = out.DueDate
@@ -61,3 +192,12 @@ func toTodoResponse(out *output.TodoOutput) api.TodoResponse {
 	}
 	return resp
 }
+
+// toHTMLHighlight escapes the snippet and only then turns the highlight markers into
+// <mark> tags, so user text can never inject markup.
+func toHTMLHighlight(snippet string) string {
+	escaped := html.EscapeString(snippet)
+	return highlightReplacer.Replace(escaped)
+}
+
+var highlightReplacer = strings.NewReplacer(model.HighlightStart, "<mark>", model.HighlightEnd, "</mark>")
@@ -7,11 +7,14 @@ import (
 	"good-todo-go/internal/usecase/output"
 
 	"github.com/labstack/echo/v4"
+	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
 type IUserPresenter interface {
 	GetMe(c echo.Context, out *output.UserOutput) error
 	UpdateMe(c echo.Context, out *output.UserOutput) error
+	ListMyTenants(c echo.Context, out []*output.MembershipOutput) error
+	Digest(c echo.Context, out *output.DigestOutput) error
 }
 
 type UserPresenter struct{}
@@ -28,12 +31,44 @@ func (p *UserPresenter) UpdateMe(c echo.Context, out *output.UserOutput) error {
 	return c.JSON(http.StatusOK, toUserResponse(out))
 }
 
+func (p *UserPresenter) ListMyTenants(c echo.Context, out []*output.MembershipOutput) error {
+	memberships := make([]api.MembershipResponse, len(out))
+	for i, m := range out {
+		memberships[i] = toMembershipResponse(m)
+	}
+	return c.JSON(http.StatusOK, api.MembershipListResponse{Memberships: memberships})
+}
+
+func toMembershipResponse(m *output.MembershipOutput) api.MembershipResponse {
+	return api.MembershipResponse{
+		TenantId:   m.TenantID,
+		TenantName: m.TenantName,
+		TenantSlug: m.TenantSlug,
+		Role:       api.MembershipResponseRole(m.Role),
+		Current:    m.Current,
+		JoinedAt:   m.JoinedAt,
+	}
+}
+
+func (p *UserPresenter) Digest(c echo.Context, out *output.DigestOutput) error {
+	resp := api.DigestPreferenceResponse{
+		Enabled:  out.Enabled,
+		Time:     out.Time,
+		Timezone: out.Timezone,
+	}
+	if out.LastSentOn != nil {
+		resp.LastSentOn = &openapi_types.Date{Time: *out.LastSentOn}
+	}
+	return c.JSON(http.StatusOK, resp)
+}
+
 func toUserResponse(out *output.UserOutput) api.UserResponse {
 	return api.UserResponse{
 		Id:            out.ID,
 		TenantId:      out.TenantID,
 		Email:         out.Email,
 		Name:          out.Name,
+		Timezone:      out.Timezone,
 		Role:          api.UserResponseRole(out.Role),
 		EmailVerified: out.EmailVerified,
 		CreatedAt:     out.CreatedAt,
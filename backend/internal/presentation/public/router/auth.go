@@ -37,3 +37,19 @@ func (s *Server) RefreshToken(ctx echo.Context) error {
 	}
 	return s.authController.RefreshToken(ctx, req)
 }
+
+func (s *Server) SwitchTenant(ctx echo.Context) error {
+	var req api.SwitchTenantRequest
+	if err := ctx.Bind(&req); err != nil {
+		return err
+	}
+	return s.authController.SwitchTenant(ctx, req)
+}
+
+func (s *Server) CreateMyTenant(ctx echo.Context) error {
+	var req api.CreateTenantRequest
+	if err := ctx.Bind(&req); err != nil {
+		return err
+	}
+	return s.authController.CreateTenant(ctx, req)
+}
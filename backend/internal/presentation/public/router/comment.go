@@ -0,0 +1,31 @@
+package router
+
+import (
+	"good-todo-go/internal/presentation/public/api"
+
+	"github.com/labstack/echo/v4"
+)
+
+func (s *Server) ListComments(ctx echo.Context, id string, params api.ListCommentsParams) error {
+	return s.commentController.ListComments(ctx, id, params)
+}
+
+func (s *Server) CreateComment(ctx echo.Context, id string) error {
+	var req api.CreateCommentRequest
+	if err := ctx.Bind(&req); err != nil {
+		return err
+	}
+	return s.commentController.CreateComment(ctx, id, req)
+}
+
+func (s *Server) UpdateComment(ctx echo.Context, id, commentID string) error {
+	var req api.UpdateCommentRequest
+	if err := ctx.Bind(&req); err != nil {
+		return err
+	}
+	return s.commentController.UpdateComment(ctx, id, commentID, req)
+}
+
+func (s *Server) DeleteComment(ctx echo.Context, id, commentID string) error {
+	return s.commentController.DeleteComment(ctx, id, commentID)
+}
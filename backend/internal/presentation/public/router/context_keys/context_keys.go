@@ -5,4 +5,7 @@ const (
 	TenantIDContextKey = "tenant_id"
 	EmailContextKey    = "email"
 	RoleContextKey     = "role"
+
+	// HostTenantIDContextKey holds the tenant identified by the request host, if any
+	HostTenantIDContextKey = "host_tenant_id"
 )
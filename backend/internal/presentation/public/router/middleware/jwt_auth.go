@@ -36,6 +36,11 @@ func (m *JWTAuthMiddleware) Authenticate(next echo.HandlerFunc) echo.HandlerFunc
 			return echo.NewHTTPError(http.StatusUnauthorized, "invalid token")
 		}
 
+		// A token issued for one tenant must not be used on another tenant's host
+		if hostTenantID, ok := c.Get(context_keys.HostTenantIDContextKey).(string); ok && hostTenantID != claims.TenantID {
+			return echo.NewHTTPError(http.StatusForbidden, "token was issued for a different tenant")
+		}
+
 		c.Set(context_keys.UserIDContextKey, claims.UserID)
 		c.Set(context_keys.TenantIDContextKey, claims.TenantID)
 		c.Set(context_keys.EmailContextKey, claims.Email)
@@ -0,0 +1,58 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"good-todo-go/internal/pkg"
+	"good-todo-go/internal/presentation/public/router/context_keys"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJWTAuthMiddleware_Authenticate(t *testing.T) {
+	jwtService := pkg.NewJWTService("test-secret")
+	token, err := jwtService.GenerateAccessToken("user-123", "tenant-acme", "alice@example.com", "member")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name          string
+		authorization string
+		hostTenantID  string
+		status        int
+	}{
+		{name: "no host tenant", authorization: "Bearer " + token, status: http.StatusOK},
+		{name: "matching host tenant", authorization: "Bearer " + token, hostTenantID: "tenant-acme", status: http.StatusOK},
+		{name: "token for another tenant than the host", authorization: "Bearer " + token, hostTenantID: "tenant-other", status: http.StatusForbidden},
+		{name: "missing token", status: http.StatusUnauthorized},
+		{name: "invalid token", authorization: "Bearer not-a-token", status: http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/me", nil)
+			if tt.authorization != "" {
+				req.Header.Set(echo.HeaderAuthorization, tt.authorization)
+			}
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			if tt.hostTenantID != "" {
+				c.Set(context_keys.HostTenantIDContextKey, tt.hostTenantID)
+			}
+
+			err := NewJWTAuthMiddleware(jwtService).Authenticate(func(c echo.Context) error {
+				assert.Equal(t, "tenant-acme", c.Get(context_keys.TenantIDContextKey))
+				return c.NoContent(http.StatusOK)
+			})(c)
+			if err != nil {
+				e.HTTPErrorHandler(err, c)
+			}
+
+			assert.Equal(t, tt.status, rec.Code)
+		})
+	}
+}
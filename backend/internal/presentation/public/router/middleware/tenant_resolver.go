@@ -0,0 +1,145 @@
+package middleware
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"good-todo-go/internal/domain/repository"
+	"good-todo-go/internal/pkg"
+	"good-todo-go/internal/presentation/public/router/context_keys"
+	"good-todo-go/internal/usecase"
+
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	// TenantResolveTTL is how long a host's tenant is served from memory before it is
+	// looked up again.
+	TenantResolveTTL = 30 * time.Second
+	// MaxResolvedHosts caps the cache, which holds a host per slug and custom domain
+	// of every tenant seen.
+	MaxResolvedHosts = 10000
+)
+
+// TenantResolverMiddleware identifies the tenant from the request host. Subdomains of the
+// base domain are looked up as tenant slugs, any other host as a verified custom domain.
+// Found tenants are cached for TenantResolveTTL. Hosts that identify no tenant are looked
+// up on every request, so a domain or slug works as soon as it is verified or taken.
+type TenantResolverMiddleware struct {
+	tenantRepo repository.ITenantRepository
+	baseDomain string
+	clock      pkg.IClock
+
+	mu       sync.Mutex
+	resolved map[string]resolvedHost
+}
+
+// resolvedHost is a cached lookup of a host that identifies a tenant.
+type resolvedHost struct {
+	tenantID  string
+	expiresAt time.Time
+}
+
+func NewTenantResolverMiddleware(tenantRepo repository.ITenantRepository, baseDomain string, clock pkg.IClock) *TenantResolverMiddleware {
+	return &TenantResolverMiddleware{
+		tenantRepo: tenantRepo,
+		baseDomain: strings.ToLower(baseDomain),
+		clock:      clock,
+		resolved:   make(map[string]resolvedHost),
+	}
+}
+
+func (m *TenantResolverMiddleware) Resolve(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		host := requestHostname(c.Request())
+
+		tenantID, err := m.tenantID(c.Request().Context(), host)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		}
+		if tenantID != "" {
+			c.Set(context_keys.HostTenantIDContextKey, tenantID)
+		} else if m.isTenantSubdomain(host) {
+			return echo.NewHTTPError(http.StatusNotFound, "tenant not found")
+		}
+
+		return next(c)
+	}
+}
+
+// tenantID returns the ID of the tenant for host, or "" if the host does not identify
+// one. Found tenants come from the cache while it is fresh.
+func (m *TenantResolverMiddleware) tenantID(ctx context.Context, host string) (string, error) {
+	if host == "" || host == m.baseDomain {
+		return "", nil
+	}
+
+	now := m.clock.Now()
+	m.mu.Lock()
+	cached, ok := m.resolved[host]
+	m.mu.Unlock()
+	if ok && now.Before(cached.expiresAt) {
+		return cached.tenantID, nil
+	}
+
+	tenantID, err := m.lookup(ctx, host)
+	if err != nil || tenantID == "" {
+		return "", err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if len(m.resolved) >= MaxResolvedHosts {
+		for h, r := range m.resolved {
+			if !now.Before(r.expiresAt) {
+				delete(m.resolved, h)
+			}
+		}
+		if len(m.resolved) >= MaxResolvedHosts {
+			clear(m.resolved)
+		}
+	}
+	m.resolved[host] = resolvedHost{tenantID: tenantID, expiresAt: now.Add(TenantResolveTTL)}
+	return tenantID, nil
+}
+
+// lookup finds the tenant for host in the repository.
+func (m *TenantResolverMiddleware) lookup(ctx context.Context, host string) (string, error) {
+	find := m.tenantRepo.FindByDomain
+	key := host
+	if sub, ok := strings.CutSuffix(host, "."+m.baseDomain); ok {
+		if !m.isTenantSubdomain(host) {
+			return "", nil
+		}
+		find, key = m.tenantRepo.FindBySlug, sub
+	}
+
+	tenant, err := find(ctx, key)
+	if err != nil || tenant == nil {
+		return "", err
+	}
+	return tenant.ID, nil
+}
+
+// isTenantSubdomain reports whether host is a subdomain of the base domain that can
+// only be a tenant slug. Reserved names such as www or api are served without a tenant.
+func (m *TenantResolverMiddleware) isTenantSubdomain(host string) bool {
+	sub, ok := strings.CutSuffix(host, "."+m.baseDomain)
+	if !ok {
+		return false
+	}
+	return usecase.ValidateTenantSlug(sub) != usecase.ErrReservedTenantSlug
+}
+
+// requestHostname returns the lower-cased host of r without port or trailing dot.
+func requestHostname(r *http.Request) string {
+	host := r.Host
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	return strings.TrimSuffix(strings.ToLower(host), ".")
+}
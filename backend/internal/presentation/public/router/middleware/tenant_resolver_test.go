@@ -0,0 +1,195 @@
+package middleware
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/domain/repository/mock"
+	mocku "good-todo-go/internal/pkg/mock"
+	"good-todo-go/internal/presentation/public/router/context_keys"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// resolveHost runs a request for host through m and returns the response status and
+// the host tenant the handler saw.
+func resolveHost(m *TenantResolverMiddleware, host string) (int, string) {
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/todos", nil)
+	req.Host = host
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	var hostTenantID string
+	err := m.Resolve(func(c echo.Context) error {
+		hostTenantID, _ = c.Get(context_keys.HostTenantIDContextKey).(string)
+		return c.NoContent(http.StatusOK)
+	})(c)
+	if err != nil {
+		e.HTTPErrorHandler(err, c)
+	}
+	return rec.Code, hostTenantID
+}
+
+func TestTenantResolverMiddleware_Resolve(t *testing.T) {
+	acme := &model.Tenant{ID: "tenant-acme", Slug: "acme"}
+
+	tests := []struct {
+		name     string
+		host     string
+		expect   func(repo *mock.MockITenantRepository)
+		status   int
+		tenantID string
+	}{
+		{
+			name: "subdomain slug",
+			host: "acme.todo.example.com",
+			expect: func(repo *mock.MockITenantRepository) {
+				repo.EXPECT().FindBySlug(gomock.Any(), "acme").Return(acme, nil)
+			},
+			status:   http.StatusOK,
+			tenantID: "tenant-acme",
+		},
+		{
+			name: "renamed slug",
+			host: "acme-old.todo.example.com:8080",
+			expect: func(repo *mock.MockITenantRepository) {
+				// The repository resolves slugs from the rename history too
+				repo.EXPECT().FindBySlug(gomock.Any(), "acme-old").Return(acme, nil)
+			},
+			status:   http.StatusOK,
+			tenantID: "tenant-acme",
+		},
+		{
+			name: "verified custom domain",
+			host: "Todos.Acme.com.",
+			expect: func(repo *mock.MockITenantRepository) {
+				repo.EXPECT().FindByDomain(gomock.Any(), "todos.acme.com").Return(acme, nil)
+			},
+			status:   http.StatusOK,
+			tenantID: "tenant-acme",
+		},
+		{
+			name: "unverified custom domain",
+			host: "pending.acme.com",
+			expect: func(repo *mock.MockITenantRepository) {
+				// Only verified domains resolve
+				repo.EXPECT().FindByDomain(gomock.Any(), "pending.acme.com").Return(nil, nil)
+			},
+			status: http.StatusOK,
+		},
+		{
+			name: "unknown subdomain",
+			host: "nobody.todo.example.com",
+			expect: func(repo *mock.MockITenantRepository) {
+				repo.EXPECT().FindBySlug(gomock.Any(), "nobody").Return(nil, nil)
+			},
+			status: http.StatusNotFound,
+		},
+		{
+			name:   "base domain",
+			host:   "todo.example.com",
+			status: http.StatusOK,
+		},
+		{
+			name:   "reserved subdomain",
+			host:   "www.todo.example.com",
+			status: http.StatusOK,
+		},
+		{
+			name: "lookup failure",
+			host: "acme.todo.example.com",
+			expect: func(repo *mock.MockITenantRepository) {
+				repo.EXPECT().FindBySlug(gomock.Any(), "acme").Return(nil, errors.New("connection refused"))
+			},
+			status: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mock.NewMockITenantRepository(ctrl)
+			if tt.expect != nil {
+				tt.expect(repo)
+			}
+			m := NewTenantResolverMiddleware(repo, "todo.example.com", mocku.NewMockClock(time.Now()))
+
+			status, tenantID := resolveHost(m, tt.host)
+
+			assert.Equal(t, tt.status, status)
+			assert.Equal(t, tt.tenantID, tenantID)
+		})
+	}
+}
+
+func TestTenantResolverMiddleware_Cache(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mock.NewMockITenantRepository(ctrl)
+	clock := mocku.NewMockClock(time.Date(2024, 3, 11, 10, 0, 0, 0, time.UTC))
+	m := NewTenantResolverMiddleware(repo, "todo.example.com", clock)
+
+	t.Run("a found tenant is looked up once per TTL", func(t *testing.T) {
+		repo.EXPECT().FindBySlug(gomock.Any(), "acme").Return(&model.Tenant{ID: "tenant-acme"}, nil)
+
+		for range 3 {
+			status, tenantID := resolveHost(m, "acme.todo.example.com")
+			require.Equal(t, http.StatusOK, status)
+			require.Equal(t, "tenant-acme", tenantID)
+		}
+	})
+
+	t.Run("hosts without a tenant are not cached", func(t *testing.T) {
+		repo.EXPECT().FindByDomain(gomock.Any(), "unknown.example.org").Return(nil, nil)
+		// The domain is verified right after the first request
+		repo.EXPECT().FindByDomain(gomock.Any(), "unknown.example.org").Return(&model.Tenant{ID: "tenant-new"}, nil)
+
+		status, tenantID := resolveHost(m, "unknown.example.org")
+		require.Equal(t, http.StatusOK, status)
+		require.Empty(t, tenantID)
+
+		_, tenantID = resolveHost(m, "unknown.example.org")
+		assert.Equal(t, "tenant-new", tenantID)
+	})
+
+	t.Run("an unknown slug is found once the tenant exists", func(t *testing.T) {
+		repo.EXPECT().FindBySlug(gomock.Any(), "newco").Return(nil, nil)
+		repo.EXPECT().FindBySlug(gomock.Any(), "newco").Return(&model.Tenant{ID: "tenant-newco"}, nil)
+
+		status, _ := resolveHost(m, "newco.todo.example.com")
+		require.Equal(t, http.StatusNotFound, status)
+
+		_, tenantID := resolveHost(m, "newco.todo.example.com")
+		assert.Equal(t, "tenant-newco", tenantID)
+	})
+
+	t.Run("failed lookups are not cached", func(t *testing.T) {
+		repo.EXPECT().FindByDomain(gomock.Any(), "flaky.example.org").Return(nil, errors.New("connection refused"))
+		repo.EXPECT().FindByDomain(gomock.Any(), "flaky.example.org").Return(&model.Tenant{ID: "tenant-flaky"}, nil)
+
+		status, _ := resolveHost(m, "flaky.example.org")
+		require.Equal(t, http.StatusInternalServerError, status)
+
+		_, tenantID := resolveHost(m, "flaky.example.org")
+		assert.Equal(t, "tenant-flaky", tenantID)
+	})
+
+	t.Run("entries are looked up again once the TTL passes", func(t *testing.T) {
+		clock.At = clock.At.Add(TenantResolveTTL)
+		repo.EXPECT().FindBySlug(gomock.Any(), "acme").Return(nil, nil)
+
+		status, _ := resolveHost(m, "acme.todo.example.com")
+		assert.Equal(t, http.StatusNotFound, status)
+	})
+}
@@ -0,0 +1,55 @@
+package router
+
+import (
+	"good-todo-go/internal/presentation/public/api"
+
+	"github.com/labstack/echo/v4"
+)
+
+func (s *Server) ListProjects(ctx echo.Context, params api.ListProjectsParams) error {
+	return s.projectController.ListProjects(ctx, params)
+}
+
+func (s *Server) GetProject(ctx echo.Context, id string) error {
+	return s.projectController.GetProject(ctx, id)
+}
+
+func (s *Server) CreateProject(ctx echo.Context) error {
+	var req api.CreateProjectRequest
+	if err := ctx.Bind(&req); err != nil {
+		return err
+	}
+	return s.projectController.CreateProject(ctx, req)
+}
+
+func (s *Server) UpdateProject(ctx echo.Context, id string) error {
+	var req api.UpdateProjectRequest
+	if err := ctx.Bind(&req); err != nil {
+		return err
+	}
+	return s.projectController.UpdateProject(ctx, id, req)
+}
+
+func (s *Server) DeleteProject(ctx echo.Context, id string, params api.DeleteProjectParams) error {
+	deleteTodos := params.DeleteTodos != nil && *params.DeleteTodos
+	return s.projectController.DeleteProject(ctx, id, deleteTodos)
+}
+
+func (s *Server) ListProjectTodos(ctx echo.Context, id string, params api.ListProjectTodosParams) error {
+	// The project's todos take the listing parameters apart from project_id
+	return s.todoController.ListProjectTodos(ctx, id, api.ListTodosParams{
+		Limit:          params.Limit,
+		Cursor:         params.Cursor,
+		Query:          params.Query,
+		Completed:      params.Completed,
+		DueBefore:      params.DueBefore,
+		DueAfter:       params.DueAfter,
+		Overdue:        params.Overdue,
+		HasDueDate:     params.HasDueDate,
+		CreatedAfter:   params.CreatedAfter,
+		TagId:          params.TagId,
+		IncludeSnoozed: params.IncludeSnoozed,
+		Sort:           params.Sort,
+		Order:          params.Order,
+	})
+}
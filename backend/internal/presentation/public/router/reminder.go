@@ -0,0 +1,23 @@
+package router
+
+import (
+	"good-todo-go/internal/presentation/public/api"
+
+	"github.com/labstack/echo/v4"
+)
+
+func (s *Server) ListReminders(ctx echo.Context, id string) error {
+	return s.reminderController.ListReminders(ctx, id)
+}
+
+func (s *Server) CreateReminder(ctx echo.Context, id string) error {
+	var req api.CreateReminderRequest
+	if err := ctx.Bind(&req); err != nil {
+		return err
+	}
+	return s.reminderController.CreateReminder(ctx, id, req)
+}
+
+func (s *Server) DeleteReminder(ctx echo.Context, id, reminderID string) error {
+	return s.reminderController.DeleteReminder(ctx, id, reminderID)
+}
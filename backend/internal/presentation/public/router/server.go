@@ -5,19 +5,34 @@ import (
 )
 
 type Server struct {
-	authController *controller.AuthController
-	userController *controller.UserController
-	todoController *controller.TodoController
+	authController     *controller.AuthController
+	userController     *controller.UserController
+	tenantController   *controller.TenantController
+	todoController     *controller.TodoController
+	tagController      *controller.TagController
+	projectController  *controller.ProjectController
+	reminderController *controller.ReminderController
+	commentController  *controller.CommentController
 }
 
 func NewServer(
 	authController *controller.AuthController,
 	userController *controller.UserController,
+	tenantController *controller.TenantController,
 	todoController *controller.TodoController,
+	tagController *controller.TagController,
+	projectController *controller.ProjectController,
+	reminderController *controller.ReminderController,
+	commentController *controller.CommentController,
 ) *Server {
 	return &Server{
-		authController: authController,
-		userController: userController,
-		todoController: todoController,
+		authController:     authController,
+		userController:     userController,
+		tenantController:   tenantController,
+		todoController:     todoController,
+		tagController:      tagController,
+		projectController:  projectController,
+		reminderController: reminderController,
+		commentController:  commentController,
 	}
 }
@@ -0,0 +1,31 @@
+package router
+
+import (
+	"good-todo-go/internal/presentation/public/api"
+
+	"github.com/labstack/echo/v4"
+)
+
+func (s *Server) ListTags(ctx echo.Context) error {
+	return s.tagController.ListTags(ctx)
+}
+
+func (s *Server) CreateTag(ctx echo.Context) error {
+	var req api.CreateTagRequest
+	if err := ctx.Bind(&req); err != nil {
+		return err
+	}
+	return s.tagController.CreateTag(ctx, req)
+}
+
+func (s *Server) UpdateTag(ctx echo.Context, id string) error {
+	var req api.UpdateTagRequest
+	if err := ctx.Bind(&req); err != nil {
+		return err
+	}
+	return s.tagController.UpdateTag(ctx, id, req)
+}
+
+func (s *Server) DeleteTag(ctx echo.Context, id string) error {
+	return s.tagController.DeleteTag(ctx, id)
+}
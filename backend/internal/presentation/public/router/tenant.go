@@ -0,0 +1,39 @@
+package router
+
+import (
+	"good-todo-go/internal/presentation/public/api"
+
+	"github.com/labstack/echo/v4"
+)
+
+func (s *Server) GetTenant(ctx echo.Context) error {
+	return s.tenantController.GetTenant(ctx)
+}
+
+func (s *Server) UpdateTenant(ctx echo.Context) error {
+	var req api.UpdateTenantRequest
+	if err := ctx.Bind(&req); err != nil {
+		return err
+	}
+	return s.tenantController.UpdateTenant(ctx, req)
+}
+
+func (s *Server) ListTenantDomains(ctx echo.Context) error {
+	return s.tenantController.ListDomains(ctx)
+}
+
+func (s *Server) AddTenantDomain(ctx echo.Context) error {
+	var req api.AddTenantDomainRequest
+	if err := ctx.Bind(&req); err != nil {
+		return err
+	}
+	return s.tenantController.AddDomain(ctx, req)
+}
+
+func (s *Server) VerifyTenantDomain(ctx echo.Context, id string) error {
+	return s.tenantController.VerifyDomain(ctx, id)
+}
+
+func (s *Server) RemoveTenantDomain(ctx echo.Context, id string) error {
+	return s.tenantController.RemoveDomain(ctx, id)
+}
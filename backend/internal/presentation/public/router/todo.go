@@ -1,17 +1,52 @@
 package router
 
 import (
+	"encoding/json"
+	"net/http"
+
 	"good-todo-go/internal/presentation/public/api"
 
 	"github.com/labstack/echo/v4"
 )
 
-func (s *Server) ListTodos(ctx echo.Context) error {
-	return s.todoController.ListTodos(ctx)
+func (s *Server) ListTodos(ctx echo.Context, params api.ListTodosParams) error {
+	return s.todoController.ListTodos(ctx, params)
+}
+
+func (s *Server) ListPublicTodos(ctx echo.Context, params api.ListPublicTodosParams) error {
+	// Both listings accept the same parameters
+	return s.todoController.ListPublicTodos(ctx, api.ListTodosParams(params))
+}
+
+func (s *Server) ListTrashedTodos(ctx echo.Context, params api.ListTrashedTodosParams) error {
+	// The trash holds snoozed todos either way, so it takes the listing parameters apart from include_snoozed
+	return s.todoController.ListTrashedTodos(ctx, api.ListTodosParams{
+		Limit:        params.Limit,
+		Cursor:       params.Cursor,
+		Query:        params.Query,
+		Completed:    params.Completed,
+		DueBefore:    params.DueBefore,
+		DueAfter:     params.DueAfter,
+		Overdue:      params.Overdue,
+		HasDueDate:   params.HasDueDate,
+		CreatedAfter: params.CreatedAfter,
+		TagId:        params.TagId,
+		ProjectId:    params.ProjectId,
+		Sort:         params.Sort,
+		Order:        params.Order,
+	})
+}
+
+func (s *Server) ListAssignedTodos(ctx echo.Context, params api.ListAssignedTodosParams) error {
+	return s.todoController.ListAssignedTodos(ctx, params)
 }
 
-func (s *Server) ListPublicTodos(ctx echo.Context) error {
-	return s.todoController.ListPublicTodos(ctx)
+func (s *Server) ListTodoView(ctx echo.Context, view api.TodoView, params api.ListTodoViewParams) error {
+	return s.todoController.ListTodoView(ctx, view, params)
+}
+
+func (s *Server) SearchTodos(ctx echo.Context, params api.SearchTodosParams) error {
+	return s.todoController.SearchTodos(ctx, params)
 }
 
 func (s *Server) CreateTodo(ctx echo.Context) error {
@@ -22,14 +57,100 @@ func (s *Server) CreateTodo(ctx echo.Context) error {
 	return s.todoController.CreateTodo(ctx, req)
 }
 
-func (s *Server) UpdateTodo(ctx echo.Context, id string) error {
+func (s *Server) BatchTodos(ctx echo.Context) error {
+	var req api.BatchTodoRequest
+	if err := ctx.Bind(&req); err != nil {
+		return err
+	}
+	return s.todoController.BatchTodos(ctx, req)
+}
+
+func (s *Server) UpdateTodo(ctx echo.Context, id string, params api.UpdateTodoParams) error {
 	var req api.UpdateTodoRequest
 	if err := ctx.Bind(&req); err != nil {
 		return err
 	}
-	return s.todoController.UpdateTodo(ctx, id, req)
+	force := params.Force != nil && *params.Force
+	return s.todoController.UpdateTodo(ctx, id, req, params.IfMatch, force)
+}
+
+func (s *Server) PatchTodo(ctx echo.Context, id string, params api.PatchTodoParams) error {
+	// A merge patch tells a null member from a missing one, which the generated
+	// request type cannot, so the controller gets the raw members
+	var patch map[string]json.RawMessage
+	if err := json.NewDecoder(ctx.Request().Body).Decode(&patch); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "request body must be a JSON object")
+	}
+	force := params.Force != nil && *params.Force
+	return s.todoController.PatchTodo(ctx, id, patch, params.IfMatch, force)
+}
+
+func (s *Server) DeleteTodo(ctx echo.Context, id string, params api.DeleteTodoParams) error {
+	permanent := params.Permanent != nil && *params.Permanent
+	cascade := params.Cascade != nil && *params.Cascade
+	return s.todoController.DeleteTodo(ctx, id, permanent, cascade, params.IfMatch)
+}
+
+func (s *Server) RestoreTodo(ctx echo.Context, id string) error {
+	return s.todoController.RestoreTodo(ctx, id)
+}
+
+func (s *Server) ListSubtasks(ctx echo.Context, id string) error {
+	return s.todoController.ListSubtasks(ctx, id)
+}
+
+func (s *Server) ListTodoOccurrences(ctx echo.Context, id string, params api.ListTodoOccurrencesParams) error {
+	return s.todoController.ListTodoOccurrences(ctx, id, params)
+}
+
+func (s *Server) ListBlockers(ctx echo.Context, id string) error {
+	return s.todoController.ListBlockers(ctx, id)
 }
 
-func (s *Server) DeleteTodo(ctx echo.Context, id string) error {
-	return s.todoController.DeleteTodo(ctx, id)
+func (s *Server) AddBlocker(ctx echo.Context, id string) error {
+	var req api.AddBlockerRequest
+	if err := ctx.Bind(&req); err != nil {
+		return err
+	}
+	return s.todoController.AddBlocker(ctx, id, req)
+}
+
+func (s *Server) RemoveBlocker(ctx echo.Context, id string, blockerID string) error {
+	return s.todoController.RemoveBlocker(ctx, id, blockerID)
+}
+
+func (s *Server) AssignTodo(ctx echo.Context, id string) error {
+	var req api.AssignTodoRequest
+	if err := ctx.Bind(&req); err != nil {
+		return err
+	}
+	return s.todoController.AssignTodo(ctx, id, req)
+}
+
+func (s *Server) UnassignTodo(ctx echo.Context, id string) error {
+	return s.todoController.UnassignTodo(ctx, id)
+}
+
+func (s *Server) SnoozeTodo(ctx echo.Context, id string) error {
+	var req api.SnoozeTodoRequest
+	if err := ctx.Bind(&req); err != nil {
+		return err
+	}
+	return s.todoController.SnoozeTodo(ctx, id, req)
+}
+
+func (s *Server) UnsnoozeTodo(ctx echo.Context, id string) error {
+	return s.todoController.UnsnoozeTodo(ctx, id)
+}
+
+func (s *Server) ListTodoActivity(ctx echo.Context, id string) error {
+	return s.todoController.ListTodoActivity(ctx, id)
+}
+
+func (s *Server) MoveTodo(ctx echo.Context, id string) error {
+	var req api.MoveTodoRequest
+	if err := ctx.Bind(&req); err != nil {
+		return err
+	}
+	return s.todoController.MoveTodo(ctx, id, req)
 }
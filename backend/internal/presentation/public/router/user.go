@@ -17,3 +17,19 @@ func (s *Server) UpdateMe(ctx echo.Context) error {
 	}
 	return s.userController.UpdateMe(ctx, req)
 }
+
+func (s *Server) ListMyTenants(ctx echo.Context) error {
+	return s.userController.ListMyTenants(ctx)
+}
+
+func (s *Server) GetMyDigest(ctx echo.Context) error {
+	return s.userController.GetMyDigest(ctx)
+}
+
+func (s *Server) UpdateMyDigest(ctx echo.Context) error {
+	var req api.UpdateDigestPreferenceRequest
+	if err := ctx.Bind(&req); err != nil {
+		return err
+	}
+	return s.userController.UpdateMyDigest(ctx, req)
+}
@@ -3,7 +3,6 @@ package usecase
 import (
 	"context"
 	"errors"
-	"strings"
 	"time"
 
 	"good-todo-go/internal/domain/model"
@@ -16,9 +15,11 @@ import (
 var (
 	ErrInvalidCredentials = errors.New("invalid credentials")
 	ErrEmailNotVerified   = errors.New("email not verified")
-	ErrUserAlreadyExists  = errors.New("user already exists")
+	ErrUserAlreadyExists  = repository.ErrUserAlreadyExists
+	ErrTenantSlugTaken    = repository.ErrTenantSlugConflict
 	ErrInvalidToken       = errors.New("invalid token")
 	ErrTokenExpired       = errors.New("token expired")
+	ErrNotTenantMember    = errors.New("not a member of the tenant")
 )
 
 type IAuthInteractor interface {
@@ -26,11 +27,15 @@ type IAuthInteractor interface {
 	Login(ctx context.Context, input *input.LoginInput) (*output.LoginOutput, error)
 	VerifyEmail(ctx context.Context, input *input.VerifyEmailInput) (*output.VerifyEmailOutput, error)
 	RefreshToken(ctx context.Context, input *input.RefreshTokenInput) (*output.RefreshTokenOutput, error)
+	SwitchTenant(ctx context.Context, actor input.Actor, input *input.SwitchTenantInput) (*output.LoginOutput, error)
+	CreateTenant(ctx context.Context, actor input.Actor, input *input.CreateTenantInput) (*output.MembershipOutput, error)
 }
 
 type AuthInteractor struct {
+	unitOfWork      repository.IUnitOfWork
 	tenantRepo      repository.ITenantRepository
 	userRepo        repository.IUserRepository
+	membershipRepo  repository.IMembershipRepository
 	authRepo        repository.IAuthRepository
 	jwtService      *pkg.JWTService
 	passwordService *pkg.PasswordService
@@ -38,16 +43,20 @@ type AuthInteractor struct {
 }
 
 func NewAuthInteractor(
+	unitOfWork repository.IUnitOfWork,
 	tenantRepo repository.ITenantRepository,
 	userRepo repository.IUserRepository,
+	membershipRepo repository.IMembershipRepository,
 	authRepo repository.IAuthRepository,
 	jwtService *pkg.JWTService,
 	passwordService *pkg.PasswordService,
 	uuidGenerator pkg.IUUIDGenerator,
 ) IAuthInteractor {
 	return &AuthInteractor{
+		unitOfWork:      unitOfWork,
 		tenantRepo:      tenantRepo,
 		userRepo:        userRepo,
+		membershipRepo:  membershipRepo,
 		authRepo:        authRepo,
 		jwtService:      jwtService,
 		passwordService: passwordService,
@@ -55,46 +64,67 @@ func NewAuthInteractor(
 	}
 }
 
+// Register creates a tenant with a new user as its admin. An email that already has an
+// account is rejected; its owner adds tenants through CreateTenant once logged in.
 func (i *AuthInteractor) Register(ctx context.Context, inp *input.RegisterInput) (*output.RegisterOutput, error) {
 	// Generate IDs
 	tenantID := i.uuidGenerator.Generate()
 	userID := i.uuidGenerator.Generate()
 	verificationToken := i.uuidGenerator.Generate()
 
-	// Create slug from email domain or use random
-	slug := strings.Split(inp.Email, "@")[0] + "-" + tenantID[:8]
-
-	// Create tenant
-	tenant := &model.Tenant{
-		ID:   tenantID,
-		Name: inp.Name,
-		Slug: slug,
+	slug, err := i.tenantSlug(ctx, inp.TenantSlug, inp.Email, tenantID)
+	if err != nil {
+		return nil, err
 	}
-	_, err := i.tenantRepo.Create(ctx, tenant)
+
+	existing, err := i.userRepo.FindByEmail(ctx, inp.Email)
 	if err != nil {
 		return nil, err
 	}
+	if existing != nil {
+		return nil, ErrUserAlreadyExists
+	}
 
-	// Hash password
 	hashedPassword, err := i.passwordService.HashPassword(inp.Password)
 	if err != nil {
 		return nil, err
 	}
-
-	// Create user
 	tokenExpiry := time.Now().Add(24 * time.Hour)
+
+	tenant := &model.Tenant{
+		ID:   tenantID,
+		Name: inp.Name,
+		Slug: slug,
+	}
 	user := &model.User{
 		ID:                         userID,
-		TenantID:                   tenantID,
 		Email:                      inp.Email,
 		PasswordHash:               hashedPassword,
 		Name:                       inp.Name,
-		Role:                       model.UserRoleAdmin,
 		EmailVerified:              false,
 		VerificationToken:          &verificationToken,
 		VerificationTokenExpiresAt: &tokenExpiry,
 	}
-	_, err = i.userRepo.Create(ctx, user)
+	membership := &model.Membership{
+		ID:       i.uuidGenerator.Generate(),
+		TenantID: tenantID,
+		UserID:   userID,
+		Role:     model.UserRoleAdmin,
+	}
+
+	// Create tenant, membership and user atomically so a failure never leaves an orphan
+	// tenant. RLS only lets the tenant write a user that is already its member; the
+	// membership's foreign key to the user is checked at commit.
+	err = i.unitOfWork.RunInTenantTx(ctx, tenantID, func(ctx context.Context) error {
+		if _, err := i.tenantRepo.Create(ctx, tenant); err != nil {
+			return err
+		}
+		if _, err := i.membershipRepo.Create(ctx, membership); err != nil {
+			return err
+		}
+		_, err := i.userRepo.Create(ctx, user)
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -114,6 +144,78 @@ func (i *AuthInteractor) Register(ctx context.Context, inp *input.RegisterInput)
 	}, nil
 }
 
+// CreateTenant creates another tenant with the actor as its admin. The actor's tokens stay
+// scoped to their current tenant until they switch to the new one.
+func (i *AuthInteractor) CreateTenant(ctx context.Context, actor input.Actor, inp *input.CreateTenantInput) (*output.MembershipOutput, error) {
+	user, err := i.userRepo.FindByID(ctx, actor.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, ErrUserNotFound
+	}
+
+	tenantID := i.uuidGenerator.Generate()
+	slug, err := i.tenantSlug(ctx, inp.TenantSlug, user.Email, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	tenant := &model.Tenant{
+		ID:   tenantID,
+		Name: inp.Name,
+		Slug: slug,
+	}
+	membership := &model.Membership{
+		ID:       i.uuidGenerator.Generate(),
+		TenantID: tenantID,
+		UserID:   user.ID,
+		Role:     model.UserRoleAdmin,
+	}
+
+	var created *model.Membership
+	err = i.unitOfWork.RunInTenantTx(ctx, tenantID, func(ctx context.Context) error {
+		if _, err := i.tenantRepo.Create(ctx, tenant); err != nil {
+			return err
+		}
+		created, err = i.membershipRepo.Create(ctx, membership)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &output.MembershipOutput{
+		TenantID:   tenant.ID,
+		TenantName: tenant.Name,
+		TenantSlug: tenant.Slug,
+		Role:       string(created.Role),
+		Current:    false,
+		JoinedAt:   created.CreatedAt,
+	}, nil
+}
+
+// tenantSlug returns the requested vanity slug for a new tenant, or one derived from
+// email when none is requested.
+func (i *AuthInteractor) tenantSlug(ctx context.Context, requested, email, tenantID string) (string, error) {
+	if requested == "" {
+		return defaultTenantSlug(email, tenantID), nil
+	}
+	slug := NormalizeTenantSlug(requested)
+	if err := ValidateTenantSlug(slug); err != nil {
+		return "", err
+	}
+	// Previous slugs in their grace period are not available either
+	existing, err := i.tenantRepo.FindBySlug(ctx, slug)
+	if err != nil {
+		return "", err
+	}
+	if existing != nil {
+		return "", ErrTenantSlugTaken
+	}
+	return slug, nil
+}
+
 func (i *AuthInteractor) Login(ctx context.Context, inp *input.LoginInput) (*output.LoginOutput, error) {
 	// Find all users with this email (across tenants) - in real app, you'd need tenant slug in login
 	// For simplicity, we'll search without tenant context (RLS allows this for empty tenant)
@@ -132,7 +234,7 @@ func (i *AuthInteractor) Login(ctx context.Context, inp *input.LoginInput) (*out
 }
 
 func (i *AuthInteractor) LoginWithTenant(ctx context.Context, tenantID string, inp *input.LoginInput) (*output.LoginOutput, error) {
-	user, err := i.userRepo.FindByEmail(ctx, tenantID, inp.Email)
+	user, err := i.userRepo.FindByEmail(ctx, inp.Email)
 	if err != nil {
 		return nil, err
 	}
@@ -148,12 +250,45 @@ func (i *AuthInteractor) LoginWithTenant(ctx context.Context, tenantID string, i
 		return nil, ErrEmailNotVerified
 	}
 
-	accessToken, err := i.jwtService.GenerateAccessToken(user.ID, user.TenantID, user.Email, string(user.Role))
+	membership, err := i.membershipRepo.FindByUserAndTenant(ctx, user.ID, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	if membership == nil {
+		return nil, ErrInvalidCredentials
+	}
+
+	return i.issueTokens(user, membership)
+}
+
+func (i *AuthInteractor) SwitchTenant(ctx context.Context, actor input.Actor, inp *input.SwitchTenantInput) (*output.LoginOutput, error) {
+	membership, err := i.membershipRepo.FindByUserAndTenant(ctx, actor.UserID, inp.TenantID)
+	if err != nil {
+		return nil, err
+	}
+	if membership == nil {
+		return nil, ErrNotTenantMember
+	}
+
+	user, err := i.userRepo.FindByID(ctx, actor.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, ErrUserNotFound
+	}
+
+	return i.issueTokens(user, membership)
+}
+
+// issueTokens creates a token pair scoped to the membership's tenant and role.
+func (i *AuthInteractor) issueTokens(user *model.User, membership *model.Membership) (*output.LoginOutput, error) {
+	accessToken, err := i.jwtService.GenerateAccessToken(user.ID, membership.TenantID, user.Email, string(membership.Role))
 	if err != nil {
 		return nil, err
 	}
 
-	refreshToken, err := i.jwtService.GenerateRefreshToken(user.ID, user.TenantID, user.Email, string(user.Role))
+	refreshToken, err := i.jwtService.GenerateRefreshToken(user.ID, membership.TenantID, user.Email, string(membership.Role))
 	if err != nil {
 		return nil, err
 	}
@@ -162,10 +297,10 @@ func (i *AuthInteractor) LoginWithTenant(ctx context.Context, tenantID string, i
 		AccessToken:  accessToken,
 		RefreshToken: refreshToken,
 		UserID:       user.ID,
-		TenantID:     user.TenantID,
+		TenantID:     membership.TenantID,
 		Email:        user.Email,
 		Name:         user.Name,
-		Role:         string(user.Role),
+		Role:         string(membership.Role),
 	}, nil
 }
 
@@ -203,12 +338,21 @@ func (i *AuthInteractor) RefreshToken(ctx context.Context, inp *input.RefreshTok
 		return nil, ErrInvalidToken
 	}
 
-	accessToken, err := i.jwtService.GenerateAccessToken(claims.UserID, claims.TenantID, claims.Email, claims.Role)
+	// The membership may have been removed or its role changed since the token was issued
+	membership, err := i.membershipRepo.FindByUserAndTenant(ctx, claims.UserID, claims.TenantID)
+	if err != nil {
+		return nil, err
+	}
+	if membership == nil {
+		return nil, ErrInvalidToken
+	}
+
+	accessToken, err := i.jwtService.GenerateAccessToken(claims.UserID, claims.TenantID, claims.Email, string(membership.Role))
 	if err != nil {
 		return nil, err
 	}
 
-	refreshToken, err := i.jwtService.GenerateRefreshToken(claims.UserID, claims.TenantID, claims.Email, claims.Role)
+	refreshToken, err := i.jwtService.GenerateRefreshToken(claims.UserID, claims.TenantID, claims.Email, string(membership.Role))
 	if err != nil {
 		return nil, err
 	}
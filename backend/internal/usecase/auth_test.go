@@ -0,0 +1,479 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/domain/repository"
+	"good-todo-go/internal/domain/repository/mock"
+	"good-todo-go/internal/pkg"
+	mocku "good-todo-go/internal/pkg/mock"
+	"good-todo-go/internal/usecase/input"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+)
+
+func runInTx(ctx context.Context, tenantID string, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func TestAuthInteractor_Register(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUnitOfWork := mock.NewMockIUnitOfWork(ctrl)
+	mockTenantRepo := mock.NewMockITenantRepository(ctrl)
+	mockUserRepo := mock.NewMockIUserRepository(ctrl)
+	mockMembershipRepo := mock.NewMockIMembershipRepository(ctrl)
+	mockAuthRepo := mock.NewMockIAuthRepository(ctrl)
+
+	newInteractor := func() IAuthInteractor {
+		return NewAuthInteractor(
+			mockUnitOfWork,
+			mockTenantRepo,
+			mockUserRepo,
+			mockMembershipRepo,
+			mockAuthRepo,
+			pkg.NewJWTService("test-secret"),
+			pkg.NewPasswordService(),
+			mocku.NewMockUUIDGenerator("tenant-12345678", "user-123", "verify-token", "membership-123"),
+		)
+	}
+
+	inp := &input.RegisterInput{
+		Email:    "alice@example.com",
+		Password: "password123",
+		Name:     "Alice",
+	}
+
+	t.Run("success", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockUserRepo.EXPECT().
+			FindByEmail(ctx, inp.Email).
+			Return(nil, nil)
+
+		mockUnitOfWork.EXPECT().
+			RunInTenantTx(ctx, "tenant-12345678", gomock.Any()).
+			DoAndReturn(runInTx)
+
+		mockTenantRepo.EXPECT().
+			Create(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, tenant *model.Tenant) (*model.Tenant, error) {
+				assert.Equal(t, "alice-tenant-1", tenant.Slug)
+				return tenant, nil
+			})
+
+		// The membership goes first so RLS lets the tenant write its new user
+		gomock.InOrder(
+			mockMembershipRepo.EXPECT().
+				Create(ctx, gomock.Any()).
+				DoAndReturn(func(_ context.Context, membership *model.Membership) (*model.Membership, error) {
+					assert.Equal(t, "tenant-12345678", membership.TenantID)
+					assert.Equal(t, "user-123", membership.UserID)
+					assert.Equal(t, model.UserRoleAdmin, membership.Role)
+					return membership, nil
+				}),
+			mockUserRepo.EXPECT().
+				Create(ctx, gomock.Any()).
+				DoAndReturn(func(_ context.Context, user *model.User) (*model.User, error) {
+					assert.Equal(t, "user-123", user.ID)
+					return user, nil
+				}),
+		)
+
+		mockAuthRepo.EXPECT().
+			SendVerificationEmail(ctx, inp.Email, "verify-token").
+			Return(nil)
+
+		result, err := newInteractor().Register(ctx, inp)
+
+		require.NoError(t, err)
+		assert.Equal(t, "user-123", result.UserID)
+		assert.Equal(t, "tenant-12345678", result.TenantID)
+	})
+
+	t.Run("user already exists", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockUserRepo.EXPECT().
+			FindByEmail(ctx, inp.Email).
+			Return(nil, nil)
+
+		mockUnitOfWork.EXPECT().
+			RunInTenantTx(ctx, "tenant-12345678", gomock.Any()).
+			DoAndReturn(runInTx)
+
+		mockTenantRepo.EXPECT().
+			Create(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, tenant *model.Tenant) (*model.Tenant, error) {
+				return tenant, nil
+			})
+
+		mockMembershipRepo.EXPECT().
+			Create(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, membership *model.Membership) (*model.Membership, error) {
+				return membership, nil
+			})
+
+		mockUserRepo.EXPECT().
+			Create(ctx, gomock.Any()).
+			Return(nil, repository.ErrUserAlreadyExists)
+
+		_, err := newInteractor().Register(ctx, inp)
+
+		assert.Equal(t, ErrUserAlreadyExists, err)
+	})
+
+	t.Run("registered email is rejected even with its password", func(t *testing.T) {
+		ctx := context.Background()
+		hash, err := pkg.NewPasswordService().HashPassword(inp.Password)
+		require.NoError(t, err)
+
+		mockUserRepo.EXPECT().
+			FindByEmail(ctx, inp.Email).
+			Return(&model.User{ID: "existing-user", Email: inp.Email, PasswordHash: hash, EmailVerified: true}, nil)
+
+		_, err = newInteractor().Register(ctx, inp)
+
+		assert.Equal(t, ErrUserAlreadyExists, err)
+	})
+
+	t.Run("slug conflict skips user creation", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockUserRepo.EXPECT().
+			FindByEmail(ctx, inp.Email).
+			Return(nil, nil)
+
+		mockUnitOfWork.EXPECT().
+			RunInTenantTx(ctx, "tenant-12345678", gomock.Any()).
+			DoAndReturn(runInTx)
+
+		mockTenantRepo.EXPECT().
+			Create(ctx, gomock.Any()).
+			Return(nil, repository.ErrTenantSlugConflict)
+
+		_, err := newInteractor().Register(ctx, inp)
+
+		assert.Equal(t, ErrTenantSlugTaken, err)
+	})
+
+	t.Run("transaction failure sends no email", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockUserRepo.EXPECT().
+			FindByEmail(ctx, inp.Email).
+			Return(nil, nil)
+		txErr := errors.New("commit failed")
+
+		mockUnitOfWork.EXPECT().
+			RunInTenantTx(ctx, "tenant-12345678", gomock.Any()).
+			Return(txErr)
+
+		_, err := newInteractor().Register(ctx, inp)
+
+		assert.Equal(t, txErr, err)
+	})
+
+	t.Run("vanity slug is normalized", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockTenantRepo.EXPECT().
+			FindBySlug(ctx, "acme").
+			Return(nil, nil)
+
+		mockUserRepo.EXPECT().
+			FindByEmail(ctx, inp.Email).
+			Return(nil, nil)
+
+		mockUnitOfWork.EXPECT().
+			RunInTenantTx(ctx, "tenant-12345678", gomock.Any()).
+			DoAndReturn(runInTx)
+
+		mockTenantRepo.EXPECT().
+			Create(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, tenant *model.Tenant) (*model.Tenant, error) {
+				assert.Equal(t, "acme", tenant.Slug)
+				return tenant, nil
+			})
+
+		mockUserRepo.EXPECT().
+			Create(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, user *model.User) (*model.User, error) {
+				return user, nil
+			})
+
+		mockMembershipRepo.EXPECT().
+			Create(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, membership *model.Membership) (*model.Membership, error) {
+				return membership, nil
+			})
+
+		mockAuthRepo.EXPECT().
+			SendVerificationEmail(ctx, inp.Email, "verify-token").
+			Return(nil)
+
+		_, err := newInteractor().Register(ctx, &input.RegisterInput{
+			Email:      inp.Email,
+			Password:   inp.Password,
+			Name:       inp.Name,
+			TenantSlug: " Acme ",
+		})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("vanity slug held by another tenant", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockTenantRepo.EXPECT().
+			FindBySlug(ctx, "acme").
+			Return(&model.Tenant{ID: "other-tenant", Slug: "acme-corp"}, nil)
+
+		_, err := newInteractor().Register(ctx, &input.RegisterInput{
+			Email:      inp.Email,
+			Password:   inp.Password,
+			Name:       inp.Name,
+			TenantSlug: "acme",
+		})
+
+		assert.Equal(t, ErrTenantSlugTaken, err)
+	})
+
+	t.Run("reserved vanity slug", func(t *testing.T) {
+		_, err := newInteractor().Register(context.Background(), &input.RegisterInput{
+			Email:      inp.Email,
+			Password:   inp.Password,
+			Name:       inp.Name,
+			TenantSlug: "api",
+		})
+
+		assert.Equal(t, ErrReservedTenantSlug, err)
+	})
+}
+
+func TestAuthInteractor_SwitchTenant(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserRepo := mock.NewMockIUserRepository(ctrl)
+	mockMembershipRepo := mock.NewMockIMembershipRepository(ctrl)
+
+	jwtService := pkg.NewJWTService("test-secret")
+	interactor := NewAuthInteractor(
+		mock.NewMockIUnitOfWork(ctrl),
+		mock.NewMockITenantRepository(ctrl),
+		mockUserRepo,
+		mockMembershipRepo,
+		mock.NewMockIAuthRepository(ctrl),
+		jwtService,
+		pkg.NewPasswordService(),
+		mocku.NewMockUUIDGenerator(),
+	)
+
+	actor := input.Actor{UserID: "user-123", TenantID: "tenant-123", Role: model.UserRoleAdmin}
+
+	t.Run("success", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockMembershipRepo.EXPECT().
+			FindByUserAndTenant(ctx, "user-123", "tenant-456").
+			Return(&model.Membership{ID: "membership-456", TenantID: "tenant-456", UserID: "user-123", Role: model.UserRoleMember}, nil)
+
+		mockUserRepo.EXPECT().
+			FindByID(ctx, "user-123").
+			Return(&model.User{ID: "user-123", Email: "alice@example.com", Name: "Alice"}, nil)
+
+		result, err := interactor.SwitchTenant(ctx, actor, &input.SwitchTenantInput{TenantID: "tenant-456"})
+
+		require.NoError(t, err)
+		assert.Equal(t, "tenant-456", result.TenantID)
+		assert.Equal(t, string(model.UserRoleMember), result.Role)
+
+		claims, err := jwtService.ValidateToken(result.AccessToken)
+		require.NoError(t, err)
+		assert.Equal(t, "tenant-456", claims.TenantID)
+		assert.Equal(t, string(model.UserRoleMember), claims.Role)
+	})
+
+	t.Run("not a member", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockMembershipRepo.EXPECT().
+			FindByUserAndTenant(ctx, "user-123", "tenant-789").
+			Return(nil, nil)
+
+		_, err := interactor.SwitchTenant(ctx, actor, &input.SwitchTenantInput{TenantID: "tenant-789"})
+
+		assert.Equal(t, ErrNotTenantMember, err)
+	})
+}
+
+func TestAuthInteractor_CreateTenant(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUnitOfWork := mock.NewMockIUnitOfWork(ctrl)
+	mockTenantRepo := mock.NewMockITenantRepository(ctrl)
+	mockUserRepo := mock.NewMockIUserRepository(ctrl)
+	mockMembershipRepo := mock.NewMockIMembershipRepository(ctrl)
+
+	newInteractor := func() IAuthInteractor {
+		return NewAuthInteractor(
+			mockUnitOfWork,
+			mockTenantRepo,
+			mockUserRepo,
+			mockMembershipRepo,
+			mock.NewMockIAuthRepository(ctrl),
+			pkg.NewJWTService("test-secret"),
+			pkg.NewPasswordService(),
+			mocku.NewMockUUIDGenerator("tenant-12345678", "membership-123"),
+		)
+	}
+
+	actor := input.Actor{UserID: "user-123", TenantID: "tenant-123", Role: model.UserRoleMember}
+	joined := time.Date(2024, 3, 11, 10, 0, 0, 0, time.UTC)
+
+	t.Run("adds a tenant with the actor as admin", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockUserRepo.EXPECT().
+			FindByID(ctx, "user-123").
+			Return(&model.User{ID: "user-123", Email: "alice@example.com"}, nil)
+
+		mockTenantRepo.EXPECT().
+			FindBySlug(ctx, "acme").
+			Return(nil, nil)
+
+		mockUnitOfWork.EXPECT().
+			RunInTenantTx(ctx, "tenant-12345678", gomock.Any()).
+			DoAndReturn(runInTx)
+
+		mockTenantRepo.EXPECT().
+			Create(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, tenant *model.Tenant) (*model.Tenant, error) {
+				assert.Equal(t, "Acme", tenant.Name)
+				assert.Equal(t, "acme", tenant.Slug)
+				return tenant, nil
+			})
+
+		mockMembershipRepo.EXPECT().
+			Create(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, membership *model.Membership) (*model.Membership, error) {
+				assert.Equal(t, "tenant-12345678", membership.TenantID)
+				assert.Equal(t, "user-123", membership.UserID)
+				assert.Equal(t, model.UserRoleAdmin, membership.Role)
+				membership.CreatedAt = joined
+				return membership, nil
+			})
+
+		result, err := newInteractor().CreateTenant(ctx, actor, &input.CreateTenantInput{Name: "Acme", TenantSlug: "Acme"})
+
+		require.NoError(t, err)
+		assert.Equal(t, "tenant-12345678", result.TenantID)
+		assert.Equal(t, "acme", result.TenantSlug)
+		assert.Equal(t, string(model.UserRoleAdmin), result.Role)
+		assert.False(t, result.Current)
+		assert.Equal(t, joined, result.JoinedAt)
+	})
+
+	t.Run("slug is derived from the actor's email", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockUserRepo.EXPECT().
+			FindByID(ctx, "user-123").
+			Return(&model.User{ID: "user-123", Email: "alice@example.com"}, nil)
+
+		mockUnitOfWork.EXPECT().
+			RunInTenantTx(ctx, "tenant-12345678", gomock.Any()).
+			DoAndReturn(runInTx)
+
+		mockTenantRepo.EXPECT().
+			Create(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, tenant *model.Tenant) (*model.Tenant, error) {
+				assert.Equal(t, "alice-tenant-1", tenant.Slug)
+				return tenant, nil
+			})
+
+		mockMembershipRepo.EXPECT().
+			Create(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, membership *model.Membership) (*model.Membership, error) {
+				return membership, nil
+			})
+
+		_, err := newInteractor().CreateTenant(ctx, actor, &input.CreateTenantInput{Name: "Alice's side project"})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("taken slug", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockUserRepo.EXPECT().
+			FindByID(ctx, "user-123").
+			Return(&model.User{ID: "user-123", Email: "alice@example.com"}, nil)
+
+		mockTenantRepo.EXPECT().
+			FindBySlug(ctx, "acme").
+			Return(&model.Tenant{ID: "tenant-456", Slug: "acme"}, nil)
+
+		_, err := newInteractor().CreateTenant(ctx, actor, &input.CreateTenantInput{Name: "Acme", TenantSlug: "acme"})
+
+		assert.Equal(t, ErrTenantSlugTaken, err)
+	})
+}
+
+func TestAuthInteractor_RefreshToken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockMembershipRepo := mock.NewMockIMembershipRepository(ctrl)
+
+	jwtService := pkg.NewJWTService("test-secret")
+	interactor := NewAuthInteractor(
+		mock.NewMockIUnitOfWork(ctrl),
+		mock.NewMockITenantRepository(ctrl),
+		mock.NewMockIUserRepository(ctrl),
+		mockMembershipRepo,
+		mock.NewMockIAuthRepository(ctrl),
+		jwtService,
+		pkg.NewPasswordService(),
+		mocku.NewMockUUIDGenerator(),
+	)
+
+	refreshToken, err := jwtService.GenerateRefreshToken("user-123", "tenant-123", "alice@example.com", string(model.UserRoleAdmin))
+	require.NoError(t, err)
+
+	t.Run("uses current membership role", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockMembershipRepo.EXPECT().
+			FindByUserAndTenant(ctx, "user-123", "tenant-123").
+			Return(&model.Membership{TenantID: "tenant-123", UserID: "user-123", Role: model.UserRoleMember}, nil)
+
+		result, err := interactor.RefreshToken(ctx, &input.RefreshTokenInput{RefreshToken: refreshToken})
+
+		require.NoError(t, err)
+		claims, err := jwtService.ValidateToken(result.AccessToken)
+		require.NoError(t, err)
+		assert.Equal(t, string(model.UserRoleMember), claims.Role)
+	})
+
+	t.Run("membership removed", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockMembershipRepo.EXPECT().
+			FindByUserAndTenant(ctx, "user-123", "tenant-123").
+			Return(nil, nil)
+
+		_, err := interactor.RefreshToken(ctx, &input.RefreshTokenInput{RefreshToken: refreshToken})
+
+		assert.Equal(t, ErrInvalidToken, err)
+	})
+}
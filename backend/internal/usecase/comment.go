@@ -0,0 +1,343 @@
+package usecase
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"log"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/domain/repository"
+	"good-todo-go/internal/pkg"
+	"good-todo-go/internal/usecase/input"
+	"good-todo-go/internal/usecase/output"
+)
+
+const (
+	DefaultCommentPageSize = 20
+	MaxCommentPageSize     = 100
+	// MaxCommentLength caps a comment's body in characters.
+	MaxCommentLength = 10000
+	// MaxCommentMentions caps how many members one comment notifies; later mentions are
+	// left as plain text.
+	MaxCommentMentions = 20
+)
+
+var (
+	ErrCommentNotFound  = errors.New("comment not found")
+	ErrInvalidComment   = errors.New("comment body must be between 1 and 10000 characters")
+	ErrNotCommentAuthor = errors.New("not comment author")
+)
+
+// mentionPattern matches @ followed by an email address where a word may start, so
+// neither the address itself nor an address in running text reads as a mention.
+var mentionPattern = regexp.MustCompile(`(?:^|[^\w@.+-])@([\w.%+-]+@[A-Za-z0-9-]+(?:\.[A-Za-z0-9-]+)*\.[A-Za-z]{2,})`)
+
+// ICommentInteractor manages the comments on a todo. Whoever can see the todo can read
+// and add comments; editing and deleting one is left to its author and admins.
+type ICommentInteractor interface {
+	List(ctx context.Context, actor input.Actor, input *input.ListCommentsInput) (*output.CommentListOutput, error)
+	Create(ctx context.Context, actor input.Actor, input *input.CreateCommentInput) (*output.CommentOutput, error)
+	Update(ctx context.Context, actor input.Actor, input *input.UpdateCommentInput) (*output.CommentOutput, error)
+	Delete(ctx context.Context, actor input.Actor, todoID, commentID string) error
+}
+
+type CommentInteractor struct {
+	commentRepo    repository.ICommentRepository
+	todoRepo       repository.ITodoRepository
+	userRepo       repository.IUserRepository
+	membershipRepo repository.IMembershipRepository
+	mailRepo       repository.IMailRepository
+	permission     IPermissionEvaluator
+	uuidGenerator  pkg.IUUIDGenerator
+	clock          pkg.IClock
+}
+
+func NewCommentInteractor(
+	commentRepo repository.ICommentRepository,
+	todoRepo repository.ITodoRepository,
+	userRepo repository.IUserRepository,
+	membershipRepo repository.IMembershipRepository,
+	mailRepo repository.IMailRepository,
+	permission IPermissionEvaluator,
+	uuidGenerator pkg.IUUIDGenerator,
+	clock pkg.IClock,
+) ICommentInteractor {
+	return &CommentInteractor{
+		commentRepo:    commentRepo,
+		todoRepo:       todoRepo,
+		userRepo:       userRepo,
+		membershipRepo: membershipRepo,
+		mailRepo:       mailRepo,
+		permission:     permission,
+		uuidGenerator:  uuidGenerator,
+		clock:          clock,
+	}
+}
+
+func (i *CommentInteractor) List(ctx context.Context, actor input.Actor, inp *input.ListCommentsInput) (*output.CommentListOutput, error) {
+	limit, err := commentPageSize(inp.Limit)
+	if err != nil {
+		return nil, err
+	}
+	after, err := DecodeCommentCursor(inp.Cursor)
+	if err != nil {
+		return nil, err
+	}
+	todo, err := i.findTodo(ctx, actor, inp.TodoID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Fetch one extra row to learn whether another page follows
+	comments, err := i.commentRepo.FindByTodoID(ctx, actor.TenantID, todo.ID, after, limit+1)
+	if err != nil {
+		return nil, err
+	}
+	var nextCursor *string
+	if len(comments) > limit {
+		comments = comments[:limit]
+		next := EncodeCommentCursor(commentCursorOf(comments[limit-1]))
+		nextCursor = &next
+	}
+
+	result := make([]*output.CommentOutput, len(comments))
+	for j, comment := range comments {
+		result[j] = toCommentOutput(comment)
+	}
+	return &output.CommentListOutput{Comments: result, NextCursor: nextCursor}, nil
+}
+
+// Create comments on a todo the actor can see and emails the members it mentions. A
+// failed email does not undo the comment.
+func (i *CommentInteractor) Create(ctx context.Context, actor input.Actor, inp *input.CreateCommentInput) (*output.CommentOutput, error) {
+	if err := validateCommentBody(inp.Body); err != nil {
+		return nil, err
+	}
+	todo, err := i.findTodo(ctx, actor, inp.TodoID)
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := i.commentRepo.Create(ctx, &model.Comment{
+		ID:       i.uuidGenerator.Generate(),
+		TenantID: actor.TenantID,
+		TodoID:   todo.ID,
+		AuthorID: actor.UserID,
+		Body:     inp.Body,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	i.notifyMentions(ctx, actor, todo, created, parseMentions(created.Body))
+	return toCommentOutput(created), nil
+}
+
+// Update replaces a comment's body and marks it edited. Members the earlier body
+// already mentioned are not emailed again.
+func (i *CommentInteractor) Update(ctx context.Context, actor input.Actor, inp *input.UpdateCommentInput) (*output.CommentOutput, error) {
+	if err := validateCommentBody(inp.Body); err != nil {
+		return nil, err
+	}
+	todo, err := i.findTodo(ctx, actor, inp.TodoID)
+	if err != nil {
+		return nil, err
+	}
+	comment, err := i.findComment(ctx, actor, ActionUpdate, todo, inp.CommentID)
+	if err != nil {
+		return nil, err
+	}
+	if comment.Body == inp.Body {
+		return toCommentOutput(comment), nil
+	}
+
+	before := parseMentions(comment.Body)
+	now := i.clock.Now()
+	comment.Body = inp.Body
+	comment.EditedAt = &now
+	updated, err := i.commentRepo.Update(ctx, comment)
+	if err != nil {
+		return nil, err
+	}
+
+	var added []string
+	for _, email := range parseMentions(updated.Body) {
+		if !slices.Contains(before, email) {
+			added = append(added, email)
+		}
+	}
+	i.notifyMentions(ctx, actor, todo, updated, added)
+	return toCommentOutput(updated), nil
+}
+
+func (i *CommentInteractor) Delete(ctx context.Context, actor input.Actor, todoID, commentID string) error {
+	todo, err := i.findTodo(ctx, actor, todoID)
+	if err != nil {
+		return err
+	}
+	comment, err := i.findComment(ctx, actor, ActionDelete, todo, commentID)
+	if err != nil {
+		return err
+	}
+	return i.commentRepo.Delete(ctx, comment.ID)
+}
+
+// findTodo loads a live todo the actor can see. Its comments are as private as it is.
+func (i *CommentInteractor) findTodo(ctx context.Context, actor input.Actor, todoID string) (*model.Todo, error) {
+	todo, err := i.todoRepo.FindByID(ctx, todoID)
+	if err != nil {
+		return nil, err
+	}
+	if todo == nil || !i.permission.Can(ctx, actor, ActionView, ResourceTodo, TodoTarget(todo)) {
+		return nil, ErrTodoNotFound
+	}
+	return todo, nil
+}
+
+// findComment loads a comment on todo and checks the actor may perform action on it.
+func (i *CommentInteractor) findComment(ctx context.Context, actor input.Actor, action Action, todo *model.Todo, commentID string) (*model.Comment, error) {
+	comment, err := i.commentRepo.FindByID(ctx, actor.TenantID, commentID)
+	if err != nil {
+		return nil, err
+	}
+	if comment == nil || comment.TodoID != todo.ID {
+		return nil, ErrCommentNotFound
+	}
+	if !i.permission.Can(ctx, actor, action, ResourceComment, CommentTarget(comment)) {
+		return nil, ErrNotCommentAuthor
+	}
+	return comment, nil
+}
+
+// notifyMentions emails the members of the todo's tenant among emails who can see the
+// todo, so a mention never shows a private todo to anyone else. The author is not told
+// about their own mention, and unknown addresses are passed over.
+func (i *CommentInteractor) notifyMentions(ctx context.Context, actor input.Actor, todo *model.Todo, comment *model.Comment, emails []string) {
+	if len(emails) == 0 {
+		return
+	}
+	author, err := i.userRepo.FindByID(ctx, actor.UserID)
+	if err != nil {
+		log.Printf("failed to load the author of comment %s: %v", comment.ID, err)
+		return
+	}
+	if author == nil {
+		return
+	}
+
+	for _, email := range emails {
+		if err := i.notifyMention(ctx, author, todo, comment, email); err != nil {
+			log.Printf("failed to email a mention in comment %s: %v", comment.ID, err)
+		}
+	}
+}
+
+func (i *CommentInteractor) notifyMention(ctx context.Context, author *model.User, todo *model.Todo, comment *model.Comment, email string) error {
+	user, err := i.userRepo.FindByEmail(ctx, email)
+	if err != nil || user == nil || user.ID == author.ID {
+		return err
+	}
+	membership, err := i.membershipRepo.FindByUserAndTenant(ctx, user.ID, todo.TenantID)
+	if err != nil || membership == nil {
+		return err
+	}
+	mentioned := input.Actor{UserID: user.ID, TenantID: membership.TenantID, Role: membership.Role}
+	if !i.permission.Can(ctx, mentioned, ActionView, ResourceTodo, TodoTarget(todo)) {
+		return nil
+	}
+
+	return i.mailRepo.SendMention(ctx, &model.CommentMention{
+		TodoTitle:   todo.Title,
+		CommentBody: comment.Body,
+		MentionedBy: author.Name,
+		Email:       user.Email,
+		Name:        user.Name,
+	})
+}
+
+// parseMentions returns the email addresses mentioned in body in order of first
+// appearance, at most MaxCommentMentions of them.
+func parseMentions(body string) []string {
+	var emails []string
+	for _, match := range mentionPattern.FindAllStringSubmatch(body, -1) {
+		email := match[1]
+		if slices.Contains(emails, email) {
+			continue
+		}
+		emails = append(emails, email)
+		if len(emails) == MaxCommentMentions {
+			break
+		}
+	}
+	return emails
+}
+
+func validateCommentBody(body string) error {
+	if strings.TrimSpace(body) == "" || utf8.RuneCountInString(body) > MaxCommentLength {
+		return ErrInvalidComment
+	}
+	return nil
+}
+
+// commentPageSize applies the default page size and rejects sizes outside 1..MaxCommentPageSize.
+func commentPageSize(limit int) (int, error) {
+	if limit == 0 {
+		return DefaultCommentPageSize, nil
+	}
+	if limit < 0 || limit > MaxCommentPageSize {
+		return 0, ErrInvalidPageLimit
+	}
+	return limit, nil
+}
+
+// commentCursorPayload is the JSON shape behind an opaque comment cursor.
+type commentCursorPayload struct {
+	CreatedAt time.Time `json:"c"`
+	ID        string    `json:"i"`
+}
+
+// EncodeCommentCursor turns a keyset position into an opaque URL-safe token.
+func EncodeCommentCursor(c repository.CommentCursor) string {
+	raw, _ := json.Marshal(commentCursorPayload{CreatedAt: c.CreatedAt.UTC(), ID: c.ID})
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+// DecodeCommentCursor parses a token produced by EncodeCommentCursor. An empty token
+// means the first page and yields a nil cursor.
+func DecodeCommentCursor(token string) (*repository.CommentCursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	var p commentCursorPayload
+	if err := json.Unmarshal(raw, &p); err != nil || p.ID == "" || p.CreatedAt.IsZero() {
+		return nil, ErrInvalidCursor
+	}
+	return &repository.CommentCursor{CreatedAt: p.CreatedAt, ID: p.ID}, nil
+}
+
+func commentCursorOf(comment *model.Comment) repository.CommentCursor {
+	return repository.CommentCursor{CreatedAt: comment.CreatedAt, ID: comment.ID}
+}
+
+func toCommentOutput(comment *model.Comment) *output.CommentOutput {
+	return &output.CommentOutput{
+		ID:        comment.ID,
+		TodoID:    comment.TodoID,
+		AuthorID:  comment.AuthorID,
+		Body:      comment.Body,
+		EditedAt:  comment.EditedAt,
+		CreatedAt: comment.CreatedAt,
+		UpdatedAt: comment.UpdatedAt,
+	}
+}
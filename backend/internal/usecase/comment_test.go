@@ -0,0 +1,312 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/domain/repository"
+	"good-todo-go/internal/domain/repository/mock"
+	mocku "good-todo-go/internal/pkg/mock"
+	"good-todo-go/internal/usecase/input"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+)
+
+func TestCommentInteractor_List(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCommentRepo := mock.NewMockICommentRepository(ctrl)
+	mockTodoRepo := mock.NewMockITodoRepository(ctrl)
+
+	interactor := NewCommentInteractor(mockCommentRepo, mockTodoRepo, mock.NewMockIUserRepository(ctrl), mock.NewMockIMembershipRepository(ctrl), mock.NewMockIMailRepository(ctrl), NewPermissionEvaluator(DefaultPermissionRules()), mocku.NewMockUUIDGenerator(), mocku.NewMockClock(time.Now()))
+
+	ctx := context.Background()
+	actor := memberActor("user-123")
+	created := time.Date(2024, 3, 11, 10, 0, 0, 0, time.UTC)
+
+	t.Run("pages through the comments on a public todo", func(t *testing.T) {
+		mockTodoRepo.EXPECT().FindByID(ctx, "todo-1").Return(&model.Todo{ID: "todo-1", TenantID: "tenant-123", UserID: "other-user", IsPublic: true}, nil)
+		mockCommentRepo.EXPECT().FindByTodoID(ctx, "tenant-123", "todo-1", nil, 3).Return([]*model.Comment{
+			{ID: "comment-1", TenantID: "tenant-123", TodoID: "todo-1", AuthorID: "other-user", Body: "First", CreatedAt: created},
+			{ID: "comment-2", TenantID: "tenant-123", TodoID: "todo-1", AuthorID: "user-123", Body: "Second", CreatedAt: created.Add(time.Minute)},
+			{ID: "comment-3", TenantID: "tenant-123", TodoID: "todo-1", AuthorID: "other-user", Body: "Third", CreatedAt: created.Add(2 * time.Minute)},
+		}, nil)
+
+		result, err := interactor.List(ctx, actor, &input.ListCommentsInput{TodoID: "todo-1", Limit: 2})
+
+		require.NoError(t, err)
+		require.Len(t, result.Comments, 2)
+		assert.Equal(t, "comment-2", result.Comments[1].ID)
+		require.NotNil(t, result.NextCursor)
+
+		after, err := DecodeCommentCursor(*result.NextCursor)
+		require.NoError(t, err)
+		assert.Equal(t, &repository.CommentCursor{CreatedAt: created.Add(time.Minute), ID: "comment-2"}, after)
+	})
+
+	t.Run("comments on someone else's private todo are hidden", func(t *testing.T) {
+		mockTodoRepo.EXPECT().FindByID(ctx, "todo-1").Return(&model.Todo{ID: "todo-1", TenantID: "tenant-123", UserID: "other-user"}, nil)
+
+		_, err := interactor.List(ctx, actor, &input.ListCommentsInput{TodoID: "todo-1"})
+
+		assert.Equal(t, ErrTodoNotFound, err)
+	})
+
+	t.Run("admins cannot read comments on a private todo either", func(t *testing.T) {
+		mockTodoRepo.EXPECT().FindByID(ctx, "todo-1").Return(&model.Todo{ID: "todo-1", TenantID: "tenant-123", UserID: "other-user"}, nil)
+
+		_, err := interactor.List(ctx, adminActor("admin-1"), &input.ListCommentsInput{TodoID: "todo-1"})
+
+		assert.Equal(t, ErrTodoNotFound, err)
+	})
+
+	t.Run("invalid cursor", func(t *testing.T) {
+		_, err := interactor.List(ctx, actor, &input.ListCommentsInput{TodoID: "todo-1", Cursor: "not a cursor"})
+
+		assert.Equal(t, ErrInvalidCursor, err)
+	})
+
+	t.Run("limit out of range", func(t *testing.T) {
+		_, err := interactor.List(ctx, actor, &input.ListCommentsInput{TodoID: "todo-1", Limit: MaxCommentPageSize + 1})
+
+		assert.Equal(t, ErrInvalidPageLimit, err)
+	})
+}
+
+func TestCommentInteractor_Create(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCommentRepo := mock.NewMockICommentRepository(ctrl)
+	mockTodoRepo := mock.NewMockITodoRepository(ctrl)
+	mockUserRepo := mock.NewMockIUserRepository(ctrl)
+	mockMembershipRepo := mock.NewMockIMembershipRepository(ctrl)
+	mockMailRepo := mock.NewMockIMailRepository(ctrl)
+
+	interactor := NewCommentInteractor(mockCommentRepo, mockTodoRepo, mockUserRepo, mockMembershipRepo, mockMailRepo, NewPermissionEvaluator(DefaultPermissionRules()), mocku.NewMockUUIDGenerator(), mocku.NewMockClock(time.Now()))
+
+	ctx := context.Background()
+	actor := memberActor("user-123")
+	author := &model.User{ID: "user-123", Email: "alice@example.com", Name: "Alice"}
+	bob := &model.User{ID: "user-456", Email: "bob@example.com", Name: "Bob"}
+	saved := func(ctx context.Context, comment *model.Comment) (*model.Comment, error) {
+		return comment, nil
+	}
+
+	t.Run("emails the members it mentions", func(t *testing.T) {
+		body := "Can you take this, @bob@example.com? @nobody@example.com and @alice@example.com know too"
+		mockTodoRepo.EXPECT().FindByID(ctx, "todo-1").Return(&model.Todo{ID: "todo-1", TenantID: "tenant-123", UserID: "user-123", Title: "Ship it", IsPublic: true}, nil)
+		mockCommentRepo.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, comment *model.Comment) (*model.Comment, error) {
+			assert.Equal(t, "tenant-123", comment.TenantID)
+			assert.Equal(t, "todo-1", comment.TodoID)
+			assert.Equal(t, "user-123", comment.AuthorID)
+			assert.Equal(t, body, comment.Body)
+			return comment, nil
+		})
+		mockUserRepo.EXPECT().FindByID(ctx, "user-123").Return(author, nil)
+		mockUserRepo.EXPECT().FindByEmail(ctx, "bob@example.com").Return(bob, nil)
+		mockMembershipRepo.EXPECT().FindByUserAndTenant(ctx, "user-456", "tenant-123").Return(&model.Membership{TenantID: "tenant-123", UserID: "user-456", Role: model.UserRoleMember}, nil)
+		mockMailRepo.EXPECT().SendMention(ctx, &model.CommentMention{
+			TodoTitle:   "Ship it",
+			CommentBody: body,
+			MentionedBy: "Alice",
+			Email:       "bob@example.com",
+			Name:        "Bob",
+		}).Return(nil)
+		mockUserRepo.EXPECT().FindByEmail(ctx, "nobody@example.com").Return(nil, nil)
+		mockUserRepo.EXPECT().FindByEmail(ctx, "alice@example.com").Return(author, nil)
+
+		result, err := interactor.Create(ctx, actor, &input.CreateCommentInput{TodoID: "todo-1", Body: body})
+
+		require.NoError(t, err)
+		assert.Equal(t, body, result.Body)
+		assert.Nil(t, result.EditedAt)
+	})
+
+	t.Run("members who cannot see the private todo are not told", func(t *testing.T) {
+		mockTodoRepo.EXPECT().FindByID(ctx, "todo-1").Return(&model.Todo{ID: "todo-1", TenantID: "tenant-123", UserID: "user-123", Title: "Private"}, nil)
+		mockCommentRepo.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(saved)
+		mockUserRepo.EXPECT().FindByID(ctx, "user-123").Return(author, nil)
+		mockUserRepo.EXPECT().FindByEmail(ctx, "bob@example.com").Return(bob, nil)
+		mockMembershipRepo.EXPECT().FindByUserAndTenant(ctx, "user-456", "tenant-123").Return(&model.Membership{TenantID: "tenant-123", UserID: "user-456", Role: model.UserRoleAdmin}, nil)
+
+		_, err := interactor.Create(ctx, actor, &input.CreateCommentInput{TodoID: "todo-1", Body: "@bob@example.com look"})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("users outside the tenant are not told", func(t *testing.T) {
+		mockTodoRepo.EXPECT().FindByID(ctx, "todo-1").Return(&model.Todo{ID: "todo-1", TenantID: "tenant-123", UserID: "user-123", IsPublic: true}, nil)
+		mockCommentRepo.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(saved)
+		mockUserRepo.EXPECT().FindByID(ctx, "user-123").Return(author, nil)
+		mockUserRepo.EXPECT().FindByEmail(ctx, "bob@example.com").Return(bob, nil)
+		mockMembershipRepo.EXPECT().FindByUserAndTenant(ctx, "user-456", "tenant-123").Return(nil, nil)
+
+		_, err := interactor.Create(ctx, actor, &input.CreateCommentInput{TodoID: "todo-1", Body: "@bob@example.com look"})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("empty body", func(t *testing.T) {
+		_, err := interactor.Create(ctx, actor, &input.CreateCommentInput{TodoID: "todo-1", Body: "  \n "})
+
+		assert.Equal(t, ErrInvalidComment, err)
+	})
+
+	t.Run("someone else's private todo is not found", func(t *testing.T) {
+		mockTodoRepo.EXPECT().FindByID(ctx, "todo-1").Return(&model.Todo{ID: "todo-1", TenantID: "tenant-123", UserID: "other-user"}, nil)
+
+		_, err := interactor.Create(ctx, actor, &input.CreateCommentInput{TodoID: "todo-1", Body: "Hello"})
+
+		assert.Equal(t, ErrTodoNotFound, err)
+	})
+}
+
+func TestCommentInteractor_Update(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCommentRepo := mock.NewMockICommentRepository(ctrl)
+	mockTodoRepo := mock.NewMockITodoRepository(ctrl)
+	mockUserRepo := mock.NewMockIUserRepository(ctrl)
+	mockMembershipRepo := mock.NewMockIMembershipRepository(ctrl)
+	mockMailRepo := mock.NewMockIMailRepository(ctrl)
+	clock := mocku.NewMockClock(time.Date(2024, 3, 11, 10, 0, 0, 0, time.UTC))
+
+	interactor := NewCommentInteractor(mockCommentRepo, mockTodoRepo, mockUserRepo, mockMembershipRepo, mockMailRepo, NewPermissionEvaluator(DefaultPermissionRules()), mocku.NewMockUUIDGenerator(), clock)
+
+	ctx := context.Background()
+	publicTodo := &model.Todo{ID: "todo-1", TenantID: "tenant-123", UserID: "other-user", Title: "Ship it", IsPublic: true}
+	comment := func(authorID, body string) *model.Comment {
+		return &model.Comment{ID: "comment-1", TenantID: "tenant-123", TodoID: "todo-1", AuthorID: authorID, Body: body}
+	}
+	saved := func(ctx context.Context, comment *model.Comment) (*model.Comment, error) {
+		return comment, nil
+	}
+
+	t.Run("the author edits and only newly mentioned members are told", func(t *testing.T) {
+		mockTodoRepo.EXPECT().FindByID(ctx, "todo-1").Return(publicTodo, nil)
+		mockCommentRepo.EXPECT().FindByID(ctx, "tenant-123", "comment-1").Return(comment("user-123", "cc @bob@example.com"), nil)
+		mockCommentRepo.EXPECT().Update(ctx, gomock.Any()).DoAndReturn(saved)
+		mockUserRepo.EXPECT().FindByID(ctx, "user-123").Return(&model.User{ID: "user-123", Name: "Alice"}, nil)
+		mockUserRepo.EXPECT().FindByEmail(ctx, "carol@example.com").Return(&model.User{ID: "user-789", Email: "carol@example.com"}, nil)
+		mockMembershipRepo.EXPECT().FindByUserAndTenant(ctx, "user-789", "tenant-123").Return(&model.Membership{TenantID: "tenant-123", UserID: "user-789", Role: model.UserRoleMember}, nil)
+		mockMailRepo.EXPECT().SendMention(ctx, gomock.Any()).Return(nil)
+
+		result, err := interactor.Update(ctx, memberActor("user-123"), &input.UpdateCommentInput{TodoID: "todo-1", CommentID: "comment-1", Body: "cc @bob@example.com @carol@example.com"})
+
+		require.NoError(t, err)
+		assert.Equal(t, "cc @bob@example.com @carol@example.com", result.Body)
+		require.NotNil(t, result.EditedAt)
+		assert.True(t, clock.At.Equal(*result.EditedAt))
+	})
+
+	t.Run("the same body changes nothing", func(t *testing.T) {
+		mockTodoRepo.EXPECT().FindByID(ctx, "todo-1").Return(publicTodo, nil)
+		mockCommentRepo.EXPECT().FindByID(ctx, "tenant-123", "comment-1").Return(comment("user-123", "Done"), nil)
+
+		result, err := interactor.Update(ctx, memberActor("user-123"), &input.UpdateCommentInput{TodoID: "todo-1", CommentID: "comment-1", Body: "Done"})
+
+		require.NoError(t, err)
+		assert.Nil(t, result.EditedAt)
+	})
+
+	t.Run("admins can edit anyone's comment", func(t *testing.T) {
+		mockTodoRepo.EXPECT().FindByID(ctx, "todo-1").Return(publicTodo, nil)
+		mockCommentRepo.EXPECT().FindByID(ctx, "tenant-123", "comment-1").Return(comment("user-123", "Rude"), nil)
+		mockCommentRepo.EXPECT().Update(ctx, gomock.Any()).DoAndReturn(saved)
+
+		result, err := interactor.Update(ctx, adminActor("admin-1"), &input.UpdateCommentInput{TodoID: "todo-1", CommentID: "comment-1", Body: "[removed]"})
+
+		require.NoError(t, err)
+		assert.Equal(t, "[removed]", result.Body)
+	})
+
+	t.Run("members cannot edit someone else's comment", func(t *testing.T) {
+		mockTodoRepo.EXPECT().FindByID(ctx, "todo-1").Return(publicTodo, nil)
+		mockCommentRepo.EXPECT().FindByID(ctx, "tenant-123", "comment-1").Return(comment("other-user", "Mine"), nil)
+
+		_, err := interactor.Update(ctx, memberActor("user-123"), &input.UpdateCommentInput{TodoID: "todo-1", CommentID: "comment-1", Body: "Yours"})
+
+		assert.Equal(t, ErrNotCommentAuthor, err)
+	})
+
+	t.Run("a comment on another todo is not found", func(t *testing.T) {
+		onOther := comment("user-123", "Elsewhere")
+		onOther.TodoID = "todo-2"
+		mockTodoRepo.EXPECT().FindByID(ctx, "todo-1").Return(publicTodo, nil)
+		mockCommentRepo.EXPECT().FindByID(ctx, "tenant-123", "comment-1").Return(onOther, nil)
+
+		_, err := interactor.Update(ctx, memberActor("user-123"), &input.UpdateCommentInput{TodoID: "todo-1", CommentID: "comment-1", Body: "Here"})
+
+		assert.Equal(t, ErrCommentNotFound, err)
+	})
+}
+
+func TestCommentInteractor_Delete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCommentRepo := mock.NewMockICommentRepository(ctrl)
+	mockTodoRepo := mock.NewMockITodoRepository(ctrl)
+
+	interactor := NewCommentInteractor(mockCommentRepo, mockTodoRepo, mock.NewMockIUserRepository(ctrl), mock.NewMockIMembershipRepository(ctrl), mock.NewMockIMailRepository(ctrl), NewPermissionEvaluator(DefaultPermissionRules()), mocku.NewMockUUIDGenerator(), mocku.NewMockClock(time.Now()))
+
+	ctx := context.Background()
+	publicTodo := &model.Todo{ID: "todo-1", TenantID: "tenant-123", UserID: "other-user", IsPublic: true}
+	comment := &model.Comment{ID: "comment-1", TenantID: "tenant-123", TodoID: "todo-1", AuthorID: "user-123", Body: "Hello"}
+
+	t.Run("the author deletes", func(t *testing.T) {
+		mockTodoRepo.EXPECT().FindByID(ctx, "todo-1").Return(publicTodo, nil)
+		mockCommentRepo.EXPECT().FindByID(ctx, "tenant-123", "comment-1").Return(comment, nil)
+		mockCommentRepo.EXPECT().Delete(ctx, "comment-1").Return(nil)
+
+		err := interactor.Delete(ctx, memberActor("user-123"), "todo-1", "comment-1")
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("members cannot delete someone else's comment", func(t *testing.T) {
+		mockTodoRepo.EXPECT().FindByID(ctx, "todo-1").Return(publicTodo, nil)
+		mockCommentRepo.EXPECT().FindByID(ctx, "tenant-123", "comment-1").Return(comment, nil)
+
+		err := interactor.Delete(ctx, memberActor("user-456"), "todo-1", "comment-1")
+
+		assert.Equal(t, ErrNotCommentAuthor, err)
+	})
+
+	t.Run("unknown comment", func(t *testing.T) {
+		mockTodoRepo.EXPECT().FindByID(ctx, "todo-1").Return(publicTodo, nil)
+		mockCommentRepo.EXPECT().FindByID(ctx, "tenant-123", "missing").Return(nil, nil)
+
+		err := interactor.Delete(ctx, memberActor("user-123"), "todo-1", "missing")
+
+		assert.Equal(t, ErrCommentNotFound, err)
+	})
+}
+
+func TestParseMentions(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want []string
+	}{
+		{"at the start", "@bob@example.com please look", []string{"bob@example.com"}},
+		{"trailing punctuation", "Thanks, @bob@example.com.", []string{"bob@example.com"}},
+		{"in order without repeats", "@carol@example.org then @bob@example.com and @carol@example.org", []string{"carol@example.org", "bob@example.com"}},
+		{"in markdown", "**@bob@example.com** (cc @ana.b+todo@mail.example.com)", []string{"bob@example.com", "ana.b+todo@mail.example.com"}},
+		{"a bare address is not a mention", "write to bob@example.com", nil},
+		{"an @ inside a word is not a mention", "foo@bob@example.com", nil},
+		{"a handle without a domain is not a mention", "@bob", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, parseMentions(tt.body))
+		})
+	}
+}
@@ -0,0 +1,175 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/domain/repository"
+)
+
+const (
+	// DefaultDigestTime is when a digest is sent unless the member picks another time.
+	DefaultDigestTime = "08:00"
+	// DigestSectionSize is how many todos each section of a digest lists at most.
+	DigestSectionSize = 20
+)
+
+// IDigestInteractor emails members their daily digest of due and overdue todos.
+type IDigestInteractor interface {
+	// SendDueDigests sends the digest of every subscribed member whose digest time has
+	// passed today in their timezone and who has not had one today, and returns how many
+	// were sent. A member with nothing due is not emailed. A failing member or tenant does
+	// not stop the others. Digests are per membership, like the preference that schedules
+	// them, so a user in several tenants gets one from each at the time set there.
+	SendDueDigests(ctx context.Context, now time.Time) (int, error)
+}
+
+type DigestInteractor struct {
+	unitOfWork     repository.IUnitOfWork
+	tenantRepo     repository.ITenantRepository
+	membershipRepo repository.IMembershipRepository
+	todoRepo       repository.ITodoRepository
+	mailRepo       repository.IMailRepository
+}
+
+func NewDigestInteractor(
+	unitOfWork repository.IUnitOfWork,
+	tenantRepo repository.ITenantRepository,
+	membershipRepo repository.IMembershipRepository,
+	todoRepo repository.ITodoRepository,
+	mailRepo repository.IMailRepository,
+) IDigestInteractor {
+	return &DigestInteractor{
+		unitOfWork:     unitOfWork,
+		tenantRepo:     tenantRepo,
+		membershipRepo: membershipRepo,
+		todoRepo:       todoRepo,
+		mailRepo:       mailRepo,
+	}
+}
+
+func (i *DigestInteractor) SendDueDigests(ctx context.Context, now time.Time) (int, error) {
+	tenants, err := i.tenantRepo.FindAll(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	total := 0
+	var errs []error
+	for _, tenant := range tenants {
+		var subscribers []*model.Membership
+		err := i.unitOfWork.RunInTenantTx(ctx, tenant.ID, func(ctx context.Context) error {
+			var err error
+			subscribers, err = i.membershipRepo.ListDigestSubscribers(ctx, tenant.ID)
+			return err
+		})
+		if err != nil {
+			errs = append(errs, fmt.Errorf("tenant %s: %w", tenant.ID, err))
+			continue
+		}
+
+		for _, membership := range subscribers {
+			sent, err := i.sendDigest(ctx, tenant, membership, now)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("tenant %s, user %s: %w", tenant.ID, membership.UserID, err))
+				continue
+			}
+			if sent {
+				total++
+			}
+		}
+	}
+	return total, errors.Join(errs...)
+}
+
+// sendDigest sends the member's digest for their local day if it is due. The day is
+// recorded first, in the same transaction, so a send that fails rolls it back to be
+// retried while a restart after it succeeds does not send again.
+func (i *DigestInteractor) sendDigest(ctx context.Context, tenant *model.Tenant, membership *model.Membership, now time.Time) (bool, error) {
+	if membership.User == nil {
+		return false, nil
+	}
+	local := now.In(digestLocation(membership))
+	today := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, local.Location())
+	if local.Format("15:04") < membership.DigestTime {
+		return false, nil
+	}
+	if last := membership.DigestLastSentOn; last != nil && last.Format(time.DateOnly) >= today.Format(time.DateOnly) {
+		return false, nil
+	}
+
+	sent := false
+	err := i.unitOfWork.RunInTenantTx(ctx, tenant.ID, func(ctx context.Context) error {
+		claimed, err := i.membershipRepo.MarkDigestSent(ctx, membership.ID, today)
+		if err != nil || !claimed {
+			return err
+		}
+		digest, err := i.buildDigest(ctx, membership, tenant.Name, local, today)
+		if err != nil {
+			return err
+		}
+		if digest.IsEmpty() {
+			return nil
+		}
+		if err := i.mailRepo.SendDigest(ctx, digest); err != nil {
+			return err
+		}
+		sent = true
+		return nil
+	})
+	return sent, err
+}
+
+// buildDigest collects the member's open todos that are overdue at now, due later today
+// and due in the UpcomingTodoDays after today, like the smart views.
+func (i *DigestInteractor) buildDigest(ctx context.Context, membership *model.Membership, tenantName string, now, today time.Time) (*model.Digest, error) {
+	digest := &model.Digest{
+		Email:      membership.User.Email,
+		Name:       membership.User.Name,
+		TenantName: tenantName,
+		Date:       today,
+	}
+	for _, section := range []struct {
+		view string
+		into *model.DigestSection
+	}{
+		{TodoViewOverdue, &digest.Overdue},
+		{TodoViewToday, &digest.DueToday},
+		{TodoViewUpcoming, &digest.DueThisWeek},
+	} {
+		filter, err := todoViewFilter(section.view, now)
+		if err != nil {
+			return nil, err
+		}
+		todos, err := i.todoRepo.FindByUserID(ctx, membership.UserID, repository.TodoQuery{
+			Filter: filter,
+			Sort:   repository.TodoSort{Field: repository.TodoSortDueDate},
+			Limit:  DigestSectionSize + 1,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if len(todos) > DigestSectionSize {
+			todos, section.into.More = todos[:DigestSectionSize], true
+		}
+		section.into.Todos = todos
+	}
+	return digest, nil
+}
+
+// digestLocation is the timezone the member's digest follows: their chosen one, else the
+// user's, else UTC when neither loads.
+func digestLocation(membership *model.Membership) *time.Location {
+	for _, name := range []*string{membership.DigestTimezone, &membership.User.Timezone} {
+		if name == nil {
+			continue
+		}
+		if loc, err := time.LoadLocation(*name); err == nil {
+			return loc
+		}
+	}
+	return time.UTC
+}
@@ -0,0 +1,179 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/domain/repository"
+	"good-todo-go/internal/domain/repository/mock"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+)
+
+func TestDigestInteractor_SendDueDigests(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUnitOfWork := mock.NewMockIUnitOfWork(ctrl)
+	mockTenantRepo := mock.NewMockITenantRepository(ctrl)
+	mockMembershipRepo := mock.NewMockIMembershipRepository(ctrl)
+	mockTodoRepo := mock.NewMockITodoRepository(ctrl)
+	mockMailRepo := mock.NewMockIMailRepository(ctrl)
+
+	interactor := NewDigestInteractor(mockUnitOfWork, mockTenantRepo, mockMembershipRepo, mockTodoRepo, mockMailRepo)
+
+	ctx := context.Background()
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	require.NoError(t, err)
+	// 08:30 on June 1st in Tokyo, still May 31st in UTC
+	now := time.Date(2024, 5, 31, 23, 30, 0, 0, time.UTC)
+	tokyoToday := time.Date(2024, 6, 1, 0, 0, 0, 0, tokyo)
+
+	tenant := &model.Tenant{ID: "tenant-a", Name: "Acme"}
+	subscriber := func(id, digestTime, timezone string, lastSentOn *time.Time) *model.Membership {
+		return &model.Membership{
+			ID: id, TenantID: "tenant-a", UserID: "user-" + id, DigestEnabled: true, DigestTime: digestTime, DigestLastSentOn: lastSentOn,
+			User: &model.User{ID: "user-" + id, Email: id + "@example.com", Name: id, Timezone: timezone},
+		}
+	}
+	due := now.Add(2 * time.Hour)
+	overdue := now.Add(-2 * time.Hour)
+
+	expectTodos := func(userID string, overdueTodos, todayTodos, weekTodos []*model.Todo) {
+		gomock.InOrder(
+			mockTodoRepo.EXPECT().FindByUserID(ctx, userID, gomock.Any()).DoAndReturn(func(ctx context.Context, userID string, query repository.TodoQuery) ([]*model.Todo, error) {
+				require.NotNil(t, query.Filter.Overdue)
+				assert.True(t, *query.Filter.Overdue)
+				assert.Equal(t, DigestSectionSize+1, query.Limit)
+				return overdueTodos, nil
+			}),
+			mockTodoRepo.EXPECT().FindByUserID(ctx, userID, gomock.Any()).DoAndReturn(func(ctx context.Context, userID string, query repository.TodoQuery) ([]*model.Todo, error) {
+				// Today ends at midnight in the member's timezone
+				assert.True(t, tokyoToday.AddDate(0, 0, 1).Equal(*query.Filter.DueBefore))
+				return todayTodos, nil
+			}),
+			mockTodoRepo.EXPECT().FindByUserID(ctx, userID, gomock.Any()).Return(weekTodos, nil),
+		)
+	}
+
+	t.Run("sends once the member's local time has passed", func(t *testing.T) {
+		yesterday := time.Date(2024, 5, 31, 0, 0, 0, 0, time.UTC)
+		tokyoMember := subscriber("tokyo", "08:00", "Asia/Tokyo", &yesterday)
+		// 23:30 in UTC is before 23:45
+		lateMember := subscriber("late", "23:45", "UTC", nil)
+
+		mockTenantRepo.EXPECT().FindAll(ctx).Return([]*model.Tenant{tenant}, nil)
+		mockUnitOfWork.EXPECT().RunInTenantTx(ctx, "tenant-a", gomock.Any()).DoAndReturn(runInTx).Times(2)
+		mockMembershipRepo.EXPECT().ListDigestSubscribers(ctx, "tenant-a").Return([]*model.Membership{tokyoMember, lateMember}, nil)
+		mockMembershipRepo.EXPECT().MarkDigestSent(ctx, "tokyo", tokyoToday).Return(true, nil)
+		expectTodos("user-tokyo", []*model.Todo{{Title: "File taxes", DueDate: &overdue}}, []*model.Todo{{Title: "Pay rent", DueDate: &due}}, nil)
+		mockMailRepo.EXPECT().SendDigest(ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, digest *model.Digest) error {
+			assert.Equal(t, "tokyo@example.com", digest.Email)
+			assert.Equal(t, "Acme", digest.TenantName)
+			assert.True(t, tokyoToday.Equal(digest.Date))
+			assert.Equal(t, "File taxes", digest.Overdue.Todos[0].Title)
+			assert.Equal(t, "Pay rent", digest.DueToday.Todos[0].Title)
+			assert.Empty(t, digest.DueThisWeek.Todos)
+			return nil
+		})
+
+		sent, err := interactor.SendDueDigests(ctx, now)
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, sent)
+	})
+
+	t.Run("a member of two tenants gets a digest from each", func(t *testing.T) {
+		other := &model.Tenant{ID: "tenant-b", Name: "Globex"}
+		inAcme := subscriber("tokyo", "08:00", "Asia/Tokyo", nil)
+		inGlobex := subscriber("tokyo", "08:00", "Asia/Tokyo", nil)
+		inGlobex.ID, inGlobex.TenantID = "tokyo-globex", "tenant-b"
+
+		mockTenantRepo.EXPECT().FindAll(ctx).Return([]*model.Tenant{tenant, other}, nil)
+		mockUnitOfWork.EXPECT().RunInTenantTx(ctx, "tenant-a", gomock.Any()).DoAndReturn(runInTx).Times(2)
+		mockUnitOfWork.EXPECT().RunInTenantTx(ctx, "tenant-b", gomock.Any()).DoAndReturn(runInTx).Times(2)
+		mockMembershipRepo.EXPECT().ListDigestSubscribers(ctx, "tenant-a").Return([]*model.Membership{inAcme}, nil)
+		mockMembershipRepo.EXPECT().ListDigestSubscribers(ctx, "tenant-b").Return([]*model.Membership{inGlobex}, nil)
+		mockMembershipRepo.EXPECT().MarkDigestSent(ctx, "tokyo", tokyoToday).Return(true, nil)
+		mockMembershipRepo.EXPECT().MarkDigestSent(ctx, "tokyo-globex", tokyoToday).Return(true, nil)
+		expectTodos("user-tokyo", []*model.Todo{{Title: "File taxes", DueDate: &overdue}}, nil, nil)
+		expectTodos("user-tokyo", nil, []*model.Todo{{Title: "Ship release", DueDate: &due}}, nil)
+		var tenantNames []string
+		mockMailRepo.EXPECT().SendDigest(ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, digest *model.Digest) error {
+			assert.Equal(t, "tokyo@example.com", digest.Email)
+			tenantNames = append(tenantNames, digest.TenantName)
+			return nil
+		}).Times(2)
+
+		sent, err := interactor.SendDueDigests(ctx, now)
+
+		require.NoError(t, err)
+		assert.Equal(t, 2, sent)
+		assert.Equal(t, []string{"Acme", "Globex"}, tenantNames)
+	})
+
+	t.Run("skips members who already had today's digest", func(t *testing.T) {
+		today := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+		member := subscriber("tokyo", "08:00", "Asia/Tokyo", &today)
+
+		mockTenantRepo.EXPECT().FindAll(ctx).Return([]*model.Tenant{tenant}, nil)
+		mockUnitOfWork.EXPECT().RunInTenantTx(ctx, "tenant-a", gomock.Any()).DoAndReturn(runInTx)
+		mockMembershipRepo.EXPECT().ListDigestSubscribers(ctx, "tenant-a").Return([]*model.Membership{member}, nil)
+
+		sent, err := interactor.SendDueDigests(ctx, now)
+
+		require.NoError(t, err)
+		assert.Zero(t, sent)
+	})
+
+	t.Run("another sender got there first", func(t *testing.T) {
+		member := subscriber("tokyo", "08:00", "Asia/Tokyo", nil)
+
+		mockTenantRepo.EXPECT().FindAll(ctx).Return([]*model.Tenant{tenant}, nil)
+		mockUnitOfWork.EXPECT().RunInTenantTx(ctx, "tenant-a", gomock.Any()).DoAndReturn(runInTx).Times(2)
+		mockMembershipRepo.EXPECT().ListDigestSubscribers(ctx, "tenant-a").Return([]*model.Membership{member}, nil)
+		mockMembershipRepo.EXPECT().MarkDigestSent(ctx, "tokyo", tokyoToday).Return(false, nil)
+
+		sent, err := interactor.SendDueDigests(ctx, now)
+
+		require.NoError(t, err)
+		assert.Zero(t, sent)
+	})
+
+	t.Run("nothing due sends nothing", func(t *testing.T) {
+		member := subscriber("tokyo", "08:00", "Asia/Tokyo", nil)
+
+		mockTenantRepo.EXPECT().FindAll(ctx).Return([]*model.Tenant{tenant}, nil)
+		mockUnitOfWork.EXPECT().RunInTenantTx(ctx, "tenant-a", gomock.Any()).DoAndReturn(runInTx).Times(2)
+		mockMembershipRepo.EXPECT().ListDigestSubscribers(ctx, "tenant-a").Return([]*model.Membership{member}, nil)
+		mockMembershipRepo.EXPECT().MarkDigestSent(ctx, "tokyo", tokyoToday).Return(true, nil)
+		expectTodos("user-tokyo", nil, nil, nil)
+
+		sent, err := interactor.SendDueDigests(ctx, now)
+
+		require.NoError(t, err)
+		assert.Zero(t, sent)
+	})
+
+	t.Run("a failed send is returned to roll back the date", func(t *testing.T) {
+		smtpErr := errors.New("connection refused")
+		member := subscriber("tokyo", "08:00", "Asia/Tokyo", nil)
+
+		mockTenantRepo.EXPECT().FindAll(ctx).Return([]*model.Tenant{tenant}, nil)
+		mockUnitOfWork.EXPECT().RunInTenantTx(ctx, "tenant-a", gomock.Any()).DoAndReturn(runInTx).Times(2)
+		mockMembershipRepo.EXPECT().ListDigestSubscribers(ctx, "tenant-a").Return([]*model.Membership{member}, nil)
+		mockMembershipRepo.EXPECT().MarkDigestSent(ctx, "tokyo", tokyoToday).Return(true, nil)
+		expectTodos("user-tokyo", nil, []*model.Todo{{Title: "Pay rent", DueDate: &due}}, nil)
+		mockMailRepo.EXPECT().SendDigest(ctx, gomock.Any()).Return(smtpErr)
+
+		sent, err := interactor.SendDueDigests(ctx, now)
+
+		assert.ErrorIs(t, err, smtpErr)
+		assert.Zero(t, sent)
+	})
+}
@@ -0,0 +1,10 @@
+package input
+
+import "good-todo-go/internal/domain/model"
+
+// Actor identifies the authenticated user on whose behalf a usecase runs.
+type Actor struct {
+	UserID   string
+	TenantID string
+	Role     model.UserRole
+}
@@ -4,6 +4,8 @@ type RegisterInput struct {
 	Email    string
 	Password string
 	Name     string
+	// TenantSlug is optional; a slug is derived from the email when empty
+	TenantSlug string
 }
 
 type LoginInput struct {
@@ -18,3 +20,13 @@ type VerifyEmailInput struct {
 type RefreshTokenInput struct {
 	RefreshToken string
 }
+
+type SwitchTenantInput struct {
+	TenantID string
+}
+
+type CreateTenantInput struct {
+	Name string
+	// TenantSlug is optional; a slug is derived from the actor's email when empty
+	TenantSlug string
+}
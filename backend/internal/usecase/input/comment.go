@@ -0,0 +1,24 @@
+package input
+
+// ListCommentsInput selects one page of a todo's comments, oldest first. Limit defaults
+// to 20 and may be at most 100; Cursor is the NextCursor of the previous page.
+type ListCommentsInput struct {
+	TodoID string
+	Limit  int
+	Cursor string
+}
+
+// CreateCommentInput adds a markdown comment to a todo. Members mentioned in the body
+// as @ followed by their email address are notified.
+type CreateCommentInput struct {
+	TodoID string
+	Body   string
+}
+
+// UpdateCommentInput replaces a comment's body. Only members newly mentioned by the new
+// body are notified.
+type UpdateCommentInput struct {
+	TodoID    string
+	CommentID string
+	Body      string
+}
@@ -0,0 +1,30 @@
+package input
+
+// CreateProjectInput creates a project. An empty Color uses the default color.
+type CreateProjectInput struct {
+	Name        string
+	Description string
+	Color       string
+	IsPublic    bool
+}
+
+// UpdateProjectInput changes the fields it sets.
+type UpdateProjectInput struct {
+	ID          string
+	Name        *string
+	Description *string
+	Color       *string
+	Archived    *bool
+	IsPublic    *bool
+}
+
+type ListProjectsInput struct {
+	IncludeArchived bool
+}
+
+// DeleteProjectInput deletes a project. Its todos move to the inbox unless DeleteTodos
+// is set, in which case the actor's own todos in it go to the trash instead.
+type DeleteProjectInput struct {
+	ID          string
+	DeleteTodos bool
+}
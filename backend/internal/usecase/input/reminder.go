@@ -0,0 +1,11 @@
+package input
+
+import "time"
+
+// CreateReminderInput sets a reminder on a todo. Exactly one of RemindAt and
+// OffsetMinutes must be given; an offset counts back from the todo's due date.
+type CreateReminderInput struct {
+	TodoID        string
+	RemindAt      *time.Time
+	OffsetMinutes *int
+}
@@ -0,0 +1,13 @@
+package input
+
+type CreateTagInput struct {
+	Name  string
+	Color string
+}
+
+// UpdateTagInput changes the fields it sets.
+type UpdateTagInput struct {
+	ID    string
+	Name  *string
+	Color *string
+}
@@ -0,0 +1,13 @@
+package input
+
+type UpdateTenantInput struct {
+	// Nil fields are left unchanged
+	Name *string
+	Slug *string
+	// TrashRetentionDays must be between MinTrashRetentionDays and MaxTrashRetentionDays
+	TrashRetentionDays *int
+}
+
+type AddTenantDomainInput struct {
+	Domain string
+}
@@ -2,18 +2,178 @@ package input
 
 import "time"
 
+// CreateTodoInput creates a todo in the project named by ProjectID, or in the inbox
+// when it is nil or empty. A non-empty ParentID creates it as a subtask of that todo.
+// AutoComplete completes the todo once all of its subtasks are done. Priority is one of
+// none, low, medium, high or urgent; empty means none. A RecurrenceRule is an RFC 5545
+// RRULE that repeats the todo from its DueDate, which it then requires, in the wall clock
+// of RecurrenceTimezone, an IANA zone defaulting to the owner's.
 type CreateTodoInput struct {
-	Title       string
-	Description string
-	IsPublic    bool
-	DueDate     *time.Time
+	Title              string
+	Description        string
+	IsPublic           bool
+	AutoComplete       bool
+	Priority           string
+	DueDate            *time.Time
+	RecurrenceRule     string
+	RecurrenceTimezone string
+	TagIDs             []string
+	ProjectID          *string
+	ParentID           *string
 }
 
+// UpdateTodoInput replaces a todo. A non-nil IfMatch only applies the update while
+// the todo is still at that version. A nil TagIDs keeps the current tags; any other
+// value, including an empty slice, replaces them. Likewise a nil ProjectID keeps the
+// todo's project, and an empty one moves it to the inbox; a nil ParentID keeps the
+// todo's parent, and an empty one makes it a top-level todo. A nil AutoComplete or
+// Priority keeps the current setting. Completing a todo that open todos block fails
+// unless Force is set.
+// A nil RecurrenceRule or RecurrenceTimezone keeps the current one; an empty rule stops
+// the todo repeating, and so does removing its due date. Completing a recurring todo
+// keeps it as history and creates the next occurrence.
 type UpdateTodoInput struct {
-	ID          string
-	Title       string
-	Description string
-	Completed   bool
-	IsPublic    bool
-	DueDate     *time.Time
+	ID                 string
+	Title              string
+	Description        string
+	Completed          bool
+	IsPublic           bool
+	AutoComplete       *bool
+	Priority           *string
+	DueDate            *time.Time
+	RecurrenceRule     *string
+	RecurrenceTimezone *string
+	TagIDs             []string
+	ProjectID          *string
+	ParentID           *string
+	IfMatch            *int
+	Force              bool
+}
+
+// PatchTodoInput changes only the fields it sets, following JSON Merge Patch.
+// ClearDueDate removes the due date and takes precedence over DueDate. TagIDs,
+// ProjectID, ParentID, RecurrenceRule, RecurrenceTimezone and Force follow UpdateTodoInput.
+type PatchTodoInput struct {
+	ID                 string
+	Title              *string
+	Description        *string
+	Completed          *bool
+	IsPublic           *bool
+	AutoComplete       *bool
+	Priority           *string
+	DueDate            *time.Time
+	ClearDueDate       bool
+	RecurrenceRule     *string
+	RecurrenceTimezone *string
+	TagIDs             []string
+	ProjectID          *string
+	ParentID           *string
+	IfMatch            *int
+	Force              bool
+}
+
+// DeleteTodoInput moves a todo to the trash, or removes it for good when Permanent is set,
+// under the same IfMatch condition as UpdateTodoInput. Only permanent deletes reach
+// todos that are already in the trash. A todo with subtasks is only deleted with Cascade,
+// which takes all of its subtasks along.
+type DeleteTodoInput struct {
+	ID        string
+	IfMatch   *int
+	Permanent bool
+	Cascade   bool
+}
+
+// MoveTodoInput places a todo in the manual order right before BeforeID or right after
+// AfterID, of which at most one may be set. A non-nil ProjectID also files the todo in
+// that project, or in the inbox when it is empty; without a neighbour the todo then goes
+// to the top.
+type MoveTodoInput struct {
+	ID        string
+	BeforeID  string
+	AfterID   string
+	ProjectID *string
+}
+
+// SnoozeTodoInput hides a todo from listings until Until, or until the time Preset
+// names in the actor's timezone. Exactly one of them must be set.
+type SnoozeTodoInput struct {
+	ID     string
+	Preset string
+	Until  *time.Time
+}
+
+type BatchTodoAction string
+
+const (
+	BatchTodoComplete   BatchTodoAction = "complete"
+	BatchTodoUncomplete BatchTodoAction = "uncomplete"
+	BatchTodoSetPublic  BatchTodoAction = "set_public"
+	BatchTodoSetDueDate BatchTodoAction = "set_due_date"
+	BatchTodoDelete     BatchTodoAction = "delete"
+	BatchTodoMoveToList BatchTodoAction = "move_to_list"
+)
+
+// BatchTodoOperation applies one action to every todo in IDs. IsPublic is required for
+// set_public; set_due_date clears the due date when DueDate is nil, and move_to_list
+// moves the todos to the inbox when ProjectID is nil. Delete moves the todos to the trash.
+type BatchTodoOperation struct {
+	Action    BatchTodoAction
+	IDs       []string
+	IsPublic  *bool
+	DueDate   *time.Time
+	ProjectID *string
+}
+
+// BatchTodoInput runs Operations in order in one transaction. When Atomic is set,
+// a single failing item rolls the whole batch back.
+type BatchTodoInput struct {
+	Operations []BatchTodoOperation
+	Atomic     bool
+}
+
+// ListTodosInput selects one page of todos. A zero Limit uses the default page size
+// and an empty Cursor starts from the first todo in sort order.
+type ListTodosInput struct {
+	Limit  int
+	Cursor string
+	Filter TodoFilter
+}
+
+// ListTodoViewInput selects one page of a smart view: today, upcoming, overdue or
+// someday. Limit and Cursor work as in ListTodosInput.
+type ListTodoViewInput struct {
+	View   string
+	Limit  int
+	Cursor string
+}
+
+// TodoFilter narrows and orders a todo listing. Nil fields are not applied.
+// SortBy is one of created_at, due_date, updated_at, title, position or priority and
+// defaults to created_at; SortOrder is asc or desc and defaults to desc, or to asc for
+// position.
+// Query is written in the todoquery language and combines with the other fields. Todos
+// must carry every tag in TagIDs. ProjectID keeps the todos of one project, or those in
+// the inbox when it is InboxProjectID. Snoozed todos are left out unless IncludeSnoozed.
+type TodoFilter struct {
+	Query          string
+	Completed      *bool
+	DueBefore      *time.Time
+	DueAfter       *time.Time
+	Overdue        *bool
+	HasDueDate     *bool
+	CreatedAfter   *time.Time
+	TagIDs         []string
+	ProjectID      string
+	IncludeSnoozed bool
+	SortBy         string
+	SortOrder      string
+}
+
+// InboxProjectID stands for the inbox, the todos without a project, in TodoFilter.
+const InboxProjectID = "inbox"
+
+// SearchTodosInput is a free-text search. A zero Limit uses the default page size.
+type SearchTodosInput struct {
+	Query string
+	Limit int
 }
@@ -1,5 +1,15 @@
 package input
 
+// UpdateUserInput changes the actor's profile. A nil Timezone keeps the current one.
 type UpdateUserInput struct {
-	Name string
+	Name     string
+	Timezone *string
+}
+
+// UpdateDigestInput replaces the actor's daily digest preference. An empty Time uses
+// DefaultDigestTime and a nil Timezone follows the user's own.
+type UpdateDigestInput struct {
+	Enabled  bool
+	Time     string
+	Timezone *string
 }
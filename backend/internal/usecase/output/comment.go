@@ -0,0 +1,19 @@
+package output
+
+import "time"
+
+type CommentOutput struct {
+	ID        string
+	TodoID    string
+	AuthorID  string
+	Body      string
+	EditedAt  *time.Time
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// CommentListOutput is one page of comments. NextCursor is nil on the last page.
+type CommentListOutput struct {
+	Comments   []*CommentOutput
+	NextCursor *string
+}
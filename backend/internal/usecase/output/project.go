@@ -0,0 +1,15 @@
+package output
+
+import "time"
+
+type ProjectOutput struct {
+	ID          string
+	UserID      string
+	Name        string
+	Description string
+	Color       string
+	Archived    bool
+	IsPublic    bool
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
@@ -0,0 +1,15 @@
+package output
+
+import "time"
+
+type ReminderOutput struct {
+	ID            string
+	TodoID        string
+	RemindAt      *time.Time
+	OffsetMinutes *int
+	Status        string
+	Attempts      int
+	LastError     *string
+	DeliveredAt   *time.Time
+	CreatedAt     time.Time
+}
@@ -0,0 +1,11 @@
+package output
+
+import "time"
+
+type TagOutput struct {
+	ID        string
+	Name      string
+	Color     string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
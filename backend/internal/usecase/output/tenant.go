@@ -0,0 +1,21 @@
+package output
+
+import "time"
+
+type TenantOutput struct {
+	ID                 string
+	Name               string
+	Slug               string
+	TrashRetentionDays int
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+}
+
+type TenantDomainOutput struct {
+	ID                 string
+	Domain             string
+	VerificationRecord string
+	VerificationToken  string
+	VerifiedAt         *time.Time
+	CreatedAt          time.Time
+}
@@ -3,14 +3,76 @@ package output
 import "time"
 
 type TodoOutput struct {
-	ID          string
-	UserID      string
-	Title       string
-	Description string
-	Completed   bool
-	IsPublic    bool
-	DueDate     *time.Time
-	CompletedAt *time.Time
-	CreatedAt   time.Time
-	UpdatedAt   time.Time
+	ID                    string
+	UserID                string
+	AssigneeID            *string
+	ProjectID             *string
+	ParentID              *string
+	Title                 string
+	Description           string
+	Completed             bool
+	IsPublic              bool
+	AutoComplete          bool
+	Priority              string
+	DueDate               *time.Time
+	RecurrenceRule        *string
+	RecurrenceTimezone    *string
+	CompletedAt           *time.Time
+	SnoozedUntil          *time.Time
+	Version               int
+	DeletedAt             *time.Time
+	Position              string
+	Tags                  []*TagOutput
+	SubtaskCount          int
+	CompletedSubtaskCount int
+	BlockedCount          int
+	BlockingCount         int
+	CreatedAt             time.Time
+	UpdatedAt             time.Time
+}
+
+// TodoListOutput is one page of todos. NextCursor is nil on the last page.
+type TodoListOutput struct {
+	Todos      []*TodoOutput
+	NextCursor *string
+}
+
+// TodoSearchResultOutput is a ranked search match. Matched terms in the highlights are
+// wrapped in model.HighlightStart and model.HighlightEnd.
+type TodoSearchResultOutput struct {
+	Todo                 *TodoOutput
+	Rank                 float64
+	TitleHighlight       string
+	DescriptionHighlight string
+}
+
+type BatchTodoItemStatus string
+
+const (
+	BatchTodoItemUpdated   BatchTodoItemStatus = "updated"
+	BatchTodoItemDeleted   BatchTodoItemStatus = "deleted"
+	BatchTodoItemNotFound  BatchTodoItemStatus = "not_found"
+	BatchTodoItemForbidden BatchTodoItemStatus = "forbidden"
+	// BatchTodoItemHasSubtasks is a delete refused because the todo has subtasks
+	BatchTodoItemHasSubtasks BatchTodoItemStatus = "has_subtasks"
+	// BatchTodoItemBlocked is a completion refused because open todos block the todo
+	BatchTodoItemBlocked    BatchTodoItemStatus = "blocked"
+	BatchTodoItemRolledBack BatchTodoItemStatus = "rolled_back"
+)
+
+// BatchTodoItemOutput is the outcome for one id of one operation. Todo is set for
+// updated items only. Items that succeeded in a batch that was rolled back report
+// BatchTodoItemRolledBack.
+type BatchTodoItemOutput struct {
+	Operation int
+	ID        string
+	Status    BatchTodoItemStatus
+	Todo      *TodoOutput
+}
+
+// BatchTodoOutput lists one item per id in request order. Committed is false when an
+// atomic batch was rolled back.
+type BatchTodoOutput struct {
+	Committed bool
+	Items     []*BatchTodoItemOutput
 }
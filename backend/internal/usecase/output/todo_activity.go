@@ -0,0 +1,13 @@
+package output
+
+import "time"
+
+// TodoActivityOutput is one entry in a todo's history. SnoozedUntil is set for snoozes only.
+type TodoActivityOutput struct {
+	ID           string
+	TodoID       string
+	ActorID      string
+	Action       string
+	SnoozedUntil *time.Time
+	CreatedAt    time.Time
+}
@@ -7,8 +7,27 @@ type UserOutput struct {
 	TenantID      string
 	Email         string
 	Name          string
+	Timezone      string
 	Role          string
 	EmailVerified bool
 	CreatedAt     time.Time
 	UpdatedAt     time.Time
 }
+
+type MembershipOutput struct {
+	TenantID   string
+	TenantName string
+	TenantSlug string
+	Role       string
+	Current    bool
+	JoinedAt   time.Time
+}
+
+// DigestOutput is a daily digest preference. LastSentOn is the member's local date of
+// the last digest.
+type DigestOutput struct {
+	Enabled    bool
+	Time       string
+	Timezone   *string
+	LastSentOn *time.Time
+}
@@ -0,0 +1,283 @@
+package usecase
+
+import (
+	"context"
+	"log"
+	"slices"
+
+	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/usecase/input"
+)
+
+type Action string
+
+const (
+	ActionView     Action = "view"
+	ActionCreate   Action = "create"
+	ActionUpdate   Action = "update"
+	ActionComplete Action = "complete"
+	ActionDelete   Action = "delete"
+	ActionAssign   Action = "assign"
+)
+
+type Resource string
+
+const (
+	ResourceTodo    Resource = "todo"
+	ResourceUser    Resource = "user"
+	ResourceTenant  Resource = "tenant"
+	ResourceTag     Resource = "tag"
+	ResourceProject Resource = "project"
+	ResourceComment Resource = "comment"
+)
+
+// Relation describes how an actor is related to the resource being accessed.
+type Relation string
+
+const (
+	// RelationOwner means the actor owns the resource.
+	RelationOwner Relation = "owner"
+	// RelationAssignee means the resource is assigned to the actor (e.g. a delegated todo).
+	RelationAssignee Relation = "assignee"
+	// RelationShared means the resource is shared with the whole tenant (e.g. a public todo).
+	RelationShared Relation = "shared"
+	// RelationTenant means the resource belongs to the actor's tenant.
+	RelationTenant Relation = "tenant"
+)
+
+// PermissionRule grants the listed actions on a resource when the actor has one of
+// the roles (any role if empty) and one of the relations (any relation if empty).
+type PermissionRule struct {
+	Resource  Resource
+	Actions   []Action
+	Roles     []model.UserRole
+	Relations []Relation
+}
+
+// DefaultPermissionRules returns the rules used by the API.
+func DefaultPermissionRules() []PermissionRule {
+	return []PermissionRule{
+		// Owners have full control over their own todos
+		{
+			Resource:  ResourceTodo,
+			Actions:   []Action{ActionView, ActionCreate, ActionUpdate, ActionComplete, ActionDelete, ActionAssign},
+			Relations: []Relation{RelationOwner},
+		},
+		// Assignees work on the todo next to its owner, but only the owner can delete it or
+		// hand it to someone else
+		{
+			Resource:  ResourceTodo,
+			Actions:   []Action{ActionView, ActionUpdate, ActionComplete},
+			Relations: []Relation{RelationAssignee},
+		},
+		// Public todos are visible to everyone in the tenant
+		{
+			Resource:  ResourceTodo,
+			Actions:   []Action{ActionView},
+			Relations: []Relation{RelationShared},
+		},
+		// Admins can edit and assign any public todo
+		{
+			Resource:  ResourceTodo,
+			Actions:   []Action{ActionUpdate, ActionComplete, ActionAssign},
+			Roles:     []model.UserRole{model.UserRoleAdmin},
+			Relations: []Relation{RelationShared},
+		},
+		// Members can complete but not edit or delete public todos
+		{
+			Resource:  ResourceTodo,
+			Actions:   []Action{ActionComplete},
+			Roles:     []model.UserRole{model.UserRoleMember},
+			Relations: []Relation{RelationShared},
+		},
+		// Users manage their own profile
+		{
+			Resource:  ResourceUser,
+			Actions:   []Action{ActionView, ActionUpdate},
+			Relations: []Relation{RelationOwner},
+		},
+		// Admins can view users in their tenant
+		{
+			Resource:  ResourceUser,
+			Actions:   []Action{ActionView},
+			Roles:     []model.UserRole{model.UserRoleAdmin},
+			Relations: []Relation{RelationTenant},
+		},
+		// Everyone can see their tenant, only admins can change it
+		{
+			Resource:  ResourceTenant,
+			Actions:   []Action{ActionView},
+			Relations: []Relation{RelationTenant},
+		},
+		{
+			Resource:  ResourceTenant,
+			Actions:   []Action{ActionUpdate},
+			Roles:     []model.UserRole{model.UserRoleAdmin},
+			Relations: []Relation{RelationTenant},
+		},
+		// Tags are shared by the tenant: everyone can use and add them, but renaming or
+		// deleting one changes it for everybody, so that is left to admins
+		{
+			Resource:  ResourceTag,
+			Actions:   []Action{ActionView, ActionCreate},
+			Relations: []Relation{RelationTenant},
+		},
+		{
+			Resource:  ResourceTag,
+			Actions:   []Action{ActionUpdate, ActionDelete},
+			Roles:     []model.UserRole{model.UserRoleAdmin},
+			Relations: []Relation{RelationTenant},
+		},
+		// Projects follow todos: owners manage their own, public projects are visible to
+		// everyone and can be changed by admins
+		{
+			Resource:  ResourceProject,
+			Actions:   []Action{ActionView, ActionCreate, ActionUpdate, ActionDelete},
+			Relations: []Relation{RelationOwner},
+		},
+		{
+			Resource:  ResourceProject,
+			Actions:   []Action{ActionView},
+			Relations: []Relation{RelationShared},
+		},
+		{
+			Resource:  ResourceProject,
+			Actions:   []Action{ActionUpdate, ActionDelete},
+			Roles:     []model.UserRole{model.UserRoleAdmin},
+			Relations: []Relation{RelationShared},
+		},
+		// Whoever can see a todo can read and add its comments; authors change their own
+		// comments and admins moderate everyone's
+		{
+			Resource:  ResourceComment,
+			Actions:   []Action{ActionUpdate, ActionDelete},
+			Relations: []Relation{RelationOwner},
+		},
+		{
+			Resource:  ResourceComment,
+			Actions:   []Action{ActionUpdate, ActionDelete},
+			Roles:     []model.UserRole{model.UserRoleAdmin},
+			Relations: []Relation{RelationTenant},
+		},
+	}
+}
+
+// PermissionTarget is the part of a resource that permission rules look at.
+type PermissionTarget struct {
+	OwnerID    string
+	AssigneeID string
+	TenantID   string
+	Shared     bool
+}
+
+func TodoTarget(todo *model.Todo) PermissionTarget {
+	target := PermissionTarget{
+		OwnerID:  todo.UserID,
+		TenantID: todo.TenantID,
+		Shared:   todo.IsPublic,
+	}
+	if todo.AssigneeID != nil {
+		target.AssigneeID = *todo.AssigneeID
+	}
+	return target
+}
+
+// MemberTarget targets a user as a member of the membership's tenant.
+func MemberTarget(membership *model.Membership) PermissionTarget {
+	return PermissionTarget{
+		OwnerID:  membership.UserID,
+		TenantID: membership.TenantID,
+	}
+}
+
+func TenantTarget(tenant *model.Tenant) PermissionTarget {
+	return PermissionTarget{
+		TenantID: tenant.ID,
+	}
+}
+
+func TagTarget(tag *model.Tag) PermissionTarget {
+	return PermissionTarget{
+		TenantID: tag.TenantID,
+	}
+}
+
+func ProjectTarget(project *model.Project) PermissionTarget {
+	return PermissionTarget{
+		OwnerID:  project.UserID,
+		TenantID: project.TenantID,
+		Shared:   project.IsPublic,
+	}
+}
+
+func CommentTarget(comment *model.Comment) PermissionTarget {
+	return PermissionTarget{
+		OwnerID:  comment.AuthorID,
+		TenantID: comment.TenantID,
+	}
+}
+
+type IPermissionEvaluator interface {
+	Can(ctx context.Context, actor input.Actor, action Action, resource Resource, target PermissionTarget) bool
+}
+
+type PermissionEvaluator struct {
+	rules []PermissionRule
+}
+
+func NewPermissionEvaluator(rules []PermissionRule) IPermissionEvaluator {
+	return &PermissionEvaluator{rules: rules}
+}
+
+func (e *PermissionEvaluator) Can(ctx context.Context, actor input.Actor, action Action, resource Resource, target PermissionTarget) bool {
+	relations := relationsOf(actor, target)
+	if len(relations) > 0 {
+		for _, rule := range e.rules {
+			if rule.matches(actor, action, resource, relations) {
+				return true
+			}
+		}
+	}
+
+	log.Printf("permission denied: user=%s tenant=%s role=%s action=%s resource=%s owner=%s",
+		actor.UserID, actor.TenantID, actor.Role, action, resource, target.OwnerID)
+	return false
+}
+
+// relationsOf returns every relation the actor has to the target.
+// Resources in another tenant have no relation at all.
+func relationsOf(actor input.Actor, target PermissionTarget) []Relation {
+	if actor.UserID == "" || target.TenantID != actor.TenantID {
+		return nil
+	}
+
+	relations := []Relation{RelationTenant}
+	if target.OwnerID == actor.UserID {
+		relations = append(relations, RelationOwner)
+	}
+	if target.AssigneeID == actor.UserID {
+		relations = append(relations, RelationAssignee)
+	}
+	if target.Shared {
+		relations = append(relations, RelationShared)
+	}
+	return relations
+}
+
+func (r PermissionRule) matches(actor input.Actor, action Action, resource Resource, relations []Relation) bool {
+	if r.Resource != resource || !slices.Contains(r.Actions, action) {
+		return false
+	}
+	if len(r.Roles) > 0 && !slices.Contains(r.Roles, actor.Role) {
+		return false
+	}
+	if len(r.Relations) == 0 {
+		return true
+	}
+	for _, rel := range relations {
+		if slices.Contains(r.Relations, rel) {
+			return true
+		}
+	}
+	return false
+}
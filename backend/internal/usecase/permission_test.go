@@ -0,0 +1,168 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/usecase/input"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func memberActor(userID string) input.Actor {
+	return input.Actor{UserID: userID, TenantID: "tenant-123", Role: model.UserRoleMember}
+}
+
+func adminActor(userID string) input.Actor {
+	return input.Actor{UserID: userID, TenantID: "tenant-123", Role: model.UserRoleAdmin}
+}
+
+func TestPermissionEvaluator_Todo(t *testing.T) {
+	evaluator := NewPermissionEvaluator(DefaultPermissionRules())
+
+	ownTodo := &model.Todo{ID: "todo-1", TenantID: "tenant-123", UserID: "user-123"}
+	privateTodo := &model.Todo{ID: "todo-2", TenantID: "tenant-123", UserID: "other-user"}
+	publicTodo := &model.Todo{ID: "todo-3", TenantID: "tenant-123", UserID: "other-user", IsPublic: true}
+	otherTenantTodo := &model.Todo{ID: "todo-4", TenantID: "tenant-456", UserID: "user-123", IsPublic: true}
+	assignee := "user-123"
+	assignedTodo := &model.Todo{ID: "todo-5", TenantID: "tenant-123", UserID: "other-user", AssigneeID: &assignee}
+
+	tests := []struct {
+		name    string
+		actor   input.Actor
+		action  Action
+		todo    *model.Todo
+		allowed bool
+	}{
+		{"owner can view", memberActor("user-123"), ActionView, ownTodo, true},
+		{"owner can update", memberActor("user-123"), ActionUpdate, ownTodo, true},
+		{"owner can complete", memberActor("user-123"), ActionComplete, ownTodo, true},
+		{"owner can delete", memberActor("user-123"), ActionDelete, ownTodo, true},
+		{"member cannot view private todo", memberActor("user-123"), ActionView, privateTodo, false},
+		{"member cannot update private todo", memberActor("user-123"), ActionUpdate, privateTodo, false},
+		{"admin cannot update private todo", adminActor("user-123"), ActionUpdate, privateTodo, false},
+		{"member can view public todo", memberActor("user-123"), ActionView, publicTodo, true},
+		{"member can complete public todo", memberActor("user-123"), ActionComplete, publicTodo, true},
+		{"member cannot update public todo", memberActor("user-123"), ActionUpdate, publicTodo, false},
+		{"member cannot delete public todo", memberActor("user-123"), ActionDelete, publicTodo, false},
+		{"admin can update public todo", adminActor("user-123"), ActionUpdate, publicTodo, true},
+		{"admin cannot delete public todo", adminActor("user-123"), ActionDelete, publicTodo, false},
+		{"assignee can view private todo", memberActor("user-123"), ActionView, assignedTodo, true},
+		{"assignee can update", memberActor("user-123"), ActionUpdate, assignedTodo, true},
+		{"assignee can complete", memberActor("user-123"), ActionComplete, assignedTodo, true},
+		{"assignee cannot delete", memberActor("user-123"), ActionDelete, assignedTodo, false},
+		{"assignee cannot assign", memberActor("user-123"), ActionAssign, assignedTodo, false},
+		{"owner can assign", memberActor("user-123"), ActionAssign, ownTodo, true},
+		{"member cannot assign public todo", memberActor("user-123"), ActionAssign, publicTodo, false},
+		{"admin can assign public todo", adminActor("user-123"), ActionAssign, publicTodo, true},
+		{"other member cannot view assigned todo", memberActor("user-456"), ActionView, assignedTodo, false},
+		{"other tenant is always denied", adminActor("user-123"), ActionView, otherTenantTodo, false},
+		{"anonymous is always denied", input.Actor{TenantID: "tenant-123"}, ActionView, publicTodo, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			allowed := evaluator.Can(context.Background(), tt.actor, tt.action, ResourceTodo, TodoTarget(tt.todo))
+			assert.Equal(t, tt.allowed, allowed)
+		})
+	}
+}
+
+func TestPermissionEvaluator_User(t *testing.T) {
+	evaluator := NewPermissionEvaluator(DefaultPermissionRules())
+
+	self := &model.Membership{UserID: "user-123", TenantID: "tenant-123"}
+	colleague := &model.Membership{UserID: "user-456", TenantID: "tenant-123"}
+	elsewhere := &model.Membership{UserID: "user-123", TenantID: "tenant-456"}
+
+	tests := []struct {
+		name    string
+		actor   input.Actor
+		action  Action
+		member  *model.Membership
+		allowed bool
+	}{
+		{"user can view self", memberActor("user-123"), ActionView, self, true},
+		{"user can update self", memberActor("user-123"), ActionUpdate, self, true},
+		{"member cannot view colleague", memberActor("user-123"), ActionView, colleague, false},
+		{"admin can view colleague", adminActor("user-123"), ActionView, colleague, true},
+		{"admin cannot update colleague", adminActor("user-123"), ActionUpdate, colleague, false},
+		{"user cannot view self in another tenant", memberActor("user-123"), ActionView, elsewhere, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			allowed := evaluator.Can(context.Background(), tt.actor, tt.action, ResourceUser, MemberTarget(tt.member))
+			assert.Equal(t, tt.allowed, allowed)
+		})
+	}
+}
+
+func TestPermissionEvaluator_Tenant(t *testing.T) {
+	evaluator := NewPermissionEvaluator(DefaultPermissionRules())
+
+	own := &model.Tenant{ID: "tenant-123"}
+	other := &model.Tenant{ID: "tenant-456"}
+
+	tests := []struct {
+		name    string
+		actor   input.Actor
+		action  Action
+		tenant  *model.Tenant
+		allowed bool
+	}{
+		{"member can view own tenant", memberActor("user-123"), ActionView, own, true},
+		{"member cannot update own tenant", memberActor("user-123"), ActionUpdate, own, false},
+		{"admin can update own tenant", adminActor("user-123"), ActionUpdate, own, true},
+		{"admin cannot view other tenant", adminActor("user-123"), ActionView, other, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			allowed := evaluator.Can(context.Background(), tt.actor, tt.action, ResourceTenant, TenantTarget(tt.tenant))
+			assert.Equal(t, tt.allowed, allowed)
+		})
+	}
+}
+
+func TestPermissionEvaluator_Comment(t *testing.T) {
+	evaluator := NewPermissionEvaluator(DefaultPermissionRules())
+
+	own := &model.Comment{ID: "comment-1", TenantID: "tenant-123", AuthorID: "user-123"}
+	others := &model.Comment{ID: "comment-2", TenantID: "tenant-123", AuthorID: "other-user"}
+	otherTenant := &model.Comment{ID: "comment-3", TenantID: "tenant-456", AuthorID: "other-user"}
+
+	tests := []struct {
+		name    string
+		actor   input.Actor
+		action  Action
+		comment *model.Comment
+		allowed bool
+	}{
+		{"author can update", memberActor("user-123"), ActionUpdate, own, true},
+		{"author can delete", memberActor("user-123"), ActionDelete, own, true},
+		{"member cannot update another's comment", memberActor("user-123"), ActionUpdate, others, false},
+		{"member cannot delete another's comment", memberActor("user-123"), ActionDelete, others, false},
+		{"admin can update another's comment", adminActor("user-123"), ActionUpdate, others, true},
+		{"admin can delete another's comment", adminActor("user-123"), ActionDelete, others, true},
+		{"admin cannot delete in other tenant", adminActor("user-123"), ActionDelete, otherTenant, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			allowed := evaluator.Can(context.Background(), tt.actor, tt.action, ResourceComment, CommentTarget(tt.comment))
+			assert.Equal(t, tt.allowed, allowed)
+		})
+	}
+}
+
+func TestPermissionEvaluator_CustomRules(t *testing.T) {
+	evaluator := NewPermissionEvaluator([]PermissionRule{
+		{Resource: ResourceTodo, Actions: []Action{ActionView}, Roles: []model.UserRole{model.UserRoleAdmin}},
+	})
+	todo := &model.Todo{ID: "todo-1", TenantID: "tenant-123", UserID: "user-123"}
+
+	assert.True(t, evaluator.Can(context.Background(), adminActor("user-456"), ActionView, ResourceTodo, TodoTarget(todo)))
+	assert.False(t, evaluator.Can(context.Background(), memberActor("user-123"), ActionView, ResourceTodo, TodoTarget(todo)))
+}
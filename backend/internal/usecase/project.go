@@ -0,0 +1,202 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/domain/repository"
+	"good-todo-go/internal/pkg"
+	"good-todo-go/internal/usecase/input"
+	"good-todo-go/internal/usecase/output"
+)
+
+const (
+	MaxProjectNameLength = 100
+	// DefaultProjectColor is used when a project is created without a color
+	DefaultProjectColor = "#6b7280"
+)
+
+var (
+	ErrProjectNotFound     = errors.New("project not found")
+	ErrNotProjectOwner     = errors.New("not project owner")
+	ErrProjectArchived     = errors.New("project is archived")
+	ErrInvalidProjectName  = errors.New("invalid project name")
+	ErrInvalidProjectColor = errors.New("invalid project color")
+)
+
+type IProjectInteractor interface {
+	// List returns the actor's own projects and the tenant's public ones, sorted by name.
+	List(ctx context.Context, actor input.Actor, input *input.ListProjectsInput) ([]*output.ProjectOutput, error)
+	Get(ctx context.Context, actor input.Actor, projectID string) (*output.ProjectOutput, error)
+	Create(ctx context.Context, actor input.Actor, input *input.CreateProjectInput) (*output.ProjectOutput, error)
+	Update(ctx context.Context, actor input.Actor, input *input.UpdateProjectInput) (*output.ProjectOutput, error)
+	Delete(ctx context.Context, actor input.Actor, input *input.DeleteProjectInput) error
+}
+
+type ProjectInteractor struct {
+	unitOfWork    repository.IUnitOfWork
+	projectRepo   repository.IProjectRepository
+	todoRepo      repository.ITodoRepository
+	permission    IPermissionEvaluator
+	uuidGenerator pkg.IUUIDGenerator
+}
+
+func NewProjectInteractor(unitOfWork repository.IUnitOfWork, projectRepo repository.IProjectRepository, todoRepo repository.ITodoRepository, permission IPermissionEvaluator, uuidGenerator pkg.IUUIDGenerator) IProjectInteractor {
+	return &ProjectInteractor{
+		unitOfWork:    unitOfWork,
+		projectRepo:   projectRepo,
+		todoRepo:      todoRepo,
+		permission:    permission,
+		uuidGenerator: uuidGenerator,
+	}
+}
+
+func (i *ProjectInteractor) List(ctx context.Context, actor input.Actor, inp *input.ListProjectsInput) ([]*output.ProjectOutput, error) {
+	projects, err := i.projectRepo.FindVisible(ctx, actor.TenantID, actor.UserID, inp.IncludeArchived)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*output.ProjectOutput, 0, len(projects))
+	for _, project := range projects {
+		if i.permission.Can(ctx, actor, ActionView, ResourceProject, ProjectTarget(project)) {
+			result = append(result, toProjectOutput(project))
+		}
+	}
+	return result, nil
+}
+
+func (i *ProjectInteractor) Get(ctx context.Context, actor input.Actor, projectID string) (*output.ProjectOutput, error) {
+	project, err := i.find(ctx, actor, ActionView, projectID)
+	if err != nil {
+		return nil, err
+	}
+	return toProjectOutput(project), nil
+}
+
+func (i *ProjectInteractor) Create(ctx context.Context, actor input.Actor, inp *input.CreateProjectInput) (*output.ProjectOutput, error) {
+	name, err := normalizeProjectName(inp.Name)
+	if err != nil {
+		return nil, err
+	}
+	color := DefaultProjectColor
+	if inp.Color != "" {
+		var ok bool
+		if color, ok = normalizeColor(inp.Color); !ok {
+			return nil, ErrInvalidProjectColor
+		}
+	}
+
+	project := &model.Project{
+		ID:          i.uuidGenerator.Generate(),
+		TenantID:    actor.TenantID,
+		UserID:      actor.UserID,
+		Name:        name,
+		Description: inp.Description,
+		Color:       color,
+		IsPublic:    inp.IsPublic,
+	}
+	if !i.permission.Can(ctx, actor, ActionCreate, ResourceProject, ProjectTarget(project)) {
+		return nil, ErrUnauthorized
+	}
+
+	created, err := i.projectRepo.Create(ctx, project)
+	if err != nil {
+		return nil, err
+	}
+	return toProjectOutput(created), nil
+}
+
+func (i *ProjectInteractor) Update(ctx context.Context, actor input.Actor, inp *input.UpdateProjectInput) (*output.ProjectOutput, error) {
+	project, err := i.find(ctx, actor, ActionUpdate, inp.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if inp.Name != nil {
+		if project.Name, err = normalizeProjectName(*inp.Name); err != nil {
+			return nil, err
+		}
+	}
+	if inp.Description != nil {
+		project.Description = *inp.Description
+	}
+	if inp.Color != nil {
+		var ok bool
+		if project.Color, ok = normalizeColor(*inp.Color); !ok {
+			return nil, ErrInvalidProjectColor
+		}
+	}
+	if inp.Archived != nil {
+		project.Archived = *inp.Archived
+	}
+	if inp.IsPublic != nil {
+		project.IsPublic = *inp.IsPublic
+	}
+
+	updated, err := i.projectRepo.Update(ctx, project)
+	if err != nil {
+		return nil, err
+	}
+	return toProjectOutput(updated), nil
+}
+
+// Delete removes the project. Todos the actor may not delete, such as other members'
+// todos in a public project, always move to the inbox.
+func (i *ProjectInteractor) Delete(ctx context.Context, actor input.Actor, inp *input.DeleteProjectInput) error {
+	project, err := i.find(ctx, actor, ActionDelete, inp.ID)
+	if err != nil {
+		return err
+	}
+
+	return i.unitOfWork.RunInTenantTx(ctx, actor.TenantID, func(ctx context.Context) error {
+		if inp.DeleteTodos {
+			if _, err := i.todoRepo.TrashProjectTodos(ctx, project.ID, actor.UserID, time.Now()); err != nil {
+				return err
+			}
+		}
+		return i.projectRepo.Delete(ctx, project.ID)
+	})
+}
+
+// find loads a project of the actor's tenant and checks the actor may perform action on it.
+func (i *ProjectInteractor) find(ctx context.Context, actor input.Actor, action Action, projectID string) (*model.Project, error) {
+	project, err := i.projectRepo.FindByID(ctx, actor.TenantID, projectID)
+	if err != nil {
+		return nil, err
+	}
+	if project == nil {
+		return nil, ErrProjectNotFound
+	}
+	if !i.permission.Can(ctx, actor, action, ResourceProject, ProjectTarget(project)) {
+		return nil, ErrNotProjectOwner
+	}
+	return project, nil
+}
+
+// normalizeProjectName trims name and checks it is 1 to MaxProjectNameLength characters.
+func normalizeProjectName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || utf8.RuneCountInString(name) > MaxProjectNameLength {
+		return "", ErrInvalidProjectName
+	}
+	return name, nil
+}
+
+func toProjectOutput(project *model.Project) *output.ProjectOutput {
+	return &output.ProjectOutput{
+		ID:          project.ID,
+		UserID:      project.UserID,
+		Name:        project.Name,
+		Description: project.Description,
+		Color:       project.Color,
+		Archived:    project.Archived,
+		IsPublic:    project.IsPublic,
+		CreatedAt:   project.CreatedAt,
+		UpdatedAt:   project.UpdatedAt,
+	}
+}
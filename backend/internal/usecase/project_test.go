@@ -0,0 +1,154 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/domain/repository/mock"
+	mocku "good-todo-go/internal/pkg/mock"
+	"good-todo-go/internal/usecase/input"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+)
+
+func TestProjectInteractor_Create(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockProjectRepo := mock.NewMockIProjectRepository(ctrl)
+	interactor := NewProjectInteractor(mock.NewMockIUnitOfWork(ctrl), mockProjectRepo, mock.NewMockITodoRepository(ctrl), NewPermissionEvaluator(DefaultPermissionRules()), mocku.NewMockUUIDGenerator("project-1"))
+	ctx := context.Background()
+
+	t.Run("member creates a project with the default color", func(t *testing.T) {
+		mockProjectRepo.EXPECT().
+			Create(ctx, &model.Project{ID: "project-1", TenantID: "tenant-123", UserID: "user-123", Name: "Home", Color: DefaultProjectColor}).
+			DoAndReturn(func(ctx context.Context, project *model.Project) (*model.Project, error) {
+				return project, nil
+			})
+
+		result, err := interactor.Create(ctx, memberActor("user-123"), &input.CreateProjectInput{Name: " Home "})
+
+		require.NoError(t, err)
+		assert.Equal(t, "Home", result.Name)
+		assert.Equal(t, "user-123", result.UserID)
+		assert.Equal(t, DefaultProjectColor, result.Color)
+	})
+
+	t.Run("invalid input", func(t *testing.T) {
+		tests := []struct {
+			name string
+			inp  input.CreateProjectInput
+			err  error
+		}{
+			{name: "blank name", inp: input.CreateProjectInput{Name: "  "}, err: ErrInvalidProjectName},
+			{name: "name too long", inp: input.CreateProjectInput{Name: strings.Repeat("a", MaxProjectNameLength+1)}, err: ErrInvalidProjectName},
+			{name: "color name", inp: input.CreateProjectInput{Name: "Home", Color: "blue"}, err: ErrInvalidProjectColor},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				_, err := interactor.Create(ctx, memberActor("user-123"), &tt.inp)
+
+				assert.Equal(t, tt.err, err)
+			})
+		}
+	})
+}
+
+func TestProjectInteractor_Update(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockProjectRepo := mock.NewMockIProjectRepository(ctrl)
+	interactor := NewProjectInteractor(mock.NewMockIUnitOfWork(ctrl), mockProjectRepo, mock.NewMockITodoRepository(ctrl), NewPermissionEvaluator(DefaultPermissionRules()), mocku.NewMockUUIDGenerator())
+	ctx := context.Background()
+	project := func(isPublic bool) *model.Project {
+		return &model.Project{ID: "project-1", TenantID: "tenant-123", UserID: "user-456", Name: "Launch", Color: DefaultProjectColor, IsPublic: isPublic}
+	}
+	archived := true
+
+	t.Run("member cannot change another member's public project", func(t *testing.T) {
+		mockProjectRepo.EXPECT().FindByID(ctx, "tenant-123", "project-1").Return(project(true), nil)
+
+		_, err := interactor.Update(ctx, memberActor("user-123"), &input.UpdateProjectInput{ID: "project-1", Archived: &archived})
+
+		assert.Equal(t, ErrNotProjectOwner, err)
+	})
+
+	t.Run("admin archives a public project", func(t *testing.T) {
+		mockProjectRepo.EXPECT().FindByID(ctx, "tenant-123", "project-1").Return(project(true), nil)
+		mockProjectRepo.EXPECT().
+			Update(ctx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, project *model.Project) (*model.Project, error) {
+				return project, nil
+			})
+
+		result, err := interactor.Update(ctx, adminActor("user-123"), &input.UpdateProjectInput{ID: "project-1", Archived: &archived})
+
+		require.NoError(t, err)
+		assert.True(t, result.Archived)
+	})
+
+	t.Run("private project of another member is hidden from admins", func(t *testing.T) {
+		mockProjectRepo.EXPECT().FindByID(ctx, "tenant-123", "project-1").Return(project(false), nil)
+
+		_, err := interactor.Update(ctx, adminActor("user-123"), &input.UpdateProjectInput{ID: "project-1", Archived: &archived})
+
+		assert.Equal(t, ErrNotProjectOwner, err)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		mockProjectRepo.EXPECT().FindByID(ctx, "tenant-123", "project-9").Return(nil, nil)
+
+		_, err := interactor.Update(ctx, memberActor("user-123"), &input.UpdateProjectInput{ID: "project-9"})
+
+		assert.Equal(t, ErrProjectNotFound, err)
+	})
+}
+
+func TestProjectInteractor_Delete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUnitOfWork := mock.NewMockIUnitOfWork(ctrl)
+	mockProjectRepo := mock.NewMockIProjectRepository(ctrl)
+	mockTodoRepo := mock.NewMockITodoRepository(ctrl)
+	interactor := NewProjectInteractor(mockUnitOfWork, mockProjectRepo, mockTodoRepo, NewPermissionEvaluator(DefaultPermissionRules()), mocku.NewMockUUIDGenerator())
+	ctx := context.Background()
+	project := &model.Project{ID: "project-1", TenantID: "tenant-123", UserID: "user-123", Name: "Home"}
+
+	t.Run("todos move to the inbox", func(t *testing.T) {
+		mockProjectRepo.EXPECT().FindByID(ctx, "tenant-123", "project-1").Return(project, nil)
+		mockUnitOfWork.EXPECT().RunInTenantTx(ctx, "tenant-123", gomock.Any()).DoAndReturn(runInTx)
+		mockProjectRepo.EXPECT().Delete(ctx, "project-1").Return(nil)
+
+		err := interactor.Delete(ctx, memberActor("user-123"), &input.DeleteProjectInput{ID: "project-1"})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("own todos go to the trash with the project", func(t *testing.T) {
+		mockProjectRepo.EXPECT().FindByID(ctx, "tenant-123", "project-1").Return(project, nil)
+		mockUnitOfWork.EXPECT().RunInTenantTx(ctx, "tenant-123", gomock.Any()).DoAndReturn(runInTx)
+		gomock.InOrder(
+			mockTodoRepo.EXPECT().TrashProjectTodos(ctx, "project-1", "user-123", gomock.Any()).Return(2, nil),
+			mockProjectRepo.EXPECT().Delete(ctx, "project-1").Return(nil),
+		)
+
+		err := interactor.Delete(ctx, memberActor("user-123"), &input.DeleteProjectInput{ID: "project-1", DeleteTodos: true})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("member cannot delete another member's project", func(t *testing.T) {
+		mockProjectRepo.EXPECT().FindByID(ctx, "tenant-123", "project-1").Return(project, nil)
+
+		err := interactor.Delete(ctx, memberActor("user-456"), &input.DeleteProjectInput{ID: "project-1"})
+
+		assert.Equal(t, ErrNotProjectOwner, err)
+	})
+}
@@ -0,0 +1,146 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/domain/repository"
+	"good-todo-go/internal/pkg"
+	"good-todo-go/internal/usecase/input"
+	"good-todo-go/internal/usecase/output"
+)
+
+var (
+	ErrReminderNotFound     = errors.New("reminder not found")
+	ErrInvalidReminder      = errors.New("give exactly one of remind_at and a non-negative offset_minutes")
+	ErrReminderInPast       = errors.New("remind_at is in the past")
+	ErrReminderNeedsDueDate = errors.New("an offset reminder needs the todo to have a due date")
+)
+
+// IReminderInteractor manages the reminders of a todo. Seeing the todo is enough to list
+// them, while setting or removing one needs permission to edit it.
+type IReminderInteractor interface {
+	List(ctx context.Context, actor input.Actor, todoID string) ([]*output.ReminderOutput, error)
+	Create(ctx context.Context, actor input.Actor, input *input.CreateReminderInput) (*output.ReminderOutput, error)
+	Delete(ctx context.Context, actor input.Actor, todoID, reminderID string) error
+}
+
+type ReminderInteractor struct {
+	reminderRepo  repository.IReminderRepository
+	todoRepo      repository.ITodoRepository
+	permission    IPermissionEvaluator
+	uuidGenerator pkg.IUUIDGenerator
+	clock         pkg.IClock
+}
+
+func NewReminderInteractor(
+	reminderRepo repository.IReminderRepository,
+	todoRepo repository.ITodoRepository,
+	permission IPermissionEvaluator,
+	uuidGenerator pkg.IUUIDGenerator,
+	clock pkg.IClock,
+) IReminderInteractor {
+	return &ReminderInteractor{
+		reminderRepo:  reminderRepo,
+		todoRepo:      todoRepo,
+		permission:    permission,
+		uuidGenerator: uuidGenerator,
+		clock:         clock,
+	}
+}
+
+func (i *ReminderInteractor) List(ctx context.Context, actor input.Actor, todoID string) ([]*output.ReminderOutput, error) {
+	todo, err := i.findTodo(ctx, actor, ActionView, todoID)
+	if err != nil {
+		return nil, err
+	}
+
+	reminders, err := i.reminderRepo.FindByTodoID(ctx, actor.TenantID, todo.ID)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*output.ReminderOutput, len(reminders))
+	for j, reminder := range reminders {
+		result[j] = toReminderOutput(reminder)
+	}
+	return result, nil
+}
+
+func (i *ReminderInteractor) Create(ctx context.Context, actor input.Actor, inp *input.CreateReminderInput) (*output.ReminderOutput, error) {
+	if (inp.RemindAt == nil) == (inp.OffsetMinutes == nil) {
+		return nil, ErrInvalidReminder
+	}
+	if inp.OffsetMinutes != nil && *inp.OffsetMinutes < 0 {
+		return nil, ErrInvalidReminder
+	}
+	if inp.RemindAt != nil && !inp.RemindAt.After(i.clock.Now()) {
+		return nil, ErrReminderInPast
+	}
+
+	todo, err := i.findTodo(ctx, actor, ActionUpdate, inp.TodoID)
+	if err != nil {
+		return nil, err
+	}
+	if inp.OffsetMinutes != nil && todo.DueDate == nil {
+		return nil, ErrReminderNeedsDueDate
+	}
+
+	created, err := i.reminderRepo.Create(ctx, &model.Reminder{
+		ID:            i.uuidGenerator.Generate(),
+		TenantID:      actor.TenantID,
+		TodoID:        todo.ID,
+		RemindAt:      inp.RemindAt,
+		OffsetMinutes: inp.OffsetMinutes,
+		Status:        model.ReminderStatusPending,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toReminderOutput(created), nil
+}
+
+func (i *ReminderInteractor) Delete(ctx context.Context, actor input.Actor, todoID, reminderID string) error {
+	todo, err := i.findTodo(ctx, actor, ActionUpdate, todoID)
+	if err != nil {
+		return err
+	}
+
+	reminder, err := i.reminderRepo.FindByID(ctx, actor.TenantID, reminderID)
+	if err != nil {
+		return err
+	}
+	if reminder == nil || reminder.TodoID != todo.ID {
+		return ErrReminderNotFound
+	}
+	return i.reminderRepo.Delete(ctx, reminder.ID)
+}
+
+// findTodo loads a live todo the actor can see and checks they may perform action on it.
+func (i *ReminderInteractor) findTodo(ctx context.Context, actor input.Actor, action Action, todoID string) (*model.Todo, error) {
+	todo, err := i.todoRepo.FindByID(ctx, todoID)
+	if err != nil {
+		return nil, err
+	}
+	if todo == nil || !i.permission.Can(ctx, actor, ActionView, ResourceTodo, TodoTarget(todo)) {
+		return nil, ErrTodoNotFound
+	}
+	if !i.permission.Can(ctx, actor, action, ResourceTodo, TodoTarget(todo)) {
+		return nil, ErrNotTodoOwner
+	}
+	return todo, nil
+}
+
+func toReminderOutput(reminder *model.Reminder) *output.ReminderOutput {
+	return &output.ReminderOutput{
+		ID:            reminder.ID,
+		TodoID:        reminder.TodoID,
+		RemindAt:      reminder.RemindAt,
+		OffsetMinutes: reminder.OffsetMinutes,
+		Status:        string(reminder.Status),
+		Attempts:      reminder.Attempts,
+		LastError:     reminder.LastError,
+		DeliveredAt:   reminder.DeliveredAt,
+		CreatedAt:     reminder.CreatedAt,
+	}
+}
@@ -0,0 +1,129 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/domain/repository"
+)
+
+const (
+	// ReminderBatchSize is how many reminders a tenant's transaction claims at a time.
+	ReminderBatchSize = 50
+	// MaxReminderAttempts is how many sends a reminder gets before it fails for good.
+	MaxReminderAttempts = 5
+	// ReminderRetryDelay is the wait after the first failed send; it doubles after each
+	// further failure.
+	ReminderRetryDelay = time.Minute
+)
+
+// IReminderDeliveryInteractor emails the reminders that have come due in every tenant.
+type IReminderDeliveryInteractor interface {
+	// DeliverDueReminders sends every reminder due at now and returns how many were
+	// delivered. A failed send is retried later and is not an error, and a failing tenant
+	// does not stop the others.
+	DeliverDueReminders(ctx context.Context, now time.Time) (int, error)
+}
+
+type ReminderDeliveryInteractor struct {
+	unitOfWork   repository.IUnitOfWork
+	tenantRepo   repository.ITenantRepository
+	reminderRepo repository.IReminderRepository
+	mailRepo     repository.IMailRepository
+}
+
+func NewReminderDeliveryInteractor(
+	unitOfWork repository.IUnitOfWork,
+	tenantRepo repository.ITenantRepository,
+	reminderRepo repository.IReminderRepository,
+	mailRepo repository.IMailRepository,
+) IReminderDeliveryInteractor {
+	return &ReminderDeliveryInteractor{
+		unitOfWork:   unitOfWork,
+		tenantRepo:   tenantRepo,
+		reminderRepo: reminderRepo,
+		mailRepo:     mailRepo,
+	}
+}
+
+func (i *ReminderDeliveryInteractor) DeliverDueReminders(ctx context.Context, now time.Time) (int, error) {
+	tenants, err := i.tenantRepo.FindAll(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	total := 0
+	var errs []error
+	for _, tenant := range tenants {
+		delivered, err := i.deliverTenant(ctx, tenant.ID, now)
+		total += delivered
+		if err != nil {
+			errs = append(errs, fmt.Errorf("tenant %s: %w", tenant.ID, err))
+		}
+	}
+	return total, errors.Join(errs...)
+}
+
+// deliverTenant works through the tenant's due reminders a batch at a time. Each batch
+// is claimed in its own transaction, which holds the row locks while the emails go out
+// and commits the outcome. A crash before the commit leaves the batch pending, so a
+// reminder is sent at least once.
+func (i *ReminderDeliveryInteractor) deliverTenant(ctx context.Context, tenantID string, now time.Time) (int, error) {
+	total := 0
+	for {
+		var claimed, delivered int
+		err := i.unitOfWork.RunInTenantTx(ctx, tenantID, func(ctx context.Context) error {
+			due, err := i.reminderRepo.ClaimDue(ctx, tenantID, now, ReminderBatchSize)
+			if err != nil {
+				return err
+			}
+			claimed = len(due)
+			for _, d := range due {
+				sent := i.deliver(ctx, d, now)
+				if err := i.reminderRepo.UpdateDelivery(ctx, d.Reminder); err != nil {
+					return err
+				}
+				if sent {
+					delivered++
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return total, err
+		}
+		total += delivered
+		if claimed < ReminderBatchSize {
+			return total, nil
+		}
+	}
+}
+
+// deliver sends one reminder and records the outcome on it. A failed send is retried
+// with exponential backoff until MaxReminderAttempts is reached.
+func (i *ReminderDeliveryInteractor) deliver(ctx context.Context, due *model.DueReminder, now time.Time) bool {
+	reminder := due.Reminder
+	reminder.Attempts++
+
+	if err := i.mailRepo.SendReminder(ctx, due); err != nil {
+		message := err.Error()
+		reminder.LastError = &message
+		if reminder.Attempts >= MaxReminderAttempts {
+			reminder.Status = model.ReminderStatusFailed
+			reminder.NextAttemptAt = nil
+		} else {
+			next := now.Add(ReminderRetryDelay << (reminder.Attempts - 1))
+			reminder.NextAttemptAt = &next
+		}
+		return false
+	}
+
+	reminder.Status = model.ReminderStatusDelivered
+	reminder.NextAttemptAt = nil
+	reminder.LastError = nil
+	reminder.DeliveredAt = &now
+	return true
+}
@@ -0,0 +1,105 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/domain/repository/mock"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+)
+
+func TestReminderDeliveryInteractor_DeliverDueReminders(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUnitOfWork := mock.NewMockIUnitOfWork(ctrl)
+	mockTenantRepo := mock.NewMockITenantRepository(ctrl)
+	mockReminderRepo := mock.NewMockIReminderRepository(ctrl)
+	mockMailRepo := mock.NewMockIMailRepository(ctrl)
+
+	interactor := NewReminderDeliveryInteractor(mockUnitOfWork, mockTenantRepo, mockReminderRepo, mockMailRepo)
+
+	now := time.Date(2024, 6, 1, 9, 0, 0, 0, time.UTC)
+	ctx := context.Background()
+	smtpErr := errors.New("connection refused")
+
+	t.Run("records the outcome of each send", func(t *testing.T) {
+		delivered := &model.DueReminder{Reminder: &model.Reminder{ID: "reminder-1", Status: model.ReminderStatusPending}, TodoTitle: "Pay rent"}
+		retried := &model.DueReminder{Reminder: &model.Reminder{ID: "reminder-2", Status: model.ReminderStatusPending, Attempts: 2}, TodoTitle: "Call mum"}
+		failed := &model.DueReminder{Reminder: &model.Reminder{ID: "reminder-3", Status: model.ReminderStatusPending, Attempts: MaxReminderAttempts - 1}, TodoTitle: "Renew passport"}
+
+		mockTenantRepo.EXPECT().FindAll(ctx).Return([]*model.Tenant{{ID: "tenant-a"}}, nil)
+		mockUnitOfWork.EXPECT().RunInTenantTx(ctx, "tenant-a", gomock.Any()).DoAndReturn(runInTx)
+		mockReminderRepo.EXPECT().ClaimDue(ctx, "tenant-a", now, ReminderBatchSize).Return([]*model.DueReminder{delivered, retried, failed}, nil)
+		mockMailRepo.EXPECT().SendReminder(ctx, delivered).Return(nil)
+		mockMailRepo.EXPECT().SendReminder(ctx, retried).Return(smtpErr)
+		mockMailRepo.EXPECT().SendReminder(ctx, failed).Return(smtpErr)
+		mockReminderRepo.EXPECT().UpdateDelivery(ctx, gomock.Any()).Return(nil).Times(3)
+
+		count, err := interactor.DeliverDueReminders(ctx, now)
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, count)
+
+		assert.Equal(t, model.ReminderStatusDelivered, delivered.Reminder.Status)
+		assert.Equal(t, 1, delivered.Reminder.Attempts)
+		assert.Equal(t, &now, delivered.Reminder.DeliveredAt)
+
+		// The third attempt waits four times the first retry delay
+		assert.Equal(t, model.ReminderStatusPending, retried.Reminder.Status)
+		assert.Equal(t, 3, retried.Reminder.Attempts)
+		require.NotNil(t, retried.Reminder.NextAttemptAt)
+		assert.Equal(t, now.Add(4*ReminderRetryDelay), *retried.Reminder.NextAttemptAt)
+		require.NotNil(t, retried.Reminder.LastError)
+		assert.Contains(t, *retried.Reminder.LastError, "connection refused")
+
+		assert.Equal(t, model.ReminderStatusFailed, failed.Reminder.Status)
+		assert.Equal(t, MaxReminderAttempts, failed.Reminder.Attempts)
+		assert.Nil(t, failed.Reminder.NextAttemptAt)
+	})
+
+	t.Run("claims batches until the tenant runs out", func(t *testing.T) {
+		full := make([]*model.DueReminder, ReminderBatchSize)
+		for i := range full {
+			full[i] = &model.DueReminder{Reminder: &model.Reminder{ID: "reminder", Status: model.ReminderStatusPending}}
+		}
+
+		mockTenantRepo.EXPECT().FindAll(ctx).Return([]*model.Tenant{{ID: "tenant-a"}}, nil)
+		mockUnitOfWork.EXPECT().RunInTenantTx(ctx, "tenant-a", gomock.Any()).DoAndReturn(runInTx).Times(2)
+		gomock.InOrder(
+			mockReminderRepo.EXPECT().ClaimDue(ctx, "tenant-a", now, ReminderBatchSize).Return(full, nil),
+			mockReminderRepo.EXPECT().ClaimDue(ctx, "tenant-a", now, ReminderBatchSize).Return(nil, nil),
+		)
+		mockMailRepo.EXPECT().SendReminder(ctx, gomock.Any()).Return(nil).Times(ReminderBatchSize)
+		mockReminderRepo.EXPECT().UpdateDelivery(ctx, gomock.Any()).Return(nil).Times(ReminderBatchSize)
+
+		count, err := interactor.DeliverDueReminders(ctx, now)
+
+		require.NoError(t, err)
+		assert.Equal(t, ReminderBatchSize, count)
+	})
+
+	t.Run("a failing tenant does not stop the others", func(t *testing.T) {
+		dbErr := errors.New("connection reset")
+		due := &model.DueReminder{Reminder: &model.Reminder{ID: "reminder-4", Status: model.ReminderStatusPending}}
+
+		mockTenantRepo.EXPECT().FindAll(ctx).Return([]*model.Tenant{{ID: "tenant-a"}, {ID: "tenant-b"}}, nil)
+		mockUnitOfWork.EXPECT().RunInTenantTx(ctx, "tenant-a", gomock.Any()).DoAndReturn(runInTx)
+		mockReminderRepo.EXPECT().ClaimDue(ctx, "tenant-a", now, ReminderBatchSize).Return(nil, dbErr)
+		mockUnitOfWork.EXPECT().RunInTenantTx(ctx, "tenant-b", gomock.Any()).DoAndReturn(runInTx)
+		mockReminderRepo.EXPECT().ClaimDue(ctx, "tenant-b", now, ReminderBatchSize).Return([]*model.DueReminder{due}, nil)
+		mockMailRepo.EXPECT().SendReminder(ctx, due).Return(nil)
+		mockReminderRepo.EXPECT().UpdateDelivery(ctx, due.Reminder).Return(nil)
+
+		count, err := interactor.DeliverDueReminders(ctx, now)
+
+		assert.ErrorIs(t, err, dbErr)
+		assert.Equal(t, 1, count)
+	})
+}
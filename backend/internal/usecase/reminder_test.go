@@ -0,0 +1,142 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/domain/repository/mock"
+	mocku "good-todo-go/internal/pkg/mock"
+	"good-todo-go/internal/usecase/input"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+)
+
+func TestReminderInteractor_Create(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockReminderRepo := mock.NewMockIReminderRepository(ctrl)
+	mockTodoRepo := mock.NewMockITodoRepository(ctrl)
+	now := time.Date(2024, 6, 1, 9, 0, 0, 0, time.UTC)
+	interactor := NewReminderInteractor(mockReminderRepo, mockTodoRepo, NewPermissionEvaluator(DefaultPermissionRules()), mocku.NewMockUUIDGenerator("reminder-1", "reminder-2"), mocku.NewMockClock(now))
+	ctx := context.Background()
+
+	due := now.Add(48 * time.Hour)
+	todo := &model.Todo{ID: "todo-1", TenantID: "tenant-123", UserID: "user-123", Title: "Pay rent", DueDate: &due}
+	created := func(ctx context.Context, reminder *model.Reminder) (*model.Reminder, error) {
+		return reminder, nil
+	}
+
+	t.Run("at a fixed time", func(t *testing.T) {
+		at := now.Add(time.Hour)
+		mockTodoRepo.EXPECT().FindByID(ctx, "todo-1").Return(todo, nil)
+		mockReminderRepo.EXPECT().
+			Create(ctx, &model.Reminder{ID: "reminder-1", TenantID: "tenant-123", TodoID: "todo-1", RemindAt: &at, Status: model.ReminderStatusPending}).
+			DoAndReturn(created)
+
+		result, err := interactor.Create(ctx, memberActor("user-123"), &input.CreateReminderInput{TodoID: "todo-1", RemindAt: &at})
+
+		require.NoError(t, err)
+		assert.Equal(t, "reminder-1", result.ID)
+		assert.Equal(t, "pending", result.Status)
+	})
+
+	t.Run("before the due date", func(t *testing.T) {
+		offset := 60
+		mockTodoRepo.EXPECT().FindByID(ctx, "todo-1").Return(todo, nil)
+		mockReminderRepo.EXPECT().
+			Create(ctx, &model.Reminder{ID: "reminder-2", TenantID: "tenant-123", TodoID: "todo-1", OffsetMinutes: &offset, Status: model.ReminderStatusPending}).
+			DoAndReturn(created)
+
+		result, err := interactor.Create(ctx, memberActor("user-123"), &input.CreateReminderInput{TodoID: "todo-1", OffsetMinutes: &offset})
+
+		require.NoError(t, err)
+		assert.Equal(t, &offset, result.OffsetMinutes)
+	})
+
+	t.Run("invalid input", func(t *testing.T) {
+		past := now.Add(-time.Minute)
+		future := now.Add(time.Hour)
+		offset, negative := 30, -5
+		tests := []struct {
+			name string
+			inp  input.CreateReminderInput
+			err  error
+		}{
+			{name: "neither", inp: input.CreateReminderInput{TodoID: "todo-1"}, err: ErrInvalidReminder},
+			{name: "both", inp: input.CreateReminderInput{TodoID: "todo-1", RemindAt: &future, OffsetMinutes: &offset}, err: ErrInvalidReminder},
+			{name: "negative offset", inp: input.CreateReminderInput{TodoID: "todo-1", OffsetMinutes: &negative}, err: ErrInvalidReminder},
+			{name: "in the past", inp: input.CreateReminderInput{TodoID: "todo-1", RemindAt: &past}, err: ErrReminderInPast},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				_, err := interactor.Create(ctx, memberActor("user-123"), &tt.inp)
+
+				assert.Equal(t, tt.err, err)
+			})
+		}
+	})
+
+	t.Run("offset without a due date", func(t *testing.T) {
+		offset := 30
+		mockTodoRepo.EXPECT().FindByID(ctx, "todo-2").Return(&model.Todo{ID: "todo-2", TenantID: "tenant-123", UserID: "user-123"}, nil)
+
+		_, err := interactor.Create(ctx, memberActor("user-123"), &input.CreateReminderInput{TodoID: "todo-2", OffsetMinutes: &offset})
+
+		assert.Equal(t, ErrReminderNeedsDueDate, err)
+	})
+
+	t.Run("on another member's todo", func(t *testing.T) {
+		at := now.Add(time.Hour)
+		mockTodoRepo.EXPECT().FindByID(ctx, "todo-3").Return(&model.Todo{ID: "todo-3", TenantID: "tenant-123", UserID: "user-456", IsPublic: true}, nil)
+
+		_, err := interactor.Create(ctx, memberActor("user-123"), &input.CreateReminderInput{TodoID: "todo-3", RemindAt: &at})
+
+		assert.Equal(t, ErrNotTodoOwner, err)
+	})
+
+	t.Run("on a todo the actor cannot see", func(t *testing.T) {
+		at := now.Add(time.Hour)
+		mockTodoRepo.EXPECT().FindByID(ctx, "todo-4").Return(&model.Todo{ID: "todo-4", TenantID: "tenant-123", UserID: "user-456"}, nil)
+
+		_, err := interactor.Create(ctx, memberActor("user-123"), &input.CreateReminderInput{TodoID: "todo-4", RemindAt: &at})
+
+		assert.Equal(t, ErrTodoNotFound, err)
+	})
+}
+
+func TestReminderInteractor_Delete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockReminderRepo := mock.NewMockIReminderRepository(ctrl)
+	mockTodoRepo := mock.NewMockITodoRepository(ctrl)
+	interactor := NewReminderInteractor(mockReminderRepo, mockTodoRepo, NewPermissionEvaluator(DefaultPermissionRules()), mocku.NewMockUUIDGenerator(), mocku.NewMockClock(time.Now()))
+	ctx := context.Background()
+
+	todo := &model.Todo{ID: "todo-1", TenantID: "tenant-123", UserID: "user-123"}
+
+	t.Run("removes the todo's reminder", func(t *testing.T) {
+		mockTodoRepo.EXPECT().FindByID(ctx, "todo-1").Return(todo, nil)
+		mockReminderRepo.EXPECT().FindByID(ctx, "tenant-123", "reminder-1").Return(&model.Reminder{ID: "reminder-1", TodoID: "todo-1"}, nil)
+		mockReminderRepo.EXPECT().Delete(ctx, "reminder-1").Return(nil)
+
+		err := interactor.Delete(ctx, memberActor("user-123"), "todo-1", "reminder-1")
+
+		require.NoError(t, err)
+	})
+
+	t.Run("a reminder of another todo is not found", func(t *testing.T) {
+		mockTodoRepo.EXPECT().FindByID(ctx, "todo-1").Return(todo, nil)
+		mockReminderRepo.EXPECT().FindByID(ctx, "tenant-123", "reminder-2").Return(&model.Reminder{ID: "reminder-2", TodoID: "todo-9"}, nil)
+
+		err := interactor.Delete(ctx, memberActor("user-123"), "todo-1", "reminder-2")
+
+		assert.Equal(t, ErrReminderNotFound, err)
+	})
+}
@@ -0,0 +1,172 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/domain/repository"
+	"good-todo-go/internal/pkg"
+	"good-todo-go/internal/usecase/input"
+	"good-todo-go/internal/usecase/output"
+)
+
+const (
+	MaxTagNameLength = 50
+	// DefaultTagColor is used when a tag is created without a color
+	DefaultTagColor = "#6b7280"
+)
+
+var (
+	ErrTagNotFound     = errors.New("tag not found")
+	ErrInvalidTagName  = errors.New("invalid tag name")
+	ErrInvalidTagColor = errors.New("invalid tag color")
+	ErrTagNameTaken    = repository.ErrTagNameConflict
+)
+
+var colorPattern = regexp.MustCompile(`^#[0-9a-f]{6}$`)
+
+type ITagInteractor interface {
+	List(ctx context.Context, actor input.Actor) ([]*output.TagOutput, error)
+	Create(ctx context.Context, actor input.Actor, input *input.CreateTagInput) (*output.TagOutput, error)
+	Update(ctx context.Context, actor input.Actor, input *input.UpdateTagInput) (*output.TagOutput, error)
+	// Delete removes the tag from every todo carrying it.
+	Delete(ctx context.Context, actor input.Actor, tagID string) error
+}
+
+type TagInteractor struct {
+	tagRepo       repository.ITagRepository
+	permission    IPermissionEvaluator
+	uuidGenerator pkg.IUUIDGenerator
+}
+
+func NewTagInteractor(tagRepo repository.ITagRepository, permission IPermissionEvaluator, uuidGenerator pkg.IUUIDGenerator) ITagInteractor {
+	return &TagInteractor{
+		tagRepo:       tagRepo,
+		permission:    permission,
+		uuidGenerator: uuidGenerator,
+	}
+}
+
+func (i *TagInteractor) List(ctx context.Context, actor input.Actor) ([]*output.TagOutput, error) {
+	if !i.permission.Can(ctx, actor, ActionView, ResourceTag, PermissionTarget{TenantID: actor.TenantID}) {
+		return nil, ErrUnauthorized
+	}
+
+	tags, err := i.tagRepo.ListByTenant(ctx, actor.TenantID)
+	if err != nil {
+		return nil, err
+	}
+	return toTagOutputs(tags), nil
+}
+
+func (i *TagInteractor) Create(ctx context.Context, actor input.Actor, inp *input.CreateTagInput) (*output.TagOutput, error) {
+	name, err := normalizeTagName(inp.Name)
+	if err != nil {
+		return nil, err
+	}
+	color := DefaultTagColor
+	if inp.Color != "" {
+		var ok bool
+		if color, ok = normalizeColor(inp.Color); !ok {
+			return nil, ErrInvalidTagColor
+		}
+	}
+
+	if !i.permission.Can(ctx, actor, ActionCreate, ResourceTag, PermissionTarget{TenantID: actor.TenantID}) {
+		return nil, ErrUnauthorized
+	}
+
+	created, err := i.tagRepo.Create(ctx, &model.Tag{
+		ID:       i.uuidGenerator.Generate(),
+		TenantID: actor.TenantID,
+		Name:     name,
+		Color:    color,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toTagOutput(created), nil
+}
+
+func (i *TagInteractor) Update(ctx context.Context, actor input.Actor, inp *input.UpdateTagInput) (*output.TagOutput, error) {
+	tag, err := i.tagRepo.FindByID(ctx, actor.TenantID, inp.ID)
+	if err != nil {
+		return nil, err
+	}
+	if tag == nil {
+		return nil, ErrTagNotFound
+	}
+	if !i.permission.Can(ctx, actor, ActionUpdate, ResourceTag, TagTarget(tag)) {
+		return nil, ErrUnauthorized
+	}
+
+	if inp.Name != nil {
+		if tag.Name, err = normalizeTagName(*inp.Name); err != nil {
+			return nil, err
+		}
+	}
+	if inp.Color != nil {
+		var ok bool
+		if tag.Color, ok = normalizeColor(*inp.Color); !ok {
+			return nil, ErrInvalidTagColor
+		}
+	}
+
+	updated, err := i.tagRepo.Update(ctx, tag)
+	if err != nil {
+		return nil, err
+	}
+	return toTagOutput(updated), nil
+}
+
+func (i *TagInteractor) Delete(ctx context.Context, actor input.Actor, tagID string) error {
+	tag, err := i.tagRepo.FindByID(ctx, actor.TenantID, tagID)
+	if err != nil {
+		return err
+	}
+	if tag == nil {
+		return ErrTagNotFound
+	}
+	if !i.permission.Can(ctx, actor, ActionDelete, ResourceTag, TagTarget(tag)) {
+		return ErrUnauthorized
+	}
+
+	return i.tagRepo.Delete(ctx, tag.ID)
+}
+
+// normalizeTagName trims name and checks it is 1 to MaxTagNameLength characters.
+func normalizeTagName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || utf8.RuneCountInString(name) > MaxTagNameLength {
+		return "", ErrInvalidTagName
+	}
+	return name, nil
+}
+
+// normalizeColor lower-cases color and reports whether it is a #rrggbb hex color.
+func normalizeColor(color string) (string, bool) {
+	color = strings.ToLower(strings.TrimSpace(color))
+	return color, colorPattern.MatchString(color)
+}
+
+func toTagOutput(tag *model.Tag) *output.TagOutput {
+	return &output.TagOutput{
+		ID:        tag.ID,
+		Name:      tag.Name,
+		Color:     tag.Color,
+		CreatedAt: tag.CreatedAt,
+		UpdatedAt: tag.UpdatedAt,
+	}
+}
+
+func toTagOutputs(tags []*model.Tag) []*output.TagOutput {
+	outputs := make([]*output.TagOutput, len(tags))
+	for idx, tag := range tags {
+		outputs[idx] = toTagOutput(tag)
+	}
+	return outputs
+}
@@ -0,0 +1,139 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/domain/repository/mock"
+	mocku "good-todo-go/internal/pkg/mock"
+	"good-todo-go/internal/usecase/input"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+)
+
+func TestTagInteractor_Create(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTagRepo := mock.NewMockITagRepository(ctrl)
+	interactor := NewTagInteractor(mockTagRepo, NewPermissionEvaluator(DefaultPermissionRules()), mocku.NewMockUUIDGenerator("tag-1"))
+	ctx := context.Background()
+
+	t.Run("member creates a tag with the default color", func(t *testing.T) {
+		mockTagRepo.EXPECT().
+			Create(ctx, &model.Tag{ID: "tag-1", TenantID: "tenant-123", Name: "Work", Color: DefaultTagColor}).
+			DoAndReturn(func(ctx context.Context, tag *model.Tag) (*model.Tag, error) {
+				return tag, nil
+			})
+
+		result, err := interactor.Create(ctx, memberActor("user-123"), &input.CreateTagInput{Name: "  Work "})
+
+		require.NoError(t, err)
+		assert.Equal(t, "Work", result.Name)
+		assert.Equal(t, DefaultTagColor, result.Color)
+	})
+
+	t.Run("name already taken", func(t *testing.T) {
+		mockTagRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil, ErrTagNameTaken)
+
+		_, err := interactor.Create(ctx, memberActor("user-123"), &input.CreateTagInput{Name: "work", Color: "#3B82F6"})
+
+		assert.Equal(t, ErrTagNameTaken, err)
+	})
+
+	t.Run("invalid input", func(t *testing.T) {
+		tests := []struct {
+			name string
+			inp  input.CreateTagInput
+			err  error
+		}{
+			{name: "blank name", inp: input.CreateTagInput{Name: "   "}, err: ErrInvalidTagName},
+			{name: "name too long", inp: input.CreateTagInput{Name: strings.Repeat("a", MaxTagNameLength+1)}, err: ErrInvalidTagName},
+			{name: "color name", inp: input.CreateTagInput{Name: "Work", Color: "red"}, err: ErrInvalidTagColor},
+			{name: "short hex color", inp: input.CreateTagInput{Name: "Work", Color: "#fff"}, err: ErrInvalidTagColor},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				_, err := interactor.Create(ctx, memberActor("user-123"), &tt.inp)
+
+				assert.Equal(t, tt.err, err)
+			})
+		}
+	})
+}
+
+func TestTagInteractor_Update(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTagRepo := mock.NewMockITagRepository(ctrl)
+	interactor := NewTagInteractor(mockTagRepo, NewPermissionEvaluator(DefaultPermissionRules()), mocku.NewMockUUIDGenerator())
+	ctx := context.Background()
+	tag := func() *model.Tag {
+		return &model.Tag{ID: "tag-1", TenantID: "tenant-123", Name: "Work", Color: DefaultTagColor}
+	}
+
+	t.Run("admin recolors a tag", func(t *testing.T) {
+		color := "#3B82F6"
+		mockTagRepo.EXPECT().FindByID(ctx, "tenant-123", "tag-1").Return(tag(), nil)
+		mockTagRepo.EXPECT().
+			Update(ctx, &model.Tag{ID: "tag-1", TenantID: "tenant-123", Name: "Work", Color: "#3b82f6"}).
+			DoAndReturn(func(ctx context.Context, tag *model.Tag) (*model.Tag, error) {
+				return tag, nil
+			})
+
+		result, err := interactor.Update(ctx, adminActor("user-123"), &input.UpdateTagInput{ID: "tag-1", Color: &color})
+
+		require.NoError(t, err)
+		assert.Equal(t, "#3b82f6", result.Color)
+	})
+
+	t.Run("member cannot update", func(t *testing.T) {
+		name := "Home"
+		mockTagRepo.EXPECT().FindByID(ctx, "tenant-123", "tag-1").Return(tag(), nil)
+
+		_, err := interactor.Update(ctx, memberActor("user-123"), &input.UpdateTagInput{ID: "tag-1", Name: &name})
+
+		assert.Equal(t, ErrUnauthorized, err)
+	})
+
+	t.Run("tag not found", func(t *testing.T) {
+		mockTagRepo.EXPECT().FindByID(ctx, "tenant-123", "tag-9").Return(nil, nil)
+
+		_, err := interactor.Update(ctx, adminActor("user-123"), &input.UpdateTagInput{ID: "tag-9"})
+
+		assert.Equal(t, ErrTagNotFound, err)
+	})
+}
+
+func TestTagInteractor_Delete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTagRepo := mock.NewMockITagRepository(ctrl)
+	interactor := NewTagInteractor(mockTagRepo, NewPermissionEvaluator(DefaultPermissionRules()), mocku.NewMockUUIDGenerator())
+	ctx := context.Background()
+	tag := &model.Tag{ID: "tag-1", TenantID: "tenant-123", Name: "Work", Color: DefaultTagColor}
+
+	t.Run("admin deletes a tag", func(t *testing.T) {
+		mockTagRepo.EXPECT().FindByID(ctx, "tenant-123", "tag-1").Return(tag, nil)
+		mockTagRepo.EXPECT().Delete(ctx, "tag-1").Return(nil)
+
+		err := interactor.Delete(ctx, adminActor("user-123"), "tag-1")
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("member cannot delete", func(t *testing.T) {
+		mockTagRepo.EXPECT().FindByID(ctx, "tenant-123", "tag-1").Return(tag, nil)
+
+		err := interactor.Delete(ctx, memberActor("user-123"), "tag-1")
+
+		assert.Equal(t, ErrUnauthorized, err)
+	})
+}
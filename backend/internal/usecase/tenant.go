@@ -0,0 +1,259 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/domain/repository"
+	"good-todo-go/internal/pkg"
+	"good-todo-go/internal/usecase/input"
+	"good-todo-go/internal/usecase/output"
+)
+
+var (
+	ErrTenantNotFound        = errors.New("tenant not found")
+	ErrInvalidTrashRetention = errors.New("trash retention must be between 1 and 365 days")
+)
+
+const (
+	MinTrashRetentionDays = 1
+	MaxTrashRetentionDays = 365
+)
+
+// tenantSlugGracePeriod is how long a previous slug keeps resolving after a rename.
+const tenantSlugGracePeriod = 30 * 24 * time.Hour
+
+type ITenantInteractor interface {
+	GetTenant(ctx context.Context, actor input.Actor) (*output.TenantOutput, error)
+	UpdateTenant(ctx context.Context, actor input.Actor, input *input.UpdateTenantInput) (*output.TenantOutput, error)
+	ListDomains(ctx context.Context, actor input.Actor) ([]*output.TenantDomainOutput, error)
+	AddDomain(ctx context.Context, actor input.Actor, input *input.AddTenantDomainInput) (*output.TenantDomainOutput, error)
+	VerifyDomain(ctx context.Context, actor input.Actor, domainID string) (*output.TenantDomainOutput, error)
+	RemoveDomain(ctx context.Context, actor input.Actor, domainID string) error
+}
+
+type TenantInteractor struct {
+	unitOfWork       repository.IUnitOfWork
+	tenantRepo       repository.ITenantRepository
+	tenantDomainRepo repository.ITenantDomainRepository
+	dnsRepo          repository.IDNSRepository
+	permission       IPermissionEvaluator
+	uuidGenerator    pkg.IUUIDGenerator
+}
+
+func NewTenantInteractor(
+	unitOfWork repository.IUnitOfWork,
+	tenantRepo repository.ITenantRepository,
+	tenantDomainRepo repository.ITenantDomainRepository,
+	dnsRepo repository.IDNSRepository,
+	permission IPermissionEvaluator,
+	uuidGenerator pkg.IUUIDGenerator,
+) ITenantInteractor {
+	return &TenantInteractor{
+		unitOfWork:       unitOfWork,
+		tenantRepo:       tenantRepo,
+		tenantDomainRepo: tenantDomainRepo,
+		dnsRepo:          dnsRepo,
+		permission:       permission,
+		uuidGenerator:    uuidGenerator,
+	}
+}
+
+func (i *TenantInteractor) GetTenant(ctx context.Context, actor input.Actor) (*output.TenantOutput, error) {
+	tenant, err := i.tenantRepo.FindByID(ctx, actor.TenantID)
+	if err != nil {
+		return nil, err
+	}
+	if tenant == nil {
+		return nil, ErrTenantNotFound
+	}
+
+	if !i.permission.Can(ctx, actor, ActionView, ResourceTenant, TenantTarget(tenant)) {
+		return nil, ErrUnauthorized
+	}
+
+	return toTenantOutput(tenant), nil
+}
+
+func (i *TenantInteractor) UpdateTenant(ctx context.Context, actor input.Actor, inp *input.UpdateTenantInput) (*output.TenantOutput, error) {
+	var slug string
+	if inp.Slug != nil {
+		slug = NormalizeTenantSlug(*inp.Slug)
+		if err := ValidateTenantSlug(slug); err != nil {
+			return nil, err
+		}
+	}
+	if days := inp.TrashRetentionDays; days != nil && (*days < MinTrashRetentionDays || *days > MaxTrashRetentionDays) {
+		return nil, ErrInvalidTrashRetention
+	}
+
+	var updated *model.Tenant
+	err := i.unitOfWork.RunInTenantTx(ctx, actor.TenantID, func(ctx context.Context) error {
+		tenant, err := i.tenantRepo.FindByID(ctx, actor.TenantID)
+		if err != nil {
+			return err
+		}
+		if tenant == nil {
+			return ErrTenantNotFound
+		}
+
+		if !i.permission.Can(ctx, actor, ActionUpdate, ResourceTenant, TenantTarget(tenant)) {
+			return ErrUnauthorized
+		}
+
+		if inp.Name != nil || inp.TrashRetentionDays != nil {
+			if inp.Name != nil {
+				tenant.Name = *inp.Name
+			}
+			if inp.TrashRetentionDays != nil {
+				tenant.TrashRetentionDays = *inp.TrashRetentionDays
+			}
+			if tenant, err = i.tenantRepo.Update(ctx, tenant); err != nil {
+				return err
+			}
+		}
+		if inp.Slug != nil {
+			tenant, err = i.tenantRepo.ChangeSlug(ctx, tenant.ID, slug, time.Now().Add(tenantSlugGracePeriod))
+			if err != nil {
+				return err
+			}
+		}
+
+		updated = tenant
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toTenantOutput(updated), nil
+}
+
+func (i *TenantInteractor) ListDomains(ctx context.Context, actor input.Actor) ([]*output.TenantDomainOutput, error) {
+	// Domains carry verification tokens, so listing them is restricted like editing
+	if err := i.authorizeTenantUpdate(ctx, actor); err != nil {
+		return nil, err
+	}
+
+	domains, err := i.tenantDomainRepo.ListByTenant(ctx, actor.TenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	outputs := make([]*output.TenantDomainOutput, len(domains))
+	for idx, domain := range domains {
+		outputs[idx] = toTenantDomainOutput(domain)
+	}
+	return outputs, nil
+}
+
+func (i *TenantInteractor) AddDomain(ctx context.Context, actor input.Actor, inp *input.AddTenantDomainInput) (*output.TenantDomainOutput, error) {
+	name := NormalizeTenantDomain(inp.Domain)
+	if err := ValidateTenantDomain(name); err != nil {
+		return nil, err
+	}
+
+	if err := i.authorizeTenantUpdate(ctx, actor); err != nil {
+		return nil, err
+	}
+
+	created, err := i.tenantDomainRepo.Create(ctx, &model.TenantDomain{
+		ID:                i.uuidGenerator.Generate(),
+		TenantID:          actor.TenantID,
+		Domain:            name,
+		VerificationToken: i.uuidGenerator.Generate(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toTenantDomainOutput(created), nil
+}
+
+func (i *TenantInteractor) VerifyDomain(ctx context.Context, actor input.Actor, domainID string) (*output.TenantDomainOutput, error) {
+	if err := i.authorizeTenantUpdate(ctx, actor); err != nil {
+		return nil, err
+	}
+
+	domain, err := i.tenantDomainRepo.FindByID(ctx, actor.TenantID, domainID)
+	if err != nil {
+		return nil, err
+	}
+	if domain == nil {
+		return nil, ErrTenantDomainNotFound
+	}
+	if domain.VerifiedAt != nil {
+		return toTenantDomainOutput(domain), nil
+	}
+
+	records, err := i.dnsRepo.LookupTXT(ctx, tenantDomainVerificationRecord(domain.Domain))
+	if err != nil {
+		return nil, err
+	}
+	if !slices.Contains(records, domain.VerificationToken) {
+		return nil, ErrTenantDomainUnverified
+	}
+
+	verified, err := i.tenantDomainRepo.MarkVerified(ctx, domain.ID, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	return toTenantDomainOutput(verified), nil
+}
+
+func (i *TenantInteractor) RemoveDomain(ctx context.Context, actor input.Actor, domainID string) error {
+	if err := i.authorizeTenantUpdate(ctx, actor); err != nil {
+		return err
+	}
+
+	domain, err := i.tenantDomainRepo.FindByID(ctx, actor.TenantID, domainID)
+	if err != nil {
+		return err
+	}
+	if domain == nil {
+		return ErrTenantDomainNotFound
+	}
+
+	return i.tenantDomainRepo.Delete(ctx, domain.ID)
+}
+
+// authorizeTenantUpdate checks that the actor may change settings of their own tenant.
+func (i *TenantInteractor) authorizeTenantUpdate(ctx context.Context, actor input.Actor) error {
+	tenant, err := i.tenantRepo.FindByID(ctx, actor.TenantID)
+	if err != nil {
+		return err
+	}
+	if tenant == nil {
+		return ErrTenantNotFound
+	}
+	if !i.permission.Can(ctx, actor, ActionUpdate, ResourceTenant, TenantTarget(tenant)) {
+		return ErrUnauthorized
+	}
+	return nil
+}
+
+func toTenantDomainOutput(domain *model.TenantDomain) *output.TenantDomainOutput {
+	return &output.TenantDomainOutput{
+		ID:                 domain.ID,
+		Domain:             domain.Domain,
+		VerificationRecord: tenantDomainVerificationRecord(domain.Domain),
+		VerificationToken:  domain.VerificationToken,
+		VerifiedAt:         domain.VerifiedAt,
+		CreatedAt:          domain.CreatedAt,
+	}
+}
+
+func toTenantOutput(tenant *model.Tenant) *output.TenantOutput {
+	return &output.TenantOutput{
+		ID:                 tenant.ID,
+		Name:               tenant.Name,
+		Slug:               tenant.Slug,
+		TrashRetentionDays: tenant.TrashRetentionDays,
+		CreatedAt:          tenant.CreatedAt,
+		UpdatedAt:          tenant.UpdatedAt,
+	}
+}
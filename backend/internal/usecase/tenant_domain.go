@@ -0,0 +1,55 @@
+package usecase
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+
+	"good-todo-go/internal/domain/repository"
+)
+
+var (
+	ErrInvalidTenantDomain    = errors.New("invalid domain name")
+	ErrTenantDomainNotFound   = errors.New("tenant domain not found")
+	ErrTenantDomainUnverified = errors.New("domain verification record not found")
+	ErrTenantDomainTaken      = repository.ErrTenantDomainConflict
+)
+
+// tenantDomainVerificationPrefix is prepended to a custom domain to build the name of
+// the TXT record that must contain the verification token.
+const tenantDomainVerificationPrefix = "_good-todo-verification."
+
+var (
+	domainLabelPattern = regexp.MustCompile(`^[a-z0-9](?:[a-z0-9-]*[a-z0-9])?$`)
+	numericLabel       = regexp.MustCompile(`^[0-9]+$`)
+)
+
+// NormalizeTenantDomain lower-cases domain and strips surrounding whitespace and a trailing dot.
+func NormalizeTenantDomain(domain string) string {
+	return strings.TrimSuffix(strings.ToLower(strings.TrimSpace(domain)), ".")
+}
+
+// ValidateTenantDomain checks that a normalized domain is a fully qualified host name.
+func ValidateTenantDomain(domain string) error {
+	if len(domain) > 253 {
+		return ErrInvalidTenantDomain
+	}
+	labels := strings.Split(domain, ".")
+	if len(labels) < 2 {
+		return ErrInvalidTenantDomain
+	}
+	for _, label := range labels {
+		if len(label) > 63 || !domainLabelPattern.MatchString(label) {
+			return ErrInvalidTenantDomain
+		}
+	}
+	// A numeric top-level label means an IP address, not a domain
+	if numericLabel.MatchString(labels[len(labels)-1]) {
+		return ErrInvalidTenantDomain
+	}
+	return nil
+}
+
+func tenantDomainVerificationRecord(domain string) string {
+	return tenantDomainVerificationPrefix + domain
+}
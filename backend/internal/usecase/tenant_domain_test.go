@@ -0,0 +1,30 @@
+package usecase
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateTenantDomain(t *testing.T) {
+	tests := []struct {
+		domain string
+		err    error
+	}{
+		{"todo.acme.com", nil},
+		{"acme.co.uk", nil},
+		{"xn--80ak6aa92e.com", nil},
+		{"localhost", ErrInvalidTenantDomain},
+		{"acme..com", ErrInvalidTenantDomain},
+		{"-acme.com", ErrInvalidTenantDomain},
+		{"acme_corp.com", ErrInvalidTenantDomain},
+		{"192.168.0.1", ErrInvalidTenantDomain},
+		{"acme.com/path", ErrInvalidTenantDomain},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.domain, func(t *testing.T) {
+			assert.Equal(t, tt.err, ValidateTenantDomain(tt.domain))
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package usecase
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+)
+
+var (
+	ErrInvalidTenantSlug  = errors.New("tenant slug must be 3-63 lowercase letters, digits or hyphens")
+	ErrReservedTenantSlug = errors.New("tenant slug is reserved")
+)
+
+const (
+	minTenantSlugLength = 3
+	// Slugs double as DNS labels, which are limited to 63 characters
+	maxTenantSlugLength = 63
+)
+
+var tenantSlugPattern = regexp.MustCompile(`^[a-z0-9](?:[a-z0-9-]*[a-z0-9])?$`)
+
+var reservedTenantSlugs = map[string]struct{}{
+	"admin":     {},
+	"api":       {},
+	"app":       {},
+	"assets":    {},
+	"auth":      {},
+	"dashboard": {},
+	"help":      {},
+	"login":     {},
+	"mail":      {},
+	"register":  {},
+	"root":      {},
+	"settings":  {},
+	"static":    {},
+	"status":    {},
+	"support":   {},
+	"system":    {},
+	"tenant":    {},
+	"www":       {},
+}
+
+// NormalizeTenantSlug trims surrounding whitespace and lower-cases slug.
+func NormalizeTenantSlug(slug string) string {
+	return strings.ToLower(strings.TrimSpace(slug))
+}
+
+// ValidateTenantSlug checks charset, length and reserved words of a normalized slug.
+func ValidateTenantSlug(slug string) error {
+	if len(slug) < minTenantSlugLength || len(slug) > maxTenantSlugLength || !tenantSlugPattern.MatchString(slug) {
+		return ErrInvalidTenantSlug
+	}
+	if _, ok := reservedTenantSlugs[slug]; ok {
+		return ErrReservedTenantSlug
+	}
+	return nil
+}
+
+var nonSlugChars = regexp.MustCompile(`[^a-z0-9]+`)
+
+// defaultTenantSlug derives a slug from the email local part and the tenant ID.
+func defaultTenantSlug(email, tenantID string) string {
+	local := strings.ToLower(strings.Split(email, "@")[0])
+	local = strings.Trim(nonSlugChars.ReplaceAllString(local, "-"), "-")
+	if len(local) > maxTenantSlugLength-9 {
+		local = strings.Trim(local[:maxTenantSlugLength-9], "-")
+	}
+	if local == "" {
+		return "tenant-" + tenantID[:8]
+	}
+	return local + "-" + tenantID[:8]
+}
@@ -0,0 +1,53 @@
+package usecase
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateTenantSlug(t *testing.T) {
+	tests := []struct {
+		slug string
+		err  error
+	}{
+		{"acme", nil},
+		{"acme-corp-2", nil},
+		{"a1b", nil},
+		{"ab", ErrInvalidTenantSlug},
+		{strings.Repeat("a", 64), ErrInvalidTenantSlug},
+		{"Acme", ErrInvalidTenantSlug},
+		{"acme_corp", ErrInvalidTenantSlug},
+		{"-acme", ErrInvalidTenantSlug},
+		{"acme-", ErrInvalidTenantSlug},
+		{"acmé", ErrInvalidTenantSlug},
+		{"admin", ErrReservedTenantSlug},
+		{"www", ErrReservedTenantSlug},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.slug, func(t *testing.T) {
+			assert.Equal(t, tt.err, ValidateTenantSlug(tt.slug))
+		})
+	}
+}
+
+func TestDefaultTenantSlug(t *testing.T) {
+	tests := []struct {
+		email    string
+		expected string
+	}{
+		{"alice@example.com", "alice-12345678"},
+		{"Alice.Smith+todo@example.com", "alice-smith-todo-12345678"},
+		{"__@example.com", "tenant-12345678"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.email, func(t *testing.T) {
+			slug := defaultTenantSlug(tt.email, "12345678-abcd")
+			assert.Equal(t, tt.expected, slug)
+			assert.NoError(t, ValidateTenantSlug(slug))
+		})
+	}
+}
@@ -0,0 +1,318 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/domain/repository"
+	"good-todo-go/internal/domain/repository/mock"
+	mocku "good-todo-go/internal/pkg/mock"
+	"good-todo-go/internal/usecase/input"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+)
+
+func TestTenantInteractor_GetTenant(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUnitOfWork := mock.NewMockIUnitOfWork(ctrl)
+	mockTenantRepo := mock.NewMockITenantRepository(ctrl)
+
+	interactor := NewTenantInteractor(
+		mockUnitOfWork,
+		mockTenantRepo,
+		mock.NewMockITenantDomainRepository(ctrl),
+		mock.NewMockIDNSRepository(ctrl),
+		NewPermissionEvaluator(DefaultPermissionRules()),
+		mocku.NewMockUUIDGenerator(),
+	)
+
+	t.Run("member can view own tenant", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockTenantRepo.EXPECT().
+			FindByID(ctx, "tenant-123").
+			Return(&model.Tenant{ID: "tenant-123", Name: "Acme", Slug: "acme"}, nil)
+
+		result, err := interactor.GetTenant(ctx, memberActor("user-123"))
+
+		require.NoError(t, err)
+		assert.Equal(t, "acme", result.Slug)
+	})
+
+	t.Run("tenant not found", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockTenantRepo.EXPECT().
+			FindByID(ctx, "tenant-123").
+			Return(nil, nil)
+
+		_, err := interactor.GetTenant(ctx, memberActor("user-123"))
+
+		assert.Equal(t, ErrTenantNotFound, err)
+	})
+}
+
+func TestTenantInteractor_UpdateTenant(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUnitOfWork := mock.NewMockIUnitOfWork(ctrl)
+	mockTenantRepo := mock.NewMockITenantRepository(ctrl)
+
+	interactor := NewTenantInteractor(
+		mockUnitOfWork,
+		mockTenantRepo,
+		mock.NewMockITenantDomainRepository(ctrl),
+		mock.NewMockIDNSRepository(ctrl),
+		NewPermissionEvaluator(DefaultPermissionRules()),
+		mocku.NewMockUUIDGenerator(),
+	)
+
+	strPtr := func(s string) *string { return &s }
+	current := func() *model.Tenant {
+		return &model.Tenant{ID: "tenant-123", Name: "Acme", Slug: "acme"}
+	}
+
+	t.Run("admin changes slug with grace period", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockUnitOfWork.EXPECT().
+			RunInTenantTx(ctx, "tenant-123", gomock.Any()).
+			DoAndReturn(runInTx)
+		mockTenantRepo.EXPECT().
+			FindByID(ctx, "tenant-123").
+			Return(current(), nil)
+		mockTenantRepo.EXPECT().
+			ChangeSlug(ctx, "tenant-123", "acme-corp", gomock.Any()).
+			DoAndReturn(func(_ context.Context, tenantID, slug string, keepPreviousUntil time.Time) (*model.Tenant, error) {
+				assert.WithinDuration(t, time.Now().Add(tenantSlugGracePeriod), keepPreviousUntil, time.Minute)
+				return &model.Tenant{ID: tenantID, Name: "Acme", Slug: slug}, nil
+			})
+
+		result, err := interactor.UpdateTenant(ctx, adminActor("user-123"), &input.UpdateTenantInput{
+			Slug: strPtr(" Acme-Corp "),
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "acme-corp", result.Slug)
+	})
+
+	t.Run("admin renames tenant", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockUnitOfWork.EXPECT().
+			RunInTenantTx(ctx, "tenant-123", gomock.Any()).
+			DoAndReturn(runInTx)
+		mockTenantRepo.EXPECT().
+			FindByID(ctx, "tenant-123").
+			Return(current(), nil)
+		mockTenantRepo.EXPECT().
+			Update(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, tenant *model.Tenant) (*model.Tenant, error) {
+				return tenant, nil
+			})
+
+		result, err := interactor.UpdateTenant(ctx, adminActor("user-123"), &input.UpdateTenantInput{
+			Name: strPtr("Acme Inc"),
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "Acme Inc", result.Name)
+		assert.Equal(t, "acme", result.Slug)
+	})
+
+	t.Run("admin sets trash retention", func(t *testing.T) {
+		ctx := context.Background()
+		days := 7
+
+		mockUnitOfWork.EXPECT().
+			RunInTenantTx(ctx, "tenant-123", gomock.Any()).
+			DoAndReturn(runInTx)
+		mockTenantRepo.EXPECT().
+			FindByID(ctx, "tenant-123").
+			Return(current(), nil)
+		mockTenantRepo.EXPECT().
+			Update(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, tenant *model.Tenant) (*model.Tenant, error) {
+				return tenant, nil
+			})
+
+		result, err := interactor.UpdateTenant(ctx, adminActor("user-123"), &input.UpdateTenantInput{
+			TrashRetentionDays: &days,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, 7, result.TrashRetentionDays)
+		assert.Equal(t, "Acme", result.Name)
+	})
+
+	t.Run("trash retention out of range", func(t *testing.T) {
+		for _, days := range []int{0, 366} {
+			_, err := interactor.UpdateTenant(context.Background(), adminActor("user-123"), &input.UpdateTenantInput{
+				TrashRetentionDays: &days,
+			})
+
+			assert.Equal(t, ErrInvalidTrashRetention, err)
+		}
+	})
+
+	t.Run("member cannot update tenant", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockUnitOfWork.EXPECT().
+			RunInTenantTx(ctx, "tenant-123", gomock.Any()).
+			DoAndReturn(runInTx)
+		mockTenantRepo.EXPECT().
+			FindByID(ctx, "tenant-123").
+			Return(current(), nil)
+
+		_, err := interactor.UpdateTenant(ctx, memberActor("user-123"), &input.UpdateTenantInput{
+			Slug: strPtr("acme-corp"),
+		})
+
+		assert.Equal(t, ErrUnauthorized, err)
+	})
+
+	t.Run("reserved slug is rejected before any query", func(t *testing.T) {
+		_, err := interactor.UpdateTenant(context.Background(), adminActor("user-123"), &input.UpdateTenantInput{
+			Slug: strPtr("admin"),
+		})
+
+		assert.Equal(t, ErrReservedTenantSlug, err)
+	})
+
+	t.Run("slug taken", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockUnitOfWork.EXPECT().
+			RunInTenantTx(ctx, "tenant-123", gomock.Any()).
+			DoAndReturn(runInTx)
+		mockTenantRepo.EXPECT().
+			FindByID(ctx, "tenant-123").
+			Return(current(), nil)
+		mockTenantRepo.EXPECT().
+			ChangeSlug(ctx, "tenant-123", "globex", gomock.Any()).
+			Return(nil, repository.ErrTenantSlugConflict)
+
+		_, err := interactor.UpdateTenant(ctx, adminActor("user-123"), &input.UpdateTenantInput{
+			Slug: strPtr("globex"),
+		})
+
+		assert.Equal(t, ErrTenantSlugTaken, err)
+	})
+}
+
+func TestTenantInteractor_Domains(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTenantRepo := mock.NewMockITenantRepository(ctrl)
+	mockTenantDomainRepo := mock.NewMockITenantDomainRepository(ctrl)
+	mockDNSRepo := mock.NewMockIDNSRepository(ctrl)
+
+	interactor := NewTenantInteractor(
+		mock.NewMockIUnitOfWork(ctrl),
+		mockTenantRepo,
+		mockTenantDomainRepo,
+		mockDNSRepo,
+		NewPermissionEvaluator(DefaultPermissionRules()),
+		mocku.NewMockUUIDGenerator("domain-1", "verify-token"),
+	)
+
+	tenant := &model.Tenant{ID: "tenant-123", Name: "Acme", Slug: "acme"}
+	pending := func() *model.TenantDomain {
+		return &model.TenantDomain{
+			ID:                "domain-1",
+			TenantID:          "tenant-123",
+			Domain:            "todo.acme.com",
+			VerificationToken: "verify-token",
+		}
+	}
+
+	t.Run("admin adds normalized domain", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockTenantRepo.EXPECT().FindByID(ctx, "tenant-123").Return(tenant, nil)
+		mockTenantDomainRepo.EXPECT().
+			Create(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, d *model.TenantDomain) (*model.TenantDomain, error) {
+				assert.Equal(t, "tenant-123", d.TenantID)
+				assert.Equal(t, "verify-token", d.VerificationToken)
+				return d, nil
+			})
+
+		result, err := interactor.AddDomain(ctx, adminActor("user-123"), &input.AddTenantDomainInput{
+			Domain: " Todo.Acme.com. ",
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "todo.acme.com", result.Domain)
+		assert.Equal(t, "_good-todo-verification.todo.acme.com", result.VerificationRecord)
+		assert.Nil(t, result.VerifiedAt)
+	})
+
+	t.Run("member cannot add domain", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockTenantRepo.EXPECT().FindByID(ctx, "tenant-123").Return(tenant, nil)
+
+		_, err := interactor.AddDomain(ctx, memberActor("user-123"), &input.AddTenantDomainInput{
+			Domain: "todo.acme.com",
+		})
+
+		assert.Equal(t, ErrUnauthorized, err)
+	})
+
+	t.Run("verify succeeds when TXT record holds token", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockTenantRepo.EXPECT().FindByID(ctx, "tenant-123").Return(tenant, nil)
+		mockTenantDomainRepo.EXPECT().FindByID(ctx, "tenant-123", "domain-1").Return(pending(), nil)
+		mockDNSRepo.EXPECT().
+			LookupTXT(ctx, "_good-todo-verification.todo.acme.com").
+			Return([]string{"unrelated", "verify-token"}, nil)
+		mockTenantDomainRepo.EXPECT().
+			MarkVerified(ctx, "domain-1", gomock.Any()).
+			DoAndReturn(func(_ context.Context, id string, at time.Time) (*model.TenantDomain, error) {
+				d := pending()
+				d.VerifiedAt = &at
+				return d, nil
+			})
+
+		result, err := interactor.VerifyDomain(ctx, adminActor("user-123"), "domain-1")
+
+		require.NoError(t, err)
+		assert.NotNil(t, result.VerifiedAt)
+	})
+
+	t.Run("verify fails without TXT record", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockTenantRepo.EXPECT().FindByID(ctx, "tenant-123").Return(tenant, nil)
+		mockTenantDomainRepo.EXPECT().FindByID(ctx, "tenant-123", "domain-1").Return(pending(), nil)
+		mockDNSRepo.EXPECT().
+			LookupTXT(ctx, "_good-todo-verification.todo.acme.com").
+			Return(nil, nil)
+
+		_, err := interactor.VerifyDomain(ctx, adminActor("user-123"), "domain-1")
+
+		assert.Equal(t, ErrTenantDomainUnverified, err)
+	})
+
+	t.Run("remove unknown domain", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockTenantRepo.EXPECT().FindByID(ctx, "tenant-123").Return(tenant, nil)
+		mockTenantDomainRepo.EXPECT().FindByID(ctx, "tenant-123", "domain-9").Return(nil, nil)
+
+		err := interactor.RemoveDomain(ctx, adminActor("user-123"), "domain-9")
+
+		assert.Equal(t, ErrTenantDomainNotFound, err)
+	})
+}
@@ -3,6 +3,8 @@ package usecase
 import (
 	"context"
 	"errors"
+	"fmt"
+	"slices"
 	"time"
 
 	"good-todo-go/internal/domain/model"
@@ -13,70 +15,237 @@ import (
 )
 
 var (
-	ErrTodoNotFound     = errors.New("todo not found")
-	ErrNotTodoOwner     = errors.New("not todo owner")
-	ErrUnauthorized     = errors.New("unauthorized")
+	ErrTodoNotFound    = errors.New("todo not found")
+	ErrNotTodoOwner    = errors.New("not todo owner")
+	ErrUnauthorized    = errors.New("unauthorized")
+	ErrTodoNotTrashed  = errors.New("todo is not in the trash")
+	ErrInvalidPriority = errors.New("priority must be one of none, low, medium, high or urgent")
 )
 
+// TodoVersionConflictError is returned when an If-Match version no longer matches the
+// stored todo. Current is the todo as it is now, for the client to merge against.
+type TodoVersionConflictError struct {
+	Current *output.TodoOutput
+}
+
+func (e *TodoVersionConflictError) Error() string {
+	return fmt.Sprintf("todo is at version %d", e.Current.Version)
+}
+
 type ITodoInteractor interface {
-	List(ctx context.Context, userID string) ([]*output.TodoOutput, error)
-	ListPublic(ctx context.Context, tenantID string) ([]*output.TodoOutput, error)
-	Create(ctx context.Context, userID, tenantID string, input *input.CreateTodoInput) (*output.TodoOutput, error)
-	Update(ctx context.Context, userID string, input *input.UpdateTodoInput) (*output.TodoOutput, error)
-	Delete(ctx context.Context, userID, todoID string) error
+	List(ctx context.Context, actor input.Actor, input *input.ListTodosInput) (*output.TodoListOutput, error)
+	ListPublic(ctx context.Context, actor input.Actor, input *input.ListTodosInput) (*output.TodoListOutput, error)
+	// ListByProject pages through the project's todos that the actor owns or that are public.
+	ListByProject(ctx context.Context, actor input.Actor, projectID string, input *input.ListTodosInput) (*output.TodoListOutput, error)
+	Search(ctx context.Context, actor input.Actor, input *input.SearchTodosInput) ([]*output.TodoSearchResultOutput, error)
+	Create(ctx context.Context, actor input.Actor, input *input.CreateTodoInput) (*output.TodoOutput, error)
+	Update(ctx context.Context, actor input.Actor, input *input.UpdateTodoInput) (*output.TodoOutput, error)
+	Patch(ctx context.Context, actor input.Actor, input *input.PatchTodoInput) (*output.TodoOutput, error)
+	Delete(ctx context.Context, actor input.Actor, input *input.DeleteTodoInput) error
+	// ListTrash pages through the actor's own todos in the trash.
+	ListTrash(ctx context.Context, actor input.Actor, input *input.ListTodosInput) (*output.TodoListOutput, error)
+	Restore(ctx context.Context, actor input.Actor, todoID string) (*output.TodoOutput, error)
+	// ListSubtasks returns the direct subtasks of a todo the actor can see, oldest first.
+	ListSubtasks(ctx context.Context, actor input.Actor, todoID string) ([]*output.TodoOutput, error)
+	// ListBlockers returns the todos blocking a todo the actor can see, oldest first.
+	ListBlockers(ctx context.Context, actor input.Actor, todoID string) ([]*output.TodoOutput, error)
+	AddBlocker(ctx context.Context, actor input.Actor, todoID, blockerID string) (*output.TodoOutput, error)
+	RemoveBlocker(ctx context.Context, actor input.Actor, todoID, blockerID string) (*output.TodoOutput, error)
+	// ListOccurrences previews the next due dates of a recurring todo the actor can see.
+	ListOccurrences(ctx context.Context, actor input.Actor, todoID string, count int) ([]time.Time, error)
+	// ListView pages through one of the actor's smart views of open todos, most urgent first.
+	ListView(ctx context.Context, actor input.Actor, input *input.ListTodoViewInput) (*output.TodoListOutput, error)
+	// Snooze hides a todo from listings until a preset or given time.
+	Snooze(ctx context.Context, actor input.Actor, input *input.SnoozeTodoInput) (*output.TodoOutput, error)
+	// Unsnooze brings a snoozed todo back into listings right away.
+	Unsnooze(ctx context.Context, actor input.Actor, todoID string) (*output.TodoOutput, error)
+	// ListActivity returns who snoozed and unsnoozed a todo the actor can see, oldest first.
+	ListActivity(ctx context.Context, actor input.Actor, todoID string) ([]*output.TodoActivityOutput, error)
+	// ListAssigned pages through the todos assigned to the actor, whoever owns them.
+	ListAssigned(ctx context.Context, actor input.Actor, input *input.ListTodosInput) (*output.TodoListOutput, error)
+	// Assign delegates a todo to a member of its tenant and emails them.
+	Assign(ctx context.Context, actor input.Actor, todoID, assigneeID string) (*output.TodoOutput, error)
+	// Unassign takes a todo off its assignee.
+	Unassign(ctx context.Context, actor input.Actor, todoID string) (*output.TodoOutput, error)
+	// Move changes a todo's place in the manual order, writing only that todo.
+	Move(ctx context.Context, actor input.Actor, input *input.MoveTodoInput) (*output.TodoOutput, error)
+	Batch(ctx context.Context, actor input.Actor, input *input.BatchTodoInput) (*output.BatchTodoOutput, error)
 }
 
 type TodoInteractor struct {
-	todoRepo      repository.ITodoRepository
-	uuidGenerator pkg.IUUIDGenerator
+	unitOfWork      repository.IUnitOfWork
+	todoRepo        repository.ITodoRepository
+	activityRepo    repository.ITodoActivityRepository
+	reminderRepo    repository.IReminderRepository
+	tagRepo         repository.ITagRepository
+	projectRepo     repository.IProjectRepository
+	userRepo        repository.IUserRepository
+	membershipRepo  repository.IMembershipRepository
+	mailRepo        repository.IMailRepository
+	permission      IPermissionEvaluator
+	uuidGenerator   pkg.IUUIDGenerator
+	clock           pkg.IClock
+	maxSubtaskDepth int
 }
 
-func NewTodoInteractor(todoRepo repository.ITodoRepository, uuidGenerator pkg.IUUIDGenerator) ITodoInteractor {
+// NewTodoInteractor builds the todo usecases. maxSubtaskDepth is how many levels todos
+// may nest, counting the top-level todo; 1 turns subtasks off.
+func NewTodoInteractor(unitOfWork repository.IUnitOfWork, todoRepo repository.ITodoRepository, activityRepo repository.ITodoActivityRepository, reminderRepo repository.IReminderRepository, tagRepo repository.ITagRepository, projectRepo repository.IProjectRepository, userRepo repository.IUserRepository, membershipRepo repository.IMembershipRepository, mailRepo repository.IMailRepository, permission IPermissionEvaluator, uuidGenerator pkg.IUUIDGenerator, clock pkg.IClock, maxSubtaskDepth int) ITodoInteractor {
 	return &TodoInteractor{
-		todoRepo:      todoRepo,
-		uuidGenerator: uuidGenerator,
+		unitOfWork:      unitOfWork,
+		todoRepo:        todoRepo,
+		activityRepo:    activityRepo,
+		reminderRepo:    reminderRepo,
+		tagRepo:         tagRepo,
+		projectRepo:     projectRepo,
+		userRepo:        userRepo,
+		membershipRepo:  membershipRepo,
+		mailRepo:        mailRepo,
+		permission:      permission,
+		uuidGenerator:   uuidGenerator,
+		clock:           clock,
+		maxSubtaskDepth: maxSubtaskDepth,
 	}
 }
 
-func (i *TodoInteractor) List(ctx context.Context, userID string) ([]*output.TodoOutput, error) {
-	todos, err := i.todoRepo.FindByUserID(ctx, userID)
+func (i *TodoInteractor) List(ctx context.Context, actor input.Actor, inp *input.ListTodosInput) (*output.TodoListOutput, error) {
+	return i.listPage(ctx, actor, inp, func(query repository.TodoQuery) ([]*model.Todo, error) {
+		return i.todoRepo.FindByUserID(ctx, actor.UserID, query)
+	})
+}
+
+func (i *TodoInteractor) ListPublic(ctx context.Context, actor input.Actor, inp *input.ListTodosInput) (*output.TodoListOutput, error) {
+	return i.listPage(ctx, actor, inp, func(query repository.TodoQuery) ([]*model.Todo, error) {
+		return i.todoRepo.FindPublicByTenantID(ctx, actor.TenantID, query)
+	})
+}
+
+func (i *TodoInteractor) ListByProject(ctx context.Context, actor input.Actor, projectID string, inp *input.ListTodosInput) (*output.TodoListOutput, error) {
+	project, err := i.projectRepo.FindByID(ctx, actor.TenantID, projectID)
 	if err != nil {
 		return nil, err
 	}
+	if project == nil {
+		return nil, ErrProjectNotFound
+	}
+	if !i.permission.Can(ctx, actor, ActionView, ResourceProject, ProjectTarget(project)) {
+		return nil, ErrNotProjectOwner
+	}
 
-	result := make([]*output.TodoOutput, len(todos))
-	for idx, todo := range todos {
-		result[idx] = toTodoOutput(todo)
+	return i.listPage(ctx, actor, inp, func(query repository.TodoQuery) ([]*model.Todo, error) {
+		return i.todoRepo.FindByProjectID(ctx, project.ID, query)
+	})
+}
+
+func (i *TodoInteractor) listPage(ctx context.Context, actor input.Actor, inp *input.ListTodosInput, find func(repository.TodoQuery) ([]*model.Todo, error)) (*output.TodoListOutput, error) {
+	sort, err := toTodoSort(inp.Filter)
+	if err != nil {
+		return nil, err
 	}
-	return result, nil
+	filter, err := toTodoFilter(inp.Filter, i.clock.Now())
+	if err != nil {
+		return nil, err
+	}
+	return i.page(ctx, actor, inp.Limit, inp.Cursor, sort, filter, find)
 }
 
-func (i *TodoInteractor) ListPublic(ctx context.Context, tenantID string) ([]*output.TodoOutput, error) {
-	todos, err := i.todoRepo.FindPublicByTenantID(ctx, tenantID)
+// page fetches one extra row to learn whether another page follows. The repository
+// only returns todos the actor can see, so the extra row is a visible one too.
+func (i *TodoInteractor) page(ctx context.Context, actor input.Actor, limit int, cursor string, sort repository.TodoSort, filter repository.TodoFilter, find func(repository.TodoQuery) ([]*model.Todo, error)) (*output.TodoListOutput, error) {
+	limit, err := todoPageSize(limit)
+	if err != nil {
+		return nil, err
+	}
+	after, err := DecodeTodoCursor(cursor, sort)
+	if err != nil {
+		return nil, err
+	}
+
+	filter.VisibleTo = actor.UserID
+	todos, err := find(repository.TodoQuery{
+		Filter: filter,
+		Sort:   sort,
+		Limit:  limit + 1,
+		After:  after,
+	})
 	if err != nil {
 		return nil, err
 	}
 
+	var nextCursor *string
+	if len(todos) > limit {
+		todos = todos[:limit]
+		next := EncodeTodoCursor(sort, todoCursorOf(todos[limit-1]))
+		nextCursor = &next
+	}
+
 	result := make([]*output.TodoOutput, len(todos))
-	for idx, todo := range todos {
-		result[idx] = toTodoOutput(todo)
+	for j, todo := range todos {
+		result[j] = toTodoOutput(todo)
 	}
-	return result, nil
+	return &output.TodoListOutput{
+		Todos:      result,
+		NextCursor: nextCursor,
+	}, nil
 }
 
-func (i *TodoInteractor) Create(ctx context.Context, userID, tenantID string, inp *input.CreateTodoInput) (*output.TodoOutput, error) {
+func (i *TodoInteractor) Create(ctx context.Context, actor input.Actor, inp *input.CreateTodoInput) (*output.TodoOutput, error) {
+	priority := model.TodoPriorityNone
+	if inp.Priority != "" {
+		var err error
+		if priority, err = toTodoPriority(inp.Priority); err != nil {
+			return nil, err
+		}
+	}
 	todo := &model.Todo{
-		ID:          i.uuidGenerator.Generate(),
-		TenantID:    tenantID,
-		UserID:      userID,
-		Title:       inp.Title,
-		Description: inp.Description,
-		Completed:   false,
-		IsPublic:    inp.IsPublic,
-		DueDate:     inp.DueDate,
+		ID:           i.uuidGenerator.Generate(),
+		TenantID:     actor.TenantID,
+		UserID:       actor.UserID,
+		Title:        inp.Title,
+		Description:  inp.Description,
+		Completed:    false,
+		IsPublic:     inp.IsPublic,
+		DueDate:      inp.DueDate,
+		AutoComplete: inp.AutoComplete,
+		Priority:     priority,
+	}
+
+	if !i.permission.Can(ctx, actor, ActionCreate, ResourceTodo, TodoTarget(todo)) {
+		return nil, ErrUnauthorized
+	}
+	tags, err := i.resolveTags(ctx, actor, inp.TagIDs)
+	if err != nil {
+		return nil, err
+	}
+	todo.Tags = tags
+	if inp.ProjectID != nil && *inp.ProjectID != "" {
+		if err := i.checkProject(ctx, actor, *inp.ProjectID); err != nil {
+			return nil, err
+		}
+		todo.ProjectID = inp.ProjectID
+	}
+	if inp.ParentID != nil {
+		if err := i.setParent(ctx, actor, todo, *inp.ParentID); err != nil {
+			return nil, err
+		}
+	}
+	if err := i.setRecurrence(ctx, todo, inp.RecurrenceRule, inp.RecurrenceTimezone); err != nil {
+		return nil, err
 	}
 
-	created, err := i.todoRepo.Create(ctx, todo)
+	var created *model.Todo
+	err = i.unitOfWork.RunInTenantTx(ctx, actor.TenantID, func(ctx context.Context) error {
+		// Creates racing each other would otherwise read the same top key and take equal keys
+		if err := i.todoRepo.LockPositions(ctx, actor.TenantID); err != nil {
+			return err
+		}
+		var err error
+		if todo.Position, err = i.topPosition(ctx, actor.TenantID, ""); err != nil {
+			return err
+		}
+		created, err = i.todoRepo.Create(ctx, todo)
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -84,7 +253,7 @@ func (i *TodoInteractor) Create(ctx context.Context, userID, tenantID string, in
 	return toTodoOutput(created), nil
 }
 
-func (i *TodoInteractor) Update(ctx context.Context, userID string, inp *input.UpdateTodoInput) (*output.TodoOutput, error) {
+func (i *TodoInteractor) Update(ctx context.Context, actor input.Actor, inp *input.UpdateTodoInput) (*output.TodoOutput, error) {
 	todo, err := i.todoRepo.FindByID(ctx, inp.ID)
 	if err != nil {
 		return nil, err
@@ -93,33 +262,167 @@ func (i *TodoInteractor) Update(ctx context.Context, userID string, inp *input.U
 		return nil, ErrTodoNotFound
 	}
 
-	if todo.UserID != userID {
+	var updated *output.TodoOutput
+	err = i.unitOfWork.RunInTenantTx(ctx, actor.TenantID, func(ctx context.Context) error {
+		updated, err = i.update(ctx, actor, todo, inp)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+// Patch applies inp on top of the stored todo and saves it like a full Update.
+func (i *TodoInteractor) Patch(ctx context.Context, actor input.Actor, inp *input.PatchTodoInput) (*output.TodoOutput, error) {
+	todo, err := i.todoRepo.FindByID(ctx, inp.ID)
+	if err != nil {
+		return nil, err
+	}
+	if todo == nil {
+		return nil, ErrTodoNotFound
+	}
+
+	update := applyTodoPatch(todo, inp)
+	update.IfMatch = inp.IfMatch
+
+	var updated *output.TodoOutput
+	err = i.unitOfWork.RunInTenantTx(ctx, actor.TenantID, func(ctx context.Context) error {
+		updated, err = i.update(ctx, actor, todo, update)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+// update saves inp over todo. Completing a subtask may complete its parents too, and
+// completing a recurring todo creates its next occurrence, so callers run it in a
+// transaction.
+func (i *TodoInteractor) update(ctx context.Context, actor input.Actor, todo *model.Todo, inp *input.UpdateTodoInput) (*output.TodoOutput, error) {
+	// Toggling completion alone is a narrower permission than editing the todo
+	action := ActionUpdate
+	if isCompletionOnlyChange(todo, inp) {
+		action = ActionComplete
+	}
+	if !i.permission.Can(ctx, actor, action, ResourceTodo, TodoTarget(todo)) {
 		return nil, ErrNotTodoOwner
 	}
+	if inp.IfMatch != nil && *inp.IfMatch != todo.Version {
+		return nil, &TodoVersionConflictError{Current: toTodoOutput(todo)}
+	}
+	if inp.TagIDs != nil {
+		tags, err := i.resolveTags(ctx, actor, inp.TagIDs)
+		if err != nil {
+			return nil, err
+		}
+		todo.Tags = tags
+	}
+	if inp.ProjectID != nil && !sameOptionalID(todo.ProjectID, *inp.ProjectID) {
+		if *inp.ProjectID == "" {
+			todo.ProjectID = nil
+		} else {
+			if err := i.checkProject(ctx, actor, *inp.ProjectID); err != nil {
+				return nil, err
+			}
+			todo.ProjectID = inp.ProjectID
+		}
+	}
+	if inp.ParentID != nil && !sameOptionalID(todo.ParentID, *inp.ParentID) {
+		if err := i.setParent(ctx, actor, todo, *inp.ParentID); err != nil {
+			return nil, err
+		}
+	}
+	if inp.AutoComplete != nil {
+		todo.AutoComplete = *inp.AutoComplete
+	}
+	if inp.Priority != nil {
+		priority, err := toTodoPriority(*inp.Priority)
+		if err != nil {
+			return nil, err
+		}
+		todo.Priority = priority
+	}
 
+	wasCompleted := todo.Completed
+	if inp.Completed && !wasCompleted && !inp.Force && todo.BlockedCount > 0 {
+		blocked, err := i.isBlocked(ctx, todo.ID)
+		if err != nil {
+			return nil, err
+		}
+		if blocked {
+			return nil, ErrTodoBlocked
+		}
+	}
 	todo.Title = inp.Title
 	todo.Description = inp.Description
 	todo.Completed = inp.Completed
 	todo.IsPublic = inp.IsPublic
 	todo.DueDate = inp.DueDate
 
+	rule, timezone := todo.RecurrenceRule, todo.RecurrenceTimezone
+	if inp.RecurrenceRule != nil {
+		rule = inp.RecurrenceRule
+	} else if inp.DueDate == nil {
+		// The series repeats from the due date, so removing it ends the series
+		rule = nil
+	}
+	if inp.RecurrenceTimezone != nil {
+		timezone = inp.RecurrenceTimezone
+	}
+	if err := i.setRecurrence(ctx, todo, valueOrEmpty(rule), valueOrEmpty(timezone)); err != nil {
+		return nil, err
+	}
+
+	var next *model.Todo
+	if inp.Completed && !wasCompleted && todo.RecurrenceRule != nil {
+		var err error
+		if next, err = i.nextOccurrence(ctx, todo); err != nil {
+			return nil, err
+		}
+		// The completed todo stays behind as history; only the next occurrence repeats
+		todo.RecurrenceRule, todo.RecurrenceTimezone = nil, nil
+	}
+
 	if inp.Completed && todo.CompletedAt == nil {
-		now := time.Now()
+		now := i.clock.Now()
 		todo.CompletedAt = &now
 	} else if !inp.Completed {
 		todo.CompletedAt = nil
 	}
 
-	updated, err := i.todoRepo.Update(ctx, todo)
+	updated, err := i.todoRepo.Update(ctx, todo, inp.IfMatch)
+	if err == repository.ErrVersionMismatch {
+		return nil, i.versionConflict(ctx, i.todoRepo.FindByID, todo.ID)
+	}
 	if err != nil {
 		return nil, err
 	}
+	if next != nil {
+		if _, err := i.todoRepo.Create(ctx, next); err != nil {
+			return nil, err
+		}
+		if err := i.carryReminders(ctx, todo, next); err != nil {
+			return nil, err
+		}
+	}
+	if updated.Completed && !wasCompleted && updated.ParentID != nil {
+		if err := i.completeParents(ctx, *updated.ParentID, *updated.CompletedAt); err != nil {
+			return nil, err
+		}
+	}
 
 	return toTodoOutput(updated), nil
 }
 
-func (i *TodoInteractor) Delete(ctx context.Context, userID, todoID string) error {
-	todo, err := i.todoRepo.FindByID(ctx, todoID)
+func (i *TodoInteractor) Delete(ctx context.Context, actor input.Actor, inp *input.DeleteTodoInput) error {
+	find := i.todoRepo.FindByID
+	if inp.Permanent {
+		find = i.todoRepo.FindByIDWithTrashed
+	}
+
+	todo, err := find(ctx, inp.ID)
 	if err != nil {
 		return err
 	}
@@ -127,24 +430,279 @@ func (i *TodoInteractor) Delete(ctx context.Context, userID, todoID string) erro
 		return ErrTodoNotFound
 	}
 
-	if todo.UserID != userID {
+	if !i.permission.Can(ctx, actor, ActionDelete, ResourceTodo, TodoTarget(todo)) {
 		return ErrNotTodoOwner
 	}
+	if inp.IfMatch != nil && *inp.IfMatch != todo.Version {
+		return &TodoVersionConflictError{Current: toTodoOutput(todo)}
+	}
+
+	now := i.clock.Now()
+	remove := func(ctx context.Context, id string, expectedVersion *int) error {
+		if inp.Permanent {
+			return i.todoRepo.Delete(ctx, id, expectedVersion)
+		}
+		return i.todoRepo.Trash(ctx, id, now, expectedVersion)
+	}
+
+	if todo.SubtaskCount > 0 {
+		if !inp.Cascade {
+			return ErrTodoHasSubtasks
+		}
+		subtasks, err := i.subtaskTree(ctx, todo)
+		if err != nil {
+			return err
+		}
+		for _, subtask := range subtasks {
+			if !i.permission.Can(ctx, actor, ActionDelete, ResourceTodo, TodoTarget(subtask)) {
+				return ErrNotTodoOwner
+			}
+		}
+		return i.unitOfWork.RunInTenantTx(ctx, actor.TenantID, func(ctx context.Context) error {
+			err := remove(ctx, inp.ID, inp.IfMatch)
+			if err == repository.ErrVersionMismatch {
+				return i.versionConflict(ctx, find, inp.ID)
+			}
+			if err == repository.ErrTodoNotFound {
+				return ErrTodoNotFound
+			}
+			if err != nil {
+				return err
+			}
+			for _, subtask := range subtasks {
+				// A subtask someone else removed meanwhile is already where it should be
+				if err := remove(ctx, subtask.ID, nil); err != nil && err != repository.ErrTodoNotFound {
+					return err
+				}
+			}
+			return nil
+		})
+	}
+
+	err = remove(ctx, inp.ID, inp.IfMatch)
+	if err == repository.ErrVersionMismatch {
+		return i.versionConflict(ctx, find, inp.ID)
+	}
+	if err == repository.ErrTodoNotFound {
+		return ErrTodoNotFound
+	}
+	return err
+}
+
+func (i *TodoInteractor) ListTrash(ctx context.Context, actor input.Actor, inp *input.ListTodosInput) (*output.TodoListOutput, error) {
+	return i.listPage(ctx, actor, inp, func(query repository.TodoQuery) ([]*model.Todo, error) {
+		query.Filter.Trashed = true
+		return i.todoRepo.FindByUserID(ctx, actor.UserID, query)
+	})
+}
+
+// Restore takes a todo out of the trash. Whoever may delete a todo may also restore it.
+func (i *TodoInteractor) Restore(ctx context.Context, actor input.Actor, todoID string) (*output.TodoOutput, error) {
+	todo, err := i.todoRepo.FindByIDWithTrashed(ctx, todoID)
+	if err != nil {
+		return nil, err
+	}
+	if todo == nil {
+		return nil, ErrTodoNotFound
+	}
+
+	if !i.permission.Can(ctx, actor, ActionDelete, ResourceTodo, TodoTarget(todo)) {
+		return nil, ErrNotTodoOwner
+	}
+	if todo.DeletedAt == nil {
+		return nil, ErrTodoNotTrashed
+	}
+
+	restored, err := i.todoRepo.Restore(ctx, todoID)
+	if err != nil {
+		return nil, err
+	}
+	if restored == nil {
+		// Restored or purged since we looked
+		return nil, ErrTodoNotTrashed
+	}
+
+	return toTodoOutput(restored), nil
+}
+
+// resolveTags loads the tags named by ids from the actor's tenant, ignoring duplicates.
+// Any id that is not one of the tenant's tags fails with ErrTagNotFound.
+func (i *TodoInteractor) resolveTags(ctx context.Context, actor input.Actor, ids []string) ([]*model.Tag, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	unique := slices.Compact(slices.Sorted(slices.Values(ids)))
+
+	tags, err := i.tagRepo.FindByIDs(ctx, actor.TenantID, unique)
+	if err != nil {
+		return nil, err
+	}
+	if len(tags) != len(unique) {
+		return nil, ErrTagNotFound
+	}
+	return tags, nil
+}
+
+// checkProject makes sure todos can be filed into the project: it must be one the actor
+// can see, so private projects of others are reported as not found, and not archived.
+func (i *TodoInteractor) checkProject(ctx context.Context, actor input.Actor, projectID string) error {
+	project, err := i.projectRepo.FindByID(ctx, actor.TenantID, projectID)
+	if err != nil {
+		return err
+	}
+	if project == nil || !i.permission.Can(ctx, actor, ActionView, ResourceProject, ProjectTarget(project)) {
+		return ErrProjectNotFound
+	}
+	if project.Archived {
+		return ErrProjectArchived
+	}
+	return nil
+}
+
+// versionConflict reloads, through find, a todo that changed between our read and a conditional write.
+func (i *TodoInteractor) versionConflict(ctx context.Context, find func(context.Context, string) (*model.Todo, error), id string) error {
+	current, err := find(ctx, id)
+	if err != nil {
+		return err
+	}
+	if current == nil {
+		return ErrTodoNotFound
+	}
+	return &TodoVersionConflictError{Current: toTodoOutput(current)}
+}
+
+func (i *TodoInteractor) toVisibleTodoOutputs(ctx context.Context, actor input.Actor, todos []*model.Todo) []*output.TodoOutput {
+	result := make([]*output.TodoOutput, 0, len(todos))
+	for _, todo := range todos {
+		if !i.permission.Can(ctx, actor, ActionView, ResourceTodo, TodoTarget(todo)) {
+			continue
+		}
+		result = append(result, toTodoOutput(todo))
+	}
+	return result
+}
 
-	return i.todoRepo.Delete(ctx, todoID)
+// applyTodoPatch returns the full update that results from patching todo.
+func applyTodoPatch(todo *model.Todo, patch *input.PatchTodoInput) *input.UpdateTodoInput {
+	inp := &input.UpdateTodoInput{
+		ID:                 todo.ID,
+		Title:              todo.Title,
+		Description:        todo.Description,
+		Completed:          todo.Completed,
+		IsPublic:           todo.IsPublic,
+		DueDate:            todo.DueDate,
+		TagIDs:             patch.TagIDs,
+		ProjectID:          patch.ProjectID,
+		AutoComplete:       patch.AutoComplete,
+		Priority:           patch.Priority,
+		ParentID:           patch.ParentID,
+		Force:              patch.Force,
+		RecurrenceRule:     patch.RecurrenceRule,
+		RecurrenceTimezone: patch.RecurrenceTimezone,
+	}
+	if patch.Title != nil {
+		inp.Title = *patch.Title
+	}
+	if patch.Description != nil {
+		inp.Description = *patch.Description
+	}
+	if patch.Completed != nil {
+		inp.Completed = *patch.Completed
+	}
+	if patch.IsPublic != nil {
+		inp.IsPublic = *patch.IsPublic
+	}
+	if patch.ClearDueDate {
+		inp.DueDate = nil
+	} else if patch.DueDate != nil {
+		inp.DueDate = patch.DueDate
+	}
+	return inp
+}
+
+// isCompletionOnlyChange reports whether inp leaves everything but the completed flag untouched.
+func isCompletionOnlyChange(todo *model.Todo, inp *input.UpdateTodoInput) bool {
+	return todo.Title == inp.Title &&
+		todo.Description == inp.Description &&
+		todo.IsPublic == inp.IsPublic &&
+		sameTime(todo.DueDate, inp.DueDate) &&
+		(inp.TagIDs == nil || sameTagIDs(todo.Tags, inp.TagIDs)) &&
+		(inp.ProjectID == nil || sameOptionalID(todo.ProjectID, *inp.ProjectID)) &&
+		(inp.ParentID == nil || sameOptionalID(todo.ParentID, *inp.ParentID)) &&
+		(inp.AutoComplete == nil || todo.AutoComplete == *inp.AutoComplete) &&
+		(inp.Priority == nil || string(todo.Priority) == *inp.Priority) &&
+		(inp.RecurrenceRule == nil || valueOrEmpty(todo.RecurrenceRule) == *inp.RecurrenceRule) &&
+		(inp.RecurrenceTimezone == nil || valueOrEmpty(todo.RecurrenceTimezone) == *inp.RecurrenceTimezone)
+}
+
+func toTodoPriority(s string) (model.TodoPriority, error) {
+	priority := model.TodoPriority(s)
+	if !priority.Valid() {
+		return "", ErrInvalidPriority
+	}
+	return priority, nil
+}
+
+// sameOptionalID reports whether id, empty for none, is what the todo already points to,
+// such as its project or parent.
+func sameOptionalID(current *string, id string) bool {
+	if current == nil {
+		return id == ""
+	}
+	return *current == id
+}
+
+// sameTagIDs reports whether ids names exactly tags, in any order and ignoring duplicates.
+func sameTagIDs(tags []*model.Tag, ids []string) bool {
+	current := make([]string, len(tags))
+	for idx, tag := range tags {
+		current[idx] = tag.ID
+	}
+	slices.Sort(current)
+	return slices.Equal(current, slices.Compact(slices.Sorted(slices.Values(ids))))
+}
+
+func valueOrEmpty(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func sameTime(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
 }
 
 func toTodoOutput(todo *model.Todo) *output.TodoOutput {
 	return &output.TodoOutput{
-		ID:          todo.ID,
-		UserID:      todo.UserID,
-		Title:       todo.Title,
-		Description: todo.Description,
-		Completed:   todo.Completed,
-		IsPublic:    todo.IsPublic,
-		DueDate:     todo.DueDate,
-		CompletedAt: todo.CompletedAt,
-		CreatedAt:   todo.CreatedAt,
-		UpdatedAt:   todo.UpdatedAt,
+		ID:                    todo.ID,
+		UserID:                todo.UserID,
+		AssigneeID:            todo.AssigneeID,
+		ProjectID:             todo.ProjectID,
+		ParentID:              todo.ParentID,
+		Title:                 todo.Title,
+		Description:           todo.Description,
+		Completed:             todo.Completed,
+		IsPublic:              todo.IsPublic,
+		DueDate:               todo.DueDate,
+		RecurrenceRule:        todo.RecurrenceRule,
+		RecurrenceTimezone:    todo.RecurrenceTimezone,
+		CompletedAt:           todo.CompletedAt,
+		SnoozedUntil:          todo.SnoozedUntil,
+		Version:               todo.Version,
+		DeletedAt:             todo.DeletedAt,
+		Position:              todo.Position,
+		Tags:                  toTagOutputs(todo.Tags),
+		CreatedAt:             todo.CreatedAt,
+		UpdatedAt:             todo.UpdatedAt,
+		AutoComplete:          todo.AutoComplete,
+		Priority:              string(todo.Priority),
+		SubtaskCount:          todo.SubtaskCount,
+		CompletedSubtaskCount: todo.CompletedSubtaskCount,
+		BlockedCount:          todo.BlockedCount,
+		BlockingCount:         todo.BlockingCount,
 	}
 }
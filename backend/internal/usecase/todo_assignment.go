@@ -0,0 +1,128 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/domain/repository"
+	"good-todo-go/internal/usecase/input"
+	"good-todo-go/internal/usecase/output"
+)
+
+var ErrAssigneeNotFound = errors.New("assignee is not a member of the tenant")
+
+// ListAssigned pages through the todos assigned to the actor in their tenant, whoever
+// owns them.
+func (i *TodoInteractor) ListAssigned(ctx context.Context, actor input.Actor, inp *input.ListTodosInput) (*output.TodoListOutput, error) {
+	return i.listPage(ctx, actor, inp, func(query repository.TodoQuery) ([]*model.Todo, error) {
+		return i.todoRepo.FindByAssigneeID(ctx, actor.UserID, query)
+	})
+}
+
+// Assign hands the todo to a member of its tenant, replacing any earlier assignee.
+// Only the owner and admins may assign a todo; its assignee may not hand it on, as that
+// would show a private todo to whoever they pick. The new assignee is emailed unless
+// they assigned it to themselves; a failed email does not undo the assignment.
+func (i *TodoInteractor) Assign(ctx context.Context, actor input.Actor, todoID, assigneeID string) (*output.TodoOutput, error) {
+	todo, err := i.findTodoToAssign(ctx, actor, todoID)
+	if err != nil {
+		return nil, err
+	}
+	if todo.AssigneeID != nil && *todo.AssigneeID == assigneeID {
+		return toTodoOutput(todo), nil
+	}
+
+	membership, err := i.membershipRepo.FindByUserAndTenant(ctx, assigneeID, todo.TenantID)
+	if err != nil {
+		return nil, err
+	}
+	if membership == nil {
+		return nil, ErrAssigneeNotFound
+	}
+
+	updated, err := i.setAssignee(ctx, actor, todo.ID, &assigneeID)
+	if err != nil {
+		return nil, err
+	}
+
+	if assigneeID != actor.UserID {
+		if err := i.notifyAssignee(ctx, actor, updated); err != nil {
+			log.Printf("failed to email the assignee of todo %s: %v", updated.ID, err)
+		}
+	}
+	return toTodoOutput(updated), nil
+}
+
+// Unassign takes the todo off its assignee, under the same rules as Assign. A todo
+// without one is returned unchanged.
+func (i *TodoInteractor) Unassign(ctx context.Context, actor input.Actor, todoID string) (*output.TodoOutput, error) {
+	todo, err := i.findTodoToAssign(ctx, actor, todoID)
+	if err != nil {
+		return nil, err
+	}
+	if todo.AssigneeID == nil {
+		return toTodoOutput(todo), nil
+	}
+
+	updated, err := i.setAssignee(ctx, actor, todo.ID, nil)
+	if err != nil {
+		return nil, err
+	}
+	return toTodoOutput(updated), nil
+}
+
+// findTodoToAssign loads a live todo the actor may assign. Todos the actor cannot see
+// are reported as not found.
+func (i *TodoInteractor) findTodoToAssign(ctx context.Context, actor input.Actor, todoID string) (*model.Todo, error) {
+	todo, err := i.findTodoToChange(ctx, actor, todoID)
+	if err != nil {
+		return nil, err
+	}
+	if !i.permission.Can(ctx, actor, ActionAssign, ResourceTodo, TodoTarget(todo)) {
+		return nil, ErrNotTodoOwner
+	}
+	return todo, nil
+}
+
+// setAssignee saves only the todo's assignee, so edits made since it was loaded are kept.
+func (i *TodoInteractor) setAssignee(ctx context.Context, actor input.Actor, todoID string, assigneeID *string) (*model.Todo, error) {
+	var assigned *model.Todo
+	err := i.unitOfWork.RunInTenantTx(ctx, actor.TenantID, func(ctx context.Context) error {
+		var err error
+		assigned, err = i.todoRepo.SetAssignee(ctx, todoID, assigneeID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if assigned == nil {
+		return nil, ErrTodoNotFound
+	}
+	return assigned, nil
+}
+
+// notifyAssignee emails the todo's assignee who assigned it to them.
+func (i *TodoInteractor) notifyAssignee(ctx context.Context, actor input.Actor, todo *model.Todo) error {
+	assignee, err := i.userRepo.FindByID(ctx, *todo.AssigneeID)
+	if err != nil {
+		return err
+	}
+	assigner, err := i.userRepo.FindByID(ctx, actor.UserID)
+	if err != nil {
+		return err
+	}
+	if assignee == nil || assigner == nil {
+		return ErrUserNotFound
+	}
+
+	return i.mailRepo.SendAssignment(ctx, &model.TodoAssignment{
+		TodoTitle:  todo.Title,
+		DueDate:    todo.DueDate,
+		AssignedBy: assigner.Name,
+		Email:      assignee.Email,
+		Name:       assignee.Name,
+		Timezone:   assignee.Timezone,
+	})
+}
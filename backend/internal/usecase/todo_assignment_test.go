@@ -0,0 +1,237 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/domain/repository"
+	"good-todo-go/internal/domain/repository/mock"
+	mocku "good-todo-go/internal/pkg/mock"
+	"good-todo-go/internal/usecase/input"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+)
+
+func TestTodoInteractor_Assign(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTodoRepo := mock.NewMockITodoRepository(ctrl)
+	mockUserRepo := mock.NewMockIUserRepository(ctrl)
+	mockMembershipRepo := mock.NewMockIMembershipRepository(ctrl)
+	mockMailRepo := mock.NewMockIMailRepository(ctrl)
+	mockUnitOfWork := mock.NewMockIUnitOfWork(ctrl)
+	mockUnitOfWork.EXPECT().RunInTenantTx(gomock.Any(), "tenant-123", gomock.Any()).DoAndReturn(runInTx).AnyTimes()
+
+	interactor := NewTodoInteractor(mockUnitOfWork, mockTodoRepo, mock.NewMockITodoActivityRepository(ctrl), mock.NewMockIReminderRepository(ctrl), mock.NewMockITagRepository(ctrl), mock.NewMockIProjectRepository(ctrl), mockUserRepo, mockMembershipRepo, mockMailRepo, NewPermissionEvaluator(DefaultPermissionRules()), mocku.NewMockUUIDGenerator(), mocku.NewMockClock(time.Now()), DefaultMaxSubtaskDepth)
+
+	ctx := context.Background()
+	actor := memberActor("user-123")
+	due := time.Date(2024, 3, 15, 9, 0, 0, 0, time.UTC)
+
+	todo := func(userID string) *model.Todo {
+		return &model.Todo{ID: "todo-1", TenantID: "tenant-123", UserID: userID, Title: "File the report", DueDate: &due, Version: 1}
+	}
+	// saved stands in for SetAssignee, which writes the assignee alone
+	saved := func(ctx context.Context, id string, assigneeID *string) (*model.Todo, error) {
+		assigned := todo("user-123")
+		assigned.AssigneeID = assigneeID
+		assigned.Version++
+		return assigned, nil
+	}
+	member := func(userID string) *model.Membership {
+		return &model.Membership{ID: "membership-" + userID, TenantID: "tenant-123", UserID: userID, Role: model.UserRoleMember}
+	}
+
+	t.Run("emails the new assignee", func(t *testing.T) {
+		mockTodoRepo.EXPECT().FindByID(ctx, "todo-1").Return(todo("user-123"), nil)
+		mockMembershipRepo.EXPECT().FindByUserAndTenant(ctx, "user-456", "tenant-123").Return(member("user-456"), nil)
+		mockTodoRepo.EXPECT().SetAssignee(ctx, "todo-1", strPtr("user-456")).DoAndReturn(saved)
+		mockUserRepo.EXPECT().FindByID(ctx, "user-456").Return(&model.User{ID: "user-456", Email: "bob@example.com", Name: "Bob", Timezone: "Asia/Tokyo"}, nil)
+		mockUserRepo.EXPECT().FindByID(ctx, "user-123").Return(&model.User{ID: "user-123", Name: "Alice"}, nil)
+		mockMailRepo.EXPECT().SendAssignment(ctx, &model.TodoAssignment{
+			TodoTitle:  "File the report",
+			DueDate:    &due,
+			AssignedBy: "Alice",
+			Email:      "bob@example.com",
+			Name:       "Bob",
+			Timezone:   "Asia/Tokyo",
+		}).Return(nil)
+
+		result, err := interactor.Assign(ctx, actor, "todo-1", "user-456")
+
+		require.NoError(t, err)
+		require.NotNil(t, result.AssigneeID)
+		assert.Equal(t, "user-456", *result.AssigneeID)
+		assert.Equal(t, 2, result.Version)
+	})
+
+	t.Run("assigning yourself sends no email", func(t *testing.T) {
+		mockTodoRepo.EXPECT().FindByID(ctx, "todo-1").Return(todo("user-123"), nil)
+		mockMembershipRepo.EXPECT().FindByUserAndTenant(ctx, "user-123", "tenant-123").Return(member("user-123"), nil)
+		mockTodoRepo.EXPECT().SetAssignee(ctx, "todo-1", gomock.Any()).DoAndReturn(saved)
+
+		result, err := interactor.Assign(ctx, actor, "todo-1", "user-123")
+
+		require.NoError(t, err)
+		require.NotNil(t, result.AssigneeID)
+		assert.Equal(t, "user-123", *result.AssigneeID)
+	})
+
+	t.Run("the same assignee again changes nothing", func(t *testing.T) {
+		assigned := todo("user-123")
+		assigned.AssigneeID = strPtr("user-456")
+		mockTodoRepo.EXPECT().FindByID(ctx, "todo-1").Return(assigned, nil)
+
+		result, err := interactor.Assign(ctx, actor, "todo-1", "user-456")
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, result.Version)
+	})
+
+	t.Run("a failed email still assigns the todo", func(t *testing.T) {
+		mockTodoRepo.EXPECT().FindByID(ctx, "todo-1").Return(todo("user-123"), nil)
+		mockMembershipRepo.EXPECT().FindByUserAndTenant(ctx, "user-456", "tenant-123").Return(member("user-456"), nil)
+		mockTodoRepo.EXPECT().SetAssignee(ctx, "todo-1", gomock.Any()).DoAndReturn(saved)
+		mockUserRepo.EXPECT().FindByID(ctx, "user-456").Return(&model.User{ID: "user-456", Email: "bob@example.com"}, nil)
+		mockUserRepo.EXPECT().FindByID(ctx, "user-123").Return(&model.User{ID: "user-123"}, nil)
+		mockMailRepo.EXPECT().SendAssignment(ctx, gomock.Any()).Return(errors.New("smtp unavailable"))
+
+		result, err := interactor.Assign(ctx, actor, "todo-1", "user-456")
+
+		require.NoError(t, err)
+		assert.Equal(t, "user-456", *result.AssigneeID)
+	})
+
+	t.Run("someone outside the tenant cannot be assigned", func(t *testing.T) {
+		mockTodoRepo.EXPECT().FindByID(ctx, "todo-1").Return(todo("user-123"), nil)
+		mockMembershipRepo.EXPECT().FindByUserAndTenant(ctx, "stranger", "tenant-123").Return(nil, nil)
+
+		_, err := interactor.Assign(ctx, actor, "todo-1", "stranger")
+
+		assert.Equal(t, ErrAssigneeNotFound, err)
+	})
+
+	t.Run("the assignee cannot hand the todo on", func(t *testing.T) {
+		assigned := todo("other-user")
+		assigned.AssigneeID = strPtr("user-123")
+		mockTodoRepo.EXPECT().FindByID(ctx, "todo-1").Return(assigned, nil)
+
+		_, err := interactor.Assign(ctx, actor, "todo-1", "user-456")
+
+		assert.Equal(t, ErrNotTodoOwner, err)
+	})
+
+	t.Run("an admin may assign a public todo", func(t *testing.T) {
+		public := todo("other-user")
+		public.IsPublic = true
+		mockTodoRepo.EXPECT().FindByID(ctx, "todo-1").Return(public, nil)
+		mockMembershipRepo.EXPECT().FindByUserAndTenant(ctx, "user-456", "tenant-123").Return(member("user-456"), nil)
+		mockTodoRepo.EXPECT().SetAssignee(ctx, "todo-1", strPtr("user-456")).DoAndReturn(saved)
+		mockUserRepo.EXPECT().FindByID(ctx, "user-456").Return(&model.User{ID: "user-456"}, nil)
+		mockUserRepo.EXPECT().FindByID(ctx, "admin-1").Return(&model.User{ID: "admin-1"}, nil)
+		mockMailRepo.EXPECT().SendAssignment(ctx, gomock.Any()).Return(nil)
+
+		result, err := interactor.Assign(ctx, adminActor("admin-1"), "todo-1", "user-456")
+
+		require.NoError(t, err)
+		assert.Equal(t, "user-456", *result.AssigneeID)
+	})
+
+	t.Run("a todo trashed meanwhile is not found", func(t *testing.T) {
+		mockTodoRepo.EXPECT().FindByID(ctx, "todo-1").Return(todo("user-123"), nil)
+		mockMembershipRepo.EXPECT().FindByUserAndTenant(ctx, "user-456", "tenant-123").Return(member("user-456"), nil)
+		mockTodoRepo.EXPECT().SetAssignee(ctx, "todo-1", strPtr("user-456")).Return(nil, nil)
+
+		_, err := interactor.Assign(ctx, actor, "todo-1", "user-456")
+
+		assert.Equal(t, ErrTodoNotFound, err)
+	})
+
+	t.Run("someone else's private todo is not found", func(t *testing.T) {
+		mockTodoRepo.EXPECT().FindByID(ctx, "todo-1").Return(todo("other-user"), nil)
+
+		_, err := interactor.Assign(ctx, actor, "todo-1", "user-123")
+
+		assert.Equal(t, ErrTodoNotFound, err)
+	})
+
+	t.Run("someone else's public todo cannot be assigned", func(t *testing.T) {
+		public := todo("other-user")
+		public.IsPublic = true
+		mockTodoRepo.EXPECT().FindByID(ctx, "todo-1").Return(public, nil)
+
+		_, err := interactor.Assign(ctx, actor, "todo-1", "user-123")
+
+		assert.Equal(t, ErrNotTodoOwner, err)
+	})
+}
+
+func TestTodoInteractor_Unassign(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTodoRepo := mock.NewMockITodoRepository(ctrl)
+	mockUnitOfWork := mock.NewMockIUnitOfWork(ctrl)
+
+	interactor := NewTodoInteractor(mockUnitOfWork, mockTodoRepo, mock.NewMockITodoActivityRepository(ctrl), mock.NewMockIReminderRepository(ctrl), mock.NewMockITagRepository(ctrl), mock.NewMockIProjectRepository(ctrl), mock.NewMockIUserRepository(ctrl), mock.NewMockIMembershipRepository(ctrl), mock.NewMockIMailRepository(ctrl), NewPermissionEvaluator(DefaultPermissionRules()), mocku.NewMockUUIDGenerator(), mocku.NewMockClock(time.Now()), DefaultMaxSubtaskDepth)
+
+	ctx := context.Background()
+	actor := memberActor("user-123")
+
+	t.Run("clears the assignee", func(t *testing.T) {
+		mockTodoRepo.EXPECT().FindByID(ctx, "todo-1").Return(&model.Todo{ID: "todo-1", TenantID: "tenant-123", UserID: "user-123", AssigneeID: strPtr("user-456"), Version: 1}, nil)
+		gomock.InOrder(
+			mockUnitOfWork.EXPECT().RunInTenantTx(ctx, "tenant-123", gomock.Any()).DoAndReturn(runInTx),
+			mockTodoRepo.EXPECT().SetAssignee(ctx, "todo-1", nil).Return(&model.Todo{ID: "todo-1", TenantID: "tenant-123", UserID: "user-123", Version: 2}, nil),
+		)
+
+		result, err := interactor.Unassign(ctx, actor, "todo-1")
+
+		require.NoError(t, err)
+		assert.Nil(t, result.AssigneeID)
+		assert.Equal(t, 2, result.Version)
+	})
+
+	t.Run("an unassigned todo is returned unchanged", func(t *testing.T) {
+		mockTodoRepo.EXPECT().FindByID(ctx, "todo-1").Return(&model.Todo{ID: "todo-1", TenantID: "tenant-123", UserID: "user-123", Version: 1}, nil)
+
+		result, err := interactor.Unassign(ctx, actor, "todo-1")
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, result.Version)
+	})
+}
+
+func TestTodoInteractor_ListAssigned(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTodoRepo := mock.NewMockITodoRepository(ctrl)
+	mockUnitOfWork := mock.NewMockIUnitOfWork(ctrl)
+
+	interactor := NewTodoInteractor(mockUnitOfWork, mockTodoRepo, mock.NewMockITodoActivityRepository(ctrl), mock.NewMockIReminderRepository(ctrl), mock.NewMockITagRepository(ctrl), mock.NewMockIProjectRepository(ctrl), mock.NewMockIUserRepository(ctrl), mock.NewMockIMembershipRepository(ctrl), mock.NewMockIMailRepository(ctrl), NewPermissionEvaluator(DefaultPermissionRules()), mocku.NewMockUUIDGenerator(), mocku.NewMockClock(time.Now()), DefaultMaxSubtaskDepth)
+
+	ctx := context.Background()
+	actor := memberActor("user-123")
+
+	mockTodoRepo.EXPECT().FindByAssigneeID(ctx, "user-123", gomock.Any()).DoAndReturn(func(ctx context.Context, userID string, query repository.TodoQuery) ([]*model.Todo, error) {
+		assert.Equal(t, DefaultTodoPageSize+1, query.Limit)
+		assert.False(t, query.Filter.IncludeSnoozed)
+		return []*model.Todo{
+			{ID: "todo-1", TenantID: "tenant-123", UserID: "other-user", AssigneeID: strPtr("user-123"), Title: "Someone else's, assigned to me"},
+		}, nil
+	})
+
+	result, err := interactor.ListAssigned(ctx, actor, &input.ListTodosInput{})
+
+	require.NoError(t, err)
+	require.Len(t, result.Todos, 1)
+	assert.Equal(t, "todo-1", result.Todos[0].ID)
+	assert.Nil(t, result.NextCursor)
+}
@@ -0,0 +1,237 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/domain/repository"
+	"good-todo-go/internal/usecase/input"
+	"good-todo-go/internal/usecase/output"
+)
+
+// MaxTodoBatchSize caps the number of ids across all operations of one batch.
+const MaxTodoBatchSize = 100
+
+var (
+	ErrEmptyTodoBatch    = errors.New("todo batch has no operations")
+	ErrTodoBatchTooLarge = errors.New("todo batch is too large")
+
+	// errTodoBatchRolledBack aborts the transaction of an atomic batch with a failed item
+	errTodoBatchRolledBack = errors.New("todo batch rolled back")
+)
+
+// BatchOperationError reports an operation that cannot run at all, before anything is changed.
+type BatchOperationError struct {
+	Index int
+	Msg   string
+}
+
+func (e *BatchOperationError) Error() string {
+	return fmt.Sprintf("operation %d: %s", e.Index, e.Msg)
+}
+
+// BatchItemError reports the item whose error aborted the batch, such as a change the
+// todo cannot take. Err is the error the single-todo update would have returned.
+type BatchItemError struct {
+	Index int
+	ID    string
+	Err   error
+}
+
+func (e *BatchItemError) Error() string {
+	return fmt.Sprintf("operation %d, todo %s: %v", e.Index, e.ID, e.Err)
+}
+
+func (e *BatchItemError) Unwrap() error {
+	return e.Err
+}
+
+// Batch runs the operations in order in one tenant transaction. All todos are loaded up
+// front, so each item costs one write. Missing and forbidden todos are reported per item;
+// any other error aborts the batch as a BatchItemError naming the item.
+func (i *TodoInteractor) Batch(ctx context.Context, actor input.Actor, inp *input.BatchTodoInput) (*output.BatchTodoOutput, error) {
+	ids, err := todoBatchIDs(inp.Operations)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &output.BatchTodoOutput{}
+	err = i.unitOfWork.RunInTenantTx(ctx, actor.TenantID, func(ctx context.Context) error {
+		if err := i.checkBatchProjects(ctx, actor, inp.Operations); err != nil {
+			return err
+		}
+		found, err := i.todoRepo.FindByIDs(ctx, ids)
+		if err != nil {
+			return err
+		}
+		todos := make(map[string]*model.Todo, len(found))
+		for _, todo := range found {
+			todos[todo.ID] = todo
+		}
+
+		now := i.clock.Now()
+		failed := false
+		for n, op := range inp.Operations {
+			for _, id := range op.IDs {
+				item, err := i.batchItem(ctx, actor, todos, op, id, now)
+				if err != nil {
+					return &BatchItemError{Index: n, ID: id, Err: err}
+				}
+				item.Operation = n
+				failed = failed || !batchItemSucceeded(item)
+				result.Items = append(result.Items, item)
+			}
+		}
+
+		if inp.Atomic && failed {
+			return errTodoBatchRolledBack
+		}
+		return nil
+	})
+	if err == errTodoBatchRolledBack {
+		for _, item := range result.Items {
+			if batchItemSucceeded(item) {
+				item.Status = output.BatchTodoItemRolledBack
+				item.Todo = nil
+			}
+		}
+		return result, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	result.Committed = true
+	return result, nil
+}
+
+// batchItem applies op to one todo. Changes are made to the todo in todos as well, so
+// later operations on the same id build on them.
+func (i *TodoInteractor) batchItem(ctx context.Context, actor input.Actor, todos map[string]*model.Todo, op input.BatchTodoOperation, id string, now time.Time) (*output.BatchTodoItemOutput, error) {
+	item := &output.BatchTodoItemOutput{ID: id}
+	todo := todos[id]
+	if todo == nil {
+		item.Status = output.BatchTodoItemNotFound
+		return item, nil
+	}
+
+	if op.Action == input.BatchTodoDelete {
+		if !i.permission.Can(ctx, actor, ActionDelete, ResourceTodo, TodoTarget(todo)) {
+			item.Status = output.BatchTodoItemForbidden
+			return item, nil
+		}
+		// Batches never cascade, so todos with subtasks are refused as a plain delete would be
+		if todo.SubtaskCount > 0 {
+			item.Status = output.BatchTodoItemHasSubtasks
+			return item, nil
+		}
+		err := i.todoRepo.Trash(ctx, id, now, nil)
+		if err == repository.ErrTodoNotFound {
+			// Trashed by someone else since we loaded it
+			item.Status = output.BatchTodoItemNotFound
+			return item, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		delete(todos, id)
+		item.Status = output.BatchTodoItemDeleted
+		return item, nil
+	}
+
+	updated, err := i.update(ctx, actor, todo, applyTodoPatch(todo, batchTodoPatch(op)))
+	if err == ErrNotTodoOwner {
+		item.Status = output.BatchTodoItemForbidden
+		return item, nil
+	}
+	// Batches never force, so blocked todos stay open
+	if err == ErrTodoBlocked {
+		item.Status = output.BatchTodoItemBlocked
+		return item, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	todo.Version = updated.Version
+	item.Status = output.BatchTodoItemUpdated
+	item.Todo = updated
+	return item, nil
+}
+
+// checkBatchProjects rejects a move to a project that cannot take todos before any todo
+// of the batch is changed, so it fails the whole request rather than each item.
+func (i *TodoInteractor) checkBatchProjects(ctx context.Context, actor input.Actor, ops []input.BatchTodoOperation) error {
+	for n, op := range ops {
+		if op.Action != input.BatchTodoMoveToList || op.ProjectID == nil || *op.ProjectID == "" {
+			continue
+		}
+		err := i.checkProject(ctx, actor, *op.ProjectID)
+		if err == ErrProjectNotFound {
+			return &BatchOperationError{Index: n, Msg: "project not found"}
+		}
+		if err == ErrProjectArchived {
+			return &BatchOperationError{Index: n, Msg: "project is archived"}
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// batchTodoPatch expresses an update action as a patch, so it is checked and saved like PATCH.
+func batchTodoPatch(op input.BatchTodoOperation) *input.PatchTodoInput {
+	patch := &input.PatchTodoInput{}
+	switch op.Action {
+	case input.BatchTodoComplete, input.BatchTodoUncomplete:
+		completed := op.Action == input.BatchTodoComplete
+		patch.Completed = &completed
+	case input.BatchTodoSetPublic:
+		patch.IsPublic = op.IsPublic
+	case input.BatchTodoSetDueDate:
+		patch.DueDate = op.DueDate
+		patch.ClearDueDate = op.DueDate == nil
+	case input.BatchTodoMoveToList:
+		patch.ProjectID = op.ProjectID
+		if patch.ProjectID == nil {
+			patch.ProjectID = new(string)
+		}
+	}
+	return patch
+}
+
+// todoBatchIDs validates the operations and returns every id they name.
+func todoBatchIDs(ops []input.BatchTodoOperation) ([]string, error) {
+	if len(ops) == 0 {
+		return nil, ErrEmptyTodoBatch
+	}
+
+	var ids []string
+	for n, op := range ops {
+		switch op.Action {
+		case input.BatchTodoComplete, input.BatchTodoUncomplete, input.BatchTodoSetDueDate, input.BatchTodoDelete, input.BatchTodoMoveToList:
+		case input.BatchTodoSetPublic:
+			if op.IsPublic == nil {
+				return nil, &BatchOperationError{Index: n, Msg: "set_public needs is_public"}
+			}
+		default:
+			return nil, &BatchOperationError{Index: n, Msg: fmt.Sprintf("unknown action %q", op.Action)}
+		}
+		if len(op.IDs) == 0 {
+			return nil, &BatchOperationError{Index: n, Msg: "ids must not be empty"}
+		}
+		ids = append(ids, op.IDs...)
+	}
+
+	if len(ids) > MaxTodoBatchSize {
+		return nil, ErrTodoBatchTooLarge
+	}
+	return ids, nil
+}
+
+func batchItemSucceeded(item *output.BatchTodoItemOutput) bool {
+	return item.Status == output.BatchTodoItemUpdated || item.Status == output.BatchTodoItemDeleted
+}
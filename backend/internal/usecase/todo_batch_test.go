@@ -0,0 +1,323 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/domain/repository/mock"
+	mocku "good-todo-go/internal/pkg/mock"
+	"good-todo-go/internal/usecase/input"
+	"good-todo-go/internal/usecase/output"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+)
+
+func TestTodoInteractor_Batch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUnitOfWork := mock.NewMockIUnitOfWork(ctrl)
+	mockTodoRepo := mock.NewMockITodoRepository(ctrl)
+	mockProjectRepo := mock.NewMockIProjectRepository(ctrl)
+
+	interactor := NewTodoInteractor(mockUnitOfWork, mockTodoRepo, mock.NewMockITodoActivityRepository(ctrl), mock.NewMockIReminderRepository(ctrl), mock.NewMockITagRepository(ctrl), mockProjectRepo, mock.NewMockIUserRepository(ctrl), mock.NewMockIMembershipRepository(ctrl), mock.NewMockIMailRepository(ctrl), NewPermissionEvaluator(DefaultPermissionRules()), mocku.NewMockUUIDGenerator(), mocku.NewMockClock(time.Now()), DefaultMaxSubtaskDepth)
+
+	ctx := context.Background()
+	actor := memberActor("user-123")
+	saved := func(ctx context.Context, todo *model.Todo, expectedVersion *int) (*model.Todo, error) {
+		updated := *todo
+		updated.Version++
+		return &updated, nil
+	}
+	todos := func() []*model.Todo {
+		return []*model.Todo{
+			{ID: "todo-1", TenantID: "tenant-123", UserID: "user-123", Title: "Mine", Version: 1},
+			{ID: "todo-2", TenantID: "tenant-123", UserID: "user-123", Title: "Also mine", Version: 4},
+			{ID: "todo-3", TenantID: "tenant-123", UserID: "user-456", Title: "Theirs", Version: 1},
+		}
+	}
+
+	t.Run("completes and deletes in one transaction", func(t *testing.T) {
+		mockUnitOfWork.EXPECT().RunInTenantTx(ctx, "tenant-123", gomock.Any()).DoAndReturn(runInTx)
+		mockTodoRepo.EXPECT().FindByIDs(ctx, []string{"todo-1", "todo-2"}).Return(todos()[:2], nil)
+		mockTodoRepo.EXPECT().
+			Update(ctx, gomock.Any(), nil).
+			DoAndReturn(func(ctx context.Context, todo *model.Todo, expectedVersion *int) (*model.Todo, error) {
+				assert.Equal(t, "todo-1", todo.ID)
+				assert.True(t, todo.Completed)
+				assert.NotNil(t, todo.CompletedAt)
+				return saved(ctx, todo, expectedVersion)
+			})
+		mockTodoRepo.EXPECT().Trash(ctx, "todo-2", gomock.Any(), nil).Return(nil)
+
+		result, err := interactor.Batch(ctx, actor, &input.BatchTodoInput{
+			Atomic: true,
+			Operations: []input.BatchTodoOperation{
+				{Action: input.BatchTodoComplete, IDs: []string{"todo-1"}},
+				{Action: input.BatchTodoDelete, IDs: []string{"todo-2"}},
+			},
+		})
+
+		require.NoError(t, err)
+		assert.True(t, result.Committed)
+		require.Len(t, result.Items, 2)
+		assert.Equal(t, output.BatchTodoItemUpdated, result.Items[0].Status)
+		assert.Equal(t, 0, result.Items[0].Operation)
+		assert.Equal(t, 2, result.Items[0].Todo.Version)
+		assert.Equal(t, output.BatchTodoItemDeleted, result.Items[1].Status)
+		assert.Equal(t, 1, result.Items[1].Operation)
+	})
+
+	t.Run("atomic batch rolls back on a failed item", func(t *testing.T) {
+		mockUnitOfWork.EXPECT().
+			RunInTenantTx(ctx, "tenant-123", gomock.Any()).
+			DoAndReturn(func(ctx context.Context, tenantID string, fn func(ctx context.Context) error) error {
+				err := fn(ctx)
+				assert.Error(t, err, "the transaction must not commit")
+				return err
+			})
+		mockTodoRepo.EXPECT().FindByIDs(ctx, []string{"todo-1", "todo-3", "todo-9"}).Return(todos(), nil)
+		mockTodoRepo.EXPECT().Update(ctx, gomock.Any(), nil).DoAndReturn(saved)
+
+		result, err := interactor.Batch(ctx, actor, &input.BatchTodoInput{
+			Atomic: true,
+			Operations: []input.BatchTodoOperation{
+				{Action: input.BatchTodoComplete, IDs: []string{"todo-1", "todo-3", "todo-9"}},
+			},
+		})
+
+		require.NoError(t, err)
+		assert.False(t, result.Committed)
+		require.Len(t, result.Items, 3)
+		assert.Equal(t, output.BatchTodoItemRolledBack, result.Items[0].Status)
+		assert.Nil(t, result.Items[0].Todo)
+		assert.Equal(t, output.BatchTodoItemForbidden, result.Items[1].Status)
+		assert.Equal(t, output.BatchTodoItemNotFound, result.Items[2].Status)
+	})
+
+	t.Run("partial batch commits what succeeded", func(t *testing.T) {
+		mockUnitOfWork.EXPECT().RunInTenantTx(ctx, "tenant-123", gomock.Any()).DoAndReturn(runInTx)
+		mockTodoRepo.EXPECT().FindByIDs(ctx, []string{"todo-3", "todo-1"}).Return(todos(), nil)
+		mockTodoRepo.EXPECT().Trash(ctx, "todo-1", gomock.Any(), nil).Return(nil)
+
+		result, err := interactor.Batch(ctx, actor, &input.BatchTodoInput{
+			Operations: []input.BatchTodoOperation{
+				{Action: input.BatchTodoDelete, IDs: []string{"todo-3", "todo-1"}},
+			},
+		})
+
+		require.NoError(t, err)
+		assert.True(t, result.Committed)
+		assert.Equal(t, output.BatchTodoItemForbidden, result.Items[0].Status)
+		assert.Equal(t, output.BatchTodoItemDeleted, result.Items[1].Status)
+	})
+
+	t.Run("todos with subtasks are not deleted", func(t *testing.T) {
+		withSubtask := todos()[:2]
+		withSubtask[0].SubtaskCount = 1
+		mockUnitOfWork.EXPECT().RunInTenantTx(ctx, "tenant-123", gomock.Any()).DoAndReturn(runInTx)
+		mockTodoRepo.EXPECT().FindByIDs(ctx, []string{"todo-1", "todo-2"}).Return(withSubtask, nil)
+		mockTodoRepo.EXPECT().Trash(ctx, "todo-2", gomock.Any(), nil).Return(nil)
+
+		result, err := interactor.Batch(ctx, actor, &input.BatchTodoInput{
+			Operations: []input.BatchTodoOperation{
+				{Action: input.BatchTodoDelete, IDs: []string{"todo-1", "todo-2"}},
+			},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, output.BatchTodoItemHasSubtasks, result.Items[0].Status)
+		assert.Equal(t, output.BatchTodoItemDeleted, result.Items[1].Status)
+	})
+
+	t.Run("blocked todos stay open", func(t *testing.T) {
+		blocked := todos()[:1]
+		blocked[0].BlockedCount = 1
+		mockUnitOfWork.EXPECT().RunInTenantTx(ctx, "tenant-123", gomock.Any()).DoAndReturn(runInTx)
+		mockTodoRepo.EXPECT().FindByIDs(ctx, []string{"todo-1"}).Return(blocked, nil)
+		mockTodoRepo.EXPECT().FindBlockers(ctx, "todo-1").Return(todos()[1:2], nil)
+
+		result, err := interactor.Batch(ctx, actor, &input.BatchTodoInput{
+			Operations: []input.BatchTodoOperation{
+				{Action: input.BatchTodoComplete, IDs: []string{"todo-1"}},
+			},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, output.BatchTodoItemBlocked, result.Items[0].Status)
+	})
+
+	t.Run("later operations see earlier changes", func(t *testing.T) {
+		mockUnitOfWork.EXPECT().RunInTenantTx(ctx, "tenant-123", gomock.Any()).DoAndReturn(runInTx)
+		mockTodoRepo.EXPECT().FindByIDs(ctx, []string{"todo-2", "todo-2", "todo-2"}).Return(todos()[1:2], nil)
+		gomock.InOrder(
+			mockTodoRepo.EXPECT().Update(ctx, gomock.Any(), nil).DoAndReturn(saved),
+			mockTodoRepo.EXPECT().
+				Update(ctx, gomock.Any(), nil).
+				DoAndReturn(func(ctx context.Context, todo *model.Todo, expectedVersion *int) (*model.Todo, error) {
+					assert.True(t, todo.Completed, "completion from the first operation is kept")
+					assert.True(t, todo.IsPublic)
+					return saved(ctx, todo, expectedVersion)
+				}),
+			mockTodoRepo.EXPECT().Trash(ctx, "todo-2", gomock.Any(), nil).Return(nil),
+		)
+
+		isPublic := true
+		result, err := interactor.Batch(ctx, actor, &input.BatchTodoInput{
+			Operations: []input.BatchTodoOperation{
+				{Action: input.BatchTodoComplete, IDs: []string{"todo-2"}},
+				{Action: input.BatchTodoSetPublic, IDs: []string{"todo-2"}, IsPublic: &isPublic},
+				{Action: input.BatchTodoDelete, IDs: []string{"todo-2"}},
+			},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, 6, result.Items[1].Todo.Version)
+		assert.Equal(t, output.BatchTodoItemDeleted, result.Items[2].Status)
+	})
+
+	t.Run("set due date clears when none is given", func(t *testing.T) {
+		dueDate := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+		due := todos()[0]
+		due.DueDate = &dueDate
+
+		mockUnitOfWork.EXPECT().RunInTenantTx(ctx, "tenant-123", gomock.Any()).DoAndReturn(runInTx)
+		mockTodoRepo.EXPECT().FindByIDs(ctx, []string{"todo-1"}).Return([]*model.Todo{due}, nil)
+		mockTodoRepo.EXPECT().
+			Update(ctx, gomock.Any(), nil).
+			DoAndReturn(func(ctx context.Context, todo *model.Todo, expectedVersion *int) (*model.Todo, error) {
+				assert.Nil(t, todo.DueDate)
+				return saved(ctx, todo, expectedVersion)
+			})
+
+		_, err := interactor.Batch(ctx, actor, &input.BatchTodoInput{
+			Operations: []input.BatchTodoOperation{
+				{Action: input.BatchTodoSetDueDate, IDs: []string{"todo-1"}},
+			},
+		})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("an item the todo cannot take aborts the batch with its index", func(t *testing.T) {
+		dueDate := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+		recurring := todos()[1]
+		recurring.DueDate = &dueDate
+		recurring.RecurrenceRule, recurring.RecurrenceTimezone = strPtr("FREQ=DAILY"), strPtr("Mars/Olympus")
+
+		mockUnitOfWork.EXPECT().RunInTenantTx(ctx, "tenant-123", gomock.Any()).DoAndReturn(runInTx)
+		mockTodoRepo.EXPECT().FindByIDs(ctx, []string{"todo-1", "todo-2"}).Return([]*model.Todo{todos()[0], recurring}, nil)
+		mockTodoRepo.EXPECT().Update(ctx, gomock.Any(), nil).DoAndReturn(saved)
+
+		later := dueDate.AddDate(0, 0, 7)
+		_, err := interactor.Batch(ctx, actor, &input.BatchTodoInput{
+			Operations: []input.BatchTodoOperation{
+				{Action: input.BatchTodoSetDueDate, IDs: []string{"todo-1", "todo-2"}, DueDate: &later},
+			},
+		})
+
+		assert.Equal(t, &BatchItemError{Index: 0, ID: "todo-2", Err: ErrInvalidTimezone}, err)
+	})
+
+	t.Run("moves todos to a project and back to the inbox", func(t *testing.T) {
+		projectID := "project-1"
+		mockUnitOfWork.EXPECT().RunInTenantTx(ctx, "tenant-123", gomock.Any()).DoAndReturn(runInTx)
+		mockProjectRepo.EXPECT().
+			FindByID(ctx, "tenant-123", projectID).
+			Return(&model.Project{ID: projectID, TenantID: "tenant-123", UserID: "user-123", Name: "Home"}, nil).
+			AnyTimes()
+		mockTodoRepo.EXPECT().FindByIDs(ctx, []string{"todo-1", "todo-2", "todo-2"}).Return(todos()[:2], nil)
+		gomock.InOrder(
+			mockTodoRepo.EXPECT().
+				Update(ctx, gomock.Any(), nil).
+				DoAndReturn(func(ctx context.Context, todo *model.Todo, expectedVersion *int) (*model.Todo, error) {
+					assert.Equal(t, &projectID, todo.ProjectID)
+					return saved(ctx, todo, expectedVersion)
+				}),
+			mockTodoRepo.EXPECT().Update(ctx, gomock.Any(), nil).DoAndReturn(saved),
+			mockTodoRepo.EXPECT().
+				Update(ctx, gomock.Any(), nil).
+				DoAndReturn(func(ctx context.Context, todo *model.Todo, expectedVersion *int) (*model.Todo, error) {
+					assert.Nil(t, todo.ProjectID)
+					return saved(ctx, todo, expectedVersion)
+				}),
+		)
+
+		result, err := interactor.Batch(ctx, actor, &input.BatchTodoInput{
+			Operations: []input.BatchTodoOperation{
+				{Action: input.BatchTodoMoveToList, IDs: []string{"todo-1", "todo-2"}, ProjectID: &projectID},
+				{Action: input.BatchTodoMoveToList, IDs: []string{"todo-2"}},
+			},
+		})
+
+		require.NoError(t, err)
+		assert.True(t, result.Committed)
+		assert.Equal(t, &projectID, result.Items[0].Todo.ProjectID)
+		assert.Nil(t, result.Items[2].Todo.ProjectID)
+	})
+
+	t.Run("move to an unknown project changes nothing", func(t *testing.T) {
+		projectID := "project-9"
+		mockUnitOfWork.EXPECT().RunInTenantTx(ctx, "tenant-123", gomock.Any()).DoAndReturn(runInTx)
+		mockProjectRepo.EXPECT().FindByID(ctx, "tenant-123", projectID).Return(nil, nil)
+
+		_, err := interactor.Batch(ctx, actor, &input.BatchTodoInput{
+			Operations: []input.BatchTodoOperation{
+				{Action: input.BatchTodoComplete, IDs: []string{"todo-1"}},
+				{Action: input.BatchTodoMoveToList, IDs: []string{"todo-1"}, ProjectID: &projectID},
+			},
+		})
+
+		assert.Equal(t, &BatchOperationError{Index: 1, Msg: "project not found"}, err)
+	})
+
+	t.Run("invalid batches change nothing", func(t *testing.T) {
+		tooMany := make([]string, MaxTodoBatchSize+1)
+		for n := range tooMany {
+			tooMany[n] = "todo-1"
+		}
+
+		tests := []struct {
+			name string
+			ops  []input.BatchTodoOperation
+			err  error
+		}{
+			{name: "no operations", err: ErrEmptyTodoBatch},
+			{
+				name: "too many ids",
+				ops:  []input.BatchTodoOperation{{Action: input.BatchTodoComplete, IDs: tooMany}},
+				err:  ErrTodoBatchTooLarge,
+			},
+			{
+				name: "set public without a value",
+				ops:  []input.BatchTodoOperation{{Action: input.BatchTodoSetPublic, IDs: []string{"todo-1"}}},
+				err:  &BatchOperationError{Index: 0, Msg: "set_public needs is_public"},
+			},
+			{
+				name: "no ids",
+				ops: []input.BatchTodoOperation{
+					{Action: input.BatchTodoComplete, IDs: []string{"todo-1"}},
+					{Action: input.BatchTodoDelete},
+				},
+				err: &BatchOperationError{Index: 1, Msg: "ids must not be empty"},
+			},
+			{
+				name: "unknown action",
+				ops:  []input.BatchTodoOperation{{Action: "archive", IDs: []string{"todo-1"}}},
+				err:  &BatchOperationError{Index: 0, Msg: `unknown action "archive"`},
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				_, err := interactor.Batch(ctx, actor, &input.BatchTodoInput{Operations: tt.ops})
+
+				assert.Equal(t, tt.err, err)
+			})
+		}
+	})
+}
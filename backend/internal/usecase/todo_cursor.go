@@ -0,0 +1,209 @@
+package usecase
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/domain/repository"
+	"good-todo-go/internal/usecase/input"
+	"good-todo-go/internal/usecase/todoquery"
+)
+
+const (
+	DefaultTodoPageSize = 20
+	MaxTodoPageSize     = 100
+)
+
+var (
+	ErrInvalidCursor    = errors.New("invalid cursor")
+	ErrInvalidPageLimit = errors.New("invalid page limit")
+	ErrInvalidTodoSort  = errors.New("invalid sort")
+	// ErrConflictingTodoFilter means a filter was set both in the query string and as a parameter.
+	ErrConflictingTodoFilter = errors.New("filter given both in query and as a parameter")
+)
+
+// todoCursorPayload is the JSON shape behind an opaque cursor. Sort records the order
+// the cursor was issued for so it cannot be replayed against a different one.
+type todoCursorPayload struct {
+	Sort      string     `json:"s"`
+	CreatedAt time.Time  `json:"c"`
+	UpdatedAt time.Time  `json:"u"`
+	DueDate   *time.Time `json:"d,omitempty"`
+	Title     string     `json:"t"`
+	Position  string     `json:"p,omitempty"`
+	Priority  string     `json:"r,omitempty"`
+	ID        string     `json:"i"`
+}
+
+// EncodeTodoCursor turns a keyset position within sort into an opaque URL-safe token.
+func EncodeTodoCursor(sort repository.TodoSort, c repository.TodoCursor) string {
+	p := todoCursorPayload{
+		Sort:      todoSortKey(sort),
+		CreatedAt: c.CreatedAt.UTC(),
+		UpdatedAt: c.UpdatedAt.UTC(),
+		Title:     c.Title,
+		Position:  c.Position,
+		Priority:  string(c.Priority),
+		ID:        c.ID,
+	}
+	if c.DueDate != nil {
+		due := c.DueDate.UTC()
+		p.DueDate = &due
+	}
+	raw, _ := json.Marshal(p)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+// DecodeTodoCursor parses a token produced by EncodeTodoCursor for the same sort.
+// An empty token means the first page and yields a nil cursor.
+func DecodeTodoCursor(token string, sort repository.TodoSort) (*repository.TodoCursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	var p todoCursorPayload
+	if err := json.Unmarshal(raw, &p); err != nil || p.ID == "" || p.CreatedAt.IsZero() {
+		return nil, ErrInvalidCursor
+	}
+	if p.Sort != todoSortKey(sort) {
+		return nil, ErrInvalidCursor
+	}
+	return &repository.TodoCursor{
+		CreatedAt: p.CreatedAt,
+		UpdatedAt: p.UpdatedAt,
+		DueDate:   p.DueDate,
+		Title:     p.Title,
+		Position:  p.Position,
+		Priority:  model.TodoPriority(p.Priority),
+		ID:        p.ID,
+	}, nil
+}
+
+func todoCursorOf(todo *model.Todo) repository.TodoCursor {
+	return repository.TodoCursor{
+		CreatedAt: todo.CreatedAt,
+		UpdatedAt: todo.UpdatedAt,
+		DueDate:   todo.DueDate,
+		Title:     todo.Title,
+		Position:  todo.Position,
+		Priority:  todo.Priority,
+		ID:        todo.ID,
+	}
+}
+
+func todoSortKey(sort repository.TodoSort) string {
+	if sort.Desc {
+		return string(sort.Field) + ":desc"
+	}
+	return string(sort.Field) + ":asc"
+}
+
+// todoPageSize applies the default page size and rejects sizes outside 1..MaxTodoPageSize.
+func todoPageSize(limit int) (int, error) {
+	if limit == 0 {
+		return DefaultTodoPageSize, nil
+	}
+	if limit < 0 || limit > MaxTodoPageSize {
+		return 0, ErrInvalidPageLimit
+	}
+	return limit, nil
+}
+
+// toTodoSort validates the requested sort, defaulting to newest created first. The
+// manual order reads top to bottom, so sorting by position defaults to ascending; other
+// fields, priority included, put the latest or most urgent first.
+func toTodoSort(f input.TodoFilter) (repository.TodoSort, error) {
+	sort := repository.TodoSort{Field: repository.TodoSortCreatedAt, Desc: true}
+	switch field := repository.TodoSortField(f.SortBy); field {
+	case "":
+	case repository.TodoSortCreatedAt, repository.TodoSortDueDate, repository.TodoSortUpdatedAt, repository.TodoSortTitle, repository.TodoSortPriority:
+		sort.Field = field
+	case repository.TodoSortPosition:
+		sort.Field = field
+		sort.Desc = false
+	default:
+		return sort, ErrInvalidTodoSort
+	}
+	switch f.SortOrder {
+	case "":
+	case "desc":
+		sort.Desc = true
+	case "asc":
+		sort.Desc = false
+	default:
+		return sort, ErrInvalidTodoSort
+	}
+	return sort, nil
+}
+
+// toTodoFilter parses f.Query and merges the explicit filter fields into it.
+// A field given both ways is rejected rather than silently picking one.
+func toTodoFilter(f input.TodoFilter, now time.Time) (repository.TodoFilter, error) {
+	filter := repository.TodoFilter{Now: now}
+	if f.Query != "" {
+		q, err := todoquery.Parse(f.Query)
+		if err != nil {
+			return filter, err
+		}
+		if filter, err = todoquery.ToFilter(q, now); err != nil {
+			return filter, err
+		}
+	}
+	if err := mergeBool(&filter.Completed, f.Completed); err != nil {
+		return filter, err
+	}
+	if err := mergeBool(&filter.Overdue, f.Overdue); err != nil {
+		return filter, err
+	}
+	if err := mergeBool(&filter.HasDueDate, f.HasDueDate); err != nil {
+		return filter, err
+	}
+	if err := mergeTime(&filter.DueBefore, f.DueBefore); err != nil {
+		return filter, err
+	}
+	if err := mergeTime(&filter.DueAfter, f.DueAfter); err != nil {
+		return filter, err
+	}
+	if err := mergeTime(&filter.CreatedAfter, f.CreatedAfter); err != nil {
+		return filter, err
+	}
+	// Tags all have to match, so query and parameter tags simply add up
+	filter.TagIDs = f.TagIDs
+	filter.IncludeSnoozed = f.IncludeSnoozed
+	switch f.ProjectID {
+	case "":
+	case input.InboxProjectID:
+		filter.ProjectID = new(string)
+	default:
+		filter.ProjectID = &f.ProjectID
+	}
+	return filter, nil
+}
+
+func mergeBool(dst **bool, v *bool) error {
+	if v == nil {
+		return nil
+	}
+	if *dst != nil {
+		return ErrConflictingTodoFilter
+	}
+	*dst = v
+	return nil
+}
+
+func mergeTime(dst **time.Time, v *time.Time) error {
+	if v == nil {
+		return nil
+	}
+	if *dst != nil {
+		return ErrConflictingTodoFilter
+	}
+	*dst = v
+	return nil
+}
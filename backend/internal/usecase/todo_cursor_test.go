@@ -0,0 +1,63 @@
+package usecase
+
+import (
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/domain/repository"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var newestFirst = repository.TodoSort{Field: repository.TodoSortCreatedAt, Desc: true}
+
+func TestTodoCursor_RoundTrip(t *testing.T) {
+	due := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	in := repository.TodoCursor{
+		CreatedAt: time.Date(2024, 5, 6, 7, 8, 9, 123456000, time.FixedZone("JST", 9*60*60)),
+		UpdatedAt: time.Date(2024, 5, 7, 0, 0, 0, 0, time.UTC),
+		DueDate:   &due,
+		Title:     "Buy milk",
+		Position:  "a0V",
+		Priority:  model.TodoPriorityHigh,
+		ID:        "todo-123",
+	}
+
+	out, err := DecodeTodoCursor(EncodeTodoCursor(newestFirst, in), newestFirst)
+
+	require.NoError(t, err)
+	assert.Equal(t, in.ID, out.ID)
+	assert.Equal(t, in.Title, out.Title)
+	assert.Equal(t, in.Position, out.Position)
+	assert.Equal(t, in.Priority, out.Priority)
+	assert.True(t, in.CreatedAt.Equal(out.CreatedAt))
+	assert.True(t, in.UpdatedAt.Equal(out.UpdatedAt))
+	require.NotNil(t, out.DueDate)
+	assert.True(t, due.Equal(*out.DueDate))
+}
+
+func TestDecodeTodoCursor(t *testing.T) {
+	t.Run("empty token is first page", func(t *testing.T) {
+		cursor, err := DecodeTodoCursor("", newestFirst)
+
+		require.NoError(t, err)
+		assert.Nil(t, cursor)
+	})
+
+	for name, token := range map[string]string{
+		"not base64":   "%%%",
+		"not json":     base64.RawURLEncoding.EncodeToString([]byte("todo-1")),
+		"missing id":   base64.RawURLEncoding.EncodeToString([]byte(`{"s":"created_at:desc","c":"2024-01-01T00:00:00Z"}`)),
+		"other sort":   EncodeTodoCursor(repository.TodoSort{Field: repository.TodoSortCreatedAt}, repository.TodoCursor{CreatedAt: time.Now(), ID: "todo-1"}),
+		"missing time": base64.RawURLEncoding.EncodeToString([]byte(`{"s":"created_at:desc","i":"todo-1"}`)),
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := DecodeTodoCursor(token, newestFirst)
+
+			assert.Equal(t, ErrInvalidCursor, err)
+		})
+	}
+}
@@ -0,0 +1,117 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"good-todo-go/internal/domain/repository"
+	"good-todo-go/internal/usecase/input"
+	"good-todo-go/internal/usecase/output"
+)
+
+var (
+	ErrBlockerNotFound    = errors.New("blocking todo not found")
+	ErrDependencyCycle    = errors.New("dependency would create a cycle")
+	ErrDependencyNotFound = errors.New("dependency not found")
+	ErrTodoBlocked        = errors.New("todo is blocked by open todos")
+)
+
+func (i *TodoInteractor) ListBlockers(ctx context.Context, actor input.Actor, todoID string) ([]*output.TodoOutput, error) {
+	todo, err := i.todoRepo.FindByID(ctx, todoID)
+	if err != nil {
+		return nil, err
+	}
+	if todo == nil || !i.permission.Can(ctx, actor, ActionView, ResourceTodo, TodoTarget(todo)) {
+		return nil, ErrTodoNotFound
+	}
+
+	blockers, err := i.todoRepo.FindBlockers(ctx, todo.ID)
+	if err != nil {
+		return nil, err
+	}
+	return i.toVisibleTodoOutputs(ctx, actor, blockers), nil
+}
+
+// AddBlocker makes todoID wait on blockerID and returns the todo with its new counts. It
+// changes what may be done with the todo, so the actor must be allowed to edit it, while
+// seeing the blocker is enough.
+func (i *TodoInteractor) AddBlocker(ctx context.Context, actor input.Actor, todoID, blockerID string) (*output.TodoOutput, error) {
+	if err := i.checkDependencyEdit(ctx, actor, todoID); err != nil {
+		return nil, err
+	}
+	blocker, err := i.todoRepo.FindByID(ctx, blockerID)
+	if err != nil {
+		return nil, err
+	}
+	if blocker == nil || !i.permission.Can(ctx, actor, ActionView, ResourceTodo, TodoTarget(blocker)) {
+		return nil, ErrBlockerNotFound
+	}
+
+	err = i.unitOfWork.RunInTenantTx(ctx, actor.TenantID, func(ctx context.Context) error {
+		return i.todoRepo.AddBlocker(ctx, actor.TenantID, todoID, blocker.ID)
+	})
+	if err == repository.ErrDependencyCycle {
+		return nil, ErrDependencyCycle
+	}
+	if err != nil {
+		return nil, err
+	}
+	return i.reloadTodo(ctx, todoID)
+}
+
+// RemoveBlocker stops todoID waiting on blockerID and returns the todo with its new counts.
+func (i *TodoInteractor) RemoveBlocker(ctx context.Context, actor input.Actor, todoID, blockerID string) (*output.TodoOutput, error) {
+	if err := i.checkDependencyEdit(ctx, actor, todoID); err != nil {
+		return nil, err
+	}
+	removed, err := i.todoRepo.RemoveBlocker(ctx, todoID, blockerID)
+	if err != nil {
+		return nil, err
+	}
+	if !removed {
+		return nil, ErrDependencyNotFound
+	}
+	return i.reloadTodo(ctx, todoID)
+}
+
+// reloadTodo reads a todo back after a change that does not go through Update.
+func (i *TodoInteractor) reloadTodo(ctx context.Context, todoID string) (*output.TodoOutput, error) {
+	todo, err := i.todoRepo.FindByID(ctx, todoID)
+	if err != nil {
+		return nil, err
+	}
+	if todo == nil {
+		return nil, ErrTodoNotFound
+	}
+	return toTodoOutput(todo), nil
+}
+
+// checkDependencyEdit checks that the actor may change what todoID waits on.
+func (i *TodoInteractor) checkDependencyEdit(ctx context.Context, actor input.Actor, todoID string) error {
+	todo, err := i.todoRepo.FindByID(ctx, todoID)
+	if err != nil {
+		return err
+	}
+	if todo == nil || !i.permission.Can(ctx, actor, ActionView, ResourceTodo, TodoTarget(todo)) {
+		return ErrTodoNotFound
+	}
+	if !i.permission.Can(ctx, actor, ActionUpdate, ResourceTodo, TodoTarget(todo)) {
+		return ErrNotTodoOwner
+	}
+	return nil
+}
+
+// isBlocked reports whether any live blocker of todoID is still open. The todo's own
+// BlockedCount may predate earlier changes in the same batch, so this asks again.
+func (i *TodoInteractor) isBlocked(ctx context.Context, todoID string) (bool, error) {
+	blockers, err := i.todoRepo.FindBlockers(ctx, todoID)
+	if err != nil {
+		return false, err
+	}
+	for _, blocker := range blockers {
+		if !blocker.Completed {
+			return true, nil
+		}
+	}
+	return false, nil
+}
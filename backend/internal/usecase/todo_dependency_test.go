@@ -0,0 +1,136 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/domain/repository"
+	"good-todo-go/internal/domain/repository/mock"
+	mocku "good-todo-go/internal/pkg/mock"
+	"good-todo-go/internal/usecase/input"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+)
+
+func TestTodoInteractor_Blockers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUnitOfWork := mock.NewMockIUnitOfWork(ctrl)
+	mockTodoRepo := mock.NewMockITodoRepository(ctrl)
+
+	interactor := NewTodoInteractor(mockUnitOfWork, mockTodoRepo, mock.NewMockITodoActivityRepository(ctrl), mock.NewMockIReminderRepository(ctrl), mock.NewMockITagRepository(ctrl), mock.NewMockIProjectRepository(ctrl), mock.NewMockIUserRepository(ctrl), mock.NewMockIMembershipRepository(ctrl), mock.NewMockIMailRepository(ctrl), NewPermissionEvaluator(DefaultPermissionRules()), mocku.NewMockUUIDGenerator(), mocku.NewMockClock(time.Now()), DefaultMaxSubtaskDepth)
+
+	ctx := context.Background()
+	actor := memberActor("user-123")
+	todo := func(id, userID string) *model.Todo {
+		return &model.Todo{ID: id, TenantID: "tenant-123", UserID: userID, Title: id}
+	}
+
+	t.Run("adds a blocker", func(t *testing.T) {
+		blocked := todo("todo-1", "user-123")
+		blocked.BlockedCount = 1
+
+		mockTodoRepo.EXPECT().FindByID(ctx, "todo-1").Return(todo("todo-1", "user-123"), nil)
+		mockTodoRepo.EXPECT().FindByID(ctx, "todo-2").Return(todo("todo-2", "user-123"), nil)
+		mockUnitOfWork.EXPECT().RunInTenantTx(ctx, "tenant-123", gomock.Any()).DoAndReturn(runInTx)
+		mockTodoRepo.EXPECT().AddBlocker(ctx, "tenant-123", "todo-1", "todo-2").Return(nil)
+		mockTodoRepo.EXPECT().FindByID(ctx, "todo-1").Return(blocked, nil)
+
+		result, err := interactor.AddBlocker(ctx, actor, "todo-1", "todo-2")
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, result.BlockedCount)
+	})
+
+	t.Run("refuses a cycle", func(t *testing.T) {
+		mockTodoRepo.EXPECT().FindByID(ctx, "todo-1").Return(todo("todo-1", "user-123"), nil)
+		mockTodoRepo.EXPECT().FindByID(ctx, "todo-2").Return(todo("todo-2", "user-123"), nil)
+		mockUnitOfWork.EXPECT().RunInTenantTx(ctx, "tenant-123", gomock.Any()).DoAndReturn(runInTx)
+		mockTodoRepo.EXPECT().AddBlocker(ctx, "tenant-123", "todo-1", "todo-2").Return(repository.ErrDependencyCycle)
+
+		_, err := interactor.AddBlocker(ctx, actor, "todo-1", "todo-2")
+
+		assert.Equal(t, ErrDependencyCycle, err)
+	})
+
+	t.Run("blocker must be visible", func(t *testing.T) {
+		mockTodoRepo.EXPECT().FindByID(ctx, "todo-1").Return(todo("todo-1", "user-123"), nil)
+		mockTodoRepo.EXPECT().FindByID(ctx, "private").Return(todo("private", "other-user"), nil)
+
+		_, err := interactor.AddBlocker(ctx, actor, "todo-1", "private")
+
+		assert.Equal(t, ErrBlockerNotFound, err)
+	})
+
+	t.Run("blocked todo must be editable", func(t *testing.T) {
+		theirs := todo("todo-1", "other-user")
+		theirs.IsPublic = true
+		mockTodoRepo.EXPECT().FindByID(ctx, "todo-1").Return(theirs, nil)
+
+		_, err := interactor.AddBlocker(ctx, actor, "todo-1", "todo-2")
+
+		assert.Equal(t, ErrNotTodoOwner, err)
+	})
+
+	t.Run("removing a missing dependency", func(t *testing.T) {
+		mockTodoRepo.EXPECT().FindByID(ctx, "todo-1").Return(todo("todo-1", "user-123"), nil)
+		mockTodoRepo.EXPECT().RemoveBlocker(ctx, "todo-1", "todo-2").Return(false, nil)
+
+		_, err := interactor.RemoveBlocker(ctx, actor, "todo-1", "todo-2")
+
+		assert.Equal(t, ErrDependencyNotFound, err)
+	})
+
+	t.Run("completion", func(t *testing.T) {
+		completed := true
+		blockedTodo := func() *model.Todo {
+			blocked := todo("todo-1", "user-123")
+			blocked.BlockedCount = 1
+			return blocked
+		}
+		saved := func(ctx context.Context, todo *model.Todo, expectedVersion *int) (*model.Todo, error) {
+			return todo, nil
+		}
+
+		t.Run("is refused while a blocker is open", func(t *testing.T) {
+			mockTodoRepo.EXPECT().FindByID(ctx, "todo-1").Return(blockedTodo(), nil)
+			mockUnitOfWork.EXPECT().RunInTenantTx(ctx, "tenant-123", gomock.Any()).DoAndReturn(runInTx)
+			mockTodoRepo.EXPECT().FindBlockers(ctx, "todo-1").Return([]*model.Todo{todo("todo-2", "user-123")}, nil)
+
+			_, err := interactor.Patch(ctx, actor, &input.PatchTodoInput{ID: "todo-1", Completed: &completed})
+
+			assert.Equal(t, ErrTodoBlocked, err)
+		})
+
+		t.Run("goes through when forced", func(t *testing.T) {
+			mockTodoRepo.EXPECT().FindByID(ctx, "todo-1").Return(blockedTodo(), nil)
+			mockUnitOfWork.EXPECT().RunInTenantTx(ctx, "tenant-123", gomock.Any()).DoAndReturn(runInTx)
+			mockTodoRepo.EXPECT().Update(ctx, gomock.Any(), nil).DoAndReturn(saved)
+
+			result, err := interactor.Patch(ctx, actor, &input.PatchTodoInput{ID: "todo-1", Completed: &completed, Force: true})
+
+			require.NoError(t, err)
+			assert.True(t, result.Completed)
+		})
+
+		t.Run("goes through once the blockers are done", func(t *testing.T) {
+			done := todo("todo-2", "user-123")
+			done.Completed = true
+
+			mockTodoRepo.EXPECT().FindByID(ctx, "todo-1").Return(blockedTodo(), nil)
+			mockUnitOfWork.EXPECT().RunInTenantTx(ctx, "tenant-123", gomock.Any()).DoAndReturn(runInTx)
+			mockTodoRepo.EXPECT().FindBlockers(ctx, "todo-1").Return([]*model.Todo{done}, nil)
+			mockTodoRepo.EXPECT().Update(ctx, gomock.Any(), nil).DoAndReturn(saved)
+
+			result, err := interactor.Patch(ctx, actor, &input.PatchTodoInput{ID: "todo-1", Completed: &completed})
+
+			require.NoError(t, err)
+			assert.True(t, result.Completed)
+		})
+	})
+}
@@ -0,0 +1,193 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/domain/repository"
+	"good-todo-go/internal/pkg/fracindex"
+	"good-todo-go/internal/usecase/input"
+	"good-todo-go/internal/usecase/output"
+)
+
+// DefaultMaxTodoPositionLength is how long position keys may grow before the rebalancer
+// rewrites the tenant's keys. Keys only grow when todos keep landing between the same two.
+const DefaultMaxTodoPositionLength = 32
+
+var (
+	ErrInvalidTodoMove    = errors.New("move needs exactly one neighbour other than the todo itself, or a project")
+	ErrMoveTargetNotFound = errors.New("todo to move next to not found")
+)
+
+// Move places the todo before or after a neighbour, or at the top, by giving it a key
+// between the keys around that spot. Other todos keep their keys, so only the moved
+// todo is written.
+func (i *TodoInteractor) Move(ctx context.Context, actor input.Actor, inp *input.MoveTodoInput) (*output.TodoOutput, error) {
+	neighbourID := inp.BeforeID
+	if neighbourID == "" {
+		neighbourID = inp.AfterID
+	}
+	if (inp.BeforeID != "" && inp.AfterID != "") || (neighbourID == "" && inp.ProjectID == nil) || neighbourID == inp.ID {
+		return nil, ErrInvalidTodoMove
+	}
+
+	todo, err := i.todoRepo.FindByID(ctx, inp.ID)
+	if err != nil {
+		return nil, err
+	}
+	if todo == nil || !i.permission.Can(ctx, actor, ActionView, ResourceTodo, TodoTarget(todo)) {
+		return nil, ErrTodoNotFound
+	}
+	if !i.permission.Can(ctx, actor, ActionUpdate, ResourceTodo, TodoTarget(todo)) {
+		return nil, ErrNotTodoOwner
+	}
+	if inp.ProjectID != nil {
+		todo.ProjectID = nil
+		if *inp.ProjectID != "" {
+			if err := i.checkProject(ctx, actor, *inp.ProjectID); err != nil {
+				return nil, err
+			}
+			todo.ProjectID = inp.ProjectID
+		}
+	}
+
+	var moved *model.Todo
+	err = i.unitOfWork.RunInTenantTx(ctx, actor.TenantID, func(ctx context.Context) error {
+		// The neighbour is read under the lock so a rebalance cannot rewrite its key meanwhile
+		if err := i.todoRepo.LockPositions(ctx, actor.TenantID); err != nil {
+			return err
+		}
+		var err error
+		if neighbourID == "" {
+			todo.Position, err = i.topPosition(ctx, actor.TenantID, todo.ID)
+		} else {
+			todo.Position, err = i.positionNextTo(ctx, actor, todo.ID, neighbourID, inp.AfterID != "")
+		}
+		if err != nil {
+			return err
+		}
+		moved, err = i.todoRepo.Move(ctx, todo)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if moved == nil {
+		return nil, ErrTodoNotFound
+	}
+	return toTodoOutput(moved), nil
+}
+
+// positionNextTo returns a key right after the neighbour when after is set and right
+// before it otherwise, skipping the key of the todo being moved.
+func (i *TodoInteractor) positionNextTo(ctx context.Context, actor input.Actor, todoID, neighbourID string, after bool) (string, error) {
+	neighbour, err := i.todoRepo.FindByID(ctx, neighbourID)
+	if err != nil {
+		return "", err
+	}
+	if neighbour == nil || !i.permission.Can(ctx, actor, ActionView, ResourceTodo, TodoTarget(neighbour)) {
+		return "", ErrMoveTargetNotFound
+	}
+
+	adjacent, err := i.todoRepo.AdjacentPosition(ctx, actor.TenantID, neighbour.Position, after, todoID)
+	if err != nil {
+		return "", err
+	}
+	if after {
+		return fracindex.Between(neighbour.Position, adjacent)
+	}
+	return fracindex.Between(adjacent, neighbour.Position)
+}
+
+// topPosition returns a key before all of the tenant's todos other than excludeID. The
+// caller holds the position lock until the key is written, so no other todo takes it.
+func (i *TodoInteractor) topPosition(ctx context.Context, tenantID, excludeID string) (string, error) {
+	first, err := i.todoRepo.AdjacentPosition(ctx, tenantID, "", true, excludeID)
+	if err != nil {
+		return "", err
+	}
+	return fracindex.Between("", first)
+}
+
+// ITodoRebalanceInteractor keeps the keys of every tenant's manual todo order short.
+type ITodoRebalanceInteractor interface {
+	// RebalancePositions gives fresh, short keys to the todos of each tenant whose longest
+	// key has outgrown the limit, keeping their order, and returns how many todos were
+	// rewritten. A failing tenant does not stop the others.
+	RebalancePositions(ctx context.Context) (int, error)
+}
+
+type TodoRebalanceInteractor struct {
+	unitOfWork        repository.IUnitOfWork
+	tenantRepo        repository.ITenantRepository
+	todoRepo          repository.ITodoRepository
+	maxPositionLength int
+}
+
+// NewTodoRebalanceInteractor builds the rebalancer usecase. Tenants are rebalanced once
+// a key is longer than maxPositionLength.
+func NewTodoRebalanceInteractor(
+	unitOfWork repository.IUnitOfWork,
+	tenantRepo repository.ITenantRepository,
+	todoRepo repository.ITodoRepository,
+	maxPositionLength int,
+) ITodoRebalanceInteractor {
+	return &TodoRebalanceInteractor{
+		unitOfWork:        unitOfWork,
+		tenantRepo:        tenantRepo,
+		todoRepo:          todoRepo,
+		maxPositionLength: maxPositionLength,
+	}
+}
+
+func (i *TodoRebalanceInteractor) RebalancePositions(ctx context.Context) (int, error) {
+	tenants, err := i.tenantRepo.FindAll(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	total := 0
+	var errs []error
+	for _, tenant := range tenants {
+		var rewritten int
+		err := i.unitOfWork.RunInTenantTx(ctx, tenant.ID, func(ctx context.Context) error {
+			var err error
+			rewritten, err = i.rebalance(ctx, tenant.ID)
+			return err
+		})
+		if err != nil {
+			errs = append(errs, fmt.Errorf("tenant %s: %w", tenant.ID, err))
+			continue
+		}
+		total += rewritten
+	}
+	return total, errors.Join(errs...)
+}
+
+func (i *TodoRebalanceInteractor) rebalance(ctx context.Context, tenantID string) (int, error) {
+	if err := i.todoRepo.LockPositions(ctx, tenantID); err != nil {
+		return 0, err
+	}
+	longest, err := i.todoRepo.LongestPosition(ctx, tenantID)
+	if err != nil {
+		return 0, err
+	}
+	if longest <= i.maxPositionLength {
+		return 0, nil
+	}
+
+	ids, err := i.todoRepo.FindIDsByPosition(ctx, tenantID)
+	if err != nil {
+		return 0, err
+	}
+	positions := fracindex.Sequence(len(ids))
+	if len(positions) < len(ids) {
+		return 0, fracindex.ErrKeySpace
+	}
+	if err := i.todoRepo.SetPositions(ctx, ids, positions); err != nil {
+		return 0, err
+	}
+	return len(ids), nil
+}
@@ -0,0 +1,167 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/domain/repository/mock"
+	mocku "good-todo-go/internal/pkg/mock"
+	"good-todo-go/internal/usecase/input"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+)
+
+func TestTodoInteractor_Move(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUnitOfWork := mock.NewMockIUnitOfWork(ctrl)
+	mockTodoRepo := mock.NewMockITodoRepository(ctrl)
+	mockProjectRepo := mock.NewMockIProjectRepository(ctrl)
+
+	interactor := NewTodoInteractor(mockUnitOfWork, mockTodoRepo, mock.NewMockITodoActivityRepository(ctrl), mock.NewMockIReminderRepository(ctrl), mock.NewMockITagRepository(ctrl), mockProjectRepo, mock.NewMockIUserRepository(ctrl), mock.NewMockIMembershipRepository(ctrl), mock.NewMockIMailRepository(ctrl), NewPermissionEvaluator(DefaultPermissionRules()), mocku.NewMockUUIDGenerator(), mocku.NewMockClock(time.Now()), DefaultMaxSubtaskDepth)
+
+	ctx := context.Background()
+	actor := memberActor("user-123")
+	todo := func(id, userID, position string) *model.Todo {
+		return &model.Todo{ID: id, TenantID: "tenant-123", UserID: userID, Title: id, Position: position}
+	}
+	moved := func(ctx context.Context, todo *model.Todo) (*model.Todo, error) {
+		return todo, nil
+	}
+
+	t.Run("before a todo", func(t *testing.T) {
+		mockTodoRepo.EXPECT().FindByID(ctx, "todo-1").Return(todo("todo-1", "user-123", "a5"), nil)
+		mockUnitOfWork.EXPECT().RunInTenantTx(ctx, "tenant-123", gomock.Any()).DoAndReturn(runInTx)
+		mockTodoRepo.EXPECT().LockPositions(ctx, "tenant-123").Return(nil)
+		mockTodoRepo.EXPECT().FindByID(ctx, "todo-2").Return(todo("todo-2", "user-123", "a2"), nil)
+		mockTodoRepo.EXPECT().AdjacentPosition(ctx, "tenant-123", "a2", false, "todo-1").Return("a1", nil)
+		mockTodoRepo.EXPECT().Move(ctx, gomock.Any()).DoAndReturn(moved)
+
+		result, err := interactor.Move(ctx, actor, &input.MoveTodoInput{ID: "todo-1", BeforeID: "todo-2"})
+
+		require.NoError(t, err)
+		assert.Equal(t, "a1V", result.Position)
+	})
+
+	t.Run("after the last todo", func(t *testing.T) {
+		mockTodoRepo.EXPECT().FindByID(ctx, "todo-1").Return(todo("todo-1", "user-123", "a0"), nil)
+		mockUnitOfWork.EXPECT().RunInTenantTx(ctx, "tenant-123", gomock.Any()).DoAndReturn(runInTx)
+		mockTodoRepo.EXPECT().LockPositions(ctx, "tenant-123").Return(nil)
+		mockTodoRepo.EXPECT().FindByID(ctx, "todo-2").Return(todo("todo-2", "user-123", "a5"), nil)
+		mockTodoRepo.EXPECT().AdjacentPosition(ctx, "tenant-123", "a5", true, "todo-1").Return("", nil)
+		mockTodoRepo.EXPECT().Move(ctx, gomock.Any()).DoAndReturn(moved)
+
+		result, err := interactor.Move(ctx, actor, &input.MoveTodoInput{ID: "todo-1", AfterID: "todo-2"})
+
+		require.NoError(t, err)
+		assert.Equal(t, "a6", result.Position)
+	})
+
+	t.Run("to the top of a project", func(t *testing.T) {
+		projectID := "project-1"
+
+		mockTodoRepo.EXPECT().FindByID(ctx, "todo-1").Return(todo("todo-1", "user-123", "a5"), nil)
+		mockProjectRepo.EXPECT().FindByID(ctx, "tenant-123", "project-1").Return(&model.Project{ID: "project-1", TenantID: "tenant-123", UserID: "user-123", Name: "Home"}, nil)
+		mockUnitOfWork.EXPECT().RunInTenantTx(ctx, "tenant-123", gomock.Any()).DoAndReturn(runInTx)
+		mockTodoRepo.EXPECT().LockPositions(ctx, "tenant-123").Return(nil)
+		mockTodoRepo.EXPECT().AdjacentPosition(ctx, "tenant-123", "", true, "todo-1").Return("a0", nil)
+		mockTodoRepo.EXPECT().Move(ctx, gomock.Any()).DoAndReturn(moved)
+
+		result, err := interactor.Move(ctx, actor, &input.MoveTodoInput{ID: "todo-1", ProjectID: &projectID})
+
+		require.NoError(t, err)
+		assert.Equal(t, "Zz", result.Position)
+		require.NotNil(t, result.ProjectID)
+		assert.Equal(t, "project-1", *result.ProjectID)
+	})
+
+	t.Run("invalid moves", func(t *testing.T) {
+		for name, inp := range map[string]*input.MoveTodoInput{
+			"no target":       {ID: "todo-1"},
+			"both neighbours": {ID: "todo-1", BeforeID: "todo-2", AfterID: "todo-3"},
+			"next to itself":  {ID: "todo-1", AfterID: "todo-1"},
+		} {
+			t.Run(name, func(t *testing.T) {
+				_, err := interactor.Move(ctx, actor, inp)
+
+				assert.Equal(t, ErrInvalidTodoMove, err)
+			})
+		}
+	})
+
+	t.Run("neighbour must be visible", func(t *testing.T) {
+		mockTodoRepo.EXPECT().FindByID(ctx, "todo-1").Return(todo("todo-1", "user-123", "a0"), nil)
+		mockUnitOfWork.EXPECT().RunInTenantTx(ctx, "tenant-123", gomock.Any()).DoAndReturn(runInTx)
+		mockTodoRepo.EXPECT().LockPositions(ctx, "tenant-123").Return(nil)
+		mockTodoRepo.EXPECT().FindByID(ctx, "private").Return(todo("private", "other-user", "a1"), nil)
+
+		_, err := interactor.Move(ctx, actor, &input.MoveTodoInput{ID: "todo-1", BeforeID: "private"})
+
+		assert.Equal(t, ErrMoveTargetNotFound, err)
+	})
+
+	t.Run("todo must be editable", func(t *testing.T) {
+		theirs := todo("todo-1", "other-user", "a0")
+		theirs.IsPublic = true
+		mockTodoRepo.EXPECT().FindByID(ctx, "todo-1").Return(theirs, nil)
+
+		_, err := interactor.Move(ctx, actor, &input.MoveTodoInput{ID: "todo-1", AfterID: "todo-2"})
+
+		assert.Equal(t, ErrNotTodoOwner, err)
+	})
+}
+
+func TestTodoRebalanceInteractor_RebalancePositions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUnitOfWork := mock.NewMockIUnitOfWork(ctrl)
+	mockTenantRepo := mock.NewMockITenantRepository(ctrl)
+	mockTodoRepo := mock.NewMockITodoRepository(ctrl)
+
+	interactor := NewTodoRebalanceInteractor(mockUnitOfWork, mockTenantRepo, mockTodoRepo, 8)
+
+	t.Run("rewrites only tenants with long keys", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockTenantRepo.EXPECT().FindAll(ctx).Return([]*model.Tenant{{ID: "tenant-a"}, {ID: "tenant-b"}}, nil)
+		mockUnitOfWork.EXPECT().RunInTenantTx(ctx, "tenant-a", gomock.Any()).DoAndReturn(runInTx)
+		mockTodoRepo.EXPECT().LockPositions(ctx, "tenant-a").Return(nil)
+		mockTodoRepo.EXPECT().LongestPosition(ctx, "tenant-a").Return(8, nil)
+		mockUnitOfWork.EXPECT().RunInTenantTx(ctx, "tenant-b", gomock.Any()).DoAndReturn(runInTx)
+		mockTodoRepo.EXPECT().LockPositions(ctx, "tenant-b").Return(nil)
+		mockTodoRepo.EXPECT().LongestPosition(ctx, "tenant-b").Return(9, nil)
+		mockTodoRepo.EXPECT().FindIDsByPosition(ctx, "tenant-b").Return([]string{"todo-3", "todo-1", "todo-2"}, nil)
+		mockTodoRepo.EXPECT().SetPositions(ctx, []string{"todo-3", "todo-1", "todo-2"}, []string{"a0", "a1", "a2"}).Return(nil)
+
+		rewritten, err := interactor.RebalancePositions(ctx)
+
+		require.NoError(t, err)
+		assert.Equal(t, 3, rewritten)
+	})
+
+	t.Run("a failing tenant does not stop the others", func(t *testing.T) {
+		ctx := context.Background()
+		dbErr := errors.New("connection reset")
+
+		mockTenantRepo.EXPECT().FindAll(ctx).Return([]*model.Tenant{{ID: "tenant-a"}, {ID: "tenant-b"}}, nil)
+		mockUnitOfWork.EXPECT().RunInTenantTx(ctx, "tenant-a", gomock.Any()).DoAndReturn(runInTx)
+		mockTodoRepo.EXPECT().LockPositions(ctx, "tenant-a").Return(dbErr)
+		mockUnitOfWork.EXPECT().RunInTenantTx(ctx, "tenant-b", gomock.Any()).DoAndReturn(runInTx)
+		mockTodoRepo.EXPECT().LockPositions(ctx, "tenant-b").Return(nil)
+		mockTodoRepo.EXPECT().LongestPosition(ctx, "tenant-b").Return(9, nil)
+		mockTodoRepo.EXPECT().FindIDsByPosition(ctx, "tenant-b").Return([]string{"todo-1"}, nil)
+		mockTodoRepo.EXPECT().SetPositions(ctx, []string{"todo-1"}, []string{"a0"}).Return(nil)
+
+		rewritten, err := interactor.RebalancePositions(ctx)
+
+		assert.ErrorIs(t, err, dbErr)
+		assert.Equal(t, 1, rewritten)
+	})
+}
@@ -0,0 +1,63 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"good-todo-go/internal/domain/repository"
+)
+
+// ITodoPurgeInteractor empties the trash of every tenant once todos outlive the
+// tenant's retention period.
+type ITodoPurgeInteractor interface {
+	// PurgeExpiredTrash removes todos trashed longer than their tenant's retention before now
+	// and returns how many were removed. A failing tenant does not stop the others.
+	PurgeExpiredTrash(ctx context.Context, now time.Time) (int, error)
+}
+
+type TodoPurgeInteractor struct {
+	unitOfWork repository.IUnitOfWork
+	tenantRepo repository.ITenantRepository
+	todoRepo   repository.ITodoRepository
+}
+
+func NewTodoPurgeInteractor(
+	unitOfWork repository.IUnitOfWork,
+	tenantRepo repository.ITenantRepository,
+	todoRepo repository.ITodoRepository,
+) ITodoPurgeInteractor {
+	return &TodoPurgeInteractor{
+		unitOfWork: unitOfWork,
+		tenantRepo: tenantRepo,
+		todoRepo:   todoRepo,
+	}
+}
+
+func (i *TodoPurgeInteractor) PurgeExpiredTrash(ctx context.Context, now time.Time) (int, error) {
+	tenants, err := i.tenantRepo.FindAll(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	total := 0
+	var errs []error
+	for _, tenant := range tenants {
+		cutoff := now.AddDate(0, 0, -tenant.TrashRetentionDays)
+
+		// Each tenant gets its own transaction so RLS scopes the delete
+		var purged int
+		err := i.unitOfWork.RunInTenantTx(ctx, tenant.ID, func(ctx context.Context) error {
+			var err error
+			purged, err = i.todoRepo.PurgeTrash(ctx, tenant.ID, cutoff)
+			return err
+		})
+		if err != nil {
+			errs = append(errs, fmt.Errorf("tenant %s: %w", tenant.ID, err))
+			continue
+		}
+		total += purged
+	}
+	return total, errors.Join(errs...)
+}
@@ -0,0 +1,65 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/domain/repository/mock"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+)
+
+func TestTodoPurgeInteractor_PurgeExpiredTrash(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUnitOfWork := mock.NewMockIUnitOfWork(ctrl)
+	mockTenantRepo := mock.NewMockITenantRepository(ctrl)
+	mockTodoRepo := mock.NewMockITodoRepository(ctrl)
+
+	interactor := NewTodoPurgeInteractor(mockUnitOfWork, mockTenantRepo, mockTodoRepo)
+
+	now := time.Date(2024, 6, 30, 12, 0, 0, 0, time.UTC)
+
+	t.Run("uses each tenant's retention", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockTenantRepo.EXPECT().FindAll(ctx).Return([]*model.Tenant{
+			{ID: "tenant-a", TrashRetentionDays: 30},
+			{ID: "tenant-b", TrashRetentionDays: 7},
+		}, nil)
+		mockUnitOfWork.EXPECT().RunInTenantTx(ctx, "tenant-a", gomock.Any()).DoAndReturn(runInTx)
+		mockTodoRepo.EXPECT().PurgeTrash(ctx, "tenant-a", time.Date(2024, 5, 31, 12, 0, 0, 0, time.UTC)).Return(2, nil)
+		mockUnitOfWork.EXPECT().RunInTenantTx(ctx, "tenant-b", gomock.Any()).DoAndReturn(runInTx)
+		mockTodoRepo.EXPECT().PurgeTrash(ctx, "tenant-b", time.Date(2024, 6, 23, 12, 0, 0, 0, time.UTC)).Return(3, nil)
+
+		purged, err := interactor.PurgeExpiredTrash(ctx, now)
+
+		require.NoError(t, err)
+		assert.Equal(t, 5, purged)
+	})
+
+	t.Run("a failing tenant does not stop the others", func(t *testing.T) {
+		ctx := context.Background()
+		dbErr := errors.New("connection reset")
+
+		mockTenantRepo.EXPECT().FindAll(ctx).Return([]*model.Tenant{
+			{ID: "tenant-a", TrashRetentionDays: 30},
+			{ID: "tenant-b", TrashRetentionDays: 30},
+		}, nil)
+		mockUnitOfWork.EXPECT().RunInTenantTx(ctx, "tenant-a", gomock.Any()).DoAndReturn(runInTx)
+		mockTodoRepo.EXPECT().PurgeTrash(ctx, "tenant-a", gomock.Any()).Return(0, dbErr)
+		mockUnitOfWork.EXPECT().RunInTenantTx(ctx, "tenant-b", gomock.Any()).DoAndReturn(runInTx)
+		mockTodoRepo.EXPECT().PurgeTrash(ctx, "tenant-b", gomock.Any()).Return(4, nil)
+
+		purged, err := interactor.PurgeExpiredTrash(ctx, now)
+
+		assert.ErrorIs(t, err, dbErr)
+		assert.Equal(t, 4, purged)
+	})
+}
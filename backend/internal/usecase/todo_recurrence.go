@@ -0,0 +1,178 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/pkg/fracindex"
+	"good-todo-go/internal/pkg/rrule"
+	"good-todo-go/internal/usecase/input"
+)
+
+// Occurrence previews list DefaultOccurrenceCount occurrences unless asked for up to
+// MaxOccurrenceCount.
+const (
+	DefaultOccurrenceCount = 5
+	MaxOccurrenceCount     = 50
+)
+
+var (
+	// ErrInvalidRecurrence is wrapped by errors that also name the offending rule part.
+	ErrInvalidRecurrence      = rrule.ErrInvalidRule
+	ErrRecurrenceNeedsDueDate = errors.New("a recurring todo needs a due date to repeat from")
+	ErrInvalidOccurrenceCount = fmt.Errorf("count must be between 1 and %d", MaxOccurrenceCount)
+)
+
+// ListOccurrences previews the due dates of the next count occurrences of a todo the
+// actor can see, after its current one. A todo that does not recur has none. The dates
+// are in the recurrence timezone.
+func (i *TodoInteractor) ListOccurrences(ctx context.Context, actor input.Actor, todoID string, count int) ([]time.Time, error) {
+	if count == 0 {
+		count = DefaultOccurrenceCount
+	}
+	if count < 0 || count > MaxOccurrenceCount {
+		return nil, ErrInvalidOccurrenceCount
+	}
+	todo, err := i.todoRepo.FindByID(ctx, todoID)
+	if err != nil {
+		return nil, err
+	}
+	if todo == nil || !i.permission.Can(ctx, actor, ActionView, ResourceTodo, TodoTarget(todo)) {
+		return nil, ErrTodoNotFound
+	}
+
+	rule, start, err := recurrenceOf(todo)
+	if err != nil || rule == nil {
+		return []time.Time{}, err
+	}
+	return rule.Occurrences(start, count+1)[1:], nil
+}
+
+// setRecurrence makes todo repeat by rule from its due date in the wall clock of
+// timezone, storing the rule in canonical form. An empty rule stops the todo repeating
+// and an empty timezone uses its owner's.
+func (i *TodoInteractor) setRecurrence(ctx context.Context, todo *model.Todo, rule, timezone string) error {
+	if rule == "" {
+		todo.RecurrenceRule, todo.RecurrenceTimezone = nil, nil
+		return nil
+	}
+	if todo.DueDate == nil {
+		return ErrRecurrenceNeedsDueDate
+	}
+	parsed, err := rrule.Parse(rule)
+	if err != nil {
+		return err
+	}
+	if timezone == "" {
+		loc, err := i.userLocation(ctx, todo.UserID)
+		if err != nil {
+			return err
+		}
+		timezone = loc.String()
+	} else if err := validateTimezone(timezone); err != nil {
+		return err
+	}
+
+	rule = parsed.String()
+	todo.RecurrenceRule, todo.RecurrenceTimezone = &rule, &timezone
+	return nil
+}
+
+// nextOccurrence returns the todo that follows todo in its series, or nil once COUNT
+// or UNTIL has run out. It copies todo, assignee included, with the next due date and
+// one fewer remaining COUNT, and sits right above todo in the manual order. Reminders
+// are not part of the copy; see carryReminders.
+func (i *TodoInteractor) nextOccurrence(ctx context.Context, todo *model.Todo) (*model.Todo, error) {
+	rule, start, err := recurrenceOf(todo)
+	if err != nil || rule == nil {
+		return nil, err
+	}
+	occurrences := rule.Occurrences(start, 2)
+	if len(occurrences) < 2 {
+		return nil, nil
+	}
+	if rule.Count > 0 {
+		rule.Count--
+	}
+	remaining := rule.String()
+
+	// Called within the update's transaction, so the lock holds until the occurrence is written
+	if err := i.todoRepo.LockPositions(ctx, todo.TenantID); err != nil {
+		return nil, err
+	}
+	above, err := i.todoRepo.AdjacentPosition(ctx, todo.TenantID, todo.Position, false, "")
+	if err != nil {
+		return nil, err
+	}
+	position, err := fracindex.Between(above, todo.Position)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.Todo{
+		ID:                 i.uuidGenerator.Generate(),
+		TenantID:           todo.TenantID,
+		UserID:             todo.UserID,
+		AssigneeID:         todo.AssigneeID,
+		ProjectID:          todo.ProjectID,
+		ParentID:           todo.ParentID,
+		Title:              todo.Title,
+		Description:        todo.Description,
+		IsPublic:           todo.IsPublic,
+		AutoComplete:       todo.AutoComplete,
+		Priority:           todo.Priority,
+		DueDate:            &occurrences[1],
+		RecurrenceRule:     &remaining,
+		RecurrenceTimezone: todo.RecurrenceTimezone,
+		Position:           position,
+		Tags:               todo.Tags,
+	}, nil
+}
+
+// carryReminders gives next a pending copy of each of todo's reminders relative to the
+// due date, so they fire before the next due date too. Reminders at a fixed time belong
+// to that moment and stay with the completed todo.
+func (i *TodoInteractor) carryReminders(ctx context.Context, todo, next *model.Todo) error {
+	reminders, err := i.reminderRepo.FindByTodoID(ctx, todo.TenantID, todo.ID)
+	if err != nil {
+		return err
+	}
+	for _, reminder := range reminders {
+		if reminder.OffsetMinutes == nil {
+			continue
+		}
+		if _, err := i.reminderRepo.Create(ctx, &model.Reminder{
+			ID:            i.uuidGenerator.Generate(),
+			TenantID:      next.TenantID,
+			TodoID:        next.ID,
+			OffsetMinutes: reminder.OffsetMinutes,
+			Status:        model.ReminderStatusPending,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// recurrenceOf parses the todo's stored rule and returns it with the series start, its
+// due date in the recurrence timezone. The rule is nil for a todo that does not recur.
+func recurrenceOf(todo *model.Todo) (*rrule.Rule, time.Time, error) {
+	if todo.RecurrenceRule == nil || todo.DueDate == nil {
+		return nil, time.Time{}, nil
+	}
+	rule, err := rrule.Parse(*todo.RecurrenceRule)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	loc := time.UTC
+	if todo.RecurrenceTimezone != nil {
+		// A zone that no longer loads repeats in UTC rather than stopping the series
+		if l, err := time.LoadLocation(*todo.RecurrenceTimezone); err == nil {
+			loc = l
+		}
+	}
+	return rule, todo.DueDate.In(loc), nil
+}
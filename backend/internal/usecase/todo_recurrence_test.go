@@ -0,0 +1,292 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/domain/repository/mock"
+	mocku "good-todo-go/internal/pkg/mock"
+	"good-todo-go/internal/usecase/input"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+)
+
+func strPtr(s string) *string {
+	return &s
+}
+
+func TestTodoInteractor_Create_Recurrence(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUnitOfWork := mock.NewMockIUnitOfWork(ctrl)
+	mockTodoRepo := mock.NewMockITodoRepository(ctrl)
+	mockUserRepo := mock.NewMockIUserRepository(ctrl)
+	interactor := NewTodoInteractor(mockUnitOfWork, mockTodoRepo, mock.NewMockITodoActivityRepository(ctrl), mock.NewMockIReminderRepository(ctrl), mock.NewMockITagRepository(ctrl), mock.NewMockIProjectRepository(ctrl), mockUserRepo, mock.NewMockIMembershipRepository(ctrl), mock.NewMockIMailRepository(ctrl), NewPermissionEvaluator(DefaultPermissionRules()), mocku.NewMockUUIDGenerator("todo-1"), mocku.NewMockClock(time.Now()), DefaultMaxSubtaskDepth)
+
+	ctx := context.Background()
+	due := time.Date(2024, 3, 4, 9, 0, 0, 0, time.UTC)
+
+	t.Run("stores the canonical rule in the owner's timezone", func(t *testing.T) {
+		mockUserRepo.EXPECT().FindByID(ctx, "user-123").Return(&model.User{ID: "user-123", Timezone: "Europe/Berlin"}, nil)
+		mockUnitOfWork.EXPECT().RunInTenantTx(ctx, "tenant-123", gomock.Any()).DoAndReturn(runInTx)
+		mockTodoRepo.EXPECT().LockPositions(ctx, "tenant-123").Return(nil)
+		mockTodoRepo.EXPECT().AdjacentPosition(ctx, "tenant-123", "", true, "").Return("", nil)
+		mockTodoRepo.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, todo *model.Todo) (*model.Todo, error) {
+			return todo, nil
+		})
+
+		result, err := interactor.Create(ctx, memberActor("user-123"), &input.CreateTodoInput{
+			Title: "Standup", DueDate: &due, RecurrenceRule: "rrule:freq=weekly;byday=mo,we",
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, strPtr("FREQ=WEEKLY;BYDAY=MO,WE"), result.RecurrenceRule)
+		assert.Equal(t, strPtr("Europe/Berlin"), result.RecurrenceTimezone)
+	})
+
+	t.Run("needs a due date", func(t *testing.T) {
+		_, err := interactor.Create(ctx, memberActor("user-123"), &input.CreateTodoInput{
+			Title: "Standup", RecurrenceRule: "FREQ=DAILY",
+		})
+
+		assert.Equal(t, ErrRecurrenceNeedsDueDate, err)
+	})
+
+	t.Run("invalid rule", func(t *testing.T) {
+		_, err := interactor.Create(ctx, memberActor("user-123"), &input.CreateTodoInput{
+			Title: "Standup", DueDate: &due, RecurrenceRule: "FREQ=HOURLY",
+		})
+
+		assert.True(t, errors.Is(err, ErrInvalidRecurrence))
+	})
+
+	t.Run("invalid timezone", func(t *testing.T) {
+		_, err := interactor.Create(ctx, memberActor("user-123"), &input.CreateTodoInput{
+			Title: "Standup", DueDate: &due, RecurrenceRule: "FREQ=DAILY", RecurrenceTimezone: "Mars/Olympus_Mons",
+		})
+
+		assert.Equal(t, ErrInvalidTimezone, err)
+	})
+}
+
+func TestTodoInteractor_Update_Recurrence(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUnitOfWork := mock.NewMockIUnitOfWork(ctrl)
+	mockTodoRepo := mock.NewMockITodoRepository(ctrl)
+	mockReminderRepo := mock.NewMockIReminderRepository(ctrl)
+	mockUnitOfWork.EXPECT().RunInTenantTx(gomock.Any(), "tenant-123", gomock.Any()).DoAndReturn(runInTx).AnyTimes()
+
+	interactor := NewTodoInteractor(mockUnitOfWork, mockTodoRepo, mock.NewMockITodoActivityRepository(ctrl), mockReminderRepo, mock.NewMockITagRepository(ctrl), mock.NewMockIProjectRepository(ctrl), mock.NewMockIUserRepository(ctrl), mock.NewMockIMembershipRepository(ctrl), mock.NewMockIMailRepository(ctrl), NewPermissionEvaluator(DefaultPermissionRules()), mocku.NewMockUUIDGenerator("todo-2", "todo-3"), mocku.NewMockClock(time.Now()), DefaultMaxSubtaskDepth)
+
+	ctx := context.Background()
+	newYork, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+	// The Saturday before clocks spring forward
+	due := time.Date(2024, 3, 9, 9, 0, 0, 0, newYork)
+
+	recurring := func(rule string) *model.Todo {
+		dueDate := due
+		return &model.Todo{
+			ID: "todo-1", TenantID: "tenant-123", UserID: "user-123", Title: "Water the plants",
+			Priority: model.TodoPriorityHigh, Position: "a5", DueDate: &dueDate,
+			RecurrenceRule: strPtr(rule), RecurrenceTimezone: strPtr("America/New_York"),
+		}
+	}
+	saved := func(ctx context.Context, todo *model.Todo, expectedVersion *int) (*model.Todo, error) {
+		return todo, nil
+	}
+
+	t.Run("completing creates the next occurrence", func(t *testing.T) {
+		todo := recurring("FREQ=DAILY;COUNT=3")
+		mockTodoRepo.EXPECT().FindByID(ctx, "todo-1").Return(todo, nil)
+		mockTodoRepo.EXPECT().LockPositions(ctx, "tenant-123").Return(nil)
+		mockTodoRepo.EXPECT().AdjacentPosition(ctx, "tenant-123", "a5", false, "").Return("a4", nil)
+		mockTodoRepo.EXPECT().Update(ctx, gomock.Any(), nil).DoAndReturn(saved)
+		var next *model.Todo
+		mockTodoRepo.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, todo *model.Todo) (*model.Todo, error) {
+			next = todo
+			return todo, nil
+		})
+		mockReminderRepo.EXPECT().FindByTodoID(ctx, "tenant-123", "todo-1").Return(nil, nil)
+
+		result, err := interactor.Update(ctx, memberActor("user-123"), &input.UpdateTodoInput{
+			ID: "todo-1", Title: todo.Title, Completed: true, DueDate: todo.DueDate,
+		})
+
+		require.NoError(t, err)
+		// The completed todo stays behind as history
+		assert.True(t, result.Completed)
+		assert.Nil(t, result.RecurrenceRule)
+		assert.Nil(t, result.RecurrenceTimezone)
+
+		require.NotNil(t, next)
+		assert.Equal(t, "todo-2", next.ID)
+		assert.False(t, next.Completed)
+		assert.Equal(t, "Water the plants", next.Title)
+		assert.Equal(t, model.TodoPriorityHigh, next.Priority)
+		assert.Equal(t, "a4V", next.Position)
+		assert.Equal(t, strPtr("FREQ=DAILY;COUNT=2"), next.RecurrenceRule)
+		assert.Equal(t, strPtr("America/New_York"), next.RecurrenceTimezone)
+		// Still 09:00 in New York on the Sunday, after clocks sprang forward
+		assert.True(t, time.Date(2024, 3, 10, 13, 0, 0, 0, time.UTC).Equal(*next.DueDate))
+	})
+
+	t.Run("the next occurrence keeps the assignee and reminders before the due date", func(t *testing.T) {
+		todo := recurring("FREQ=DAILY")
+		todo.AssigneeID = strPtr("user-456")
+		offset := 30
+		remindAt := due.Add(-time.Hour)
+		mockTodoRepo.EXPECT().FindByID(ctx, "todo-1").Return(todo, nil)
+		mockTodoRepo.EXPECT().LockPositions(ctx, "tenant-123").Return(nil)
+		mockTodoRepo.EXPECT().AdjacentPosition(ctx, "tenant-123", "a5", false, "").Return("a4", nil)
+		mockTodoRepo.EXPECT().Update(ctx, gomock.Any(), nil).DoAndReturn(saved)
+		var next *model.Todo
+		mockTodoRepo.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, todo *model.Todo) (*model.Todo, error) {
+			next = todo
+			return todo, nil
+		})
+		mockReminderRepo.EXPECT().FindByTodoID(ctx, "tenant-123", "todo-1").Return([]*model.Reminder{
+			{ID: "reminder-1", TenantID: "tenant-123", TodoID: "todo-1", OffsetMinutes: &offset, Status: model.ReminderStatusDelivered},
+			{ID: "reminder-2", TenantID: "tenant-123", TodoID: "todo-1", RemindAt: &remindAt, Status: model.ReminderStatusPending},
+		}, nil)
+		// Only the reminder relative to the due date moves on; the fixed one stays behind
+		mockReminderRepo.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, reminder *model.Reminder) (*model.Reminder, error) {
+			assert.Equal(t, "todo-3", reminder.TodoID)
+			assert.Equal(t, &offset, reminder.OffsetMinutes)
+			assert.Nil(t, reminder.RemindAt)
+			assert.Equal(t, model.ReminderStatusPending, reminder.Status)
+			return reminder, nil
+		})
+
+		// The assignee completes the occurrence
+		_, err := interactor.Update(ctx, memberActor("user-456"), &input.UpdateTodoInput{
+			ID: "todo-1", Title: todo.Title, Completed: true, DueDate: todo.DueDate,
+		})
+
+		require.NoError(t, err)
+		require.NotNil(t, next)
+		assert.Equal(t, "todo-3", next.ID)
+		assert.Equal(t, "user-123", next.UserID)
+		assert.Equal(t, strPtr("user-456"), next.AssigneeID)
+	})
+
+	t.Run("completing the last occurrence ends the series", func(t *testing.T) {
+		todo := recurring("FREQ=DAILY;COUNT=1")
+		mockTodoRepo.EXPECT().FindByID(ctx, "todo-1").Return(todo, nil)
+		mockTodoRepo.EXPECT().Update(ctx, gomock.Any(), nil).DoAndReturn(saved)
+
+		result, err := interactor.Update(ctx, memberActor("user-123"), &input.UpdateTodoInput{
+			ID: "todo-1", Title: todo.Title, Completed: true, DueDate: todo.DueDate,
+		})
+
+		require.NoError(t, err)
+		assert.True(t, result.Completed)
+		assert.Nil(t, result.RecurrenceRule)
+	})
+
+	t.Run("an occurrence past UNTIL ends the series", func(t *testing.T) {
+		todo := recurring("FREQ=WEEKLY;UNTIL=20240315")
+		mockTodoRepo.EXPECT().FindByID(ctx, "todo-1").Return(todo, nil)
+		mockTodoRepo.EXPECT().Update(ctx, gomock.Any(), nil).DoAndReturn(saved)
+
+		_, err := interactor.Update(ctx, memberActor("user-123"), &input.UpdateTodoInput{
+			ID: "todo-1", Title: todo.Title, Completed: true, DueDate: todo.DueDate,
+		})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("removing the due date stops the todo repeating", func(t *testing.T) {
+		todo := recurring("FREQ=DAILY")
+		mockTodoRepo.EXPECT().FindByID(ctx, "todo-1").Return(todo, nil)
+		mockTodoRepo.EXPECT().Update(ctx, gomock.Any(), nil).DoAndReturn(saved)
+
+		result, err := interactor.Update(ctx, memberActor("user-123"), &input.UpdateTodoInput{ID: "todo-1", Title: todo.Title})
+
+		require.NoError(t, err)
+		assert.Nil(t, result.RecurrenceRule)
+		assert.Nil(t, result.RecurrenceTimezone)
+	})
+
+	t.Run("a rule without a due date is refused", func(t *testing.T) {
+		todo := recurring("FREQ=DAILY")
+		mockTodoRepo.EXPECT().FindByID(ctx, "todo-1").Return(todo, nil)
+
+		_, err := interactor.Update(ctx, memberActor("user-123"), &input.UpdateTodoInput{
+			ID: "todo-1", Title: todo.Title, RecurrenceRule: strPtr("FREQ=WEEKLY"),
+		})
+
+		assert.Equal(t, ErrRecurrenceNeedsDueDate, err)
+	})
+
+	t.Run("patch replaces the rule and keeps the timezone", func(t *testing.T) {
+		todo := recurring("FREQ=DAILY")
+		mockTodoRepo.EXPECT().FindByID(ctx, "todo-1").Return(todo, nil)
+		mockTodoRepo.EXPECT().Update(ctx, gomock.Any(), nil).DoAndReturn(saved)
+
+		result, err := interactor.Patch(ctx, memberActor("user-123"), &input.PatchTodoInput{
+			ID: "todo-1", RecurrenceRule: strPtr("FREQ=MONTHLY;BYDAY=-1FR"),
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, strPtr("FREQ=MONTHLY;BYDAY=-1FR"), result.RecurrenceRule)
+		assert.Equal(t, strPtr("America/New_York"), result.RecurrenceTimezone)
+	})
+}
+
+func TestTodoInteractor_ListOccurrences(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTodoRepo := mock.NewMockITodoRepository(ctrl)
+	interactor := NewTodoInteractor(mock.NewMockIUnitOfWork(ctrl), mockTodoRepo, mock.NewMockITodoActivityRepository(ctrl), mock.NewMockIReminderRepository(ctrl), mock.NewMockITagRepository(ctrl), mock.NewMockIProjectRepository(ctrl), mock.NewMockIUserRepository(ctrl), mock.NewMockIMembershipRepository(ctrl), mock.NewMockIMailRepository(ctrl), NewPermissionEvaluator(DefaultPermissionRules()), mocku.NewMockUUIDGenerator(), mocku.NewMockClock(time.Now()), DefaultMaxSubtaskDepth)
+
+	ctx := context.Background()
+	due := time.Date(2024, 1, 31, 18, 0, 0, 0, time.UTC)
+
+	t.Run("previews the occurrences after the current one", func(t *testing.T) {
+		mockTodoRepo.EXPECT().FindByID(ctx, "todo-1").Return(&model.Todo{
+			ID: "todo-1", TenantID: "tenant-123", UserID: "user-123", DueDate: &due,
+			RecurrenceRule: strPtr("FREQ=MONTHLY;COUNT=3"), RecurrenceTimezone: strPtr("Asia/Tokyo"),
+		}, nil)
+
+		occurrences, err := interactor.ListOccurrences(ctx, memberActor("user-123"), "todo-1", 0)
+
+		require.NoError(t, err)
+		// 03:00 on February 1st in Tokyo, so the series falls on the 1st of each month
+		require.Len(t, occurrences, 2)
+		assert.Equal(t, "2024-03-01T03:00:00+09:00", occurrences[0].Format(time.RFC3339))
+		assert.Equal(t, "2024-04-01T03:00:00+09:00", occurrences[1].Format(time.RFC3339))
+	})
+
+	t.Run("a todo that does not repeat has none", func(t *testing.T) {
+		mockTodoRepo.EXPECT().FindByID(ctx, "todo-1").Return(&model.Todo{ID: "todo-1", TenantID: "tenant-123", UserID: "user-123", DueDate: &due}, nil)
+
+		occurrences, err := interactor.ListOccurrences(ctx, memberActor("user-123"), "todo-1", 3)
+
+		require.NoError(t, err)
+		assert.Empty(t, occurrences)
+	})
+
+	t.Run("hidden todos are not found", func(t *testing.T) {
+		mockTodoRepo.EXPECT().FindByID(ctx, "todo-1").Return(&model.Todo{ID: "todo-1", TenantID: "tenant-123", UserID: "other-user"}, nil)
+
+		_, err := interactor.ListOccurrences(ctx, memberActor("user-123"), "todo-1", 3)
+
+		assert.Equal(t, ErrTodoNotFound, err)
+	})
+
+	t.Run("count out of range", func(t *testing.T) {
+		_, err := interactor.ListOccurrences(ctx, memberActor("user-123"), "todo-1", MaxOccurrenceCount+1)
+
+		assert.Equal(t, ErrInvalidOccurrenceCount, err)
+	})
+}
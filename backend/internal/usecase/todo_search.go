@@ -0,0 +1,63 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/usecase/input"
+	"good-todo-go/internal/usecase/output"
+)
+
+const MaxSearchQueryLength = 200
+
+var ErrInvalidSearchQuery = errors.New("invalid search query")
+
+func (i *TodoInteractor) Search(ctx context.Context, actor input.Actor, inp *input.SearchTodosInput) ([]*output.TodoSearchResultOutput, error) {
+	query := strings.TrimSpace(inp.Query)
+	if query == "" || utf8.RuneCountInString(query) > MaxSearchQueryLength {
+		return nil, ErrInvalidSearchQuery
+	}
+	limit, err := todoPageSize(inp.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	// Search runs raw SQL, so scope it to the tenant with RLS rather than relying on the WHERE clause alone
+	var hits []*model.TodoSearchHit
+	err = i.unitOfWork.RunInTenantTx(ctx, actor.TenantID, func(ctx context.Context) error {
+		var err error
+		hits, err = i.todoRepo.Search(ctx, actor.TenantID, actor.UserID, query, limit)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*output.TodoSearchResultOutput, 0, len(hits))
+	for _, hit := range hits {
+		if !i.permission.Can(ctx, actor, ActionView, ResourceTodo, TodoTarget(hit.Todo)) {
+			continue
+		}
+		result = append(result, &output.TodoSearchResultOutput{
+			Todo:                 toTodoOutput(hit.Todo),
+			Rank:                 hit.Rank,
+			TitleHighlight:       highlightFallback(hit.TitleHighlight, query),
+			DescriptionHighlight: highlightFallback(hit.DescriptionHighlight, query),
+		})
+	}
+	return result, nil
+}
+
+// highlightFallback marks case-insensitive occurrences of query in snippets that
+// full-text search left unmarked, which happens for trigram-only matches on partial words.
+func highlightFallback(snippet, query string) string {
+	if strings.Contains(snippet, model.HighlightStart) {
+		return snippet
+	}
+	re := regexp.MustCompile("(?i)" + regexp.QuoteMeta(query))
+	return re.ReplaceAllString(snippet, model.HighlightStart+"${0}"+model.HighlightEnd)
+}
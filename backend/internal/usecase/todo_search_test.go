@@ -0,0 +1,95 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/domain/repository/mock"
+	mocku "good-todo-go/internal/pkg/mock"
+	"good-todo-go/internal/usecase/input"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+)
+
+func TestTodoInteractor_Search(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUnitOfWork := mock.NewMockIUnitOfWork(ctrl)
+	mockTodoRepo := mock.NewMockITodoRepository(ctrl)
+
+	interactor := NewTodoInteractor(mockUnitOfWork, mockTodoRepo, mock.NewMockITodoActivityRepository(ctrl), mock.NewMockIReminderRepository(ctrl), mock.NewMockITagRepository(ctrl), mock.NewMockIProjectRepository(ctrl), mock.NewMockIUserRepository(ctrl), mock.NewMockIMembershipRepository(ctrl), mock.NewMockIMailRepository(ctrl), NewPermissionEvaluator(DefaultPermissionRules()), mocku.NewMockUUIDGenerator(), mocku.NewMockClock(time.Now()), DefaultMaxSubtaskDepth)
+
+	t.Run("success", func(t *testing.T) {
+		ctx := context.Background()
+		actor := memberActor("user-123")
+
+		mockUnitOfWork.EXPECT().
+			RunInTenantTx(ctx, "tenant-123", gomock.Any()).
+			DoAndReturn(runInTx)
+
+		mockTodoRepo.EXPECT().
+			Search(ctx, "tenant-123", "user-123", "milk", DefaultTodoPageSize).
+			Return([]*model.TodoSearchHit{
+				{
+					Todo:           &model.Todo{ID: "todo-1", TenantID: "tenant-123", UserID: "user-123", Title: "Buy milk"},
+					Rank:           0.6,
+					TitleHighlight: "Buy " + model.HighlightStart + "milk" + model.HighlightEnd,
+				},
+				{
+					Todo:           &model.Todo{ID: "todo-2", TenantID: "tenant-123", UserID: "user-456", Title: "Oatmilk", IsPublic: true},
+					TitleHighlight: "Oatmilk",
+				},
+				{
+					Todo:           &model.Todo{ID: "todo-3", TenantID: "tenant-123", UserID: "user-456", Title: "Private milk"},
+					TitleHighlight: "Private milk",
+				},
+			}, nil)
+
+		result, err := interactor.Search(ctx, actor, &input.SearchTodosInput{Query: "  milk "})
+
+		require.NoError(t, err)
+		require.Len(t, result, 2)
+		assert.Equal(t, "todo-1", result[0].Todo.ID)
+		assert.Equal(t, "Buy "+model.HighlightStart+"milk"+model.HighlightEnd, result[0].TitleHighlight)
+		// Trigram-only matches are highlighted as substrings
+		assert.Equal(t, "Oat"+model.HighlightStart+"milk"+model.HighlightEnd, result[1].TitleHighlight)
+	})
+
+	t.Run("empty query", func(t *testing.T) {
+		_, err := interactor.Search(context.Background(), memberActor("user-123"), &input.SearchTodosInput{Query: "   "})
+
+		assert.Equal(t, ErrInvalidSearchQuery, err)
+	})
+
+	t.Run("query too long", func(t *testing.T) {
+		_, err := interactor.Search(context.Background(), memberActor("user-123"), &input.SearchTodosInput{Query: strings.Repeat("a", MaxSearchQueryLength+1)})
+
+		assert.Equal(t, ErrInvalidSearchQuery, err)
+	})
+}
+
+func TestHighlightFallback(t *testing.T) {
+	tests := []struct {
+		name    string
+		snippet string
+		query   string
+		want    string
+	}{
+		{"already highlighted", "Buy \x02milk\x03", "mil", "Buy \x02milk\x03"},
+		{"case insensitive", "Oat Milk", "milk", "Oat \x02Milk\x03"},
+		{"regexp characters are literal", "Fix a.b (c)", "a.b (", "Fix \x02a.b (\x03c)"},
+		{"no match", "Buy bread", "milk", "Buy bread"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, highlightFallback(tt.snippet, tt.query))
+		})
+	}
+}
@@ -0,0 +1,173 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/usecase/input"
+	"good-todo-go/internal/usecase/output"
+)
+
+// Snooze presets. Later today is LaterTodaySnooze from now; tomorrow and next week wake
+// the todo at SnoozeMorningHour in the actor's timezone, next week on the coming Monday.
+const (
+	SnoozeLaterToday = "later_today"
+	SnoozeTomorrow   = "tomorrow"
+	SnoozeNextWeek   = "next_week"
+)
+
+const (
+	LaterTodaySnooze  = 3 * time.Hour
+	SnoozeMorningHour = 8
+)
+
+var (
+	ErrInvalidSnooze       = errors.New("snooze needs exactly one of preset or until")
+	ErrUnknownSnoozePreset = errors.New("preset must be one of later_today, tomorrow or next_week")
+	ErrSnoozeInPast        = errors.New("snooze time must be in the future")
+)
+
+// Snooze hides the todo from listings until the chosen time. Listings compare against
+// the current time, so the todo comes back by itself once that time passes.
+func (i *TodoInteractor) Snooze(ctx context.Context, actor input.Actor, inp *input.SnoozeTodoInput) (*output.TodoOutput, error) {
+	if (inp.Preset == "") == (inp.Until == nil) {
+		return nil, ErrInvalidSnooze
+	}
+
+	todo, err := i.findTodoToChange(ctx, actor, inp.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := i.clock.Now()
+	until := inp.Until
+	if inp.Preset != "" {
+		loc, err := i.userLocation(ctx, actor.UserID)
+		if err != nil {
+			return nil, err
+		}
+		at, err := snoozePresetTime(inp.Preset, now.In(loc))
+		if err != nil {
+			return nil, err
+		}
+		until = &at
+	}
+	if !until.After(now) {
+		return nil, ErrSnoozeInPast
+	}
+
+	return i.snooze(ctx, actor, todo.ID, until)
+}
+
+// Unsnooze clears the todo's snooze. A todo that is not snoozed is returned unchanged.
+func (i *TodoInteractor) Unsnooze(ctx context.Context, actor input.Actor, todoID string) (*output.TodoOutput, error) {
+	todo, err := i.findTodoToChange(ctx, actor, todoID)
+	if err != nil {
+		return nil, err
+	}
+	if todo.SnoozedUntil == nil {
+		return toTodoOutput(todo), nil
+	}
+
+	return i.snooze(ctx, actor, todo.ID, nil)
+}
+
+// snooze saves only the todo's snooze, so edits made since it was loaded are kept, and
+// records the change in the todo's activity.
+func (i *TodoInteractor) snooze(ctx context.Context, actor input.Actor, todoID string, until *time.Time) (*output.TodoOutput, error) {
+	action := model.TodoActivitySnoozed
+	if until == nil {
+		action = model.TodoActivityUnsnoozed
+	}
+
+	var snoozed *model.Todo
+	err := i.unitOfWork.RunInTenantTx(ctx, actor.TenantID, func(ctx context.Context) error {
+		var err error
+		snoozed, err = i.todoRepo.Snooze(ctx, todoID, until)
+		if err != nil || snoozed == nil {
+			return err
+		}
+		_, err = i.activityRepo.Create(ctx, &model.TodoActivity{
+			ID:           i.uuidGenerator.Generate(),
+			TenantID:     snoozed.TenantID,
+			TodoID:       snoozed.ID,
+			ActorID:      actor.UserID,
+			Action:       action,
+			SnoozedUntil: until,
+		})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if snoozed == nil {
+		return nil, ErrTodoNotFound
+	}
+	return toTodoOutput(snoozed), nil
+}
+
+// ListActivity returns the history of a todo the actor can see, oldest first. Only
+// explicit snoozes and unsnoozes are in it, not snoozes running out.
+func (i *TodoInteractor) ListActivity(ctx context.Context, actor input.Actor, todoID string) ([]*output.TodoActivityOutput, error) {
+	todo, err := i.todoRepo.FindByID(ctx, todoID)
+	if err != nil {
+		return nil, err
+	}
+	if todo == nil || !i.permission.Can(ctx, actor, ActionView, ResourceTodo, TodoTarget(todo)) {
+		return nil, ErrTodoNotFound
+	}
+
+	activities, err := i.activityRepo.FindByTodoID(ctx, actor.TenantID, todo.ID)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*output.TodoActivityOutput, len(activities))
+	for j, activity := range activities {
+		result[j] = &output.TodoActivityOutput{
+			ID:           activity.ID,
+			TodoID:       activity.TodoID,
+			ActorID:      activity.ActorID,
+			Action:       string(activity.Action),
+			SnoozedUntil: activity.SnoozedUntil,
+			CreatedAt:    activity.CreatedAt,
+		}
+	}
+	return result, nil
+}
+
+// findTodoToChange loads a live todo the actor may update. Todos the actor cannot see
+// are reported as not found.
+func (i *TodoInteractor) findTodoToChange(ctx context.Context, actor input.Actor, todoID string) (*model.Todo, error) {
+	todo, err := i.todoRepo.FindByID(ctx, todoID)
+	if err != nil {
+		return nil, err
+	}
+	if todo == nil || !i.permission.Can(ctx, actor, ActionView, ResourceTodo, TodoTarget(todo)) {
+		return nil, ErrTodoNotFound
+	}
+	if !i.permission.Can(ctx, actor, ActionUpdate, ResourceTodo, TodoTarget(todo)) {
+		return nil, ErrNotTodoOwner
+	}
+	return todo, nil
+}
+
+// snoozePresetTime resolves preset as seen at now, whose location decides where days start.
+func snoozePresetTime(preset string, now time.Time) (time.Time, error) {
+	morning := time.Date(now.Year(), now.Month(), now.Day(), SnoozeMorningHour, 0, 0, 0, now.Location())
+	switch preset {
+	case SnoozeLaterToday:
+		return now.Add(LaterTodaySnooze), nil
+	case SnoozeTomorrow:
+		// AddDate keeps the wall-clock hour across daylight saving changes
+		return morning.AddDate(0, 0, 1), nil
+	case SnoozeNextWeek:
+		days := (int(time.Monday) - int(now.Weekday()) + 7) % 7
+		if days == 0 {
+			days = 7
+		}
+		return morning.AddDate(0, 0, days), nil
+	}
+	return time.Time{}, ErrUnknownSnoozePreset
+}
@@ -0,0 +1,234 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/domain/repository/mock"
+	mocku "good-todo-go/internal/pkg/mock"
+	"good-todo-go/internal/usecase/input"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+)
+
+func TestTodoInteractor_Snooze(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUnitOfWork := mock.NewMockIUnitOfWork(ctrl)
+	mockTodoRepo := mock.NewMockITodoRepository(ctrl)
+	mockActivityRepo := mock.NewMockITodoActivityRepository(ctrl)
+	mockUserRepo := mock.NewMockIUserRepository(ctrl)
+	mockUnitOfWork.EXPECT().RunInTenantTx(gomock.Any(), "tenant-123", gomock.Any()).DoAndReturn(runInTx).AnyTimes()
+	// Sunday evening in New York is already Monday morning in Tokyo
+	clock := mocku.NewMockClock(time.Date(2024, 3, 10, 23, 30, 0, 0, time.UTC))
+
+	interactor := NewTodoInteractor(mockUnitOfWork, mockTodoRepo, mockActivityRepo, mock.NewMockIReminderRepository(ctrl), mock.NewMockITagRepository(ctrl), mock.NewMockIProjectRepository(ctrl), mockUserRepo, mock.NewMockIMembershipRepository(ctrl), mock.NewMockIMailRepository(ctrl), NewPermissionEvaluator(DefaultPermissionRules()), mocku.NewMockUUIDGenerator(), clock, DefaultMaxSubtaskDepth)
+
+	ctx := context.Background()
+	actor := memberActor("user-123")
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	require.NoError(t, err)
+	newYork, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+
+	todo := func(userID string) *model.Todo {
+		return &model.Todo{ID: "todo-1", TenantID: "tenant-123", UserID: userID, Title: "Call the bank", Version: 1}
+	}
+	snoozed := func(ctx context.Context, id string, until *time.Time) (*model.Todo, error) {
+		saved := todo("user-123")
+		saved.SnoozedUntil = until
+		saved.Version++
+		return saved, nil
+	}
+	recorded := func(ctx context.Context, activity *model.TodoActivity) (*model.TodoActivity, error) {
+		return activity, nil
+	}
+
+	presets := []struct {
+		name     string
+		preset   string
+		timezone string
+		want     time.Time
+	}{
+		{"later today is three hours out", "later_today", "Asia/Tokyo", clock.At.Add(3 * time.Hour)},
+		{"tomorrow morning in the user's timezone", "tomorrow", "Asia/Tokyo", time.Date(2024, 3, 12, 8, 0, 0, 0, tokyo)},
+		{"next week on a Monday skips to the following Monday", "next_week", "Asia/Tokyo", time.Date(2024, 3, 18, 8, 0, 0, 0, tokyo)},
+		{"next week on a Sunday is the next day", "next_week", "America/New_York", time.Date(2024, 3, 11, 8, 0, 0, 0, newYork)},
+	}
+	for _, tt := range presets {
+		t.Run(tt.name, func(t *testing.T) {
+			mockTodoRepo.EXPECT().FindByID(ctx, "todo-1").Return(todo("user-123"), nil)
+			mockUserRepo.EXPECT().FindByID(ctx, "user-123").Return(&model.User{ID: "user-123", Timezone: tt.timezone}, nil)
+			mockTodoRepo.EXPECT().Snooze(ctx, "todo-1", gomock.Any()).DoAndReturn(snoozed)
+			mockActivityRepo.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(recorded)
+
+			result, err := interactor.Snooze(ctx, actor, &input.SnoozeTodoInput{ID: "todo-1", Preset: tt.preset})
+
+			require.NoError(t, err)
+			require.NotNil(t, result.SnoozedUntil)
+			assert.True(t, tt.want.Equal(*result.SnoozedUntil), "got %s", result.SnoozedUntil)
+			assert.Equal(t, 2, result.Version)
+		})
+	}
+
+	t.Run("until a given time", func(t *testing.T) {
+		until := clock.At.Add(48 * time.Hour)
+		mockTodoRepo.EXPECT().FindByID(ctx, "todo-1").Return(todo("user-123"), nil)
+		mockTodoRepo.EXPECT().Snooze(ctx, "todo-1", &until).DoAndReturn(snoozed)
+		mockActivityRepo.EXPECT().Create(ctx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, activity *model.TodoActivity) (*model.TodoActivity, error) {
+				assert.Equal(t, "tenant-123", activity.TenantID)
+				assert.Equal(t, "todo-1", activity.TodoID)
+				assert.Equal(t, "user-123", activity.ActorID)
+				assert.Equal(t, model.TodoActivitySnoozed, activity.Action)
+				assert.Equal(t, &until, activity.SnoozedUntil)
+				return activity, nil
+			})
+
+		result, err := interactor.Snooze(ctx, actor, &input.SnoozeTodoInput{ID: "todo-1", Until: &until})
+
+		require.NoError(t, err)
+		require.NotNil(t, result.SnoozedUntil)
+		assert.True(t, until.Equal(*result.SnoozedUntil))
+	})
+
+	t.Run("trashed before the snooze is saved", func(t *testing.T) {
+		until := clock.At.Add(48 * time.Hour)
+		mockTodoRepo.EXPECT().FindByID(ctx, "todo-1").Return(todo("user-123"), nil)
+		mockTodoRepo.EXPECT().Snooze(ctx, "todo-1", &until).Return(nil, nil)
+
+		_, err := interactor.Snooze(ctx, actor, &input.SnoozeTodoInput{ID: "todo-1", Until: &until})
+
+		assert.Equal(t, ErrTodoNotFound, err)
+	})
+
+	t.Run("time in the past", func(t *testing.T) {
+		until := clock.At.Add(-time.Minute)
+		mockTodoRepo.EXPECT().FindByID(ctx, "todo-1").Return(todo("user-123"), nil)
+
+		_, err := interactor.Snooze(ctx, actor, &input.SnoozeTodoInput{ID: "todo-1", Until: &until})
+
+		assert.Equal(t, ErrSnoozeInPast, err)
+	})
+
+	t.Run("needs exactly one of preset and until", func(t *testing.T) {
+		until := clock.At.Add(time.Hour)
+
+		_, err := interactor.Snooze(ctx, actor, &input.SnoozeTodoInput{ID: "todo-1"})
+		assert.Equal(t, ErrInvalidSnooze, err)
+
+		_, err = interactor.Snooze(ctx, actor, &input.SnoozeTodoInput{ID: "todo-1", Preset: "tomorrow", Until: &until})
+		assert.Equal(t, ErrInvalidSnooze, err)
+	})
+
+	t.Run("unknown preset", func(t *testing.T) {
+		mockTodoRepo.EXPECT().FindByID(ctx, "todo-1").Return(todo("user-123"), nil)
+		mockUserRepo.EXPECT().FindByID(ctx, "user-123").Return(&model.User{ID: "user-123", Timezone: "UTC"}, nil)
+
+		_, err := interactor.Snooze(ctx, actor, &input.SnoozeTodoInput{ID: "todo-1", Preset: "someday"})
+
+		assert.Equal(t, ErrUnknownSnoozePreset, err)
+	})
+
+	t.Run("someone else's private todo is not found", func(t *testing.T) {
+		mockTodoRepo.EXPECT().FindByID(ctx, "todo-1").Return(todo("other-user"), nil)
+
+		_, err := interactor.Snooze(ctx, actor, &input.SnoozeTodoInput{ID: "todo-1", Preset: "tomorrow"})
+
+		assert.Equal(t, ErrTodoNotFound, err)
+	})
+
+	t.Run("someone else's public todo cannot be snoozed", func(t *testing.T) {
+		public := todo("other-user")
+		public.IsPublic = true
+		mockTodoRepo.EXPECT().FindByID(ctx, "todo-1").Return(public, nil)
+
+		_, err := interactor.Snooze(ctx, actor, &input.SnoozeTodoInput{ID: "todo-1", Preset: "tomorrow"})
+
+		assert.Equal(t, ErrNotTodoOwner, err)
+	})
+}
+
+func TestTodoInteractor_Unsnooze(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUnitOfWork := mock.NewMockIUnitOfWork(ctrl)
+	mockTodoRepo := mock.NewMockITodoRepository(ctrl)
+	mockActivityRepo := mock.NewMockITodoActivityRepository(ctrl)
+	clock := mocku.NewMockClock(time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC))
+
+	interactor := NewTodoInteractor(mockUnitOfWork, mockTodoRepo, mockActivityRepo, mock.NewMockIReminderRepository(ctrl), mock.NewMockITagRepository(ctrl), mock.NewMockIProjectRepository(ctrl), mock.NewMockIUserRepository(ctrl), mock.NewMockIMembershipRepository(ctrl), mock.NewMockIMailRepository(ctrl), NewPermissionEvaluator(DefaultPermissionRules()), mocku.NewMockUUIDGenerator(), clock, DefaultMaxSubtaskDepth)
+
+	ctx := context.Background()
+	actor := memberActor("user-123")
+
+	t.Run("clears the snooze", func(t *testing.T) {
+		until := clock.At.Add(time.Hour)
+		mockTodoRepo.EXPECT().FindByID(ctx, "todo-1").Return(&model.Todo{ID: "todo-1", TenantID: "tenant-123", UserID: "user-123", SnoozedUntil: &until}, nil)
+		mockUnitOfWork.EXPECT().RunInTenantTx(ctx, "tenant-123", gomock.Any()).DoAndReturn(runInTx)
+		mockTodoRepo.EXPECT().Snooze(ctx, "todo-1", nil).Return(&model.Todo{ID: "todo-1", TenantID: "tenant-123", UserID: "user-123"}, nil)
+		mockActivityRepo.EXPECT().Create(ctx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, activity *model.TodoActivity) (*model.TodoActivity, error) {
+				assert.Equal(t, model.TodoActivityUnsnoozed, activity.Action)
+				assert.Nil(t, activity.SnoozedUntil)
+				return activity, nil
+			})
+
+		result, err := interactor.Unsnooze(ctx, actor, "todo-1")
+
+		require.NoError(t, err)
+		assert.Nil(t, result.SnoozedUntil)
+	})
+
+	t.Run("a todo that is not snoozed is left alone", func(t *testing.T) {
+		mockTodoRepo.EXPECT().FindByID(ctx, "todo-1").Return(&model.Todo{ID: "todo-1", TenantID: "tenant-123", UserID: "user-123", Version: 4}, nil)
+
+		result, err := interactor.Unsnooze(ctx, actor, "todo-1")
+
+		require.NoError(t, err)
+		assert.Equal(t, 4, result.Version)
+	})
+}
+
+func TestTodoInteractor_ListActivity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTodoRepo := mock.NewMockITodoRepository(ctrl)
+	mockActivityRepo := mock.NewMockITodoActivityRepository(ctrl)
+
+	interactor := NewTodoInteractor(mock.NewMockIUnitOfWork(ctrl), mockTodoRepo, mockActivityRepo, mock.NewMockIReminderRepository(ctrl), mock.NewMockITagRepository(ctrl), mock.NewMockIProjectRepository(ctrl), mock.NewMockIUserRepository(ctrl), mock.NewMockIMembershipRepository(ctrl), mock.NewMockIMailRepository(ctrl), NewPermissionEvaluator(DefaultPermissionRules()), mocku.NewMockUUIDGenerator(), mocku.NewMockClock(time.Now()), DefaultMaxSubtaskDepth)
+
+	ctx := context.Background()
+
+	t.Run("lists the todo's history", func(t *testing.T) {
+		until := time.Date(2024, 3, 11, 8, 0, 0, 0, time.UTC)
+		mockTodoRepo.EXPECT().FindByID(ctx, "todo-1").Return(&model.Todo{ID: "todo-1", TenantID: "tenant-123", UserID: "user-123"}, nil)
+		mockActivityRepo.EXPECT().FindByTodoID(ctx, "tenant-123", "todo-1").Return([]*model.TodoActivity{
+			{ID: "activity-1", TodoID: "todo-1", ActorID: "user-123", Action: model.TodoActivitySnoozed, SnoozedUntil: &until},
+			{ID: "activity-2", TodoID: "todo-1", ActorID: "user-123", Action: model.TodoActivityUnsnoozed},
+		}, nil)
+
+		result, err := interactor.ListActivity(ctx, memberActor("user-123"), "todo-1")
+
+		require.NoError(t, err)
+		require.Len(t, result, 2)
+		assert.Equal(t, "snoozed", result[0].Action)
+		assert.Equal(t, &until, result[0].SnoozedUntil)
+		assert.Equal(t, "unsnoozed", result[1].Action)
+	})
+
+	t.Run("someone else's private todo is not found", func(t *testing.T) {
+		mockTodoRepo.EXPECT().FindByID(ctx, "todo-1").Return(&model.Todo{ID: "todo-1", TenantID: "tenant-123", UserID: "other-user"}, nil)
+
+		_, err := interactor.ListActivity(ctx, memberActor("user-123"), "todo-1")
+
+		assert.Equal(t, ErrTodoNotFound, err)
+	})
+}
@@ -0,0 +1,164 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/usecase/input"
+	"good-todo-go/internal/usecase/output"
+)
+
+// DefaultMaxSubtaskDepth lets a top-level todo have subtasks that have subtasks of their own.
+const DefaultMaxSubtaskDepth = 3
+
+var (
+	ErrParentTodoNotFound = errors.New("parent todo not found")
+	ErrSubtaskTooDeep     = errors.New("subtasks nested too deep")
+	ErrTodoCycle          = errors.New("todo cannot be its own subtask")
+	ErrTodoHasSubtasks    = errors.New("todo has subtasks")
+)
+
+func (i *TodoInteractor) ListSubtasks(ctx context.Context, actor input.Actor, todoID string) ([]*output.TodoOutput, error) {
+	todo, err := i.todoRepo.FindByID(ctx, todoID)
+	if err != nil {
+		return nil, err
+	}
+	if todo == nil || !i.permission.Can(ctx, actor, ActionView, ResourceTodo, TodoTarget(todo)) {
+		return nil, ErrTodoNotFound
+	}
+
+	subtasks, err := i.todoRepo.FindSubtasks(ctx, []string{todo.ID})
+	if err != nil {
+		return nil, err
+	}
+	return i.toVisibleTodoOutputs(ctx, actor, subtasks), nil
+}
+
+// setParent makes todo a subtask of parentID, or a top-level todo when it is empty. Adding
+// a subtask changes the parent, so the actor must be allowed to edit it. The move must not
+// put a todo below itself, and its deepest subtask must stay within the depth limit.
+func (i *TodoInteractor) setParent(ctx context.Context, actor input.Actor, todo *model.Todo, parentID string) error {
+	if parentID == "" {
+		todo.ParentID = nil
+		return nil
+	}
+
+	parent, err := i.todoRepo.FindByID(ctx, parentID)
+	if err != nil {
+		return err
+	}
+	if parent == nil || !i.permission.Can(ctx, actor, ActionView, ResourceTodo, TodoTarget(parent)) {
+		return ErrParentTodoNotFound
+	}
+	if !i.permission.Can(ctx, actor, ActionUpdate, ResourceTodo, TodoTarget(parent)) {
+		return ErrNotTodoOwner
+	}
+
+	depth, err := i.todoDepth(ctx, parent, todo.ID)
+	if err != nil {
+		return err
+	}
+	height, err := i.subtaskHeight(ctx, todo)
+	if err != nil {
+		return err
+	}
+	if depth+height > i.maxSubtaskDepth {
+		return ErrSubtaskTooDeep
+	}
+
+	todo.ParentID = &parent.ID
+	return nil
+}
+
+// todoDepth returns the level todo sits at, 1 for a top-level todo. It fails with
+// ErrTodoCycle when movingID is todo or one of its parents, and stops counting once
+// the depth limit is passed.
+func (i *TodoInteractor) todoDepth(ctx context.Context, todo *model.Todo, movingID string) (int, error) {
+	depth := 1
+	for current := todo; ; depth++ {
+		if current.ID == movingID {
+			return 0, ErrTodoCycle
+		}
+		if current.ParentID == nil || depth > i.maxSubtaskDepth {
+			return depth, nil
+		}
+		parent, err := i.todoRepo.FindByIDWithTrashed(ctx, *current.ParentID)
+		if err != nil {
+			return 0, err
+		}
+		if parent == nil {
+			return depth, nil
+		}
+		current = parent
+	}
+}
+
+// subtaskHeight returns how many levels todo and its subtasks span, 1 for a todo without
+// subtasks. It stops counting once the depth limit is passed.
+func (i *TodoInteractor) subtaskHeight(ctx context.Context, todo *model.Todo) (int, error) {
+	height := 1
+	for level := []*model.Todo{todo}; height <= i.maxSubtaskDepth; height++ {
+		ids := withSubtasks(level)
+		if len(ids) == 0 {
+			break
+		}
+		var err error
+		if level, err = i.todoRepo.FindSubtasks(ctx, ids); err != nil {
+			return 0, err
+		}
+	}
+	return height, nil
+}
+
+// subtaskTree returns every live subtask below todo, each level before the next.
+func (i *TodoInteractor) subtaskTree(ctx context.Context, todo *model.Todo) ([]*model.Todo, error) {
+	var tree []*model.Todo
+	for level := []*model.Todo{todo}; ; {
+		ids := withSubtasks(level)
+		if len(ids) == 0 {
+			return tree, nil
+		}
+		var err error
+		if level, err = i.todoRepo.FindSubtasks(ctx, ids); err != nil {
+			return nil, err
+		}
+		tree = append(tree, level...)
+	}
+}
+
+// completeParents completes the todo parentID once its last open subtask is done, if it
+// asked for that and nothing blocks it, and carries on up the tree.
+func (i *TodoInteractor) completeParents(ctx context.Context, parentID string, completedAt time.Time) error {
+	for {
+		parent, err := i.todoRepo.FindByID(ctx, parentID)
+		if err != nil {
+			return err
+		}
+		if parent == nil || !parent.AutoComplete || parent.Completed || parent.CompletedSubtaskCount < parent.SubtaskCount || parent.BlockedCount > 0 {
+			return nil
+		}
+
+		parent.Completed = true
+		parent.CompletedAt = &completedAt
+		if _, err := i.todoRepo.Update(ctx, parent, nil); err != nil {
+			return err
+		}
+		if parent.ParentID == nil {
+			return nil
+		}
+		parentID = *parent.ParentID
+	}
+}
+
+// withSubtasks returns the ids of the todos that have live subtasks.
+func withSubtasks(todos []*model.Todo) []string {
+	var ids []string
+	for _, todo := range todos {
+		if todo.SubtaskCount > 0 {
+			ids = append(ids, todo.ID)
+		}
+	}
+	return ids
+}
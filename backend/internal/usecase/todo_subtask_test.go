@@ -0,0 +1,205 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/domain/repository/mock"
+	mocku "good-todo-go/internal/pkg/mock"
+	"good-todo-go/internal/usecase/input"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+)
+
+func TestTodoInteractor_Subtasks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUnitOfWork := mock.NewMockIUnitOfWork(ctrl)
+	mockTodoRepo := mock.NewMockITodoRepository(ctrl)
+	mockUUID := mocku.NewMockUUIDGenerator("subtask-id", "subtask-id")
+
+	interactor := NewTodoInteractor(mockUnitOfWork, mockTodoRepo, mock.NewMockITodoActivityRepository(ctrl), mock.NewMockIReminderRepository(ctrl), mock.NewMockITagRepository(ctrl), mock.NewMockIProjectRepository(ctrl), mock.NewMockIUserRepository(ctrl), mock.NewMockIMembershipRepository(ctrl), mock.NewMockIMailRepository(ctrl), NewPermissionEvaluator(DefaultPermissionRules()), mockUUID, mocku.NewMockClock(time.Now()), DefaultMaxSubtaskDepth)
+
+	todo := func(id string, parentID *string) *model.Todo {
+		return &model.Todo{ID: id, TenantID: "tenant-123", UserID: "user-123", Title: id, ParentID: parentID}
+	}
+	ptr := func(s string) *string { return &s }
+
+	t.Run("creates a subtask", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockTodoRepo.EXPECT().FindByID(ctx, "parent").Return(todo("parent", nil), nil)
+		mockUnitOfWork.EXPECT().RunInTenantTx(ctx, "tenant-123", gomock.Any()).DoAndReturn(runInTx)
+		mockTodoRepo.EXPECT().LockPositions(ctx, "tenant-123").Return(nil)
+		mockTodoRepo.EXPECT().AdjacentPosition(ctx, "tenant-123", "", true, "").Return("", nil)
+		mockTodoRepo.EXPECT().
+			Create(ctx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, todo *model.Todo) (*model.Todo, error) {
+				require.NotNil(t, todo.ParentID)
+				assert.Equal(t, "parent", *todo.ParentID)
+				return todo, nil
+			})
+
+		result, err := interactor.Create(ctx, memberActor("user-123"), &input.CreateTodoInput{Title: "Step", ParentID: ptr("parent")})
+
+		require.NoError(t, err)
+		assert.Equal(t, "parent", *result.ParentID)
+	})
+
+	t.Run("parent of another member must be editable", func(t *testing.T) {
+		ctx := context.Background()
+		parent := todo("parent", nil)
+		parent.UserID = "other-user"
+		parent.IsPublic = true
+
+		mockTodoRepo.EXPECT().FindByID(ctx, "parent").Return(parent, nil)
+
+		_, err := interactor.Create(ctx, memberActor("user-123"), &input.CreateTodoInput{Title: "Step", ParentID: ptr("parent")})
+
+		assert.Equal(t, ErrNotTodoOwner, err)
+	})
+
+	t.Run("unknown parent", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockTodoRepo.EXPECT().FindByID(ctx, "missing").Return(nil, nil)
+
+		_, err := interactor.Create(ctx, memberActor("user-123"), &input.CreateTodoInput{Title: "Step", ParentID: ptr("missing")})
+
+		assert.Equal(t, ErrParentTodoNotFound, err)
+	})
+
+	t.Run("nesting beyond the depth limit", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockTodoRepo.EXPECT().FindByID(ctx, "level-3").Return(todo("level-3", ptr("level-2")), nil)
+		mockTodoRepo.EXPECT().FindByIDWithTrashed(ctx, "level-2").Return(todo("level-2", ptr("level-1")), nil)
+		mockTodoRepo.EXPECT().FindByIDWithTrashed(ctx, "level-1").Return(todo("level-1", nil), nil)
+
+		_, err := interactor.Create(ctx, memberActor("user-123"), &input.CreateTodoInput{Title: "Step", ParentID: ptr("level-3")})
+
+		assert.Equal(t, ErrSubtaskTooDeep, err)
+	})
+
+	t.Run("moving a todo below its own subtask", func(t *testing.T) {
+		ctx := context.Background()
+		moving := todo("todo-1", nil)
+		moving.SubtaskCount = 1
+
+		mockUnitOfWork.EXPECT().RunInTenantTx(ctx, "tenant-123", gomock.Any()).DoAndReturn(runInTx)
+		mockTodoRepo.EXPECT().FindByID(ctx, "todo-1").Return(moving, nil)
+		mockTodoRepo.EXPECT().FindByID(ctx, "child").Return(todo("child", ptr("todo-1")), nil)
+		mockTodoRepo.EXPECT().FindByIDWithTrashed(ctx, "todo-1").Return(moving, nil)
+
+		_, err := interactor.Patch(ctx, memberActor("user-123"), &input.PatchTodoInput{ID: "todo-1", ParentID: ptr("child")})
+
+		assert.Equal(t, ErrTodoCycle, err)
+	})
+
+	t.Run("completing the last subtask completes the parent", func(t *testing.T) {
+		ctx := context.Background()
+		parent := todo("parent", nil)
+		parent.AutoComplete = true
+		parent.SubtaskCount = 2
+		parent.CompletedSubtaskCount = 2
+
+		mockUnitOfWork.EXPECT().RunInTenantTx(ctx, "tenant-123", gomock.Any()).DoAndReturn(runInTx)
+		mockTodoRepo.EXPECT().FindByID(ctx, "child").Return(todo("child", ptr("parent")), nil)
+		mockTodoRepo.EXPECT().
+			Update(ctx, gomock.Any(), nil).
+			DoAndReturn(func(ctx context.Context, todo *model.Todo, expectedVersion *int) (*model.Todo, error) {
+				return todo, nil
+			})
+		mockTodoRepo.EXPECT().FindByID(ctx, "parent").Return(parent, nil)
+		mockTodoRepo.EXPECT().
+			Update(ctx, gomock.Any(), nil).
+			DoAndReturn(func(ctx context.Context, todo *model.Todo, expectedVersion *int) (*model.Todo, error) {
+				assert.Equal(t, "parent", todo.ID)
+				assert.True(t, todo.Completed)
+				assert.NotNil(t, todo.CompletedAt)
+				return todo, nil
+			})
+
+		completed := true
+		result, err := interactor.Patch(ctx, memberActor("user-123"), &input.PatchTodoInput{ID: "child", Completed: &completed})
+
+		require.NoError(t, err)
+		assert.True(t, result.Completed)
+	})
+
+	t.Run("parent without auto-complete stays open", func(t *testing.T) {
+		ctx := context.Background()
+		parent := todo("parent", nil)
+		parent.SubtaskCount = 1
+		parent.CompletedSubtaskCount = 1
+
+		mockUnitOfWork.EXPECT().RunInTenantTx(ctx, "tenant-123", gomock.Any()).DoAndReturn(runInTx)
+		mockTodoRepo.EXPECT().FindByID(ctx, "child").Return(todo("child", ptr("parent")), nil)
+		mockTodoRepo.EXPECT().
+			Update(ctx, gomock.Any(), nil).
+			DoAndReturn(func(ctx context.Context, todo *model.Todo, expectedVersion *int) (*model.Todo, error) {
+				return todo, nil
+			})
+		mockTodoRepo.EXPECT().FindByID(ctx, "parent").Return(parent, nil)
+
+		completed := true
+		_, err := interactor.Patch(ctx, memberActor("user-123"), &input.PatchTodoInput{ID: "child", Completed: &completed})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("delete with subtasks needs cascade", func(t *testing.T) {
+		ctx := context.Background()
+		parent := todo("parent", nil)
+		parent.SubtaskCount = 1
+
+		mockTodoRepo.EXPECT().FindByID(ctx, "parent").Return(parent, nil)
+
+		err := interactor.Delete(ctx, memberActor("user-123"), &input.DeleteTodoInput{ID: "parent"})
+
+		assert.Equal(t, ErrTodoHasSubtasks, err)
+	})
+
+	t.Run("cascade trashes the whole tree", func(t *testing.T) {
+		ctx := context.Background()
+		parent := todo("parent", nil)
+		parent.SubtaskCount = 1
+		child := todo("child", ptr("parent"))
+		child.SubtaskCount = 1
+		grandchild := todo("grandchild", ptr("child"))
+
+		mockTodoRepo.EXPECT().FindByID(ctx, "parent").Return(parent, nil)
+		mockTodoRepo.EXPECT().FindSubtasks(ctx, []string{"parent"}).Return([]*model.Todo{child}, nil)
+		mockTodoRepo.EXPECT().FindSubtasks(ctx, []string{"child"}).Return([]*model.Todo{grandchild}, nil)
+		mockUnitOfWork.EXPECT().RunInTenantTx(ctx, "tenant-123", gomock.Any()).DoAndReturn(runInTx)
+		gomock.InOrder(
+			mockTodoRepo.EXPECT().Trash(ctx, "parent", gomock.Any(), nil).Return(nil),
+			mockTodoRepo.EXPECT().Trash(ctx, "child", gomock.Any(), nil).Return(nil),
+			mockTodoRepo.EXPECT().Trash(ctx, "grandchild", gomock.Any(), nil).Return(nil),
+		)
+
+		err := interactor.Delete(ctx, memberActor("user-123"), &input.DeleteTodoInput{ID: "parent", Cascade: true})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("cascade stops at subtasks the actor may not delete", func(t *testing.T) {
+		ctx := context.Background()
+		parent := todo("parent", nil)
+		parent.SubtaskCount = 1
+		child := todo("child", ptr("parent"))
+		child.UserID = "other-user"
+
+		mockTodoRepo.EXPECT().FindByID(ctx, "parent").Return(parent, nil)
+		mockTodoRepo.EXPECT().FindSubtasks(ctx, []string{"parent"}).Return([]*model.Todo{child}, nil)
+
+		err := interactor.Delete(ctx, memberActor("user-123"), &input.DeleteTodoInput{ID: "parent", Cascade: true})
+
+		assert.Equal(t, ErrNotTodoOwner, err)
+	})
+}
@@ -6,9 +6,11 @@ import (
 	"time"
 
 	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/domain/repository"
 	"good-todo-go/internal/domain/repository/mock"
 	mocku "good-todo-go/internal/pkg/mock"
 	"good-todo-go/internal/usecase/input"
+	"good-todo-go/internal/usecase/todoquery"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -19,10 +21,13 @@ func TestTodoInteractor_Create(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
+	mockUnitOfWork := mock.NewMockIUnitOfWork(ctrl)
 	mockTodoRepo := mock.NewMockITodoRepository(ctrl)
+	mockTagRepo := mock.NewMockITagRepository(ctrl)
 	mockUUID := mocku.NewMockUUIDGenerator("test-todo-id")
 
-	interactor := NewTodoInteractor(mockTodoRepo, mockUUID)
+	mockProjectRepo := mock.NewMockIProjectRepository(ctrl)
+	interactor := NewTodoInteractor(mockUnitOfWork, mockTodoRepo, mock.NewMockITodoActivityRepository(ctrl), mock.NewMockIReminderRepository(ctrl), mockTagRepo, mockProjectRepo, mock.NewMockIUserRepository(ctrl), mock.NewMockIMembershipRepository(ctrl), mock.NewMockIMailRepository(ctrl), NewPermissionEvaluator(DefaultPermissionRules()), mockUUID, mocku.NewMockClock(time.Now()), DefaultMaxSubtaskDepth)
 
 	t.Run("success", func(t *testing.T) {
 		ctx := context.Background()
@@ -46,11 +51,22 @@ func TestTodoInteractor_Create(t *testing.T) {
 			UpdatedAt:   time.Now(),
 		}
 
-		mockTodoRepo.EXPECT().
-			Create(ctx, gomock.Any()).
-			Return(expectedTodo, nil)
+		mockUnitOfWork.EXPECT().RunInTenantTx(ctx, tenantID, gomock.Any()).DoAndReturn(runInTx)
+		// The top key is read and written under the position lock
+		gomock.InOrder(
+			mockTodoRepo.EXPECT().LockPositions(ctx, tenantID).Return(nil),
+			mockTodoRepo.EXPECT().AdjacentPosition(ctx, tenantID, "", true, "").Return("a0", nil),
+			mockTodoRepo.EXPECT().
+				Create(ctx, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, todo *model.Todo) (*model.Todo, error) {
+					// New todos go on top of the manual order
+					assert.Equal(t, "Zz", todo.Position)
+					assert.Equal(t, model.TodoPriorityNone, todo.Priority)
+					return expectedTodo, nil
+				}),
+		)
 
-		result, err := interactor.Create(ctx, userID, tenantID, inp)
+		result, err := interactor.Create(ctx, memberActor(userID), inp)
 
 		require.NoError(t, err)
 		assert.Equal(t, "test-todo-id", result.ID)
@@ -58,6 +74,105 @@ func TestTodoInteractor_Create(t *testing.T) {
 		assert.Equal(t, inp.Description, result.Description)
 		assert.False(t, result.Completed)
 	})
+
+	t.Run("with priority", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockUnitOfWork.EXPECT().RunInTenantTx(ctx, "tenant-123", gomock.Any()).DoAndReturn(runInTx)
+		mockTodoRepo.EXPECT().LockPositions(ctx, "tenant-123").Return(nil)
+		mockTodoRepo.EXPECT().AdjacentPosition(ctx, "tenant-123", "", true, "").Return("", nil)
+		mockTodoRepo.EXPECT().
+			Create(ctx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, todo *model.Todo) (*model.Todo, error) {
+				return todo, nil
+			})
+
+		result, err := interactor.Create(ctx, memberActor("user-123"), &input.CreateTodoInput{Title: "Pay rent", Priority: "urgent"})
+
+		require.NoError(t, err)
+		assert.Equal(t, "urgent", result.Priority)
+	})
+
+	t.Run("unknown priority", func(t *testing.T) {
+		_, err := interactor.Create(context.Background(), memberActor("user-123"), &input.CreateTodoInput{Title: "Pay rent", Priority: "asap"})
+
+		assert.Equal(t, ErrInvalidPriority, err)
+	})
+
+	t.Run("with tags", func(t *testing.T) {
+		ctx := context.Background()
+		tags := []*model.Tag{
+			{ID: "tag-1", TenantID: "tenant-123", Name: "Home"},
+			{ID: "tag-2", TenantID: "tenant-123", Name: "Work"},
+		}
+
+		mockTagRepo.EXPECT().FindByIDs(ctx, "tenant-123", []string{"tag-1", "tag-2"}).Return(tags, nil)
+		mockUnitOfWork.EXPECT().RunInTenantTx(ctx, "tenant-123", gomock.Any()).DoAndReturn(runInTx)
+		mockTodoRepo.EXPECT().LockPositions(ctx, "tenant-123").Return(nil)
+		mockTodoRepo.EXPECT().AdjacentPosition(ctx, "tenant-123", "", true, "").Return("", nil)
+		mockTodoRepo.EXPECT().
+			Create(ctx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, todo *model.Todo) (*model.Todo, error) {
+				assert.Equal(t, tags, todo.Tags)
+				return todo, nil
+			})
+
+		result, err := interactor.Create(ctx, memberActor("user-123"), &input.CreateTodoInput{
+			Title:  "Tagged",
+			TagIDs: []string{"tag-2", "tag-1", "tag-2"},
+		})
+
+		require.NoError(t, err)
+		require.Len(t, result.Tags, 2)
+		assert.Equal(t, "Home", result.Tags[0].Name)
+	})
+
+	t.Run("unknown tag", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockTagRepo.EXPECT().
+			FindByIDs(ctx, "tenant-123", []string{"tag-1", "tag-other-tenant"}).
+			Return([]*model.Tag{{ID: "tag-1", TenantID: "tenant-123", Name: "Home"}}, nil)
+
+		_, err := interactor.Create(ctx, memberActor("user-123"), &input.CreateTodoInput{
+			Title:  "Tagged",
+			TagIDs: []string{"tag-1", "tag-other-tenant"},
+		})
+
+		assert.Equal(t, ErrTagNotFound, err)
+	})
+
+	t.Run("project", func(t *testing.T) {
+		ctx := context.Background()
+		tests := []struct {
+			name    string
+			project *model.Project
+			err     error
+		}{
+			{name: "unknown", err: ErrProjectNotFound},
+			{
+				name:    "private project of another member",
+				project: &model.Project{ID: "project-1", TenantID: "tenant-123", UserID: "user-456", Name: "Theirs"},
+				err:     ErrProjectNotFound,
+			},
+			{
+				name:    "archived",
+				project: &model.Project{ID: "project-1", TenantID: "tenant-123", UserID: "user-123", Name: "Old", Archived: true},
+				err:     ErrProjectArchived,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				mockProjectRepo.EXPECT().FindByID(ctx, "tenant-123", "project-1").Return(tt.project, nil)
+
+				projectID := "project-1"
+				_, err := interactor.Create(ctx, memberActor("user-123"), &input.CreateTodoInput{Title: "Filed", ProjectID: &projectID})
+
+				assert.Equal(t, tt.err, err)
+			})
+		}
+	})
 }
 
 func TestTodoInteractor_List(t *testing.T) {
@@ -67,7 +182,7 @@ func TestTodoInteractor_List(t *testing.T) {
 	mockTodoRepo := mock.NewMockITodoRepository(ctrl)
 	mockUUID := mocku.NewMockUUIDGenerator()
 
-	interactor := NewTodoInteractor(mockTodoRepo, mockUUID)
+	interactor := NewTodoInteractor(mock.NewMockIUnitOfWork(ctrl), mockTodoRepo, mock.NewMockITodoActivityRepository(ctrl), mock.NewMockIReminderRepository(ctrl), mock.NewMockITagRepository(ctrl), mock.NewMockIProjectRepository(ctrl), mock.NewMockIUserRepository(ctrl), mock.NewMockIMembershipRepository(ctrl), mock.NewMockIMailRepository(ctrl), NewPermissionEvaluator(DefaultPermissionRules()), mockUUID, mocku.NewMockClock(time.Now()), DefaultMaxSubtaskDepth)
 
 	t.Run("success", func(t *testing.T) {
 		ctx := context.Background()
@@ -91,15 +206,21 @@ func TestTodoInteractor_List(t *testing.T) {
 		}
 
 		mockTodoRepo.EXPECT().
-			FindByUserID(ctx, userID).
-			Return(expectedTodos, nil)
+			FindByUserID(ctx, userID, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ string, query repository.TodoQuery) ([]*model.Todo, error) {
+				assert.Equal(t, DefaultTodoPageSize+1, query.Limit)
+				assert.Equal(t, repository.TodoSort{Field: repository.TodoSortCreatedAt, Desc: true}, query.Sort)
+				assert.Nil(t, query.After)
+				return expectedTodos, nil
+			})
 
-		result, err := interactor.List(ctx, userID)
+		result, err := interactor.List(ctx, memberActor(userID), &input.ListTodosInput{})
 
 		require.NoError(t, err)
-		assert.Len(t, result, 2)
-		assert.Equal(t, "Todo 1", result[0].Title)
-		assert.Equal(t, "Todo 2", result[1].Title)
+		assert.Len(t, result.Todos, 2)
+		assert.Equal(t, "Todo 1", result.Todos[0].Title)
+		assert.Equal(t, "Todo 2", result.Todos[1].Title)
+		assert.Nil(t, result.NextCursor)
 	})
 
 	t.Run("empty list", func(t *testing.T) {
@@ -107,24 +228,233 @@ func TestTodoInteractor_List(t *testing.T) {
 		userID := "user-456"
 
 		mockTodoRepo.EXPECT().
-			FindByUserID(ctx, userID).
+			FindByUserID(ctx, userID, gomock.Any()).
 			Return([]*model.Todo{}, nil)
 
-		result, err := interactor.List(ctx, userID)
+		result, err := interactor.List(ctx, memberActor(userID), &input.ListTodosInput{})
 
 		require.NoError(t, err)
-		assert.Empty(t, result)
+		assert.Empty(t, result.Todos)
+		assert.Nil(t, result.NextCursor)
 	})
+
+	t.Run("full page returns next cursor", func(t *testing.T) {
+		ctx := context.Background()
+		userID := "user-123"
+		createdAt := time.Date(2024, 1, 2, 3, 4, 5, 6000, time.UTC)
+
+		mockTodoRepo.EXPECT().
+			FindByUserID(ctx, userID, gomock.Any()).
+			Return([]*model.Todo{
+				{ID: "todo-3", UserID: userID, TenantID: "tenant-123", CreatedAt: createdAt.Add(time.Minute)},
+				{ID: "todo-2", UserID: userID, TenantID: "tenant-123", CreatedAt: createdAt},
+				{ID: "todo-1", UserID: userID, TenantID: "tenant-123", CreatedAt: createdAt},
+			}, nil)
+
+		result, err := interactor.List(ctx, memberActor(userID), &input.ListTodosInput{Limit: 2})
+
+		require.NoError(t, err)
+		require.Len(t, result.Todos, 2)
+		require.NotNil(t, result.NextCursor)
+
+		cursor, err := DecodeTodoCursor(*result.NextCursor, repository.TodoSort{Field: repository.TodoSortCreatedAt, Desc: true})
+		require.NoError(t, err)
+		assert.Equal(t, "todo-2", cursor.ID)
+		assert.True(t, createdAt.Equal(cursor.CreatedAt))
+	})
+
+	t.Run("cursor is passed to repository", func(t *testing.T) {
+		ctx := context.Background()
+		userID := "user-123"
+		after := repository.TodoCursor{CreatedAt: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC), ID: "todo-2"}
+		sort := repository.TodoSort{Field: repository.TodoSortCreatedAt, Desc: true}
+
+		mockTodoRepo.EXPECT().
+			FindByUserID(ctx, userID, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ string, query repository.TodoQuery) ([]*model.Todo, error) {
+				require.NotNil(t, query.After)
+				assert.Equal(t, "todo-2", query.After.ID)
+				assert.True(t, after.CreatedAt.Equal(query.After.CreatedAt))
+				return []*model.Todo{}, nil
+			})
+
+		_, err := interactor.List(ctx, memberActor(userID), &input.ListTodosInput{Cursor: EncodeTodoCursor(sort, after)})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("invalid cursor", func(t *testing.T) {
+		_, err := interactor.List(context.Background(), memberActor("user-123"), &input.ListTodosInput{Cursor: "not-a-cursor"})
+
+		assert.Equal(t, ErrInvalidCursor, err)
+	})
+
+	t.Run("limit above maximum", func(t *testing.T) {
+		_, err := interactor.List(context.Background(), memberActor("user-123"), &input.ListTodosInput{Limit: MaxTodoPageSize + 1})
+
+		assert.Equal(t, ErrInvalidPageLimit, err)
+	})
+
+	t.Run("cursor from another sort order", func(t *testing.T) {
+		cursor := EncodeTodoCursor(
+			repository.TodoSort{Field: repository.TodoSortTitle},
+			repository.TodoCursor{CreatedAt: time.Now(), Title: "a", ID: "todo-1"},
+		)
+
+		_, err := interactor.List(context.Background(), memberActor("user-123"), &input.ListTodosInput{Cursor: cursor})
+
+		assert.Equal(t, ErrInvalidCursor, err)
+	})
+}
+
+func TestTodoInteractor_List_Filter(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTodoRepo := mock.NewMockITodoRepository(ctrl)
+
+	interactor := NewTodoInteractor(mock.NewMockIUnitOfWork(ctrl), mockTodoRepo, mock.NewMockITodoActivityRepository(ctrl), mock.NewMockIReminderRepository(ctrl), mock.NewMockITagRepository(ctrl), mock.NewMockIProjectRepository(ctrl), mock.NewMockIUserRepository(ctrl), mock.NewMockIMembershipRepository(ctrl), mock.NewMockIMailRepository(ctrl), NewPermissionEvaluator(DefaultPermissionRules()), mocku.NewMockUUIDGenerator(), mocku.NewMockClock(time.Now()), DefaultMaxSubtaskDepth)
+
+	yes := true
+	no := false
+	dueBefore := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+	dueAfter := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		filter     input.TodoFilter
+		wantFilter repository.TodoFilter
+		wantSort   repository.TodoSort
+		wantErr    error
+	}{
+		{
+			name:     "defaults to newest first",
+			filter:   input.TodoFilter{},
+			wantSort: repository.TodoSort{Field: repository.TodoSortCreatedAt, Desc: true},
+		},
+		{
+			name:       "completed",
+			filter:     input.TodoFilter{Completed: &yes},
+			wantFilter: repository.TodoFilter{Completed: &yes},
+			wantSort:   repository.TodoSort{Field: repository.TodoSortCreatedAt, Desc: true},
+		},
+		{
+			name:       "due range",
+			filter:     input.TodoFilter{DueBefore: &dueBefore, DueAfter: &dueAfter},
+			wantFilter: repository.TodoFilter{DueBefore: &dueBefore, DueAfter: &dueAfter},
+			wantSort:   repository.TodoSort{Field: repository.TodoSortCreatedAt, Desc: true},
+		},
+		{
+			name:       "overdue without due date flag",
+			filter:     input.TodoFilter{Overdue: &yes, HasDueDate: &no},
+			wantFilter: repository.TodoFilter{Overdue: &yes, HasDueDate: &no},
+			wantSort:   repository.TodoSort{Field: repository.TodoSortCreatedAt, Desc: true},
+		},
+		{
+			name:       "created after",
+			filter:     input.TodoFilter{CreatedAfter: &dueAfter},
+			wantFilter: repository.TodoFilter{CreatedAfter: &dueAfter},
+			wantSort:   repository.TodoSort{Field: repository.TodoSortCreatedAt, Desc: true},
+		},
+		{
+			name:       "include snoozed",
+			filter:     input.TodoFilter{IncludeSnoozed: true},
+			wantFilter: repository.TodoFilter{IncludeSnoozed: true},
+			wantSort:   repository.TodoSort{Field: repository.TodoSortCreatedAt, Desc: true},
+		},
+		{
+			name:     "due date ascending",
+			filter:   input.TodoFilter{SortBy: "due_date", SortOrder: "asc"},
+			wantSort: repository.TodoSort{Field: repository.TodoSortDueDate},
+		},
+		{
+			name:     "title descending",
+			filter:   input.TodoFilter{SortBy: "title", SortOrder: "desc"},
+			wantSort: repository.TodoSort{Field: repository.TodoSortTitle, Desc: true},
+		},
+		{
+			name:     "updated at default order",
+			filter:   input.TodoFilter{SortBy: "updated_at"},
+			wantSort: repository.TodoSort{Field: repository.TodoSortUpdatedAt, Desc: true},
+		},
+		{
+			name:     "manual order reads top down",
+			filter:   input.TodoFilter{SortBy: "position"},
+			wantSort: repository.TodoSort{Field: repository.TodoSortPosition},
+		},
+		{
+			name:     "manual order reversed",
+			filter:   input.TodoFilter{SortBy: "position", SortOrder: "desc"},
+			wantSort: repository.TodoSort{Field: repository.TodoSortPosition, Desc: true},
+		},
+		{
+			name:     "priority sorts most urgent first",
+			filter:   input.TodoFilter{SortBy: "priority"},
+			wantSort: repository.TodoSort{Field: repository.TodoSortPriority, Desc: true},
+		},
+		{
+			name:    "unknown sort field",
+			filter:  input.TodoFilter{SortBy: "importance"},
+			wantErr: ErrInvalidTodoSort,
+		},
+		{
+			name:    "unknown sort order",
+			filter:  input.TodoFilter{SortOrder: "up"},
+			wantErr: ErrInvalidTodoSort,
+		},
+		{
+			name:       "query combines with parameters",
+			filter:     input.TodoFilter{Query: `is:open -is:public "weekly report"`, DueBefore: &dueBefore},
+			wantFilter: repository.TodoFilter{Completed: &no, IsPublic: &no, DueBefore: &dueBefore, Text: []string{"weekly report"}},
+			wantSort:   repository.TodoSort{Field: repository.TodoSortCreatedAt, Desc: true},
+		},
+		{
+			name:    "query and parameter set the same filter",
+			filter:  input.TodoFilter{Query: "is:done", Completed: &yes},
+			wantErr: ErrConflictingTodoFilter,
+		},
+		{
+			name:    "query syntax error",
+			filter:  input.TodoFilter{Query: "is:blocked"},
+			wantErr: &todoquery.SyntaxError{Pos: 4, Msg: `unknown is: value "blocked", expected open, done, completed, public, private or overdue`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			if tt.wantErr == nil {
+				mockTodoRepo.EXPECT().
+					FindPublicByTenantID(ctx, "tenant-123", gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ string, query repository.TodoQuery) ([]*model.Todo, error) {
+						assert.False(t, query.Filter.Now.IsZero())
+						assert.Equal(t, "user-123", query.Filter.VisibleTo)
+						query.Filter.Now, query.Filter.VisibleTo = time.Time{}, ""
+						assert.Equal(t, tt.wantFilter, query.Filter)
+						assert.Equal(t, tt.wantSort, query.Sort)
+						return []*model.Todo{}, nil
+					})
+			}
+
+			_, err := interactor.ListPublic(ctx, memberActor("user-123"), &input.ListTodosInput{Filter: tt.filter})
+
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
 }
 
 func TestTodoInteractor_Update(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
+	mockUnitOfWork := mock.NewMockIUnitOfWork(ctrl)
 	mockTodoRepo := mock.NewMockITodoRepository(ctrl)
 	mockUUID := mocku.NewMockUUIDGenerator()
+	// Updates run in a tenant transaction so subtask follow-ups commit together.
+	mockUnitOfWork.EXPECT().RunInTenantTx(gomock.Any(), "tenant-123", gomock.Any()).DoAndReturn(runInTx).AnyTimes()
 
-	interactor := NewTodoInteractor(mockTodoRepo, mockUUID)
+	interactor := NewTodoInteractor(mockUnitOfWork, mockTodoRepo, mock.NewMockITodoActivityRepository(ctrl), mock.NewMockIReminderRepository(ctrl), mock.NewMockITagRepository(ctrl), mock.NewMockIProjectRepository(ctrl), mock.NewMockIUserRepository(ctrl), mock.NewMockIMembershipRepository(ctrl), mock.NewMockIMailRepository(ctrl), NewPermissionEvaluator(DefaultPermissionRules()), mockUUID, mocku.NewMockClock(time.Now()), DefaultMaxSubtaskDepth)
 
 	t.Run("success", func(t *testing.T) {
 		ctx := context.Background()
@@ -160,10 +490,10 @@ func TestTodoInteractor_Update(t *testing.T) {
 			Return(existingTodo, nil)
 
 		mockTodoRepo.EXPECT().
-			Update(ctx, gomock.Any()).
+			Update(ctx, gomock.Any(), nil).
 			Return(updatedTodo, nil)
 
-		result, err := interactor.Update(ctx, userID, inp)
+		result, err := interactor.Update(ctx, memberActor(userID), inp)
 
 		require.NoError(t, err)
 		assert.Equal(t, inp.Title, result.Title)
@@ -182,7 +512,7 @@ func TestTodoInteractor_Update(t *testing.T) {
 			FindByID(ctx, "non-existent").
 			Return(nil, nil)
 
-		_, err := interactor.Update(ctx, userID, inp)
+		_, err := interactor.Update(ctx, memberActor(userID), inp)
 
 		assert.Equal(t, ErrTodoNotFound, err)
 	})
@@ -206,10 +536,313 @@ func TestTodoInteractor_Update(t *testing.T) {
 			FindByID(ctx, "todo-1").
 			Return(existingTodo, nil)
 
-		_, err := interactor.Update(ctx, userID, inp)
+		_, err := interactor.Update(ctx, memberActor(userID), inp)
 
 		assert.Equal(t, ErrNotTodoOwner, err)
 	})
+
+	t.Run("member can complete public todo", func(t *testing.T) {
+		ctx := context.Background()
+		userID := "user-123"
+		inp := &input.UpdateTodoInput{
+			ID:        "todo-1",
+			Title:     "Shared Todo",
+			Completed: true,
+			IsPublic:  true,
+		}
+
+		existingTodo := &model.Todo{
+			ID:       "todo-1",
+			UserID:   "different-user",
+			TenantID: "tenant-123",
+			Title:    "Shared Todo",
+			IsPublic: true,
+		}
+
+		mockTodoRepo.EXPECT().
+			FindByID(ctx, "todo-1").
+			Return(existingTodo, nil)
+
+		mockTodoRepo.EXPECT().
+			Update(ctx, gomock.Any(), nil).
+			DoAndReturn(func(_ context.Context, todo *model.Todo, _ *int) (*model.Todo, error) {
+				return todo, nil
+			})
+
+		result, err := interactor.Update(ctx, memberActor(userID), inp)
+
+		require.NoError(t, err)
+		assert.True(t, result.Completed)
+		assert.NotNil(t, result.CompletedAt)
+	})
+
+	t.Run("member cannot edit public todo", func(t *testing.T) {
+		ctx := context.Background()
+		userID := "user-123"
+		inp := &input.UpdateTodoInput{
+			ID:       "todo-1",
+			Title:    "Renamed",
+			IsPublic: true,
+		}
+
+		existingTodo := &model.Todo{
+			ID:       "todo-1",
+			UserID:   "different-user",
+			TenantID: "tenant-123",
+			Title:    "Shared Todo",
+			IsPublic: true,
+		}
+
+		mockTodoRepo.EXPECT().
+			FindByID(ctx, "todo-1").
+			Return(existingTodo, nil)
+
+		_, err := interactor.Update(ctx, memberActor(userID), inp)
+
+		assert.Equal(t, ErrNotTodoOwner, err)
+	})
+
+	t.Run("admin can edit public todo", func(t *testing.T) {
+		ctx := context.Background()
+		userID := "user-123"
+		inp := &input.UpdateTodoInput{
+			ID:       "todo-1",
+			Title:    "Renamed",
+			IsPublic: true,
+		}
+
+		existingTodo := &model.Todo{
+			ID:       "todo-1",
+			UserID:   "different-user",
+			TenantID: "tenant-123",
+			Title:    "Shared Todo",
+			IsPublic: true,
+		}
+
+		mockTodoRepo.EXPECT().
+			FindByID(ctx, "todo-1").
+			Return(existingTodo, nil)
+
+		mockTodoRepo.EXPECT().
+			Update(ctx, gomock.Any(), nil).
+			DoAndReturn(func(_ context.Context, todo *model.Todo, _ *int) (*model.Todo, error) {
+				return todo, nil
+			})
+
+		result, err := interactor.Update(ctx, adminActor(userID), inp)
+
+		require.NoError(t, err)
+		assert.Equal(t, "Renamed", result.Title)
+	})
+}
+
+func TestTodoInteractor_Patch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUnitOfWork := mock.NewMockIUnitOfWork(ctrl)
+	mockTodoRepo := mock.NewMockITodoRepository(ctrl)
+	mockUUID := mocku.NewMockUUIDGenerator()
+	// Updates run in a tenant transaction so subtask follow-ups commit together.
+	mockUnitOfWork.EXPECT().RunInTenantTx(gomock.Any(), "tenant-123", gomock.Any()).DoAndReturn(runInTx).AnyTimes()
+
+	interactor := NewTodoInteractor(mockUnitOfWork, mockTodoRepo, mock.NewMockITodoActivityRepository(ctrl), mock.NewMockIReminderRepository(ctrl), mock.NewMockITagRepository(ctrl), mock.NewMockIProjectRepository(ctrl), mock.NewMockIUserRepository(ctrl), mock.NewMockIMembershipRepository(ctrl), mock.NewMockIMailRepository(ctrl), NewPermissionEvaluator(DefaultPermissionRules()), mockUUID, mocku.NewMockClock(time.Now()), DefaultMaxSubtaskDepth)
+
+	existing := func(userID string) *model.Todo {
+		due := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+		return &model.Todo{
+			ID:          "todo-1",
+			UserID:      userID,
+			TenantID:    "tenant-123",
+			Title:       "Shared Todo",
+			Description: "Keep me",
+			IsPublic:    true,
+			DueDate:     &due,
+		}
+	}
+	saved := func(_ context.Context, todo *model.Todo, _ *int) (*model.Todo, error) {
+		return todo, nil
+	}
+
+	t.Run("absent fields are left unchanged", func(t *testing.T) {
+		ctx := context.Background()
+		completed := true
+
+		mockTodoRepo.EXPECT().FindByID(ctx, "todo-1").Return(existing("user-123"), nil)
+		mockTodoRepo.EXPECT().Update(ctx, gomock.Any(), nil).DoAndReturn(saved)
+
+		result, err := interactor.Patch(ctx, memberActor("user-123"), &input.PatchTodoInput{ID: "todo-1", Completed: &completed})
+
+		require.NoError(t, err)
+		assert.True(t, result.Completed)
+		assert.NotNil(t, result.CompletedAt)
+		assert.Equal(t, "Shared Todo", result.Title)
+		assert.Equal(t, "Keep me", result.Description)
+		assert.True(t, result.IsPublic)
+		assert.NotNil(t, result.DueDate)
+	})
+
+	t.Run("clear due date", func(t *testing.T) {
+		ctx := context.Background()
+		title := "Renamed"
+
+		mockTodoRepo.EXPECT().FindByID(ctx, "todo-1").Return(existing("user-123"), nil)
+		mockTodoRepo.EXPECT().Update(ctx, gomock.Any(), nil).DoAndReturn(saved)
+
+		result, err := interactor.Patch(ctx, memberActor("user-123"), &input.PatchTodoInput{ID: "todo-1", Title: &title, ClearDueDate: true})
+
+		require.NoError(t, err)
+		assert.Equal(t, "Renamed", result.Title)
+		assert.Nil(t, result.DueDate)
+		assert.Equal(t, "Keep me", result.Description)
+	})
+
+	t.Run("member can complete public todo", func(t *testing.T) {
+		ctx := context.Background()
+		completed := true
+
+		mockTodoRepo.EXPECT().FindByID(ctx, "todo-1").Return(existing("different-user"), nil)
+		mockTodoRepo.EXPECT().Update(ctx, gomock.Any(), nil).DoAndReturn(saved)
+
+		result, err := interactor.Patch(ctx, memberActor("user-123"), &input.PatchTodoInput{ID: "todo-1", Completed: &completed})
+
+		require.NoError(t, err)
+		assert.True(t, result.Completed)
+	})
+
+	t.Run("member cannot edit public todo", func(t *testing.T) {
+		ctx := context.Background()
+		description := ""
+
+		mockTodoRepo.EXPECT().FindByID(ctx, "todo-1").Return(existing("different-user"), nil)
+
+		_, err := interactor.Patch(ctx, memberActor("user-123"), &input.PatchTodoInput{ID: "todo-1", Description: &description})
+
+		assert.Equal(t, ErrNotTodoOwner, err)
+	})
+
+	t.Run("member cannot retag public todo", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockTodoRepo.EXPECT().FindByID(ctx, "todo-1").Return(existing("different-user"), nil)
+
+		_, err := interactor.Patch(ctx, memberActor("user-123"), &input.PatchTodoInput{ID: "todo-1", TagIDs: []string{"tag-1"}})
+
+		assert.Equal(t, ErrNotTodoOwner, err)
+	})
+
+	t.Run("todo not found", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockTodoRepo.EXPECT().FindByID(ctx, "missing").Return(nil, nil)
+
+		_, err := interactor.Patch(ctx, memberActor("user-123"), &input.PatchTodoInput{ID: "missing"})
+
+		assert.Equal(t, ErrTodoNotFound, err)
+	})
+}
+
+func TestTodoInteractor_IfMatch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUnitOfWork := mock.NewMockIUnitOfWork(ctrl)
+	mockTodoRepo := mock.NewMockITodoRepository(ctrl)
+	mockUUID := mocku.NewMockUUIDGenerator()
+	// Updates run in a tenant transaction so subtask follow-ups commit together.
+	mockUnitOfWork.EXPECT().RunInTenantTx(gomock.Any(), "tenant-123", gomock.Any()).DoAndReturn(runInTx).AnyTimes()
+
+	interactor := NewTodoInteractor(mockUnitOfWork, mockTodoRepo, mock.NewMockITodoActivityRepository(ctrl), mock.NewMockIReminderRepository(ctrl), mock.NewMockITagRepository(ctrl), mock.NewMockIProjectRepository(ctrl), mock.NewMockIUserRepository(ctrl), mock.NewMockIMembershipRepository(ctrl), mock.NewMockIMailRepository(ctrl), NewPermissionEvaluator(DefaultPermissionRules()), mockUUID, mocku.NewMockClock(time.Now()), DefaultMaxSubtaskDepth)
+
+	stored := func(version int) *model.Todo {
+		return &model.Todo{
+			ID:       "todo-1",
+			UserID:   "user-123",
+			TenantID: "tenant-123",
+			Title:    "Todo",
+			Version:  version,
+		}
+	}
+	version := func(v int) *int { return &v }
+
+	t.Run("matching version is checked again in the write", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockTodoRepo.EXPECT().FindByID(ctx, "todo-1").Return(stored(3), nil)
+		mockTodoRepo.EXPECT().
+			Update(ctx, gomock.Any(), version(3)).
+			DoAndReturn(func(_ context.Context, todo *model.Todo, _ *int) (*model.Todo, error) {
+				todo.Version++
+				return todo, nil
+			})
+
+		result, err := interactor.Update(ctx, memberActor("user-123"), &input.UpdateTodoInput{ID: "todo-1", Title: "Renamed", IfMatch: version(3)})
+
+		require.NoError(t, err)
+		assert.Equal(t, 4, result.Version)
+	})
+
+	t.Run("stale version returns the current todo", func(t *testing.T) {
+		ctx := context.Background()
+		completed := true
+
+		mockTodoRepo.EXPECT().FindByID(ctx, "todo-1").Return(stored(4), nil)
+
+		_, err := interactor.Patch(ctx, memberActor("user-123"), &input.PatchTodoInput{ID: "todo-1", Completed: &completed, IfMatch: version(3)})
+
+		var conflict *TodoVersionConflictError
+		require.ErrorAs(t, err, &conflict)
+		assert.Equal(t, 4, conflict.Current.Version)
+	})
+
+	t.Run("concurrent write between read and update", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockTodoRepo.EXPECT().FindByID(ctx, "todo-1").Return(stored(3), nil)
+		mockTodoRepo.EXPECT().Update(ctx, gomock.Any(), version(3)).Return(nil, repository.ErrVersionMismatch)
+		mockTodoRepo.EXPECT().FindByID(ctx, "todo-1").Return(stored(4), nil)
+
+		_, err := interactor.Update(ctx, memberActor("user-123"), &input.UpdateTodoInput{ID: "todo-1", Title: "Renamed", IfMatch: version(3)})
+
+		var conflict *TodoVersionConflictError
+		require.ErrorAs(t, err, &conflict)
+		assert.Equal(t, 4, conflict.Current.Version)
+	})
+
+	t.Run("stale delete", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockTodoRepo.EXPECT().FindByID(ctx, "todo-1").Return(stored(2), nil)
+
+		err := interactor.Delete(ctx, memberActor("user-123"), &input.DeleteTodoInput{ID: "todo-1", IfMatch: version(1)})
+
+		var conflict *TodoVersionConflictError
+		require.ErrorAs(t, err, &conflict)
+		assert.Equal(t, 2, conflict.Current.Version)
+	})
+
+	t.Run("deleted before the conditional delete", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockTodoRepo.EXPECT().FindByID(ctx, "todo-1").Return(stored(2), nil)
+		mockTodoRepo.EXPECT().Trash(ctx, "todo-1", gomock.Any(), version(2)).Return(repository.ErrVersionMismatch)
+		mockTodoRepo.EXPECT().FindByID(ctx, "todo-1").Return(nil, nil)
+
+		err := interactor.Delete(ctx, memberActor("user-123"), &input.DeleteTodoInput{ID: "todo-1", IfMatch: version(2)})
+
+		assert.Equal(t, ErrTodoNotFound, err)
+	})
+
+	t.Run("deleted before an unconditional delete", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockTodoRepo.EXPECT().FindByID(ctx, "todo-1").Return(stored(2), nil)
+		mockTodoRepo.EXPECT().Trash(ctx, "todo-1", gomock.Any(), nil).Return(repository.ErrTodoNotFound)
+
+		err := interactor.Delete(ctx, memberActor("user-123"), &input.DeleteTodoInput{ID: "todo-1"})
+
+		assert.Equal(t, ErrTodoNotFound, err)
+	})
 }
 
 func TestTodoInteractor_Delete(t *testing.T) {
@@ -219,9 +852,9 @@ func TestTodoInteractor_Delete(t *testing.T) {
 	mockTodoRepo := mock.NewMockITodoRepository(ctrl)
 	mockUUID := mocku.NewMockUUIDGenerator()
 
-	interactor := NewTodoInteractor(mockTodoRepo, mockUUID)
+	interactor := NewTodoInteractor(mock.NewMockIUnitOfWork(ctrl), mockTodoRepo, mock.NewMockITodoActivityRepository(ctrl), mock.NewMockIReminderRepository(ctrl), mock.NewMockITagRepository(ctrl), mock.NewMockIProjectRepository(ctrl), mock.NewMockIUserRepository(ctrl), mock.NewMockIMembershipRepository(ctrl), mock.NewMockIMailRepository(ctrl), NewPermissionEvaluator(DefaultPermissionRules()), mockUUID, mocku.NewMockClock(time.Now()), DefaultMaxSubtaskDepth)
 
-	t.Run("success", func(t *testing.T) {
+	t.Run("moves to trash", func(t *testing.T) {
 		ctx := context.Background()
 		userID := "user-123"
 		todoID := "todo-1"
@@ -238,10 +871,37 @@ func TestTodoInteractor_Delete(t *testing.T) {
 			Return(existingTodo, nil)
 
 		mockTodoRepo.EXPECT().
-			Delete(ctx, todoID).
+			Trash(ctx, todoID, gomock.Any(), nil).
+			Return(nil)
+
+		err := interactor.Delete(ctx, memberActor(userID), &input.DeleteTodoInput{ID: todoID})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("permanent delete reaches trashed todos", func(t *testing.T) {
+		ctx := context.Background()
+		userID := "user-123"
+		todoID := "todo-1"
+		deletedAt := time.Now().Add(-time.Hour)
+
+		existingTodo := &model.Todo{
+			ID:        todoID,
+			UserID:    userID,
+			TenantID:  "tenant-123",
+			Title:     "Todo to delete",
+			DeletedAt: &deletedAt,
+		}
+
+		mockTodoRepo.EXPECT().
+			FindByIDWithTrashed(ctx, todoID).
+			Return(existingTodo, nil)
+
+		mockTodoRepo.EXPECT().
+			Delete(ctx, todoID, nil).
 			Return(nil)
 
-		err := interactor.Delete(ctx, userID, todoID)
+		err := interactor.Delete(ctx, memberActor(userID), &input.DeleteTodoInput{ID: todoID, Permanent: true})
 
 		require.NoError(t, err)
 	})
@@ -255,7 +915,7 @@ func TestTodoInteractor_Delete(t *testing.T) {
 			FindByID(ctx, todoID).
 			Return(nil, nil)
 
-		err := interactor.Delete(ctx, userID, todoID)
+		err := interactor.Delete(ctx, memberActor(userID), &input.DeleteTodoInput{ID: todoID})
 
 		assert.Equal(t, ErrTodoNotFound, err)
 	})
@@ -276,8 +936,108 @@ func TestTodoInteractor_Delete(t *testing.T) {
 			FindByID(ctx, todoID).
 			Return(existingTodo, nil)
 
-		err := interactor.Delete(ctx, userID, todoID)
+		err := interactor.Delete(ctx, memberActor(userID), &input.DeleteTodoInput{ID: todoID})
+
+		assert.Equal(t, ErrNotTodoOwner, err)
+	})
+}
+
+func TestTodoInteractor_Restore(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTodoRepo := mock.NewMockITodoRepository(ctrl)
+	mockUUID := mocku.NewMockUUIDGenerator()
+
+	interactor := NewTodoInteractor(mock.NewMockIUnitOfWork(ctrl), mockTodoRepo, mock.NewMockITodoActivityRepository(ctrl), mock.NewMockIReminderRepository(ctrl), mock.NewMockITagRepository(ctrl), mock.NewMockIProjectRepository(ctrl), mock.NewMockIUserRepository(ctrl), mock.NewMockIMembershipRepository(ctrl), mock.NewMockIMailRepository(ctrl), NewPermissionEvaluator(DefaultPermissionRules()), mockUUID, mocku.NewMockClock(time.Now()), DefaultMaxSubtaskDepth)
+
+	deletedAt := time.Now().Add(-time.Hour)
+	trashed := func(userID string) *model.Todo {
+		return &model.Todo{
+			ID:        "todo-1",
+			UserID:    userID,
+			TenantID:  "tenant-123",
+			Title:     "Trashed",
+			Version:   2,
+			DeletedAt: &deletedAt,
+		}
+	}
+
+	t.Run("success", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockTodoRepo.EXPECT().FindByIDWithTrashed(ctx, "todo-1").Return(trashed("user-123"), nil)
+		mockTodoRepo.EXPECT().Restore(ctx, "todo-1").Return(&model.Todo{ID: "todo-1", UserID: "user-123", Title: "Trashed", Version: 3}, nil)
+
+		result, err := interactor.Restore(ctx, memberActor("user-123"), "todo-1")
+
+		require.NoError(t, err)
+		assert.Nil(t, result.DeletedAt)
+		assert.Equal(t, 3, result.Version)
+	})
+
+	t.Run("not in trash", func(t *testing.T) {
+		ctx := context.Background()
+		live := trashed("user-123")
+		live.DeletedAt = nil
+
+		mockTodoRepo.EXPECT().FindByIDWithTrashed(ctx, "todo-1").Return(live, nil)
+
+		_, err := interactor.Restore(ctx, memberActor("user-123"), "todo-1")
+
+		assert.Equal(t, ErrTodoNotTrashed, err)
+	})
+
+	t.Run("restored concurrently", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockTodoRepo.EXPECT().FindByIDWithTrashed(ctx, "todo-1").Return(trashed("user-123"), nil)
+		mockTodoRepo.EXPECT().Restore(ctx, "todo-1").Return(nil, nil)
+
+		_, err := interactor.Restore(ctx, memberActor("user-123"), "todo-1")
+
+		assert.Equal(t, ErrTodoNotTrashed, err)
+	})
+
+	t.Run("not owner", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockTodoRepo.EXPECT().FindByIDWithTrashed(ctx, "todo-1").Return(trashed("different-user"), nil)
+
+		_, err := interactor.Restore(ctx, memberActor("user-123"), "todo-1")
 
 		assert.Equal(t, ErrNotTodoOwner, err)
 	})
+
+	t.Run("not found", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockTodoRepo.EXPECT().FindByIDWithTrashed(ctx, "missing").Return(nil, nil)
+
+		_, err := interactor.Restore(ctx, memberActor("user-123"), "missing")
+
+		assert.Equal(t, ErrTodoNotFound, err)
+	})
+}
+
+func TestTodoInteractor_ListTrash(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTodoRepo := mock.NewMockITodoRepository(ctrl)
+
+	interactor := NewTodoInteractor(mock.NewMockIUnitOfWork(ctrl), mockTodoRepo, mock.NewMockITodoActivityRepository(ctrl), mock.NewMockIReminderRepository(ctrl), mock.NewMockITagRepository(ctrl), mock.NewMockIProjectRepository(ctrl), mock.NewMockIUserRepository(ctrl), mock.NewMockIMembershipRepository(ctrl), mock.NewMockIMailRepository(ctrl), NewPermissionEvaluator(DefaultPermissionRules()), mocku.NewMockUUIDGenerator(), mocku.NewMockClock(time.Now()), DefaultMaxSubtaskDepth)
+
+	ctx := context.Background()
+	mockTodoRepo.EXPECT().
+		FindByUserID(ctx, "user-123", gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, query repository.TodoQuery) ([]*model.Todo, error) {
+			assert.True(t, query.Filter.Trashed)
+			return []*model.Todo{{ID: "todo-1", UserID: "user-123", TenantID: "tenant-123"}}, nil
+		})
+
+	result, err := interactor.ListTrash(ctx, memberActor("user-123"), &input.ListTodosInput{})
+
+	require.NoError(t, err)
+	assert.Len(t, result.Todos, 1)
 }
@@ -0,0 +1,91 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/domain/repository"
+	"good-todo-go/internal/usecase/input"
+	"good-todo-go/internal/usecase/output"
+)
+
+// Smart views of the actor's open todos.
+const (
+	TodoViewToday    = "today"
+	TodoViewUpcoming = "upcoming"
+	TodoViewOverdue  = "overdue"
+	TodoViewSomeday  = "someday"
+)
+
+// UpcomingTodoDays is how many days after today the upcoming view looks ahead.
+const UpcomingTodoDays = 7
+
+var ErrUnknownTodoView = errors.New("view must be one of today, upcoming, overdue or someday")
+
+// ListView lists the actor's own open todos, most urgent first and soonest due within a
+// priority. Days start at midnight in the actor's timezone: today holds what is due
+// before tomorrow's midnight but not yet overdue, upcoming the following
+// UpcomingTodoDays days, overdue everything past due and someday the todos without a
+// due date.
+func (i *TodoInteractor) ListView(ctx context.Context, actor input.Actor, inp *input.ListTodoViewInput) (*output.TodoListOutput, error) {
+	loc, err := i.userLocation(ctx, actor.UserID)
+	if err != nil {
+		return nil, err
+	}
+	filter, err := todoViewFilter(inp.View, i.clock.Now().In(loc))
+	if err != nil {
+		return nil, err
+	}
+
+	sort := repository.TodoSort{Field: repository.TodoSortPriority, Desc: true}
+	return i.page(ctx, actor, inp.Limit, inp.Cursor, sort, filter, func(query repository.TodoQuery) ([]*model.Todo, error) {
+		return i.todoRepo.FindByUserID(ctx, actor.UserID, query)
+	})
+}
+
+// userLocation loads the user's timezone, falling back to UTC if it no longer loads.
+func (i *TodoInteractor) userLocation(ctx context.Context, userID string) (*time.Location, error) {
+	user, err := i.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, ErrUserNotFound
+	}
+	loc, err := time.LoadLocation(user.Timezone)
+	if err != nil {
+		return time.UTC, nil
+	}
+	return loc, nil
+}
+
+// todoViewFilter selects the open todos of view as seen at now, whose location decides
+// where days start.
+func todoViewFilter(view string, now time.Time) (repository.TodoFilter, error) {
+	open := false
+	filter := repository.TodoFilter{Completed: &open, Now: now}
+
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	// AddDate keeps midnight across daylight saving changes, unlike adding 24 hours
+	tomorrow := today.AddDate(0, 0, 1)
+	switch view {
+	case TodoViewToday:
+		filter.DueFrom = &now
+		filter.DueBefore = &tomorrow
+	case TodoViewUpcoming:
+		end := tomorrow.AddDate(0, 0, UpcomingTodoDays)
+		filter.DueFrom = &tomorrow
+		filter.DueBefore = &end
+	case TodoViewOverdue:
+		overdue := true
+		filter.Overdue = &overdue
+	case TodoViewSomeday:
+		hasDueDate := false
+		filter.HasDueDate = &hasDueDate
+	default:
+		return filter, ErrUnknownTodoView
+	}
+	return filter, nil
+}
@@ -0,0 +1,104 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/domain/repository"
+	"good-todo-go/internal/domain/repository/mock"
+	mocku "good-todo-go/internal/pkg/mock"
+	"good-todo-go/internal/usecase/input"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+)
+
+func TestTodoInteractor_ListView(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTodoRepo := mock.NewMockITodoRepository(ctrl)
+	mockUserRepo := mock.NewMockIUserRepository(ctrl)
+	// Late on March 10th in UTC is already the morning of March 11th in Tokyo
+	clock := mocku.NewMockClock(time.Date(2024, 3, 10, 23, 30, 0, 0, time.UTC))
+
+	interactor := NewTodoInteractor(mock.NewMockIUnitOfWork(ctrl), mockTodoRepo, mock.NewMockITodoActivityRepository(ctrl), mock.NewMockIReminderRepository(ctrl), mock.NewMockITagRepository(ctrl), mock.NewMockIProjectRepository(ctrl), mockUserRepo, mock.NewMockIMembershipRepository(ctrl), mock.NewMockIMailRepository(ctrl), NewPermissionEvaluator(DefaultPermissionRules()), mocku.NewMockUUIDGenerator(), clock, DefaultMaxSubtaskDepth)
+
+	ctx := context.Background()
+	actor := memberActor("user-123")
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	require.NoError(t, err)
+
+	// listView runs the view for a user in timezone and returns the query it sent
+	listView := func(t *testing.T, view, timezone string) repository.TodoQuery {
+		var query repository.TodoQuery
+		mockUserRepo.EXPECT().FindByID(ctx, "user-123").Return(&model.User{ID: "user-123", Timezone: timezone}, nil)
+		mockTodoRepo.EXPECT().FindByUserID(ctx, "user-123", gomock.Any()).
+			DoAndReturn(func(ctx context.Context, userID string, q repository.TodoQuery) ([]*model.Todo, error) {
+				query = q
+				return []*model.Todo{{ID: "todo-1", TenantID: "tenant-123", UserID: "user-123", Priority: model.TodoPriorityUrgent}}, nil
+			})
+
+		result, err := interactor.ListView(ctx, actor, &input.ListTodoViewInput{View: view})
+
+		require.NoError(t, err)
+		require.Len(t, result.Todos, 1)
+		assert.Equal(t, "urgent", result.Todos[0].Priority)
+		assert.Equal(t, repository.TodoSort{Field: repository.TodoSortPriority, Desc: true}, query.Sort)
+		require.NotNil(t, query.Filter.Completed)
+		assert.False(t, *query.Filter.Completed)
+		return query
+	}
+
+	t.Run("today runs until midnight in the user's timezone", func(t *testing.T) {
+		query := listView(t, "today", "Asia/Tokyo")
+
+		require.NotNil(t, query.Filter.DueFrom)
+		require.NotNil(t, query.Filter.DueBefore)
+		assert.True(t, clock.At.Equal(*query.Filter.DueFrom))
+		assert.True(t, time.Date(2024, 3, 12, 0, 0, 0, 0, tokyo).Equal(*query.Filter.DueBefore))
+	})
+
+	t.Run("upcoming covers the week after today", func(t *testing.T) {
+		query := listView(t, "upcoming", "Asia/Tokyo")
+
+		require.NotNil(t, query.Filter.DueFrom)
+		require.NotNil(t, query.Filter.DueBefore)
+		assert.True(t, time.Date(2024, 3, 12, 0, 0, 0, 0, tokyo).Equal(*query.Filter.DueFrom))
+		assert.True(t, time.Date(2024, 3, 19, 0, 0, 0, 0, tokyo).Equal(*query.Filter.DueBefore))
+	})
+
+	t.Run("overdue", func(t *testing.T) {
+		query := listView(t, "overdue", "Asia/Tokyo")
+
+		require.NotNil(t, query.Filter.Overdue)
+		assert.True(t, *query.Filter.Overdue)
+		assert.True(t, clock.At.Equal(query.Filter.Now))
+	})
+
+	t.Run("someday has no due date", func(t *testing.T) {
+		query := listView(t, "someday", "Asia/Tokyo")
+
+		require.NotNil(t, query.Filter.HasDueDate)
+		assert.False(t, *query.Filter.HasDueDate)
+		assert.Nil(t, query.Filter.DueBefore)
+	})
+
+	t.Run("unknown timezone falls back to UTC", func(t *testing.T) {
+		query := listView(t, "today", "Mars/Olympus_Mons")
+
+		require.NotNil(t, query.Filter.DueBefore)
+		assert.True(t, time.Date(2024, 3, 11, 0, 0, 0, 0, time.UTC).Equal(*query.Filter.DueBefore))
+	})
+
+	t.Run("unknown view", func(t *testing.T) {
+		mockUserRepo.EXPECT().FindByID(ctx, "user-123").Return(&model.User{ID: "user-123", Timezone: "UTC"}, nil)
+
+		_, err := interactor.ListView(ctx, actor, &input.ListTodoViewInput{View: "someday-maybe"})
+
+		assert.Equal(t, ErrUnknownTodoView, err)
+	})
+}
@@ -0,0 +1,114 @@
+// Package todoquery parses the todo search syntax used by power users, for example
+//
+//	is:open due:<7d tag:work "quarterly report" -is:public
+//
+// into a typed AST and translates it into the repository's todo filter.
+//
+// Grammar:
+//
+//	query     = { space } [ term { space { space } term } ] { space }
+//	term      = [ "-" ] ( qualifier | text )
+//	qualifier = key ":" value
+//	key       = "is" | "due" | "created" | "tag"
+//	text      = word | phrase
+//	phrase    = `"` { any character except `"` } `"`
+//
+// Qualifier values:
+//
+//	is:open | is:done | is:completed | is:public | is:private | is:overdue
+//	due:none | due:any | due:<bound | due:>bound
+//	created:<bound | created:>bound
+//	tag:name | tag:"name with spaces"
+//
+// A bound is a date (2024-06-01), an RFC 3339 time, or a relative offset such as
+// 12h, 7d or 2w. Offsets point into the future for due and into the past for created,
+// so due:<7d means due within a week and created:>7d means created in the last week.
+// Words with an unknown key, such as http://example.com, are plain text.
+package todoquery
+
+import "time"
+
+// Query is a parsed query. All terms must match.
+type Query struct {
+	Terms []Term
+}
+
+// Term is one space-separated part of a query.
+type Term interface {
+	// Position is the 1-based character column where the term starts, including any "-".
+	Position() int
+	// Negated reports whether the term was prefixed with "-".
+	Negated() bool
+}
+
+type termBase struct {
+	Pos int
+	Neg bool
+}
+
+func (t termBase) Position() int { return t.Pos }
+func (t termBase) Negated() bool { return t.Neg }
+
+// TextTerm matches todos whose title or description contains Value.
+type TextTerm struct {
+	termBase
+	Value  string
+	Phrase bool
+}
+
+type State string
+
+const (
+	StateOpen      State = "open"
+	StateDone      State = "done"
+	StateCompleted State = "completed"
+	StatePublic    State = "public"
+	StatePrivate   State = "private"
+	StateOverdue   State = "overdue"
+)
+
+// IsTerm matches todos in State.
+type IsTerm struct {
+	termBase
+	State State
+}
+
+type CompareOp string
+
+const (
+	OpBefore CompareOp = "<"
+	OpAfter  CompareOp = ">"
+)
+
+// Bound is either an absolute time or an offset relative to the time the query runs.
+type Bound struct {
+	Time     time.Time
+	Offset   time.Duration
+	Relative bool
+}
+
+// DueTerm matches todos due before or after Bound.
+type DueTerm struct {
+	termBase
+	Op    CompareOp
+	Bound Bound
+}
+
+// HasDueTerm matches todos with (due:any) or without (due:none) a due date.
+type HasDueTerm struct {
+	termBase
+	Has bool
+}
+
+// CreatedTerm matches todos created before or after Bound.
+type CreatedTerm struct {
+	termBase
+	Op    CompareOp
+	Bound Bound
+}
+
+// TagTerm matches todos tagged Name.
+type TagTerm struct {
+	termBase
+	Name string
+}
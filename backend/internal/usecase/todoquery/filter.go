@@ -0,0 +1,101 @@
+package todoquery
+
+import (
+	"time"
+
+	"good-todo-go/internal/domain/repository"
+)
+
+// ToFilter translates q into a repository filter, resolving relative bounds against now.
+// Repeated range bounds keep the tightest one; contradicting terms are a SyntaxError.
+func ToFilter(q *Query, now time.Time) (repository.TodoFilter, error) {
+	f := repository.TodoFilter{Now: now}
+	for _, term := range q.Terms {
+		var err error
+		switch t := term.(type) {
+		case *TextTerm:
+			if t.Negated() {
+				f.ExcludeText = append(f.ExcludeText, t.Value)
+			} else {
+				f.Text = append(f.Text, t.Value)
+			}
+		case *IsTerm:
+			err = applyIs(&f, t)
+		case *HasDueTerm:
+			err = setBool(&f.HasDueDate, t.Has != t.Negated(), t)
+		case *DueTerm:
+			at := resolve(t.Bound, now, 1)
+			if t.Op == OpBefore {
+				f.DueBefore = earlier(f.DueBefore, at)
+			} else {
+				f.DueAfter = later(f.DueAfter, at)
+			}
+		case *CreatedTerm:
+			at := resolve(t.Bound, now, -1)
+			if t.Op == OpBefore {
+				f.CreatedBefore = earlier(f.CreatedBefore, at)
+			} else {
+				f.CreatedAfter = later(f.CreatedAfter, at)
+			}
+		case *TagTerm:
+			if t.Negated() {
+				f.ExcludeTags = append(f.ExcludeTags, t.Name)
+			} else {
+				f.Tags = append(f.Tags, t.Name)
+			}
+		}
+		if err != nil {
+			return repository.TodoFilter{}, err
+		}
+	}
+	return f, nil
+}
+
+func applyIs(f *repository.TodoFilter, t *IsTerm) error {
+	// Negating a state selects its opposite
+	positive := !t.Negated()
+	switch t.State {
+	case StateOpen:
+		return setBool(&f.Completed, !positive, t)
+	case StateDone, StateCompleted:
+		return setBool(&f.Completed, positive, t)
+	case StatePublic:
+		return setBool(&f.IsPublic, positive, t)
+	case StatePrivate:
+		return setBool(&f.IsPublic, !positive, t)
+	case StateOverdue:
+		return setBool(&f.Overdue, positive, t)
+	}
+	return nil
+}
+
+// setBool sets *dst to v unless an earlier term already required the opposite.
+func setBool(dst **bool, v bool, t Term) error {
+	if *dst != nil && **dst != v {
+		return &SyntaxError{Pos: t.Position(), Msg: "contradicts an earlier term"}
+	}
+	*dst = &v
+	return nil
+}
+
+// resolve turns a bound into a time; relative offsets move in direction from now.
+func resolve(b Bound, now time.Time, direction time.Duration) time.Time {
+	if b.Relative {
+		return now.Add(direction * b.Offset)
+	}
+	return b.Time
+}
+
+func earlier(current *time.Time, t time.Time) *time.Time {
+	if current != nil && current.Before(t) {
+		return current
+	}
+	return &t
+}
+
+func later(current *time.Time, t time.Time) *time.Time {
+	if current != nil && current.After(t) {
+		return current
+	}
+	return &t
+}
@@ -0,0 +1,101 @@
+package todoquery
+
+import (
+	"testing"
+	"time"
+
+	"good-todo-go/internal/domain/repository"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestToFilter(t *testing.T) {
+	now := time.Date(2024, 6, 10, 12, 0, 0, 0, time.UTC)
+	at := func(d time.Duration) *time.Time {
+		v := now.Add(d)
+		return &v
+	}
+	day := 24 * time.Hour
+	yes, no := true, false
+
+	tests := []struct {
+		name  string
+		query string
+		want  repository.TodoFilter
+	}{
+		{
+			name:  "states",
+			query: "is:open -is:public is:overdue",
+			want:  repository.TodoFilter{Completed: &no, IsPublic: &no, Overdue: &yes},
+		},
+		{
+			name:  "negated done is open",
+			query: "-is:done is:private",
+			want:  repository.TodoFilter{Completed: &no, IsPublic: &no},
+		},
+		{
+			name:  "relative due looks ahead and created looks back",
+			query: "due:<7d due:>1d created:>2w",
+			want:  repository.TodoFilter{DueBefore: at(7 * day), DueAfter: at(day), CreatedAfter: at(-14 * day)},
+		},
+		{
+			name:  "repeated bounds keep the tightest",
+			query: "due:<7d due:<1d created:<1d created:<1w",
+			want:  repository.TodoFilter{DueBefore: at(day), CreatedBefore: at(-7 * day)},
+		},
+		{
+			name:  "due presence",
+			query: "-due:none",
+			want:  repository.TodoFilter{HasDueDate: &yes},
+		},
+		{
+			name:  "text",
+			query: `milk "quarterly report" -draft`,
+			want:  repository.TodoFilter{Text: []string{"milk", "quarterly report"}, ExcludeText: []string{"draft"}},
+		},
+		{
+			name:  "tags",
+			query: `tag:work tag:"deep work" -tag:someday`,
+			want:  repository.TodoFilter{Tags: []string{"work", "deep work"}, ExcludeTags: []string{"someday"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q, err := Parse(tt.query)
+			require.NoError(t, err)
+
+			got, err := ToFilter(q, now)
+
+			require.NoError(t, err)
+			tt.want.Now = now
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestToFilter_Error(t *testing.T) {
+	tests := []struct {
+		name    string
+		query   string
+		wantPos int
+	}{
+		{"open and done", "is:open is:done", 9},
+		{"public and private", "is:public   is:private", 13},
+		{"due none and any", "due:none due:any", 10},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q, err := Parse(tt.query)
+			require.NoError(t, err)
+
+			_, err = ToFilter(q, time.Now())
+
+			var syntaxErr *SyntaxError
+			require.ErrorAs(t, err, &syntaxErr)
+			assert.Equal(t, tt.wantPos, syntaxErr.Pos)
+		})
+	}
+}
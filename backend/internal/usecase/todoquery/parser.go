@@ -0,0 +1,243 @@
+package todoquery
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// MaxLength is the longest query accepted, in characters.
+const MaxLength = 500
+
+// maxOffset keeps relative offsets such as 9999w far from overflowing time.Duration.
+const maxOffset = 9999
+
+// SyntaxError reports an invalid query. Pos is the 1-based character column of the problem.
+type SyntaxError struct {
+	Pos int
+	Msg string
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("query syntax error at position %d: %s", e.Pos, e.Msg)
+}
+
+// Parse parses a query. An empty or blank query has no terms.
+func Parse(query string) (*Query, error) {
+	p := &parser{src: []rune(query)}
+	if len(p.src) > MaxLength {
+		return nil, p.errorf(MaxLength, "query is longer than %d characters", MaxLength)
+	}
+
+	q := &Query{}
+	for {
+		p.skipSpace()
+		if p.eof() {
+			return q, nil
+		}
+		term, err := p.term()
+		if err != nil {
+			return nil, err
+		}
+		q.Terms = append(q.Terms, term)
+	}
+}
+
+type parser struct {
+	src []rune
+	pos int
+}
+
+func (p *parser) eof() bool { return p.pos >= len(p.src) }
+
+func (p *parser) peek() rune { return p.src[p.pos] }
+
+func (p *parser) skipSpace() {
+	for !p.eof() && unicode.IsSpace(p.peek()) {
+		p.pos++
+	}
+}
+
+// errorf reports an error at the 0-based rune offset at.
+func (p *parser) errorf(at int, format string, args ...any) error {
+	return &SyntaxError{Pos: at + 1, Msg: fmt.Sprintf(format, args...)}
+}
+
+func (p *parser) term() (Term, error) {
+	base := termBase{Pos: p.pos + 1}
+	if p.peek() == '-' && p.pos+1 < len(p.src) && !unicode.IsSpace(p.src[p.pos+1]) {
+		base.Neg = true
+		p.pos++
+	}
+
+	if p.peek() == '"' {
+		start := p.pos
+		value, err := p.phrase()
+		if err != nil {
+			return nil, err
+		}
+		if strings.TrimSpace(value) == "" {
+			return nil, p.errorf(start, "empty phrase")
+		}
+		return &TextTerm{termBase: base, Value: value, Phrase: true}, nil
+	}
+
+	start := p.pos
+	word := p.word()
+	key, _, ok := strings.Cut(word, ":")
+	if !ok {
+		return &TextTerm{termBase: base, Value: word}, nil
+	}
+
+	valueAt := start + len([]rune(key)) + 1
+	switch strings.ToLower(key) {
+	case "is":
+		return p.isTerm(base, word[len(key)+1:], valueAt)
+	case "due":
+		return p.dueTerm(base, word[len(key)+1:], valueAt)
+	case "created":
+		return p.createdTerm(base, word[len(key)+1:], valueAt)
+	case "tag":
+		return p.tagTerm(base, start, valueAt)
+	default:
+		return &TextTerm{termBase: base, Value: word}, nil
+	}
+}
+
+// word consumes runes up to the next space.
+func (p *parser) word() string {
+	start := p.pos
+	for !p.eof() && !unicode.IsSpace(p.peek()) {
+		p.pos++
+	}
+	return string(p.src[start:p.pos])
+}
+
+// phrase consumes a double-quoted string and returns its contents.
+func (p *parser) phrase() (string, error) {
+	start := p.pos
+	p.pos++
+	for !p.eof() && p.peek() != '"' {
+		p.pos++
+	}
+	if p.eof() {
+		return "", p.errorf(start, "unterminated quote")
+	}
+	value := string(p.src[start+1 : p.pos])
+	p.pos++
+	if !p.eof() && !unicode.IsSpace(p.peek()) {
+		return "", p.errorf(p.pos, "expected a space after closing quote")
+	}
+	return value, nil
+}
+
+func (p *parser) isTerm(base termBase, value string, at int) (Term, error) {
+	switch state := State(strings.ToLower(value)); state {
+	case StateOpen, StateDone, StateCompleted, StatePublic, StatePrivate, StateOverdue:
+		return &IsTerm{termBase: base, State: state}, nil
+	case "":
+		return nil, p.errorf(at, "missing value for is:")
+	default:
+		return nil, p.errorf(at, "unknown is: value %q, expected open, done, completed, public, private or overdue", value)
+	}
+}
+
+func (p *parser) dueTerm(base termBase, value string, at int) (Term, error) {
+	switch strings.ToLower(value) {
+	case "none":
+		return &HasDueTerm{termBase: base, Has: false}, nil
+	case "any":
+		return &HasDueTerm{termBase: base, Has: true}, nil
+	}
+	op, bound, err := p.comparison("due", value, at)
+	if err != nil {
+		return nil, err
+	}
+	if base.Neg {
+		return nil, p.errorf(base.Pos-1, "due: ranges cannot be negated")
+	}
+	return &DueTerm{termBase: base, Op: op, Bound: bound}, nil
+}
+
+func (p *parser) createdTerm(base termBase, value string, at int) (Term, error) {
+	op, bound, err := p.comparison("created", value, at)
+	if err != nil {
+		return nil, err
+	}
+	if base.Neg {
+		return nil, p.errorf(base.Pos-1, "created: ranges cannot be negated")
+	}
+	return &CreatedTerm{termBase: base, Op: op, Bound: bound}, nil
+}
+
+// tagTerm re-reads the value from the source so tag:"two words" can span spaces.
+func (p *parser) tagTerm(base termBase, start, at int) (Term, error) {
+	p.pos = at
+	if p.eof() || unicode.IsSpace(p.peek()) {
+		return nil, p.errorf(at, "missing value for tag:")
+	}
+	var name string
+	if p.peek() == '"' {
+		value, err := p.phrase()
+		if err != nil {
+			return nil, err
+		}
+		name = strings.TrimSpace(value)
+		if name == "" {
+			return nil, p.errorf(at, "empty tag name")
+		}
+	} else {
+		name = p.word()
+	}
+	return &TagTerm{termBase: base, Name: name}, nil
+}
+
+func (p *parser) comparison(key, value string, at int) (CompareOp, Bound, error) {
+	if value == "" {
+		return "", Bound{}, p.errorf(at, "missing value for %s:", key)
+	}
+	var op CompareOp
+	switch value[0] {
+	case '<':
+		op = OpBefore
+	case '>':
+		op = OpAfter
+	default:
+		return "", Bound{}, p.errorf(at, "%s: needs < or > before its value", key)
+	}
+	bound, ok := parseBound(value[1:])
+	if !ok {
+		return "", Bound{}, p.errorf(at+1, "invalid %s: value %q, expected a date like 2024-06-01 or an offset like 7d", key, value[1:])
+	}
+	return op, bound, nil
+}
+
+func parseBound(s string) (Bound, bool) {
+	if t, err := time.Parse("2006-01-02", s); err == nil {
+		return Bound{Time: t}, true
+	}
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return Bound{Time: t}, true
+	}
+	if len(s) < 2 {
+		return Bound{}, false
+	}
+	n, err := strconv.Atoi(s[:len(s)-1])
+	if err != nil || n < 0 || n > maxOffset {
+		return Bound{}, false
+	}
+	var unit time.Duration
+	switch s[len(s)-1] {
+	case 'h':
+		unit = time.Hour
+	case 'd':
+		unit = 24 * time.Hour
+	case 'w':
+		unit = 7 * 24 * time.Hour
+	default:
+		return Bound{}, false
+	}
+	return Bound{Offset: time.Duration(n) * unit, Relative: true}, true
+}
@@ -0,0 +1,121 @@
+package todoquery
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	day := 24 * time.Hour
+
+	tests := []struct {
+		name  string
+		query string
+		want  []Term
+	}{
+		{
+			name:  "empty",
+			query: "  ",
+			want:  nil,
+		},
+		{
+			name:  "example from the docs",
+			query: `is:open due:<7d tag:work "quarterly report" -is:public`,
+			want: []Term{
+				&IsTerm{termBase: termBase{Pos: 1}, State: StateOpen},
+				&DueTerm{termBase: termBase{Pos: 9}, Op: OpBefore, Bound: Bound{Offset: 7 * day, Relative: true}},
+				&TagTerm{termBase: termBase{Pos: 17}, Name: "work"},
+				&TextTerm{termBase: termBase{Pos: 26}, Value: "quarterly report", Phrase: true},
+				&IsTerm{termBase: termBase{Pos: 45, Neg: true}, State: StatePublic},
+			},
+		},
+		{
+			name:  "absolute bounds",
+			query: "due:>2024-06-01 created:<2024-06-01T09:30:00Z",
+			want: []Term{
+				&DueTerm{termBase: termBase{Pos: 1}, Op: OpAfter, Bound: Bound{Time: time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)}},
+				&CreatedTerm{termBase: termBase{Pos: 17}, Op: OpBefore, Bound: Bound{Time: time.Date(2024, 6, 1, 9, 30, 0, 0, time.UTC)}},
+			},
+		},
+		{
+			name:  "due presence and case-insensitive keys",
+			query: "DUE:none Is:Done -due:any",
+			want: []Term{
+				&HasDueTerm{termBase: termBase{Pos: 1}, Has: false},
+				&IsTerm{termBase: termBase{Pos: 10}, State: StateDone},
+				&HasDueTerm{termBase: termBase{Pos: 18, Neg: true}, Has: true},
+			},
+		},
+		{
+			name:  "quoted tag and negated text",
+			query: `tag:"deep work" -milk 12h`,
+			want: []Term{
+				&TagTerm{termBase: termBase{Pos: 1}, Name: "deep work"},
+				&TextTerm{termBase: termBase{Pos: 17, Neg: true}, Value: "milk"},
+				&TextTerm{termBase: termBase{Pos: 23}, Value: "12h"},
+			},
+		},
+		{
+			name:  "unknown keys and lone dash are text",
+			query: "http://example.com - 東京",
+			want: []Term{
+				&TextTerm{termBase: termBase{Pos: 1}, Value: "http://example.com"},
+				&TextTerm{termBase: termBase{Pos: 20}, Value: "-"},
+				&TextTerm{termBase: termBase{Pos: 22}, Value: "東京"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q, err := Parse(tt.query)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, q.Terms)
+		})
+	}
+}
+
+func TestParse_SyntaxError(t *testing.T) {
+	tests := []struct {
+		name    string
+		query   string
+		wantPos int
+		wantMsg string
+	}{
+		{"unknown state", "is:open is:blocked", 12, `unknown is: value "blocked"`},
+		{"missing state", "is:", 4, "missing value for is:"},
+		{"missing operator", "due:7d", 5, "due: needs < or >"},
+		{"bad bound", "created:>yesterday", 10, `invalid created: value "yesterday"`},
+		{"offset too large", "due:<10000w", 6, "invalid due: value"},
+		{"negated range", "milk -due:<7d", 6, "due: ranges cannot be negated"},
+		{"unterminated quote", `is:open "quarterly`, 9, "unterminated quote"},
+		{"text after quote", `"a"b`, 4, "expected a space after closing quote"},
+		{"empty phrase", `"  "`, 1, "empty phrase"},
+		{"empty tag", `tag:""`, 5, "empty tag name"},
+		{"position counts characters", "東京 is:nope", 7, "unknown is: value"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(tt.query)
+
+			var syntaxErr *SyntaxError
+			require.ErrorAs(t, err, &syntaxErr)
+			assert.Equal(t, tt.wantPos, syntaxErr.Pos)
+			assert.Contains(t, syntaxErr.Msg, tt.wantMsg)
+		})
+	}
+
+	t.Run("too long", func(t *testing.T) {
+		_, err := Parse(strings.Repeat("a", MaxLength+1))
+
+		var syntaxErr *SyntaxError
+		require.ErrorAs(t, err, &syntaxErr)
+		assert.Equal(t, MaxLength+1, syntaxErr.Pos)
+	})
+}
@@ -3,74 +3,208 @@ package usecase
 import (
 	"context"
 	"errors"
+	"time"
 
+	"good-todo-go/internal/domain/model"
 	"good-todo-go/internal/domain/repository"
 	"good-todo-go/internal/usecase/input"
 	"good-todo-go/internal/usecase/output"
 )
 
 var (
-	ErrUserNotFound = errors.New("user not found")
+	ErrUserNotFound      = errors.New("user not found")
+	ErrInvalidTimezone   = errors.New("timezone must be an IANA time zone name")
+	ErrInvalidDigestTime = errors.New("time must be a 24-hour HH:MM time")
 )
 
 type IUserInteractor interface {
-	GetMe(ctx context.Context, userID string) (*output.UserOutput, error)
-	UpdateMe(ctx context.Context, userID string, input *input.UpdateUserInput) (*output.UserOutput, error)
+	GetMe(ctx context.Context, actor input.Actor) (*output.UserOutput, error)
+	UpdateMe(ctx context.Context, actor input.Actor, input *input.UpdateUserInput) (*output.UserOutput, error)
+	ListMyTenants(ctx context.Context, actor input.Actor) ([]*output.MembershipOutput, error)
+	// GetDigest returns the actor's daily digest preference in the actor's tenant.
+	GetDigest(ctx context.Context, actor input.Actor) (*output.DigestOutput, error)
+	UpdateDigest(ctx context.Context, actor input.Actor, input *input.UpdateDigestInput) (*output.DigestOutput, error)
 }
 
 type UserInteractor struct {
-	userRepo repository.IUserRepository
+	userRepo       repository.IUserRepository
+	membershipRepo repository.IMembershipRepository
+	permission     IPermissionEvaluator
 }
 
-func NewUserInteractor(userRepo repository.IUserRepository) IUserInteractor {
-	return &UserInteractor{userRepo: userRepo}
+func NewUserInteractor(
+	userRepo repository.IUserRepository,
+	membershipRepo repository.IMembershipRepository,
+	permission IPermissionEvaluator,
+) IUserInteractor {
+	return &UserInteractor{
+		userRepo:       userRepo,
+		membershipRepo: membershipRepo,
+		permission:     permission,
+	}
 }
 
-func (i *UserInteractor) GetMe(ctx context.Context, userID string) (*output.UserOutput, error) {
-	user, err := i.userRepo.FindByID(ctx, userID)
+func (i *UserInteractor) GetMe(ctx context.Context, actor input.Actor) (*output.UserOutput, error) {
+	user, membership, err := i.findMember(ctx, actor)
 	if err != nil {
 		return nil, err
 	}
-	if user == nil {
-		return nil, ErrUserNotFound
+
+	if !i.permission.Can(ctx, actor, ActionView, ResourceUser, MemberTarget(membership)) {
+		return nil, ErrUnauthorized
 	}
 
-	return &output.UserOutput{
-		ID:            user.ID,
-		TenantID:      user.TenantID,
-		Email:         user.Email,
-		Name:          user.Name,
-		Role:          string(user.Role),
-		EmailVerified: user.EmailVerified,
-		CreatedAt:     user.CreatedAt,
-		UpdatedAt:     user.UpdatedAt,
-	}, nil
+	return toUserOutput(user, membership), nil
 }
 
-func (i *UserInteractor) UpdateMe(ctx context.Context, userID string, inp *input.UpdateUserInput) (*output.UserOutput, error) {
-	user, err := i.userRepo.FindByID(ctx, userID)
+func (i *UserInteractor) UpdateMe(ctx context.Context, actor input.Actor, inp *input.UpdateUserInput) (*output.UserOutput, error) {
+	user, membership, err := i.findMember(ctx, actor)
 	if err != nil {
 		return nil, err
 	}
-	if user == nil {
-		return nil, ErrUserNotFound
+
+	if !i.permission.Can(ctx, actor, ActionUpdate, ResourceUser, MemberTarget(membership)) {
+		return nil, ErrUnauthorized
 	}
 
 	user.Name = inp.Name
+	if inp.Timezone != nil {
+		if err := validateTimezone(*inp.Timezone); err != nil {
+			return nil, err
+		}
+		user.Timezone = *inp.Timezone
+	}
 
 	updated, err := i.userRepo.Update(ctx, user)
 	if err != nil {
 		return nil, err
 	}
 
+	return toUserOutput(updated, membership), nil
+}
+
+func (i *UserInteractor) ListMyTenants(ctx context.Context, actor input.Actor) ([]*output.MembershipOutput, error) {
+	memberships, err := i.membershipRepo.ListByUser(ctx, actor.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	outputs := make([]*output.MembershipOutput, 0, len(memberships))
+	for _, m := range memberships {
+		if m.Tenant == nil {
+			continue
+		}
+		outputs = append(outputs, &output.MembershipOutput{
+			TenantID:   m.TenantID,
+			TenantName: m.Tenant.Name,
+			TenantSlug: m.Tenant.Slug,
+			Role:       string(m.Role),
+			Current:    m.TenantID == actor.TenantID,
+			JoinedAt:   m.CreatedAt,
+		})
+	}
+	return outputs, nil
+}
+
+func (i *UserInteractor) GetDigest(ctx context.Context, actor input.Actor) (*output.DigestOutput, error) {
+	_, membership, err := i.findMember(ctx, actor)
+	if err != nil {
+		return nil, err
+	}
+
+	if !i.permission.Can(ctx, actor, ActionView, ResourceUser, MemberTarget(membership)) {
+		return nil, ErrUnauthorized
+	}
+
+	return toDigestOutput(membership), nil
+}
+
+func (i *UserInteractor) UpdateDigest(ctx context.Context, actor input.Actor, inp *input.UpdateDigestInput) (*output.DigestOutput, error) {
+	_, membership, err := i.findMember(ctx, actor)
+	if err != nil {
+		return nil, err
+	}
+
+	if !i.permission.Can(ctx, actor, ActionUpdate, ResourceUser, MemberTarget(membership)) {
+		return nil, ErrUnauthorized
+	}
+
+	membership.DigestEnabled = inp.Enabled
+	membership.DigestTime = DefaultDigestTime
+	if inp.Time != "" {
+		at, err := time.Parse("15:04", inp.Time)
+		if err != nil {
+			return nil, ErrInvalidDigestTime
+		}
+		membership.DigestTime = at.Format("15:04")
+	}
+	if inp.Timezone != nil {
+		if err := validateTimezone(*inp.Timezone); err != nil {
+			return nil, err
+		}
+	}
+	membership.DigestTimezone = inp.Timezone
+
+	updated, err := i.membershipRepo.UpdateDigest(ctx, membership)
+	if err != nil {
+		return nil, err
+	}
+
+	return toDigestOutput(updated), nil
+}
+
+// findMember loads the actor's identity and its membership in the actor's tenant.
+func (i *UserInteractor) findMember(ctx context.Context, actor input.Actor) (*model.User, *model.Membership, error) {
+	user, err := i.userRepo.FindByID(ctx, actor.UserID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if user == nil {
+		return nil, nil, ErrUserNotFound
+	}
+
+	membership, err := i.membershipRepo.FindByUserAndTenant(ctx, actor.UserID, actor.TenantID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if membership == nil {
+		return nil, nil, ErrUserNotFound
+	}
+
+	return user, membership, nil
+}
+
+// validateTimezone accepts IANA names only; LoadLocation would also take "" and "Local",
+// which mean UTC and the server's zone.
+func validateTimezone(name string) error {
+	if name == "" || name == "Local" {
+		return ErrInvalidTimezone
+	}
+	if _, err := time.LoadLocation(name); err != nil {
+		return ErrInvalidTimezone
+	}
+	return nil
+}
+
+func toUserOutput(user *model.User, membership *model.Membership) *output.UserOutput {
 	return &output.UserOutput{
-		ID:            updated.ID,
-		TenantID:      updated.TenantID,
-		Email:         updated.Email,
-		Name:          updated.Name,
-		Role:          string(updated.Role),
-		EmailVerified: updated.EmailVerified,
-		CreatedAt:     updated.CreatedAt,
-		UpdatedAt:     updated.UpdatedAt,
-	}, nil
+		ID:            user.ID,
+		TenantID:      membership.TenantID,
+		Email:         user.Email,
+		Name:          user.Name,
+		Timezone:      user.Timezone,
+		Role:          string(membership.Role),
+		EmailVerified: user.EmailVerified,
+		CreatedAt:     user.CreatedAt,
+		UpdatedAt:     user.UpdatedAt,
+	}
+}
+
+func toDigestOutput(membership *model.Membership) *output.DigestOutput {
+	return &output.DigestOutput{
+		Enabled:    membership.DigestEnabled,
+		Time:       membership.DigestTime,
+		Timezone:   membership.DigestTimezone,
+		LastSentOn: membership.DigestLastSentOn,
+	}
 }
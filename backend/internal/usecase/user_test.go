@@ -19,8 +19,9 @@ func TestUserInteractor_GetMe(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockUserRepo := mock.NewMockIUserRepository(ctrl)
+	mockMembershipRepo := mock.NewMockIMembershipRepository(ctrl)
 
-	interactor := NewUserInteractor(mockUserRepo)
+	interactor := NewUserInteractor(mockUserRepo, mockMembershipRepo, NewPermissionEvaluator(DefaultPermissionRules()))
 
 	t.Run("success", func(t *testing.T) {
 		ctx := context.Background()
@@ -28,10 +29,8 @@ func TestUserInteractor_GetMe(t *testing.T) {
 
 		expectedUser := &model.User{
 			ID:            userID,
-			TenantID:      "tenant-123",
 			Email:         "test@example.com",
 			Name:          "Test User",
-			Role:          model.UserRoleAdmin,
 			EmailVerified: true,
 			CreatedAt:     time.Now(),
 			UpdatedAt:     time.Now(),
@@ -41,10 +40,15 @@ func TestUserInteractor_GetMe(t *testing.T) {
 			FindByID(ctx, userID).
 			Return(expectedUser, nil)
 
-		result, err := interactor.GetMe(ctx, userID)
+		mockMembershipRepo.EXPECT().
+			FindByUserAndTenant(ctx, userID, "tenant-123").
+			Return(&model.Membership{ID: "membership-123", TenantID: "tenant-123", UserID: userID, Role: model.UserRoleAdmin}, nil)
+
+		result, err := interactor.GetMe(ctx, input.Actor{UserID: userID, TenantID: "tenant-123", Role: model.UserRoleAdmin})
 
 		require.NoError(t, err)
 		assert.Equal(t, userID, result.ID)
+		assert.Equal(t, "tenant-123", result.TenantID)
 		assert.Equal(t, "test@example.com", result.Email)
 		assert.Equal(t, "Test User", result.Name)
 		assert.Equal(t, "admin", result.Role)
@@ -59,7 +63,24 @@ func TestUserInteractor_GetMe(t *testing.T) {
 			FindByID(ctx, userID).
 			Return(nil, nil)
 
-		_, err := interactor.GetMe(ctx, userID)
+		_, err := interactor.GetMe(ctx, input.Actor{UserID: userID, TenantID: "tenant-123", Role: model.UserRoleAdmin})
+
+		assert.Equal(t, ErrUserNotFound, err)
+	})
+
+	t.Run("no membership in tenant", func(t *testing.T) {
+		ctx := context.Background()
+		userID := "user-123"
+
+		mockUserRepo.EXPECT().
+			FindByID(ctx, userID).
+			Return(&model.User{ID: userID}, nil)
+
+		mockMembershipRepo.EXPECT().
+			FindByUserAndTenant(ctx, userID, "tenant-456").
+			Return(nil, nil)
+
+		_, err := interactor.GetMe(ctx, input.Actor{UserID: userID, TenantID: "tenant-456", Role: model.UserRoleMember})
 
 		assert.Equal(t, ErrUserNotFound, err)
 	})
@@ -70,8 +91,9 @@ func TestUserInteractor_UpdateMe(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockUserRepo := mock.NewMockIUserRepository(ctrl)
+	mockMembershipRepo := mock.NewMockIMembershipRepository(ctrl)
 
-	interactor := NewUserInteractor(mockUserRepo)
+	interactor := NewUserInteractor(mockUserRepo, mockMembershipRepo, NewPermissionEvaluator(DefaultPermissionRules()))
 
 	t.Run("success", func(t *testing.T) {
 		ctx := context.Background()
@@ -82,10 +104,8 @@ func TestUserInteractor_UpdateMe(t *testing.T) {
 
 		existingUser := &model.User{
 			ID:            userID,
-			TenantID:      "tenant-123",
 			Email:         "test@example.com",
 			Name:          "Original Name",
-			Role:          model.UserRoleMember,
 			EmailVerified: true,
 			CreatedAt:     time.Now(),
 			UpdatedAt:     time.Now(),
@@ -93,10 +113,8 @@ func TestUserInteractor_UpdateMe(t *testing.T) {
 
 		updatedUser := &model.User{
 			ID:            userID,
-			TenantID:      "tenant-123",
 			Email:         "test@example.com",
 			Name:          "Updated Name",
-			Role:          model.UserRoleMember,
 			EmailVerified: true,
 			CreatedAt:     existingUser.CreatedAt,
 			UpdatedAt:     time.Now(),
@@ -106,14 +124,63 @@ func TestUserInteractor_UpdateMe(t *testing.T) {
 			FindByID(ctx, userID).
 			Return(existingUser, nil)
 
+		mockMembershipRepo.EXPECT().
+			FindByUserAndTenant(ctx, userID, "tenant-123").
+			Return(&model.Membership{ID: "membership-123", TenantID: "tenant-123", UserID: userID, Role: model.UserRoleMember}, nil)
+
 		mockUserRepo.EXPECT().
 			Update(ctx, gomock.Any()).
 			Return(updatedUser, nil)
 
-		result, err := interactor.UpdateMe(ctx, userID, inp)
+		result, err := interactor.UpdateMe(ctx, input.Actor{UserID: userID, TenantID: "tenant-123", Role: model.UserRoleMember}, inp)
 
 		require.NoError(t, err)
 		assert.Equal(t, "Updated Name", result.Name)
+		assert.Equal(t, "member", result.Role)
+	})
+
+	t.Run("timezone", func(t *testing.T) {
+		ctx := context.Background()
+		userID := "user-123"
+		timezone := "Europe/Berlin"
+
+		mockUserRepo.EXPECT().
+			FindByID(ctx, userID).
+			Return(&model.User{ID: userID, Name: "Name", Timezone: "UTC"}, nil)
+
+		mockMembershipRepo.EXPECT().
+			FindByUserAndTenant(ctx, userID, "tenant-123").
+			Return(&model.Membership{ID: "membership-123", TenantID: "tenant-123", UserID: userID, Role: model.UserRoleMember}, nil)
+
+		mockUserRepo.EXPECT().
+			Update(ctx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, user *model.User) (*model.User, error) {
+				return user, nil
+			})
+
+		result, err := interactor.UpdateMe(ctx, input.Actor{UserID: userID, TenantID: "tenant-123", Role: model.UserRoleMember}, &input.UpdateUserInput{Name: "Name", Timezone: &timezone})
+
+		require.NoError(t, err)
+		assert.Equal(t, "Europe/Berlin", result.Timezone)
+	})
+
+	t.Run("invalid timezone", func(t *testing.T) {
+		for _, timezone := range []string{"", "Local", "Europe/Atlantis"} {
+			ctx := context.Background()
+			userID := "user-123"
+
+			mockUserRepo.EXPECT().
+				FindByID(ctx, userID).
+				Return(&model.User{ID: userID, Timezone: "UTC"}, nil)
+
+			mockMembershipRepo.EXPECT().
+				FindByUserAndTenant(ctx, userID, "tenant-123").
+				Return(&model.Membership{ID: "membership-123", TenantID: "tenant-123", UserID: userID, Role: model.UserRoleMember}, nil)
+
+			_, err := interactor.UpdateMe(ctx, input.Actor{UserID: userID, TenantID: "tenant-123", Role: model.UserRoleMember}, &input.UpdateUserInput{Timezone: &timezone})
+
+			assert.Equal(t, ErrInvalidTimezone, err, timezone)
+		}
 	})
 
 	t.Run("user not found", func(t *testing.T) {
@@ -127,8 +194,105 @@ func TestUserInteractor_UpdateMe(t *testing.T) {
 			FindByID(ctx, userID).
 			Return(nil, nil)
 
-		_, err := interactor.UpdateMe(ctx, userID, inp)
+		_, err := interactor.UpdateMe(ctx, input.Actor{UserID: userID, TenantID: "tenant-123", Role: model.UserRoleMember}, inp)
 
 		assert.Equal(t, ErrUserNotFound, err)
 	})
 }
+
+func TestUserInteractor_ListMyTenants(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserRepo := mock.NewMockIUserRepository(ctrl)
+	mockMembershipRepo := mock.NewMockIMembershipRepository(ctrl)
+
+	interactor := NewUserInteractor(mockUserRepo, mockMembershipRepo, NewPermissionEvaluator(DefaultPermissionRules()))
+
+	ctx := context.Background()
+
+	mockMembershipRepo.EXPECT().
+		ListByUser(ctx, "user-123").
+		Return([]*model.Membership{
+			{TenantID: "tenant-123", UserID: "user-123", Role: model.UserRoleAdmin, Tenant: &model.Tenant{ID: "tenant-123", Name: "Acme", Slug: "acme"}},
+			{TenantID: "tenant-456", UserID: "user-123", Role: model.UserRoleMember, Tenant: &model.Tenant{ID: "tenant-456", Name: "Globex", Slug: "globex"}},
+		}, nil)
+
+	result, err := interactor.ListMyTenants(ctx, input.Actor{UserID: "user-123", TenantID: "tenant-456", Role: model.UserRoleMember})
+
+	require.NoError(t, err)
+	require.Len(t, result, 2)
+	assert.Equal(t, "acme", result[0].TenantSlug)
+	assert.Equal(t, "admin", result[0].Role)
+	assert.False(t, result[0].Current)
+	assert.Equal(t, "globex", result[1].TenantSlug)
+	assert.True(t, result[1].Current)
+}
+
+func TestUserInteractor_UpdateDigest(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserRepo := mock.NewMockIUserRepository(ctrl)
+	mockMembershipRepo := mock.NewMockIMembershipRepository(ctrl)
+
+	interactor := NewUserInteractor(mockUserRepo, mockMembershipRepo, NewPermissionEvaluator(DefaultPermissionRules()))
+	ctx := context.Background()
+	actor := memberActor("user-123")
+
+	expectMember := func() {
+		mockUserRepo.EXPECT().FindByID(ctx, "user-123").Return(&model.User{ID: "user-123", Timezone: "UTC"}, nil)
+		mockMembershipRepo.EXPECT().FindByUserAndTenant(ctx, "user-123", "tenant-123").
+			Return(&model.Membership{ID: "membership-123", TenantID: "tenant-123", UserID: "user-123", Role: model.UserRoleMember, DigestTime: DefaultDigestTime}, nil)
+	}
+	saved := func(ctx context.Context, m *model.Membership) (*model.Membership, error) {
+		return m, nil
+	}
+
+	t.Run("normalizes the time", func(t *testing.T) {
+		expectMember()
+		zone := "Asia/Tokyo"
+		mockMembershipRepo.EXPECT().UpdateDigest(ctx, gomock.Any()).DoAndReturn(saved)
+
+		result, err := interactor.UpdateDigest(ctx, actor, &input.UpdateDigestInput{Enabled: true, Time: "7:30", Timezone: &zone})
+
+		require.NoError(t, err)
+		assert.True(t, result.Enabled)
+		assert.Equal(t, "07:30", result.Time)
+		assert.Equal(t, &zone, result.Timezone)
+	})
+
+	t.Run("omitted time and timezone reset to the defaults", func(t *testing.T) {
+		expectMember()
+		mockMembershipRepo.EXPECT().UpdateDigest(ctx, gomock.Any()).DoAndReturn(saved)
+
+		result, err := interactor.UpdateDigest(ctx, actor, &input.UpdateDigestInput{Enabled: true})
+
+		require.NoError(t, err)
+		assert.Equal(t, DefaultDigestTime, result.Time)
+		assert.Nil(t, result.Timezone)
+	})
+
+	t.Run("invalid input", func(t *testing.T) {
+		zone := "Mars/Olympus"
+		tests := []struct {
+			name string
+			inp  input.UpdateDigestInput
+			err  error
+		}{
+			{name: "time of day out of range", inp: input.UpdateDigestInput{Time: "24:00"}, err: ErrInvalidDigestTime},
+			{name: "twelve-hour time", inp: input.UpdateDigestInput{Time: "8am"}, err: ErrInvalidDigestTime},
+			{name: "unknown timezone", inp: input.UpdateDigestInput{Timezone: &zone}, err: ErrInvalidTimezone},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				expectMember()
+
+				_, err := interactor.UpdateDigest(ctx, actor, &tt.inp)
+
+				assert.Equal(t, tt.err, err)
+			})
+		}
+	})
+}